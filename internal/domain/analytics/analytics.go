@@ -1,6 +1,8 @@
 package analytics
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"nexus/internal/dto"
 	"sort"
@@ -14,53 +16,172 @@ func ObservedWeekdaysList(m map[string]float64) string {
 	if len(m) == 0 {
 		return ""
 	}
+	return strings.Join(sortedKeys(m), ", ")
+}
+
+// sortedKeys возвращает ключи m по возрастанию. Суммы по картам считаются в этом порядке: обход карты
+// в случайном порядке меняет последние биты суммы от вызова к вызову.
+// Пример: sortedKeys(map[string]float64{"b": 1, "a": 2}) -> [a b].
+func sortedKeys(m map[string]float64) []string {
 	keys := make([]string, 0, len(m))
-	for d := range m {
-		keys = append(keys, d)
+	for k := range m {
+		keys = append(keys, k)
 	}
 	sort.Strings(keys)
-	return strings.Join(keys, ", ")
+	return keys
 }
 
 // ComputeEnergyByWeekday считает среднюю энергию по дням недели (Mon, Tue и т.д.).
-// Пример: ComputeEnergyByWeekday(points)["Mon"] -> 63.2.
-func ComputeEnergyByWeekday(pts []dto.TrackPoint) map[string]float64 {
-	daySum := map[time.Weekday]float64{}
-	dayCnt := map[time.Weekday]float64{}
+// Пример: ComputeEnergyByWeekday(points, DefaultEnergyScoreParams)["Mon"] -> 63.2.
+func ComputeEnergyByWeekday(pts []dto.TrackPoint, ep EnergyScoreParams) map[string]float64 {
+	scores := energyScoresByWeekday(pts, ep)
+	out := make(map[string]float64, len(scores))
+	for d, s := range scores {
+		out[d] = meanOf(s)
+	}
+	return out
+}
 
+// ComputeEnergyByWeekdayCI дополняет ComputeEnergyByWeekday 95% доверительным интервалом среднего по
+// каждому дню недели: стандартная ошибка по выборочной дисперсии энергии за этот день недели и
+// t-распределение Стьюдента, так что дни с малым числом отметок получают широкий интервал. Интервал
+// обрезается шкалой 0–100; у дня с одной отметкой дисперсия не определена — StdErr nil, а интервал
+// занимает всю шкалу.
+// Пример: ComputeEnergyByWeekdayCI(points, DefaultEnergyScoreParams)["Mon"] -> {Mean: 63.2, StdErr: 4.1, Low: 54.1, High: 72.3, Samples: 5}.
+func ComputeEnergyByWeekdayCI(pts []dto.TrackPoint, ep EnergyScoreParams) map[string]dto.EnergyCI {
+	scores := energyScoresByWeekday(pts, ep)
+	out := make(map[string]dto.EnergyCI, len(scores))
+	for d, s := range scores {
+		m := meanOf(s)
+		ci := dto.EnergyCI{Mean: m, Samples: len(s), Low: 0, High: 100}
+		if len(s) >= 2 {
+			ss := 0.0
+			for _, v := range s {
+				ss += (v - m) * (v - m)
+			}
+			se := math.Sqrt(ss/float64(len(s)-1)) / math.Sqrt(float64(len(s)))
+			half := tCritical95(len(s)-1) * se
+			ci.StdErr = &se
+			ci.Low = math.Max(0, m-half)
+			ci.High = math.Min(100, m+half)
+		}
+		out[d] = ci
+	}
+	return out
+}
+
+// energyScoresByWeekday groups the energy score of every point by its weekday ("Mon", "Tue", ...).
+func energyScoresByWeekday(pts []dto.TrackPoint, ep EnergyScoreParams) map[string][]float64 {
+	out := map[string][]float64{}
 	for _, p := range pts {
-		d := p.TS.Weekday()
-		e := energyScore(p)
-		daySum[d] += e
-		dayCnt[d]++
+		d := p.TS.Weekday().String()[:3]
+		out[d] = append(out[d], energyScore(p, ep))
 	}
+	return out
+}
 
-	out := make(map[string]float64, len(dayCnt))
-	for d, c := range dayCnt {
-		if c <= 0 {
-			continue
+// tCriticalTable — двусторонние 95% квантили t-распределения по числу степеней свободы.
+var tCriticalTable = []struct {
+	df int
+	t  float64
+}{
+	{1, 12.706}, {2, 4.303}, {3, 3.182}, {4, 2.776}, {5, 2.571}, {6, 2.447}, {7, 2.365}, {8, 2.306},
+	{9, 2.262}, {10, 2.228}, {12, 2.179}, {15, 2.131}, {20, 2.086}, {30, 2.042}, {60, 2.000},
+}
+
+// tCritical95 returns the 95% t quantile for df, rounding df down to the nearest tabulated value;
+// past the table the normal 1.96 is close enough.
+func tCritical95(df int) float64 {
+	t := 1.96
+	if df > tCriticalTable[len(tCriticalTable)-1].df {
+		return t
+	}
+	for _, row := range tCriticalTable {
+		if row.df > df {
+			break
 		}
-		out[d.String()[:3]] = round2(daySum[d] / c)
+		t = row.t
 	}
-	return out
+	return t
+}
+
+// productivityWeights — веса компонентов интегрального показателя продуктивности (в сумме 1).
+// Каждый ключ описан в metricCatalog.
+var productivityWeights = map[string]float64{
+	"energy_mean":    0.40,
+	"energy_stable":  0.15,
+	"sleep_ok":       0.10,
+	"mood_ok":        0.10,
+	"sleep_quality":  0.08,
+	"focus_ok":       0.07,
+	"stress_ok":      0.05,
+	"self_energy_ok": 0.05,
+}
+
+// ProductivityWeights подставляет переопределения весов компонентов поверх productivityWeights
+// и нормализует сумму к 1. Неизвестный ключ, отрицательный вес или нулевая сумма — ошибка.
+// Пример: ProductivityWeights(map[string]float64{"sleep_ok": 0.3}) -> веса с sleep_ok 0.3, в сумме 1.
+func ProductivityWeights(overrides map[string]float64) (map[string]float64, error) {
+	weights := make(map[string]float64, len(productivityWeights))
+	for k, w := range productivityWeights {
+		weights[k] = w
+	}
+	for k, w := range overrides {
+		if _, ok := weights[k]; !ok {
+			return nil, fmt.Errorf("unknown productivity weight %q", k)
+		}
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("productivity weight %q must be a non-negative number", k)
+		}
+		weights[k] = w
+	}
+	sum := 0.0
+	for _, k := range sortedKeys(weights) {
+		sum += weights[k]
+	}
+	if sum == 0 {
+		return nil, errors.New("productivity weights must not all be zero")
+	}
+	for k := range weights {
+		weights[k] /= sum
+	}
+	return weights, nil
 }
 
+// MinProductivityPoints — столько отметок нужно модели продуктивности: по одной нельзя судить
+// о стабильности энергии, и без этой границы пустой период получал 100 баллов стабильности.
+const MinProductivityPoints = 2
+
 // ComputeProductivityModel строит интегральную модель продуктивности по дневным данным.
-// Пример: ComputeProductivityModel(points).Score -> 72.4.
-func ComputeProductivityModel(pts []dto.TrackPoint) dto.ProductivityModel {
-	weights := map[string]float64{
-		"energy_mean":    0.40,
-		"energy_stable":  0.15,
-		"sleep_ok":       0.10,
-		"mood_ok":        0.10,
-		"sleep_quality":  0.08,
-		"focus_ok":       0.07,
-		"stress_ok":      0.05,
-		"self_energy_ok": 0.05,
-	}
-
-	meanEnergy := meanEnergyScore(pts)
-	stability := 100 - stdEnergyScore(pts)
+// Меньше MinProductivityPoints отметок — нулевая модель с Insufficient = true.
+// Пример: ComputeProductivityModel(points, DefaultEnergyScoreParams).Score -> 72.4.
+func ComputeProductivityModel(pts []dto.TrackPoint, ep EnergyScoreParams) dto.ProductivityModel {
+	return ComputeProductivityModelWeights(pts, ep, nil)
+}
+
+// ComputeProductivityModelWeights — ComputeProductivityModel с весами компонентов weights
+// (из ProductivityWeights); nil — веса по умолчанию.
+func ComputeProductivityModelWeights(pts []dto.TrackPoint, ep EnergyScoreParams, weights map[string]float64) dto.ProductivityModel {
+	src := weights
+	if src == nil {
+		src = productivityWeights
+	}
+	// The returned model owns its Weights map.
+	weights = make(map[string]float64, len(src))
+	for k, w := range src {
+		weights[k] = w
+	}
+	if len(pts) < MinProductivityPoints {
+		return dto.ProductivityModel{
+			Weights:       weights,
+			Components:    map[string]float64{},
+			Contributions: map[string]float64{},
+			Insufficient:  true,
+		}
+	}
+
+	meanEnergy := meanEnergyScore(pts, ep)
+	stability := 100 - stdEnergyScore(pts, ep)
 	sleepOK := percentSleepInRange(pts, 7.0, 9.0)
 	moodOK := percentMoodAbove(pts, 6.5)
 	sleepQualityOK := percentFieldAbove(pts, func(p dto.TrackPoint) float64 { return p.SleepQuality }, 6.5)
@@ -68,98 +189,245 @@ func ComputeProductivityModel(pts []dto.TrackPoint) dto.ProductivityModel {
 	stressOK := percentFieldBelow(pts, func(p dto.TrackPoint) float64 { return p.Stress }, 5.5)
 	selfEnergyOK := percentFieldAbove(pts, func(p dto.TrackPoint) float64 { return p.Energy }, 6.0)
 
-	score := weights["energy_mean"]*meanEnergy +
-		weights["energy_stable"]*stability +
-		weights["sleep_ok"]*sleepOK +
-		weights["mood_ok"]*moodOK +
-		weights["sleep_quality"]*sleepQualityOK +
-		weights["focus_ok"]*focusOK +
-		weights["stress_ok"]*stressOK +
-		weights["self_energy_ok"]*selfEnergyOK
+	raw := map[string]float64{
+		"energy_mean":    meanEnergy,
+		"energy_stable":  stability,
+		"sleep_ok":       sleepOK,
+		"mood_ok":        moodOK,
+		"sleep_quality":  sleepQualityOK,
+		"focus_ok":       focusOK,
+		"stress_ok":      stressOK,
+		"self_energy_ok": selfEnergyOK,
+	}
+
+	score := 0.0
+	components := make(map[string]float64, len(raw))
+	contributions := make(map[string]float64, len(raw))
+	for _, k := range sortedKeys(raw) {
+		v := raw[k]
+		score += weights[k] * v
+		components[k] = v
+		contributions[k] = weights[k] * v
+	}
 
 	return dto.ProductivityModel{
-		Weights: weights,
-		Score:   round2(clamp(score, 0, 100)),
+		Weights:       weights,
+		Score:         clamp(score, 0, 100),
+		Components:    components,
+		Contributions: contributions,
 	}
 }
 
+// ComputeFocusByHour группирует отметки по локальному часу и считает среднюю концентрацию и энергию в каждом часе.
+// Пример: ComputeFocusByHour(points, DefaultEnergyScoreParams)[10] -> {Hour: 10, AvgFocus: 7.5, AvgEnergy: 68.1, Count: 4}.
+func ComputeFocusByHour(pts []dto.TrackPoint, ep EnergyScoreParams) map[int]dto.HourStat {
+	out := map[int]dto.HourStat{}
+	for _, p := range pts {
+		h := p.TS.Hour()
+		st := out[h]
+		st.Hour = h
+		st.AvgFocus += p.Concentration
+		st.AvgEnergy += energyScore(p, ep)
+		st.Count++
+		out[h] = st
+	}
+	for h, st := range out {
+		st.AvgFocus = round2(st.AvgFocus / float64(st.Count))
+		st.AvgEnergy = round2(st.AvgEnergy / float64(st.Count))
+		out[h] = st
+	}
+	return out
+}
+
+// TypicalLogTime усредняет время суток отметок по кругу (23:50 и 00:10 дают 00:00, а не 12:00).
+// Возвращает минуты от полуночи и концентрацию 0..1: близко к 1 — человек отмечается в одно и то же время,
+// близко к 0 — отметки разбросаны по суткам и привычного времени нет.
+// Пример: TypicalLogTime(points) -> 1290, 0.86 (около 21:30).
+func TypicalLogTime(pts []dto.TrackPoint) (int, float64) {
+	if len(pts) == 0 {
+		return 0, 0
+	}
+	var sumSin, sumCos float64
+	for _, p := range pts {
+		minutes := float64(p.TS.Hour()*60 + p.TS.Minute())
+		angle := 2 * math.Pi * minutes / 1440.0
+		sumSin += math.Sin(angle)
+		sumCos += math.Cos(angle)
+	}
+	n := float64(len(pts))
+	avgAngle := math.Atan2(sumSin/n, sumCos/n)
+	if avgAngle < 0 {
+		avgAngle += 2 * math.Pi
+	}
+	concentration := math.Hypot(sumSin/n, sumCos/n)
+	return int(math.Round(avgAngle*1440.0/(2*math.Pi))) % 1440, round2(concentration)
+}
+
+// burnoutSignals — сигналы выгорания в порядке вывода и их вклад в баллы риска.
+// Каждый код описан в metricCatalog.
+var burnoutSignals = []struct {
+	code   string
+	weight float64
+}{
+	{dto.BurnoutReasonSleepDebt, 25},
+	{dto.BurnoutReasonMoodDown, 20},
+	{dto.BurnoutReasonEnergyVolatile, 15},
+	{dto.BurnoutReasonLowProductivity, 20},
+	{dto.BurnoutReasonHighStress, 20},
+	{dto.BurnoutReasonLowSelfEnergy, 15},
+	{dto.BurnoutReasonPoorSleepQuality, 10},
+	{dto.BurnoutReasonAlcoholOften, 10},
+	{dto.BurnoutReasonWorkoutRare, 5},
+	{dto.BurnoutReasonSocialJetlag, 10},
+	{dto.BurnoutReasonLowCoverage, 10},
+}
+
+func burnoutSignalWeight(code string) float64 {
+	for _, s := range burnoutSignals {
+		if s.code == code {
+			return s.weight
+		}
+	}
+	return 0
+}
+
+// DefaultBurnoutHorizonDays — горизонт прогноза выгорания, если клиент не задал свой.
+const DefaultBurnoutHorizonDays = 14
+
+// HideableMetrics — метрики, которые пользователь может скрыть из разбора, если не отслеживает их.
+var HideableMetrics = map[string]struct{}{
+	"alcohol":       {},
+	"caffeine":      {},
+	"workout":       {},
+	"stress":        {},
+	"sleep_quality": {},
+	"concentration": {},
+	"activity":      {},
+}
+
 // ComputeBurnoutRisk оценивает риск выгорания по трендам сна/настроения/стресса и модели продуктивности.
-// Пример: ComputeBurnoutRisk(points, model).Level -> "medium".
-func ComputeBurnoutRisk(pts []dto.TrackPoint, model dto.ProductivityModel) dto.BurnoutRisk {
+// Окна трендов совпадают с горизонтом прогноза horizonDays (<= 0 — DefaultBurnoutHorizonDays).
+// Сигналы по метрикам из hidden не учитываются.
+// Пример: ComputeBurnoutRisk(points, model, 14, nil, DefaultEnergyScoreParams).Level -> "medium".
+func ComputeBurnoutRisk(pts []dto.TrackPoint, model dto.ProductivityModel, horizonDays int, hidden map[string]struct{}, ep EnergyScoreParams) dto.BurnoutRisk {
+	if horizonDays <= 0 {
+		horizonDays = DefaultBurnoutHorizonDays
+	}
+	visible := func(metric string) bool {
+		_, ok := hidden[metric]
+		return !ok
+	}
 	reasons := []string{}
+	window := horizonLabelRU(horizonDays)
 
-	sleepDebt := avgSleep(pts, 14) < 6.6
-	moodDown := moodTrend(pts, 14) < -0.15
-	energyVolatile := energyVolatility(pts, 14) > 18.0
+	sleepDebt := avgTotalSleep(pts, horizonDays) < 6.6
+	moodDown := TrendReliable(pts, horizonDays) && moodTrend(pts, horizonDays) < -0.15
+	energyVolatile := energyVolatility(pts, horizonDays, ep) > 18.0
 	lowProd := model.Score < 45
-	highStress := avgField(pts, func(p dto.TrackPoint) float64 { return p.Stress }) > 6.5
+	highStress := visible("stress") && avgField(pts, func(p dto.TrackPoint) float64 { return p.Stress }) > 6.5
 	lowSelfEnergy := avgField(pts, func(p dto.TrackPoint) float64 { return p.Energy }) < 4.5
-	poorSleepQuality := avgField(pts, func(p dto.TrackPoint) float64 { return p.SleepQuality }) < 6.0
-	alcoholOften := percentBool(pts, func(p dto.TrackPoint) bool { return p.Alcohol }) > 30
-	workoutRare := percentBool(pts, func(p dto.TrackPoint) bool { return p.Workout }) < 20
+	poorSleepQuality := visible("sleep_quality") && avgField(pts, func(p dto.TrackPoint) float64 { return p.SleepQuality }) < 6.0
+	alcoholOften := visible("alcohol") && percentBool(pts, func(p dto.TrackPoint) bool { return p.Alcohol }) > 30
+	workoutRare := visible("workout") && percentBool(pts, func(p dto.TrackPoint) bool { return p.Workout }) < 20
+	jetlag := SocialJetlag(pts)
 
 	score := 0.0
+	var structured []dto.BurnoutReason
+	add := func(code, reason string) {
+		weight := burnoutSignalWeight(code)
+		score += weight
+		reasons = append(reasons, reason)
+		structured = append(structured, dto.BurnoutReason{Code: code, Weight: weight, Severity: reasonSeverity(weight)})
+	}
 	if sleepDebt {
-		score += 25
-		reasons = append(reasons, "Накопление недосыпа за последние ~2 недели")
+		add(dto.BurnoutReasonSleepDebt, "Накопление недосыпа за последние "+window)
 	}
 	if moodDown {
-		score += 20
-		reasons = append(reasons, "Нисходящий тренд настроения за последние ~2 недели")
+		add(dto.BurnoutReasonMoodDown, "Нисходящий тренд настроения за последние "+window)
 	}
 	if energyVolatile {
-		score += 15
-		reasons = append(reasons, "Высокая волатильность энергии (резкие скачки)")
+		add(dto.BurnoutReasonEnergyVolatile, "Высокая волатильность энергии (резкие скачки)")
 	}
 	if lowProd {
-		score += 20
-		reasons = append(reasons, "Низкий интегральный показатель продуктивности")
+		add(dto.BurnoutReasonLowProductivity, "Низкий интегральный показатель продуктивности")
 	}
 	if highStress {
-		score += 20
-		reasons = append(reasons, "Высокий уровень стресса по самооценке")
+		add(dto.BurnoutReasonHighStress, "Высокий уровень стресса по самооценке")
 	}
 	if lowSelfEnergy {
-		score += 15
-		reasons = append(reasons, "Низкая самооценка энергии")
+		add(dto.BurnoutReasonLowSelfEnergy, "Низкая самооценка энергии")
 	}
 	if poorSleepQuality {
-		score += 10
-		reasons = append(reasons, "Низкое качество сна в среднем")
+		add(dto.BurnoutReasonPoorSleepQuality, "Низкое качество сна в среднем")
 	}
 	if alcoholOften {
-		score += 10
-		reasons = append(reasons, "Частые отметки алкоголя")
+		add(dto.BurnoutReasonAlcoholOften, "Частые отметки алкоголя")
 	}
 	if workoutRare {
-		score += 5
-		reasons = append(reasons, "Низкая регулярность тренировок")
+		add(dto.BurnoutReasonWorkoutRare, "Низкая регулярность тренировок")
+	}
+	if math.Abs(jetlag) >= socialJetlagBurnoutHours {
+		add(dto.BurnoutReasonSocialJetlag, fmt.Sprintf("Сдвиг режима сна в выходные на %.1f ч (социальный джетлаг)", math.Abs(jetlag)))
 	}
 
 	score = clamp(score, 0, 100)
-	level := "low"
-	switch {
-	case score >= 70:
-		level = "high"
-	case score >= 40:
-		level = "medium"
-	}
+	level := burnoutLevel(score)
 
 	if len(reasons) == 0 {
 		reasons = append(reasons, "Явных триггеров выгорания не найдено по текущим данным")
 	}
 
 	return dto.BurnoutRisk{
-		Score:                 round2(score),
+		Score:                 score,
 		Level:                 level,
 		Reasons:               reasons,
-		PredictionHorizonDays: 14,
+		StructuredReasons:     structured,
+		PredictionHorizonDays: horizonDays,
+		CoverageRatio:         LoggingCoverage(pts, horizonDays, time.Time{}),
 	}
 }
 
-// energyScore рассчитывает итоговый энергетический скор по показателям сна, настроения и активности.
-// Пример: energyScore(point) -> 71.3.
-func energyScore(p dto.TrackPoint) float64 {
+// burnoutLevel переводит баллы риска в уровень: high от 70, medium от 40, иначе low.
+func burnoutLevel(score float64) string {
+	switch {
+	case score >= 70:
+		return "high"
+	case score >= 40:
+		return "medium"
+	}
+	return "low"
+}
+
+// reasonSeverity переводит вклад сигнала в баллах риска в грубую градацию для клиентов.
+// Пример: reasonSeverity(25) -> "high".
+func reasonSeverity(weight float64) string {
+	switch {
+	case weight >= 20:
+		return "high"
+	case weight >= 15:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// horizonLabelRU форматирует окно в днях для текстов причин.
+// Пример: horizonLabelRU(14) -> "~2 недели", horizonLabelRU(10) -> "10 дн.".
+func horizonLabelRU(days int) string {
+	switch {
+	case days == 7:
+		return "~неделю"
+	case days%7 == 0 && days/7 >= 2 && days/7 <= 4:
+		return fmt.Sprintf("~%d недели", days/7)
+	default:
+		return fmt.Sprintf("%d дн.", days)
+	}
+}
+
+// energyScore рассчитывает итоговый энергетический скор по показателям сна, настроения и активности
+// со смесью компонент ep.
+// Пример: energyScore(point, DefaultEnergyScoreParams) -> 71.3.
+func energyScore(p dto.TrackPoint, ep EnergyScoreParams) float64 {
 	sleepComponent := 100 * math.Exp(-math.Pow((p.SleepHours-7.75)/2.0, 2))
 	sleepQuality := clamp01(p.SleepQuality/10.0) * 100
 	moodComponent := clamp01(p.Mood/10.0) * 100
@@ -167,22 +435,23 @@ func energyScore(p dto.TrackPoint) float64 {
 	energySelf := clamp01(p.Energy/10.0) * 100
 	focusComponent := clamp01(p.Concentration/10.0) * 100
 
-	e := 0.32*sleepComponent +
-		0.13*sleepQuality +
-		0.20*moodComponent +
-		0.12*actComponent +
-		0.18*energySelf +
-		0.05*focusComponent
+	e := ep.Sleep*sleepComponent +
+		ep.SleepQuality*sleepQuality +
+		ep.Mood*moodComponent +
+		ep.Activity*actComponent +
+		ep.SelfEnergy*energySelf +
+		ep.Focus*focusComponent
 
 	if p.Caffeine {
-		e += 2.5
+		e += ep.Caffeine
 	}
 	if p.Alcohol {
-		e -= 4.0
+		e += ep.Alcohol
 	}
 	if p.Workout {
-		e += 1.5
+		e += ep.Workout
 	}
+	e += napBonus(p.NapMinutes, ep.Nap)
 	return clamp(e, 0, 100)
 }
 
@@ -208,6 +477,17 @@ func round2(x float64) float64 {
 	return math.Round(x*100) / 100
 }
 
+func meanOf(vals []float64) float64 {
+	if len(vals) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range vals {
+		sum += v
+	}
+	return sum / float64(len(vals))
+}
+
 // percentSleepInRange считает процент дней, где сон в диапазоне [lo, hi].
 // Пример: percentSleepInRange(points, 7, 9) -> 65.
 func percentSleepInRange(pts []dto.TrackPoint, lo, hi float64) float64 {
@@ -283,10 +563,22 @@ func percentBool(pts []dto.TrackPoint, f func(dto.TrackPoint) bool) float64 {
 	return 100 * ok / float64(len(pts))
 }
 
+// windowEnd — конец окон «последние N дней»: время последней отметки, но не позже текущего момента,
+// чтобы одна отметка с будущим временем не сдвигала все окна. Для разбора прошлых дней это по-прежнему
+// последняя отметка, а не сегодня.
+// Пример: windowEnd(points) -> 2026-10-14 21:00 (последняя отметка), даже если за ней есть отметка на завтра.
+func windowEnd(pts []dto.TrackPoint) time.Time {
+	end := pts[len(pts)-1].TS
+	if now := time.Now(); end.After(now) {
+		return now.In(end.Location())
+	}
+	return end
+}
+
 // avgSleep считает среднее количество сна за последние days дней.
 // Пример: avgSleep(points, 14) -> 6.9.
 func avgSleep(pts []dto.TrackPoint, days int) float64 {
-	cut := pts[len(pts)-1].TS.AddDate(0, 0, -days)
+	cut := windowEnd(pts).AddDate(0, 0, -days)
 	var s float64
 	var c float64
 	for _, p := range pts {
@@ -301,6 +593,24 @@ func avgSleep(pts []dto.TrackPoint, days int) float64 {
 	return s / c
 }
 
+// avgTotalSleep — как avgSleep, но с дневным сном (см. totalSleepHours); по нему оценивается недосып.
+// Пример: avgTotalSleep(points, 14) -> 6.8.
+func avgTotalSleep(pts []dto.TrackPoint, days int) float64 {
+	cut := windowEnd(pts).AddDate(0, 0, -days)
+	var s float64
+	var c float64
+	for _, p := range pts {
+		if p.TS.After(cut) {
+			s += totalSleepHours(p)
+			c++
+		}
+	}
+	if c == 0 {
+		return 0
+	}
+	return s / c
+}
+
 // AvgSleepDays возвращает среднее количество сна за последние days дней.
 // Пример: AvgSleepDays(points, 14) -> 6.9.
 func AvgSleepDays(pts []dto.TrackPoint, days int) float64 {
@@ -316,7 +626,7 @@ func SleepDeltaDays(pts []dto.TrackPoint, days int) float64 {
 	if len(pts) == 0 || days <= 0 {
 		return 0
 	}
-	end := pts[len(pts)-1].TS
+	end := windowEnd(pts)
 	curFrom := end.AddDate(0, 0, -days)
 	prevFrom := end.AddDate(0, 0, -2*days)
 	prevTo := curFrom
@@ -344,10 +654,55 @@ func avgSleepBetween(pts []dto.TrackPoint, from, to time.Time) float64 {
 	return s / c
 }
 
+// minTrendCoverage — минимальная доля дней окна с отметками, при которой тренду можно верить.
+const minTrendCoverage = 0.5
+
+// TrendCoverage возвращает самый длинный разрыв (в днях без отметок) между соседними днями с отметками
+// за последние days дней и долю дней окна, в которые были отметки.
+// Пример: TrendCoverage(points, 14) -> 10, 0.21 (три отметки в начале окна и одна в конце).
+func TrendCoverage(pts []dto.TrackPoint, days int) (int, float64) {
+	if len(pts) == 0 || days <= 0 {
+		return 0, 0
+	}
+	cut := windowEnd(pts).AddDate(0, 0, -days)
+	seen := map[time.Time]struct{}{}
+	for _, p := range pts {
+		if p.TS.After(cut) {
+			seen[time.Date(p.TS.Year(), p.TS.Month(), p.TS.Day(), 0, 0, 0, 0, time.UTC)] = struct{}{}
+		}
+	}
+	logged := make([]time.Time, 0, len(seen))
+	for d := range seen {
+		logged = append(logged, d)
+	}
+	sort.Slice(logged, func(i, j int) bool { return logged[i].Before(logged[j]) })
+
+	maxGap := 0
+	for i := 1; i < len(logged); i++ {
+		gap := int(logged[i].Sub(logged[i-1]).Hours()/24) - 1
+		if gap > maxGap {
+			maxGap = gap
+		}
+	}
+	return maxGap, math.Min(1, float64(len(logged))/float64(days))
+}
+
+// TrendReliable сообщает, достаточно ли равномерно покрыто окно, чтобы делать выводы о тренде:
+// нужна доля дней с отметками не ниже minTrendCoverage и разрыв не длиннее трети окна.
+// Пример: TrendReliable(points, 14) -> false, если все отметки сбились в начало окна.
+func TrendReliable(pts []dto.TrackPoint, days int) bool {
+	maxGap, coverage := TrendCoverage(pts, days)
+	maxAllowedGap := days / 3
+	if maxAllowedGap < 2 {
+		maxAllowedGap = 2
+	}
+	return coverage >= minTrendCoverage && maxGap <= maxAllowedGap
+}
+
 // moodTrend оценивает тренд настроения (средняя разница половин периода).
 // Пример: moodTrend(points, 14) -> -0.2.
 func moodTrend(pts []dto.TrackPoint, days int) float64 {
-	cut := pts[len(pts)-1].TS.AddDate(0, 0, -days)
+	cut := windowEnd(pts).AddDate(0, 0, -days)
 	var arr []dto.TrackPoint
 	for _, p := range pts {
 		if p.TS.After(cut) {
@@ -390,41 +745,41 @@ func avgField(pts []dto.TrackPoint, f func(dto.TrackPoint) float64) float64 {
 }
 
 // meanEnergyScore считает среднюю энергию по energyScore для всех точек.
-// Пример: meanEnergyScore(points) -> 67.3.
-func meanEnergyScore(pts []dto.TrackPoint) float64 {
+// Пример: meanEnergyScore(points, DefaultEnergyScoreParams) -> 67.3.
+func meanEnergyScore(pts []dto.TrackPoint, ep EnergyScoreParams) float64 {
 	if len(pts) == 0 {
 		return 0
 	}
 	var s float64
 	for _, p := range pts {
-		s += energyScore(p)
+		s += energyScore(p, ep)
 	}
 	return s / float64(len(pts))
 }
 
 // stdEnergyScore считает стандартное отклонение energyScore для всех точек.
-// Пример: stdEnergyScore(points) -> 9.4.
-func stdEnergyScore(pts []dto.TrackPoint) float64 {
+// Пример: stdEnergyScore(points, DefaultEnergyScoreParams) -> 9.4.
+func stdEnergyScore(pts []dto.TrackPoint, ep EnergyScoreParams) float64 {
 	if len(pts) == 0 {
 		return 0
 	}
-	mean := meanEnergyScore(pts)
+	mean := meanEnergyScore(pts, ep)
 	var s float64
 	for _, p := range pts {
-		d := energyScore(p) - mean
+		d := energyScore(p, ep) - mean
 		s += d * d
 	}
 	return math.Sqrt(s / float64(len(pts)))
 }
 
 // energyVolatility оценивает волатильность энергии за последние days дней.
-// Пример: energyVolatility(points, 14) -> 12.4.
-func energyVolatility(pts []dto.TrackPoint, days int) float64 {
-	cut := pts[len(pts)-1].TS.AddDate(0, 0, -days)
+// Пример: energyVolatility(points, 14, DefaultEnergyScoreParams) -> 12.4.
+func energyVolatility(pts []dto.TrackPoint, days int, ep EnergyScoreParams) float64 {
+	cut := windowEnd(pts).AddDate(0, 0, -days)
 	var vals []float64
 	for _, p := range pts {
 		if p.TS.After(cut) {
-			vals = append(vals, energyScore(p))
+			vals = append(vals, energyScore(p, ep))
 		}
 	}
 	if len(vals) < 5 {
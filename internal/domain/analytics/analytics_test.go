@@ -0,0 +1,330 @@
+package analytics
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// steadyPoint is an unremarkable day: no burnout signal fires on it alone.
+func steadyPoint(ts time.Time) dto.TrackPoint {
+	return dto.TrackPoint{
+		TS: ts, SleepHours: 8, SleepQuality: 8, Mood: 7, Activity: 7, Productive: 7,
+		Stress: 3, Energy: 7, Concentration: 7, Workout: true,
+	}
+}
+
+// dailyPoints returns one steady point per day for the last days days, oldest first, ending an hour ago.
+func dailyPoints(days int) []dto.TrackPoint {
+	end := time.Now().Add(-time.Hour)
+	pts := make([]dto.TrackPoint, 0, days)
+	for i := days - 1; i >= 0; i-- {
+		pts = append(pts, steadyPoint(end.AddDate(0, 0, -i)))
+	}
+	return pts
+}
+
+func TestBurnoutHorizonScalesLookbacks(t *testing.T) {
+	// A week of long nights, then a week of short ones: only a 7-day lookback sees the debt.
+	pts := dailyPoints(14)
+	for i := range pts {
+		pts[i].SleepHours = 9
+		if i >= 7 {
+			pts[i].SleepHours = 5
+		}
+	}
+	model := ComputeProductivityModel(pts, DefaultEnergyScoreParams)
+
+	if got := avgTotalSleep(pts, 7); got != 5 {
+		t.Errorf("7-day sleep lookback = %v, want 5", got)
+	}
+	if got := avgTotalSleep(pts, 14); got <= 6.6 {
+		t.Errorf("14-day sleep lookback = %v, want above the debt threshold", got)
+	}
+
+	week := ComputeBurnoutRisk(pts, model, 7, nil, DefaultEnergyScoreParams)
+	twoWeeks := ComputeBurnoutRisk(pts, model, 14, nil, DefaultEnergyScoreParams)
+	if week.PredictionHorizonDays != 7 || twoWeeks.PredictionHorizonDays != 14 {
+		t.Fatalf("horizons = %d, %d", week.PredictionHorizonDays, twoWeeks.PredictionHorizonDays)
+	}
+	if week.Score == twoWeeks.Score {
+		t.Errorf("7- and 14-day horizons scored the same data equally: %v", week.Score)
+	}
+	if !hasReason(week, dto.BurnoutReasonSleepDebt) || hasReason(twoWeeks, dto.BurnoutReasonSleepDebt) {
+		t.Errorf("sleep debt: 7d=%v 14d=%v, want only the 7-day horizon", week.StructuredReasons, twoWeeks.StructuredReasons)
+	}
+	if !strings.Contains(strings.Join(week.Reasons, "\n"), "~неделю") {
+		t.Errorf("7-day reason text does not name the window: %v", week.Reasons)
+	}
+}
+
+func TestBurnoutHorizonDefault(t *testing.T) {
+	pts := dailyPoints(10)
+	model := ComputeProductivityModel(pts, DefaultEnergyScoreParams)
+	if got := ComputeBurnoutRisk(pts, model, 0, nil, DefaultEnergyScoreParams).PredictionHorizonDays; got != DefaultBurnoutHorizonDays {
+		t.Errorf("horizon 0 = %d days, want %d", got, DefaultBurnoutHorizonDays)
+	}
+}
+
+func hasReason(r dto.BurnoutRisk, code string) bool {
+	for _, sr := range r.StructuredReasons {
+		if sr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBurnoutReasonCodesMatchSignals(t *testing.T) {
+	pts := dailyPoints(14)
+	for i := range pts {
+		pts[i].Stress = 8
+		pts[i].Alcohol = i%2 == 0
+	}
+	model := ComputeProductivityModel(pts, DefaultEnergyScoreParams)
+	risk := ComputeBurnoutRisk(pts, model, 14, nil, DefaultEnergyScoreParams)
+
+	want := []dto.BurnoutReason{
+		{Code: dto.BurnoutReasonHighStress, Weight: 20, Severity: "high"},
+		{Code: dto.BurnoutReasonAlcoholOften, Weight: 10, Severity: "low"},
+	}
+	if len(risk.StructuredReasons) != len(want) {
+		t.Fatalf("structured reasons = %+v, want %+v", risk.StructuredReasons, want)
+	}
+	sum := 0.0
+	for i, r := range risk.StructuredReasons {
+		if r != want[i] {
+			t.Errorf("reason %d = %+v, want %+v", i, r, want[i])
+		}
+		sum += r.Weight
+	}
+	if sum != risk.Score {
+		t.Errorf("weights sum to %v, score is %v", sum, risk.Score)
+	}
+	if len(risk.Reasons) != len(risk.StructuredReasons) {
+		t.Errorf("%d prose reasons for %d structured ones", len(risk.Reasons), len(risk.StructuredReasons))
+	}
+
+	// Hiding the metrics drops their signals, prose and codes alike.
+	hidden := map[string]struct{}{"stress": {}, "alcohol": {}}
+	if r := ComputeBurnoutRisk(pts, model, 14, hidden, DefaultEnergyScoreParams); len(r.StructuredReasons) != 0 {
+		t.Errorf("hidden metrics still reported: %+v", r.StructuredReasons)
+	}
+}
+
+func TestBurnoutSignalCodesAreStable(t *testing.T) {
+	// Clients key icons and translations on these codes and weights; changing one is a breaking change.
+	want := map[string]float64{
+		"sleep_debt":         25,
+		"mood_down":          20,
+		"energy_volatile":    15,
+		"low_productivity":   20,
+		"high_stress":        20,
+		"low_self_energy":    15,
+		"poor_sleep_quality": 10,
+		"alcohol_often":      10,
+		"workout_rare":       5,
+		"social_jetlag":      10,
+		"low_coverage":       10,
+	}
+	if len(burnoutSignals) != len(want) {
+		t.Errorf("%d burnout signals, want %d", len(burnoutSignals), len(want))
+	}
+	for code, weight := range want {
+		if got := burnoutSignalWeight(code); got != weight {
+			t.Errorf("weight of %q = %v, want %v", code, got, weight)
+		}
+	}
+}
+
+func TestTrendGapsSuppressMoodTrend(t *testing.T) {
+	end := time.Now().Add(-time.Hour)
+	// Five logs in the first three days of the window, five in the last two: a ten-day hole in between.
+	var clustered []dto.TrackPoint
+	for _, back := range []float64{13, 12.5, 12, 11.5, 11} {
+		p := steadyPoint(end.Add(-time.Duration(back * 24 * float64(time.Hour))))
+		p.Mood = 9
+		clustered = append(clustered, p)
+	}
+	for _, back := range []float64{1.5, 1, 0.5, 0.25, 0} {
+		p := steadyPoint(end.Add(-time.Duration(back * 24 * float64(time.Hour))))
+		p.Mood = 2
+		clustered = append(clustered, p)
+	}
+	even := dailyPoints(14)
+	for i := range even {
+		even[i].Mood = 9
+		if i >= 7 {
+			even[i].Mood = 2
+		}
+	}
+
+	gap, coverage := TrendCoverage(clustered, 14)
+	if gap <= 14/3 || coverage >= minTrendCoverage {
+		t.Errorf("clustered: gap=%d coverage=%v, want a long gap and sparse coverage", gap, coverage)
+	}
+	if TrendReliable(clustered, 14) {
+		t.Error("clustered data is reported as a reliable trend")
+	}
+	gap, coverage = TrendCoverage(even, 14)
+	if gap != 0 || coverage != 1 {
+		t.Errorf("even: gap=%d coverage=%v, want 0 and 1", gap, coverage)
+	}
+	if !TrendReliable(even, 14) {
+		t.Error("evenly spread data is reported as unreliable")
+	}
+
+	// Both series fall from 9 to 2, but only the evenly spread one may claim a downward trend.
+	for name, c := range map[string]struct {
+		pts  []dto.TrackPoint
+		want bool
+	}{"clustered": {clustered, false}, "even": {even, true}} {
+		if moodTrend(c.pts, 14) >= -0.15 {
+			t.Fatalf("%s: mood trend %v is not falling", name, moodTrend(c.pts, 14))
+		}
+		risk := ComputeBurnoutRisk(c.pts, ComputeProductivityModel(c.pts, DefaultEnergyScoreParams), 14, nil, DefaultEnergyScoreParams)
+		if got := hasReason(risk, dto.BurnoutReasonMoodDown); got != c.want {
+			t.Errorf("%s: mood_down reported = %v, want %v", name, got, c.want)
+		}
+	}
+}
+
+func TestProductivityContributionsSumToScore(t *testing.T) {
+	pts := dailyPoints(14)
+	for i := range pts {
+		pts[i].SleepHours = 6 + float64(i%4)
+		pts[i].Mood = float64(3 + i%6)
+		pts[i].Stress = float64(2 + i%7)
+	}
+	model := ComputeProductivityModel(pts, DefaultEnergyScoreParams)
+	if model.Insufficient {
+		t.Fatal("model is insufficient on two weeks of data")
+	}
+	if len(model.Contributions) != len(productivityWeights) || len(model.Components) != len(productivityWeights) {
+		t.Fatalf("contributions %v / components %v do not cover every weight", model.Contributions, model.Components)
+	}
+	sum := 0.0
+	for k, c := range model.Contributions {
+		sum += c
+		// Both the component and the contribution are rounded to 0.01.
+		if want := model.Weights[k] * model.Components[k]; math.Abs(c-want) > 0.01 {
+			t.Errorf("%s: contribution %v, want weight × component = %v", k, c, want)
+		}
+	}
+	// Each contribution is rounded to 0.01, so the sum may drift by half a cent per component.
+	if tol := 0.005 * float64(len(model.Contributions)); math.Abs(sum-model.Score) > tol {
+		t.Errorf("contributions sum to %v, score is %v", sum, model.Score)
+	}
+
+	// The score is summed in key order, so repeated runs agree to the last bit.
+	for i := 0; i < 50; i++ {
+		if again := ComputeProductivityModel(pts, DefaultEnergyScoreParams).Score; again != model.Score {
+			t.Fatalf("run %d scored %v, first run %v", i, again, model.Score)
+		}
+	}
+}
+
+func TestHiddenAlcoholDropsOnlyItsSignal(t *testing.T) {
+	pts := dailyPoints(14)
+	for i := range pts {
+		pts[i].Stress = 8
+		pts[i].Alcohol = i%2 == 0
+	}
+	model := ComputeProductivityModel(pts, DefaultEnergyScoreParams)
+	risk := ComputeBurnoutRisk(pts, model, 14, map[string]struct{}{"alcohol": {}}, DefaultEnergyScoreParams)
+	if hasReason(risk, dto.BurnoutReasonAlcoholOften) {
+		t.Errorf("hidden alcohol still reported: %+v", risk.StructuredReasons)
+	}
+	if !hasReason(risk, dto.BurnoutReasonHighStress) || risk.Score != burnoutSignalWeight(dto.BurnoutReasonHighStress) {
+		t.Errorf("hiding alcohol changed the other signals: score=%v reasons=%+v", risk.Score, risk.StructuredReasons)
+	}
+}
+
+func TestClockMeanWrapsMidnight(t *testing.T) {
+	at := func(starts ...string) []dto.TrackPoint {
+		pts := make([]dto.TrackPoint, len(starts))
+		for i, s := range starts {
+			pts[i].SleepStart = s
+		}
+		return pts
+	}
+	start := func(p dto.TrackPoint) string { return p.SleepStart }
+
+	cases := []struct {
+		pts       []dto.TrackPoint
+		mean, q15 int
+	}{
+		{at("23:30", "00:30"), 0, 0},
+		{at("23:00", "23:50", "bad", ""), 23*60 + 25, 23*60 + 30},
+		{at("07:07"), 7*60 + 7, 7 * 60},
+	}
+	for _, c := range cases {
+		if got, ok := ClockMean(c.pts, start); !ok || got != c.mean {
+			t.Errorf("ClockMean(%v) = %d, %v; want %d", c.pts, got, ok, c.mean)
+		}
+		if got, ok := circularMeanClock(c.pts, start); !ok || got != c.q15 {
+			t.Errorf("circularMeanClock(%v) = %d, %v; want %d", c.pts, got, ok, c.q15)
+		}
+	}
+	if _, ok := ClockMean(at("", "soon"), start); ok {
+		t.Error("ClockMean found a mean without any valid time")
+	}
+}
+
+func TestProductivityModelNeedsTwoPoints(t *testing.T) {
+	for _, pts := range [][]dto.TrackPoint{nil, dailyPoints(1)} {
+		m := ComputeProductivityModel(pts, DefaultEnergyScoreParams)
+		if !m.Insufficient || m.Score != 0 || len(m.Components) != 0 || len(m.Contributions) != 0 {
+			t.Errorf("%d points: model = %+v, want a zeroed insufficient model", len(pts), m)
+		}
+		if len(m.Weights) != len(productivityWeights) {
+			t.Errorf("%d points: weights = %v, want the defaults", len(pts), m.Weights)
+		}
+	}
+
+	m := ComputeProductivityModel(dailyPoints(2), DefaultEnergyScoreParams)
+	if m.Insufficient || m.Score <= 0 || m.Components["energy_stable"] != 100 {
+		t.Errorf("two steady points: model = %+v, want a full score with perfect stability", m)
+	}
+}
+
+func TestEnergyCIWidensWithFewerSamples(t *testing.T) {
+	monday := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	mondays := func(n int) []dto.TrackPoint {
+		pts := make([]dto.TrackPoint, n)
+		for i := range pts {
+			pts[i] = steadyPoint(monday.AddDate(0, 0, 7*i))
+			if i%2 == 1 {
+				pts[i].Energy, pts[i].Mood = 9, 8
+			}
+		}
+		return pts
+	}
+
+	single := ComputeEnergyByWeekdayCI(mondays(1), DefaultEnergyScoreParams)["Mon"]
+	if single.StdErr != nil || single.Low != 0 || single.High != 100 || single.Samples != 1 {
+		t.Errorf("single sample = %+v, want no std err and the whole 0-100 scale", single)
+	}
+	if want := energyScore(steadyPoint(monday), DefaultEnergyScoreParams); single.Mean != want {
+		t.Errorf("single sample mean = %v, want %v", single.Mean, want)
+	}
+
+	prevWidth := 100.0
+	for _, n := range []int{2, 4, 8, 16} {
+		ci := ComputeEnergyByWeekdayCI(mondays(n), DefaultEnergyScoreParams)["Mon"]
+		if ci.StdErr == nil || *ci.StdErr <= 0 || ci.Samples != n {
+			t.Fatalf("%d samples: %+v, want a positive std err", n, ci)
+		}
+		if ci.Low > ci.Mean || ci.High < ci.Mean {
+			t.Errorf("%d samples: interval [%v, %v] misses the mean %v", n, ci.Low, ci.High, ci.Mean)
+		}
+		if width := ci.High - ci.Low; width >= prevWidth {
+			t.Errorf("%d samples: width %v, want narrower than %v with fewer samples", n, width, prevWidth)
+		} else {
+			prevWidth = width
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+const (
+	// sleepDebtDays — за сколько последних дней копится недосып.
+	sleepDebtDays = 7
+	// sleepDebtMinHours — меньший недосып считается погрешностью самооценки, режим менять не нужно.
+	sleepDebtMinHours = 0.5
+	// bedtimeRepayShare — какую долю недосыпа возвращать за одну ночь: долг гасится за несколько ночей,
+	// а не одним резким сдвигом, после которого человек просто не уснёт.
+	bedtimeRepayShare = 0.25
+	// bedtimeMinShift/bedtimeMaxShift — границы сдвига отбоя за ночь, в минутах.
+	bedtimeMinShift = 15
+	bedtimeMaxShift = 60
+	// bedtimeMinNights — сколько ночей с известным временем отбоя нужно для рекомендации.
+	bedtimeMinNights = 3
+)
+
+// Статусы BedtimeTonight.
+const (
+	BedtimeShiftEarlier     = "shift_earlier"
+	BedtimeMaintain         = "maintain"
+	BedtimeInsufficientData = "insufficient_data"
+)
+
+// SleepDebt — накопленный за последние sleepDebtDays дней недосып в часах: сумма нехватки до
+// scheduleTargetSleep по каждой отметке, дневной сон учитывается (см. totalSleepHours). Дни без отметок
+// не считаются, пересып одной ночи не гасит недосып другой.
+// Пример: три ночи по 6 часов за неделю -> SleepDebt(points) = 4.5.
+func SleepDebt(pts []dto.TrackPoint) float64 {
+	if len(pts) == 0 {
+		return 0
+	}
+	cut := windowEnd(pts).AddDate(0, 0, -sleepDebtDays)
+	debt := 0.0
+	for _, p := range pts {
+		if !p.TS.After(cut) || p.SleepHours <= 0 {
+			continue
+		}
+		debt += math.Max(0, scheduleTargetSleep-totalSleepHours(p))
+	}
+	return round2(debt)
+}
+
+// BedtimeTonight рекомендует время отбоя на сегодня: привычный отбой (круговое среднее SleepStart),
+// сдвинутый раньше на bedtimeRepayShare недосыпа, но в пределах bedtimeMinShift–bedtimeMaxShift минут
+// и не больше самого долга; шаг — 15 минут, переход через полночь учитывается. Без заметного недосыпа —
+// привычный отбой и статус maintain, меньше bedtimeMinNights ночей с отбоем — insufficient_data.
+// Пример: отбой в 23:30, недосып 4 часа -> BedtimeTonight(points).Bedtime = "22:30".
+func BedtimeTonight(pts []dto.TrackPoint) dto.BedtimeRecommendation {
+	out := dto.BedtimeRecommendation{Status: BedtimeInsufficientData}
+	nights := 0
+	for _, p := range pts {
+		if _, err := time.Parse("15:04", strings.TrimSpace(p.SleepStart)); err == nil {
+			nights++
+		}
+	}
+	usual, ok := circularMeanClock(pts, func(p dto.TrackPoint) string { return p.SleepStart })
+	if !ok || nights < bedtimeMinNights {
+		out.Message = "Недостаточно данных: отметь время отбоя хотя бы за три ночи."
+		return out
+	}
+	out.UsualBedtime = formatClock(usual)
+	out.SleepDebtHours = SleepDebt(pts)
+	if out.SleepDebtHours < sleepDebtMinHours {
+		out.Status = BedtimeMaintain
+		out.Bedtime = out.UsualBedtime
+		out.Message = "Недосыпа нет — сохраняй текущий режим и ложись около " + out.Bedtime + "."
+		return out
+	}
+	shift := math.Round(out.SleepDebtHours*bedtimeRepayShare*60/15) * 15
+	shift = math.Min(math.Max(shift, bedtimeMinShift), bedtimeMaxShift)
+	shift = math.Min(shift, math.Floor(out.SleepDebtHours*60/15)*15)
+	out.ShiftMinutes = int(shift)
+	out.Status = BedtimeShiftEarlier
+	out.Bedtime = formatClock(usual - out.ShiftMinutes)
+	out.Message = fmt.Sprintf("Ляг до %s — на %d мин раньше обычного, чтобы вернуть часть недосыпа (%.1f ч за неделю).",
+		out.Bedtime, out.ShiftMinutes, out.SleepDebtHours)
+	return out
+}
+
+// formatClock переводит минуты от полуночи (в том числе отрицательные или больше суток) в "HH:MM".
+func formatClock(minutes int) string {
+	minutes = ((minutes % 1440) + 1440) % 1440
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
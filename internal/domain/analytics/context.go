@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"nexus/internal/dto"
+)
+
+// MaxContextCategories — больше разных значений у ключа быть не может: это уже не категория
+// (температура, координаты), и группы по одной отметке ничего не показывают.
+const MaxContextCategories = 12
+
+var ErrContextNotCategorical = errors.New("context key is not categorical")
+
+// ContextBreakdown группирует отметки по значению ключа key из TrackPoint.Context (без учёта регистра)
+// и считает в каждой группе среднее заполненное настроение и среднюю энергию дня. Отметки без ключа
+// пропускаются. Группы идут от самой частой; больше MaxContextCategories значений — ErrContextNotCategorical.
+// Пример: ContextBreakdown(points, "weather", DefaultEnergyScoreParams) -> {NumPoints: 9, Groups: [{rain 5 5.8 58.2} {sun 4 7.1 70.4}]}.
+func ContextBreakdown(pts []dto.TrackPoint, key string, ep EnergyScoreParams) (dto.ContextBreakdown, error) {
+	out := dto.ContextBreakdown{Key: key, Groups: []dto.ContextGroup{}}
+	type acc struct {
+		n, moodN           int
+		moodSum, energySum float64
+	}
+	byValue := map[string]*acc{}
+	for _, p := range pts {
+		v := strings.ToLower(strings.TrimSpace(p.Context[key]))
+		if v == "" {
+			continue
+		}
+		a := byValue[v]
+		if a == nil {
+			if len(byValue) == MaxContextCategories {
+				return dto.ContextBreakdown{}, ErrContextNotCategorical
+			}
+			a = &acc{}
+			byValue[v] = a
+		}
+		a.n++
+		a.energySum += energyScore(p, ep)
+		if p.Has("mood") {
+			a.moodN++
+			a.moodSum += p.Mood
+		}
+		out.NumPoints++
+	}
+	for v, a := range byValue {
+		g := dto.ContextGroup{Value: v, NumPoints: a.n, AvgEnergy: round2(a.energySum / float64(a.n))}
+		if a.moodN > 0 {
+			g.AvgMood = round2(a.moodSum / float64(a.moodN))
+		}
+		out.Groups = append(out.Groups, g)
+	}
+	sort.Slice(out.Groups, func(i, j int) bool {
+		if out.Groups[i].NumPoints != out.Groups[j].NumPoints {
+			return out.Groups[i].NumPoints > out.Groups[j].NumPoints
+		}
+		return out.Groups[i].Value < out.Groups[j].Value
+	})
+	return out, nil
+}
@@ -0,0 +1,66 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+func TestContextBreakdown(t *testing.T) {
+	day := func(i int, weather string, mood float64) dto.TrackPoint {
+		p := dto.TrackPoint{
+			TS: time.Date(2026, 3, 2+i, 9, 0, 0, 0, time.UTC), SleepHours: 7, SleepQuality: 7,
+			Mood: mood, Activity: 6, Energy: 6, Concentration: 6,
+		}
+		if weather != "" {
+			p.Context = map[string]string{"weather": weather}
+		}
+		return p
+	}
+	pts := []dto.TrackPoint{
+		day(0, "rain", 4), day(1, "Rain ", 5), day(2, "rain", 6),
+		day(3, "sun", 8), day(4, "sun", 9),
+		day(5, "", 1), // no context: left out
+	}
+	noMood := day(6, "sun", 0)
+	noMood.Missing = map[string]bool{"mood": true}
+	pts = append(pts, noMood)
+
+	got, err := ContextBreakdown(pts, "weather", DefaultEnergyScoreParams)
+	if err != nil {
+		t.Fatalf("ContextBreakdown: %v", err)
+	}
+	if got.Key != "weather" || got.NumPoints != 6 || len(got.Groups) != 2 {
+		t.Fatalf("breakdown = %+v, want 6 points in 2 groups", got)
+	}
+	// Values are grouped case-insensitively; the unrated mood counts for energy but not for the mood mean.
+	rain, sun := got.Groups[0], got.Groups[1]
+	if rain.Value != "rain" || rain.NumPoints != 3 || rain.AvgMood != 5 {
+		t.Errorf("rain = %+v, want 3 points with mood 5", rain)
+	}
+	if sun.Value != "sun" || sun.NumPoints != 3 || sun.AvgMood != 8.5 {
+		t.Errorf("sun = %+v, want 3 points with mood 8.5", sun)
+	}
+	if sun.AvgEnergy <= rain.AvgEnergy {
+		t.Errorf("sun energy %v not above rain energy %v", sun.AvgEnergy, rain.AvgEnergy)
+	}
+
+	empty, err := ContextBreakdown(pts, "location", DefaultEnergyScoreParams)
+	if err != nil || !reflect.DeepEqual(empty, dto.ContextBreakdown{Key: "location", Groups: []dto.ContextGroup{}}) {
+		t.Errorf("unknown key = %+v, %v; want an empty breakdown", empty, err)
+	}
+
+	var continuous []dto.TrackPoint
+	for i := 0; i <= MaxContextCategories; i++ {
+		p := day(i, "", 6)
+		p.Context = map[string]string{"temperature": fmt.Sprint(i)}
+		continuous = append(continuous, p)
+	}
+	if _, err := ContextBreakdown(continuous, "temperature", DefaultEnergyScoreParams); !errors.Is(err, ErrContextNotCategorical) {
+		t.Errorf("temperature: err = %v, want ErrContextNotCategorical", err)
+	}
+}
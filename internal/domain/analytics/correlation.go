@@ -0,0 +1,122 @@
+package analytics
+
+import (
+	"math"
+
+	"nexus/internal/dto"
+)
+
+const (
+	// MinCorrelationSamples — меньше пар значений корреляция не считается: r на паре точек ничего не значит.
+	MinCorrelationSamples = 7
+	// maxCorrelationPoints ограничивает расчёт матрицы последними отметками.
+	maxCorrelationPoints = 1000
+	// correlationZ — порог значимости по z Фишера (двусторонний, p < 0.05).
+	correlationZ = 1.96
+)
+
+// Сила связи в ячейке матрицы корреляций.
+const (
+	CorrelationStrong       = "strong"
+	CorrelationModerate     = "moderate"
+	CorrelationWeak         = "weak"
+	CorrelationInsufficient = "insufficient_data"
+)
+
+type correlationMetric struct {
+	name  string
+	value func(dto.TrackPoint) float64
+}
+
+// correlationMetrics — показатели матрицы корреляций, в этом порядке; имена как в track_points.
+var correlationMetrics = []correlationMetric{
+	{"sleep_hours", func(p dto.TrackPoint) float64 { return p.SleepHours }},
+	{"mood", func(p dto.TrackPoint) float64 { return p.Mood }},
+	{"energy", func(p dto.TrackPoint) float64 { return p.Energy }},
+	{"stress", func(p dto.TrackPoint) float64 { return p.Stress }},
+	{"concentration", func(p dto.TrackPoint) float64 { return p.Concentration }},
+	{"productive", func(p dto.TrackPoint) float64 { return p.Productive }},
+}
+
+// Correlation считает коэффициент Пирсона для пар (xs[i], ys[i]). ok = false, если пар меньше
+// MinCorrelationSamples, длины не совпадают или один из рядов постоянный.
+// Пример: Correlation([]float64{6, 7, 8, 5, 9, 7, 6}, []float64{5, 6, 8, 4, 9, 7, 5}) -> 0.98, true.
+func Correlation(xs, ys []float64) (float64, bool) {
+	n := len(xs)
+	if n != len(ys) || n < MinCorrelationSamples {
+		return 0, false
+	}
+	var mx, my float64
+	for i := range xs {
+		mx += xs[i]
+		my += ys[i]
+	}
+	mx /= float64(n)
+	my /= float64(n)
+	var sxy, sxx, syy float64
+	for i := range xs {
+		dx, dy := xs[i]-mx, ys[i]-my
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	if sxx == 0 || syy == 0 {
+		return 0, false
+	}
+	return clamp(sxy/math.Sqrt(sxx*syy), -1, 1), true
+}
+
+// correlationSignificant проверяет r на n парах по z Фишера: atanh(r)·√(n−3) против correlationZ.
+func correlationSignificant(r float64, n int) bool {
+	if n <= 3 {
+		return false
+	}
+	if math.Abs(r) >= 1 {
+		return true
+	}
+	return math.Abs(math.Atanh(r)*math.Sqrt(float64(n-3))) >= correlationZ
+}
+
+func correlationStrength(r float64) string {
+	switch a := math.Abs(r); {
+	case a >= 0.5:
+		return CorrelationStrong
+	case a >= 0.3:
+		return CorrelationModerate
+	default:
+		return CorrelationWeak
+	}
+}
+
+// ComputeCorrelationMatrix считает корреляцию Пирсона для каждой пары показателей correlationMetrics
+// по последним maxCorrelationPoints отметкам (pts отсортированы по времени). В паре участвуют только
+// отметки, где заполнены оба показателя. Ячейки идут по верхнему треугольнику матрицы в порядке Metrics.
+// Пример: ComputeCorrelationMatrix(points).Cells[0] -> {A: "sleep_hours", B: "mood", R: 0.42, N: 28, Strength: "moderate", Significant: true}.
+func ComputeCorrelationMatrix(pts []dto.TrackPoint) dto.CorrelationMatrix {
+	if len(pts) > maxCorrelationPoints {
+		pts = pts[len(pts)-maxCorrelationPoints:]
+	}
+	out := dto.CorrelationMatrix{NumPoints: len(pts), Cells: []dto.CorrelationCell{}}
+	for _, m := range correlationMetrics {
+		out.Metrics = append(out.Metrics, m.name)
+	}
+	for i, a := range correlationMetrics {
+		for _, b := range correlationMetrics[i+1:] {
+			var xs, ys []float64
+			for _, p := range pts {
+				if p.Has(a.name) && p.Has(b.name) {
+					xs = append(xs, a.value(p))
+					ys = append(ys, b.value(p))
+				}
+			}
+			cell := dto.CorrelationCell{A: a.name, B: b.name, N: len(xs), Strength: CorrelationInsufficient}
+			if r, ok := Correlation(xs, ys); ok {
+				cell.R = round2(r)
+				cell.Strength = correlationStrength(r)
+				cell.Significant = correlationSignificant(r, len(xs))
+			}
+			out.Cells = append(out.Cells, cell)
+		}
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+func TestComputeCorrelationMatrix(t *testing.T) {
+	// Mood follows sleep exactly, stress mirrors it, concentration alternates independently of it
+	// and productivity never changes.
+	noise := []float64{0.5, -0.5, 0, 0.5, -0.5}
+	var pts []dto.TrackPoint
+	for i := 0; i < 20; i++ {
+		sleep := float64(5 + i%5)
+		pts = append(pts, dto.TrackPoint{
+			TS:            time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC).AddDate(0, 0, i),
+			SleepHours:    sleep,
+			Mood:          sleep - 1,
+			Energy:        sleep + noise[(i/5)%5],
+			Stress:        10 - sleep,
+			Concentration: float64(5 + (i/5)%2*2),
+			Productive:    6,
+		})
+	}
+	// Energy is unrated on most days, leaving too few pairs.
+	for i := 6; i < 20; i++ {
+		pts[i].Missing = map[string]bool{"energy": true}
+	}
+
+	m := ComputeCorrelationMatrix(pts)
+	if m.NumPoints != 20 || len(m.Metrics) != 6 || len(m.Cells) != 15 {
+		t.Fatalf("matrix = %d points, %d metrics, %d cells; want 20, 6, 15", m.NumPoints, len(m.Metrics), len(m.Cells))
+	}
+	cell := func(a, b string) dto.CorrelationCell {
+		for _, c := range m.Cells {
+			if c.A == a && c.B == b {
+				return c
+			}
+		}
+		t.Fatalf("no cell %s/%s", a, b)
+		return dto.CorrelationCell{}
+	}
+
+	cases := []struct {
+		a, b        string
+		r           float64
+		n           int
+		strength    string
+		significant bool
+	}{
+		{"sleep_hours", "mood", 1, 20, CorrelationStrong, true},
+		{"sleep_hours", "stress", -1, 20, CorrelationStrong, true},
+		{"sleep_hours", "concentration", 0, 20, CorrelationWeak, false},
+		{"sleep_hours", "productive", 0, 20, CorrelationInsufficient, false},
+		{"sleep_hours", "energy", 0, 6, CorrelationInsufficient, false},
+	}
+	for _, c := range cases {
+		got := cell(c.a, c.b)
+		if math.Abs(got.R-c.r) > 1e-9 || got.N != c.n || got.Strength != c.strength || got.Significant != c.significant {
+			t.Errorf("%s/%s = %+v, want r=%v n=%d %s significant=%v", c.a, c.b, got, c.r, c.n, c.strength, c.significant)
+		}
+	}
+
+	// Only the most recent maxCorrelationPoints points are used.
+	long := make([]dto.TrackPoint, maxCorrelationPoints+50)
+	for i := range long {
+		long[i] = pts[i%len(pts)]
+	}
+	if got := ComputeCorrelationMatrix(long).NumPoints; got != maxCorrelationPoints {
+		t.Errorf("long history: %d points used, want %d", got, maxCorrelationPoints)
+	}
+}
+
+func TestCorrelationSignificance(t *testing.T) {
+	// r = 0.5 is significant on 20 pairs (z ≈ 2.26) but not on 10 (z ≈ 1.45).
+	if !correlationSignificant(0.5, 20) || correlationSignificant(0.5, 10) {
+		t.Error("Fisher z guard does not depend on the sample size")
+	}
+	if correlationSignificant(0.99, 3) {
+		t.Error("three pairs passed the significance guard")
+	}
+}
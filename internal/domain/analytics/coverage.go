@@ -0,0 +1,84 @@
+package analytics
+
+import (
+	"slices"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// coverageBurnoutThreshold — доля дней с отметками, ниже которой срабатывает сигнал low_coverage.
+const coverageBurnoutThreshold = 0.5
+
+// awayTags — метки дней, когда человек уехал или болеет: пропуски рядом с ними — не отстранённость.
+var awayTags = []string{"vacation", "sick", "travel", "отпуск", "болезнь"}
+
+// LoggingCoverage — доля календарных дней с отметками за последние days дней. Окно начинается не раньше
+// дня first — первой отметки аккаунта, чтобы новичка не штрафовать за дни до регистрации; нулевой first —
+// самая ранняя отметка в pts. Давний пользователь, не отмечавшийся первую половину окна, получает около 0.5,
+// а не 1. Без отметок в окне — 0.
+// Пример: 10 отметок за 14 дней -> LoggingCoverage(points, 14, first) = 0.71.
+func LoggingCoverage(pts []dto.TrackPoint, days int, first time.Time) float64 {
+	if len(pts) == 0 || days <= 0 {
+		return 0
+	}
+	end := windowEnd(pts)
+	start := end.AddDate(0, 0, -days+1)
+	logged := map[string]struct{}{}
+	for _, p := range pts {
+		ts := p.TS.In(end.Location())
+		if first.IsZero() || ts.Before(first) {
+			first = ts
+		}
+		if ts.After(end) || ts.Before(dateOf(start)) {
+			continue
+		}
+		logged[ts.Format("2006-01-02")] = struct{}{}
+	}
+	if len(logged) == 0 {
+		return 0
+	}
+	from := dateOf(start)
+	if f := dateOf(first.In(end.Location())); f.After(from) {
+		from = f
+	}
+	total := int(dateOf(end).Sub(from).Hours()/24+0.5) + 1
+	return round2(float64(len(logged)) / float64(total))
+}
+
+// ApplyCoverageSignal добавляет к risk сигнал low_coverage, если risk.CoverageRatio ниже
+// coverageBurnoutThreshold, и пересчитывает баллы и уровень. Сигнал не срабатывает, если в окне прогноза
+// есть отметка с меткой отпуска или болезни (awayTags): пропуски тогда объяснимы. Сигнал выключен
+// по умолчанию и включается настройкой сервиса.
+// Пример: CoverageRatio 0.3, Score 35 -> ApplyCoverageSignal(risk, points).Score = 45, Level = "medium".
+func ApplyCoverageSignal(risk dto.BurnoutRisk, pts []dto.TrackPoint) dto.BurnoutRisk {
+	if len(pts) == 0 || risk.CoverageRatio >= coverageBurnoutThreshold {
+		return risk
+	}
+	cut := windowEnd(pts).AddDate(0, 0, -risk.PredictionHorizonDays)
+	for _, p := range pts {
+		if !p.TS.After(cut) {
+			continue
+		}
+		for _, tag := range p.Tags {
+			if slices.Contains(awayTags, tag) {
+				return risk
+			}
+		}
+	}
+	weight := burnoutSignalWeight(dto.BurnoutReasonLowCoverage)
+	if len(risk.StructuredReasons) == 0 {
+		risk.Reasons = nil
+	}
+	risk.Reasons = append(risk.Reasons, "Отметки меньше чем в половине дней за последние "+horizonLabelRU(risk.PredictionHorizonDays))
+	risk.StructuredReasons = append(risk.StructuredReasons, dto.BurnoutReason{
+		Code: dto.BurnoutReasonLowCoverage, Weight: weight, Severity: reasonSeverity(weight),
+	})
+	risk.Score = clamp(risk.Score+weight, 0, 100)
+	risk.Level = burnoutLevel(risk.Score)
+	return risk
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
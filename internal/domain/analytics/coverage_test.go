@@ -0,0 +1,34 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+func TestLoggingCoverageCountsFromTheFirstEntry(t *testing.T) {
+	// Logged for a month, stopped for a week, then logged the last 7 days of a 14-day window.
+	history := dailyPoints(45)
+	pts := append(append([]dto.TrackPoint(nil), history[:31]...), history[38:]...)
+	recent := pts[len(pts)-7:]
+	accountStart := pts[0].TS.AddDate(0, 0, -100)
+
+	cases := []struct {
+		name  string
+		pts   []dto.TrackPoint
+		first time.Time
+		want  float64
+	}{
+		{"long history in pts", pts, time.Time{}, 0.5},
+		{"only the window, account older", recent, accountStart, 0.5},
+		{"newcomer of 7 days", recent, time.Time{}, 1},
+		{"first entry inside the window", recent, recent[0].TS, 1},
+		{"no points", nil, accountStart, 0},
+	}
+	for _, c := range cases {
+		if got := LoggingCoverage(c.pts, 14, c.first); got != c.want {
+			t.Errorf("%s: coverage = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package analytics
+
+import (
+	"sort"
+	"strings"
+
+	"nexus/internal/dto"
+)
+
+// Виды записей в каталоге метрик.
+const (
+	MetricKindScore     = "score"
+	MetricKindComponent = "productivity_component"
+	MetricKindSignal    = "burnout_signal"
+)
+
+// DefaultMetricLanguage — язык каталога, если запрошенный не поддерживается.
+const DefaultMetricLanguage = "ru"
+
+type metricText struct {
+	name        string
+	description string
+}
+
+// metricCatalog — названия и описания метрик по языкам. Ключи: итоговые показатели, все ключи
+// productivityWeights и все коды burnoutSignals; добавляя компонент или сигнал, добавь и запись сюда.
+var metricCatalog = map[string]map[string]metricText{
+	"energy_score": {
+		"ru": {"Энергия дня", "Оценка 0–100 из сна (с учётом дневного сна), настроения, активности, стресса и самооценки энергии."},
+		"en": {"Daily energy", "A 0–100 score blended from sleep (including naps), mood, activity, stress and self-rated energy."},
+	},
+	"productivity_score": {
+		"ru": {"Продуктивность", "Интегральный показатель 0–100: взвешенная сумма компонентов продуктивности."},
+		"en": {"Productivity", "A 0–100 composite: the weighted sum of the productivity components."},
+	},
+	"burnout_risk": {
+		"ru": {"Риск выгорания", "Сумма баллов сработавших сигналов выгорания (0–100): от 40 — средний риск, от 70 — высокий. Нужно хотя бы 5 отметок."},
+		"en": {"Burnout risk", "The sum of the triggered burnout signals (0–100): 40+ is medium, 70+ is high. Needs at least 5 entries."},
+	},
+
+	"energy_mean": {
+		"ru": {"Средняя энергия", "Средняя энергия дня за период."},
+		"en": {"Average energy", "Mean daily energy over the period."},
+	},
+	"energy_stable": {
+		"ru": {"Стабильность энергии", "100 минус разброс энергии по дням: чем ровнее энергия, тем выше."},
+		"en": {"Energy stability", "100 minus the day-to-day spread of energy: steadier energy scores higher."},
+	},
+	"sleep_ok": {
+		"ru": {"Достаточный сон", "Доля дней со сном от 7 до 9 часов."},
+		"en": {"Enough sleep", "Share of days with 7 to 9 hours of sleep."},
+	},
+	"mood_ok": {
+		"ru": {"Хорошее настроение", "Доля дней с настроением выше 6.5 из 10."},
+		"en": {"Good mood", "Share of days with mood above 6.5 out of 10."},
+	},
+	"sleep_quality": {
+		"ru": {"Качество сна", "Доля дней с качеством сна выше 6.5 из 10."},
+		"en": {"Sleep quality", "Share of days with sleep quality above 6.5 out of 10."},
+	},
+	"focus_ok": {
+		"ru": {"Концентрация", "Доля дней с концентрацией выше 6 из 10."},
+		"en": {"Focus", "Share of days with concentration above 6 out of 10."},
+	},
+	"stress_ok": {
+		"ru": {"Низкий стресс", "Доля дней со стрессом ниже 5.5 из 10."},
+		"en": {"Low stress", "Share of days with stress below 5.5 out of 10."},
+	},
+	"self_energy_ok": {
+		"ru": {"Самооценка энергии", "Доля дней, когда собственная оценка энергии выше 6 из 10."},
+		"en": {"Self-rated energy", "Share of days with self-rated energy above 6 out of 10."},
+	},
+
+	dto.BurnoutReasonSleepDebt: {
+		"ru": {"Недосып", "Средний сон (с дневным) за горизонт прогноза меньше 6.6 часа."},
+		"en": {"Sleep debt", "Average sleep (including naps) over the forecast horizon is below 6.6 hours."},
+	},
+	dto.BurnoutReasonMoodDown: {
+		"ru": {"Настроение падает", "Устойчивый нисходящий тренд настроения за горизонт прогноза."},
+		"en": {"Mood declining", "A reliable downward mood trend over the forecast horizon."},
+	},
+	dto.BurnoutReasonEnergyVolatile: {
+		"ru": {"Скачки энергии", "Энергия сильно меняется от дня ко дню."},
+		"en": {"Volatile energy", "Energy swings sharply from day to day."},
+	},
+	dto.BurnoutReasonLowProductivity: {
+		"ru": {"Низкая продуктивность", "Показатель продуктивности ниже 45."},
+		"en": {"Low productivity", "Productivity score below 45."},
+	},
+	dto.BurnoutReasonHighStress: {
+		"ru": {"Высокий стресс", "Средний стресс выше 6.5 из 10."},
+		"en": {"High stress", "Average stress above 6.5 out of 10."},
+	},
+	dto.BurnoutReasonLowSelfEnergy: {
+		"ru": {"Мало сил", "Средняя самооценка энергии ниже 4.5 из 10."},
+		"en": {"Low energy", "Average self-rated energy below 4.5 out of 10."},
+	},
+	dto.BurnoutReasonPoorSleepQuality: {
+		"ru": {"Плохой сон", "Среднее качество сна ниже 6 из 10."},
+		"en": {"Poor sleep", "Average sleep quality below 6 out of 10."},
+	},
+	dto.BurnoutReasonAlcoholOften: {
+		"ru": {"Частый алкоголь", "Алкоголь отмечен больше чем в 30% дней."},
+		"en": {"Frequent alcohol", "Alcohol logged on more than 30% of days."},
+	},
+	dto.BurnoutReasonWorkoutRare: {
+		"ru": {"Редкие тренировки", "Тренировки отмечены меньше чем в 20% дней."},
+		"en": {"Rare workouts", "Workouts logged on fewer than 20% of days."},
+	},
+	dto.BurnoutReasonSocialJetlag: {
+		"ru": {"Социальный джетлаг", "Середина сна в выходные сдвинута на 2 часа и больше относительно будней."},
+		"en": {"Social jetlag", "The weekend sleep midpoint is shifted by 2 hours or more from weekdays."},
+	},
+	dto.BurnoutReasonLowCoverage: {
+		"ru": {"Редкие отметки", "Отметки меньше чем в половине дней окна прогноза; учитывается, только если сигнал включён, и не в отпуск или болезнь."},
+		"en": {"Sparse logging", "Fewer than half of the days in the forecast window are logged; counted only when the signal is enabled and not during vacation or sickness."},
+	},
+}
+
+// MetricDefinitions возвращает каталог метрик на языке lang (неизвестный язык — DefaultMetricLanguage)
+// и сам использованный язык. Компоненты продуктивности идут по убыванию веса, сигналы выгорания —
+// в порядке ComputeBurnoutRisk; Weight — доля в продуктивности или баллы риска.
+// Пример: MetricDefinitions("en") -> [{Code: "energy_score", Kind: "score", Name: "Daily energy", ...}, ...], "en".
+func MetricDefinitions(lang string) ([]dto.MetricDefinition, string) {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if _, ok := metricCatalog["energy_score"][lang]; !ok {
+		lang = DefaultMetricLanguage
+	}
+	out := make([]dto.MetricDefinition, 0, 3+len(productivityWeights)+len(burnoutSignals))
+	define := func(code, kind string, weight float64) {
+		t := metricCatalog[code][lang]
+		if t.name == "" {
+			t.name = code
+		}
+		out = append(out, dto.MetricDefinition{Code: code, Kind: kind, Name: t.name, Description: t.description, Weight: weight})
+	}
+
+	for _, code := range []string{"energy_score", "productivity_score", "burnout_risk"} {
+		define(code, MetricKindScore, 0)
+	}
+	components := make([]string, 0, len(productivityWeights))
+	for k := range productivityWeights {
+		components = append(components, k)
+	}
+	sort.Slice(components, func(i, j int) bool {
+		wi, wj := productivityWeights[components[i]], productivityWeights[components[j]]
+		if wi != wj {
+			return wi > wj
+		}
+		return components[i] < components[j]
+	})
+	for _, k := range components {
+		define(k, MetricKindComponent, productivityWeights[k])
+	}
+	for _, s := range burnoutSignals {
+		define(s.code, MetricKindSignal, s.weight)
+	}
+	return out, lang
+}
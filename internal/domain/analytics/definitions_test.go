@@ -0,0 +1,51 @@
+package analytics
+
+import "testing"
+
+func TestMetricCatalogCoversTheAlgorithm(t *testing.T) {
+	codes := []string{"energy_score", "productivity_score", "burnout_risk"}
+	for k := range productivityWeights {
+		codes = append(codes, k)
+	}
+	for _, s := range burnoutSignals {
+		codes = append(codes, s.code)
+	}
+	for _, code := range codes {
+		for _, lang := range []string{"ru", "en"} {
+			if txt := metricCatalog[code][lang]; txt.name == "" || txt.description == "" {
+				t.Errorf("%s has no %s name or description", code, lang)
+			}
+		}
+	}
+	if len(metricCatalog) != len(codes) {
+		t.Errorf("catalog has %d entries, the algorithm %d: stale entries left behind", len(metricCatalog), len(codes))
+	}
+}
+
+func TestMetricDefinitionsLocalization(t *testing.T) {
+	en, lang := MetricDefinitions(" EN ")
+	if lang != "en" || en[0].Code != "energy_score" || en[0].Name != "Daily energy" || en[0].Kind != MetricKindScore {
+		t.Fatalf("en definitions = %q, first %+v", lang, en[0])
+	}
+	ru, lang := MetricDefinitions("de")
+	if lang != DefaultMetricLanguage || ru[0].Name != "Энергия дня" {
+		t.Errorf("unknown language: %q, first %+v; want the default language", lang, ru[0])
+	}
+	if len(ru) != len(en) || len(en) != 3+len(productivityWeights)+len(burnoutSignals) {
+		t.Fatalf("%d ru and %d en definitions", len(ru), len(en))
+	}
+
+	// Components come by descending weight, signals in ComputeBurnoutRisk order.
+	prev := 2.0
+	for _, d := range en[3 : 3+len(productivityWeights)] {
+		if d.Kind != MetricKindComponent || d.Weight != productivityWeights[d.Code] || d.Weight > prev {
+			t.Errorf("component %+v out of order or mislabeled", d)
+		}
+		prev = d.Weight
+	}
+	for i, d := range en[3+len(productivityWeights):] {
+		if s := burnoutSignals[i]; d.Kind != MetricKindSignal || d.Code != s.code || d.Weight != s.weight {
+			t.Errorf("signal %d = %+v, want %s with weight %v", i, d, s.code, s.weight)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"nexus/internal/dto"
+)
+
+// EnergyScoreParams — веса компонент energyScore (в сумме 1) и фиксированные поправки за привычки в баллах.
+type EnergyScoreParams struct {
+	Sleep        float64
+	SleepQuality float64
+	Mood         float64
+	Activity     float64
+	SelfEnergy   float64
+	Focus        float64
+
+	Caffeine float64
+	Alcohol  float64
+	Workout  float64
+	// Nap — предельная прибавка за дневной сон; фактическая растёт с длиной сна и насыщается (см. napBonus).
+	Nap float64
+}
+
+// DefaultEnergyScoreParams — исходная смесь energyScore, на которую откалиброваны пороги риска и продуктивности.
+var DefaultEnergyScoreParams = EnergyScoreParams{
+	Sleep:        0.32,
+	SleepQuality: 0.13,
+	Mood:         0.20,
+	Activity:     0.12,
+	SelfEnergy:   0.18,
+	Focus:        0.05,
+	Caffeine:     2.5,
+	Alcohol:      -4.0,
+	Workout:      1.5,
+	Nap:          4.0,
+}
+
+// Normalize проверяет веса и приводит их сумму к 1; поправки за привычки не меняются.
+// Отрицательный вес или нулевая сумма весов — ошибка.
+// Пример: EnergyScoreParams{Sleep: 2, Mood: 2}.Normalize() -> {Sleep: 0.5, Mood: 0.5}.
+func (p EnergyScoreParams) Normalize() (EnergyScoreParams, error) {
+	weights := []*float64{&p.Sleep, &p.SleepQuality, &p.Mood, &p.Activity, &p.SelfEnergy, &p.Focus}
+	sum := 0.0
+	for _, w := range weights {
+		if *w < 0 || math.IsNaN(*w) || math.IsInf(*w, 0) {
+			return EnergyScoreParams{}, errors.New("energy score weights must be non-negative numbers")
+		}
+		sum += *w
+	}
+	if sum == 0 {
+		return EnergyScoreParams{}, errors.New("energy score weights must not all be zero")
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		for _, w := range weights {
+			*w /= sum
+		}
+	}
+	return p, nil
+}
+
+// ParseEnergyScoreParams разбирает переопределения вида "mood=0.3,sleep=0.25,alcohol=-5" поверх значений
+// по умолчанию и нормализует веса. Ключи: sleep, sleep_quality, mood, activity, self_energy, focus,
+// caffeine, alcohol, workout, nap.
+// Пример: ParseEnergyScoreParams("mood=0.4") -> веса по умолчанию с mood 0.4, нормализованные к сумме 1.
+func ParseEnergyScoreParams(s string) (EnergyScoreParams, error) {
+	overrides := map[string]float64{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, raw, ok := strings.Cut(part, "=")
+		if !ok {
+			return EnergyScoreParams{}, fmt.Errorf("energy score param %q: want key=value", part)
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return EnergyScoreParams{}, fmt.Errorf("energy score param %q: %w", key, err)
+		}
+		overrides[strings.TrimSpace(key)] = v
+	}
+	return DefaultEnergyScoreParams.With(overrides)
+}
+
+// With подставляет переопределения (ключи как в ParseEnergyScoreParams) поверх p и нормализует веса.
+// Пример: DefaultEnergyScoreParams.With(map[string]float64{"alcohol": -6}) -> поправка за алкоголь -6.
+func (p EnergyScoreParams) With(overrides map[string]float64) (EnergyScoreParams, error) {
+	fields := map[string]*float64{
+		"sleep":         &p.Sleep,
+		"sleep_quality": &p.SleepQuality,
+		"mood":          &p.Mood,
+		"activity":      &p.Activity,
+		"self_energy":   &p.SelfEnergy,
+		"focus":         &p.Focus,
+		"caffeine":      &p.Caffeine,
+		"alcohol":       &p.Alcohol,
+		"workout":       &p.Workout,
+		"nap":           &p.Nap,
+	}
+	for key, v := range overrides {
+		dst, ok := fields[key]
+		if !ok {
+			return EnergyScoreParams{}, fmt.Errorf("unknown energy score param %q", key)
+		}
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return EnergyScoreParams{}, fmt.Errorf("energy score param %q must be a number", key)
+		}
+		*dst = v
+	}
+	return p.Normalize()
+}
+
+// MeanEnergyScore — средний energyScore отметок (0–100) при параметрах ep; 0 без отметок.
+// Пример: MeanEnergyScore(points, DefaultEnergyScoreParams) -> 64.3.
+func MeanEnergyScore(pts []dto.TrackPoint, ep EnergyScoreParams) float64 {
+	return round2(meanEnergyScore(pts, ep))
+}
+
+const (
+	// napScaleMinutes — за столько минут дневной сон даёт ~63% предельной прибавки ep.Nap.
+	napScaleMinutes = 30.0
+	// napSleepWeight — с каким весом час дневного сна засчитывается в сон при оценке недосыпа.
+	napSleepWeight = 0.5
+)
+
+// napBonus — прибавка к energyScore за дневной сон: растёт с убывающей отдачей и не превышает maxBonus.
+// Пример: napBonus(20, 4) -> 1.95, napBonus(90, 4) -> 3.8.
+func napBonus(minutes int, maxBonus float64) float64 {
+	if minutes <= 0 {
+		return 0
+	}
+	return maxBonus * (1 - math.Exp(-float64(minutes)/napScaleMinutes))
+}
+
+// totalSleepHours — ночной сон плюс дневной с весом napSleepWeight.
+// Пример: totalSleepHours(TrackPoint{SleepHours: 6, NapMinutes: 60}) -> 6.5.
+func totalSleepHours(p dto.TrackPoint) float64 {
+	return p.SleepHours + napSleepWeight*float64(p.NapMinutes)/60.0
+}
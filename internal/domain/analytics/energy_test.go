@@ -0,0 +1,108 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+func TestEnergyScoreMoodEmphasis(t *testing.T) {
+	p := dto.TrackPoint{TS: time.Now(), SleepHours: 7, SleepQuality: 6, Mood: 5, Activity: 5, Energy: 5, Concentration: 5}
+	happier := p
+	happier.Mood = 8
+
+	moodOnly, err := ParseEnergyScoreParams("sleep=0,sleep_quality=0,mood=1,activity=0,self_energy=0,focus=0")
+	if err != nil {
+		t.Fatalf("ParseEnergyScoreParams: %v", err)
+	}
+	if got := energyScore(p, moodOnly); math.Abs(got-50) > 1e-9 {
+		t.Errorf("mood-only score of mood 5 = %v, want 50", got)
+	}
+
+	// Each mood point is worth weight × 10 score points, so doubling the mood weight doubles the gain.
+	emphasized, err := DefaultEnergyScoreParams.With(map[string]float64{"mood": 2 * DefaultEnergyScoreParams.Mood})
+	if err != nil {
+		t.Fatalf("With: %v", err)
+	}
+	gain := func(ep EnergyScoreParams) float64 { return energyScore(happier, ep) - energyScore(p, ep) }
+	if want := 3 * 10 * DefaultEnergyScoreParams.Mood; math.Abs(gain(DefaultEnergyScoreParams)-want) > 1e-9 {
+		t.Errorf("default gain of +3 mood = %v, want %v", gain(DefaultEnergyScoreParams), want)
+	}
+	if want := 3 * 10 * emphasized.Mood; math.Abs(gain(emphasized)-want) > 1e-9 || gain(emphasized) <= gain(DefaultEnergyScoreParams) {
+		t.Errorf("emphasized gain of +3 mood = %v, want %v and above the default", gain(emphasized), want)
+	}
+}
+
+func TestEnergyScoreParamsNormalize(t *testing.T) {
+	ep, err := ParseEnergyScoreParams("mood=0.4,alcohol=-6")
+	if err != nil {
+		t.Fatalf("ParseEnergyScoreParams: %v", err)
+	}
+	if sum := ep.Sleep + ep.SleepQuality + ep.Mood + ep.Activity + ep.SelfEnergy + ep.Focus; math.Abs(sum-1) > 1e-9 {
+		t.Errorf("weights sum to %v, want 1", sum)
+	}
+	if ep.Alcohol != -6 || ep.Caffeine != DefaultEnergyScoreParams.Caffeine {
+		t.Errorf("habit deltas = alcohol %v caffeine %v; they are not weights and must stay as given", ep.Alcohol, ep.Caffeine)
+	}
+	if ep.Mood <= DefaultEnergyScoreParams.Mood {
+		t.Errorf("mood weight %v did not grow from %v", ep.Mood, DefaultEnergyScoreParams.Mood)
+	}
+
+	for _, bad := range []string{"mood", "mood=x", "joy=1", "mood=-0.1", "sleep=0,sleep_quality=0,mood=0,activity=0,self_energy=0,focus=0"} {
+		if _, err := ParseEnergyScoreParams(bad); err == nil {
+			t.Errorf("%q was accepted", bad)
+		}
+	}
+	if ep, err := ParseEnergyScoreParams(""); err != nil || ep != DefaultEnergyScoreParams {
+		t.Errorf("empty overrides = %+v, %v; want the defaults", ep, err)
+	}
+}
+
+func TestNapEnergyAndSleepDebt(t *testing.T) {
+	ep := DefaultEnergyScoreParams
+	base := dto.TrackPoint{TS: time.Now(), SleepHours: 6, SleepQuality: 6, Mood: 5, Activity: 5, Energy: 5, Concentration: 5}
+	score := func(minutes int) float64 {
+		p := base
+		p.NapMinutes = minutes
+		return energyScore(p, ep)
+	}
+
+	if score(0) != energyScore(base, ep) {
+		t.Errorf("a zero nap changed the score: %v vs %v", score(0), energyScore(base, ep))
+	}
+	prev, prevGain := score(0), math.Inf(1)
+	for _, m := range []int{20, 40, 60, 90, 300} {
+		s := score(m)
+		gain := s - prev
+		if gain <= 0 || gain >= prevGain {
+			t.Errorf("nap %d min: gain %v after %v, want positive and diminishing", m, gain, prevGain)
+		}
+		prev, prevGain = s, gain
+	}
+	if bonus := score(300) - score(0); bonus > ep.Nap || bonus < 0.99*ep.Nap {
+		t.Errorf("long nap bonus = %v, want just under the cap %v", bonus, ep.Nap)
+	}
+
+	end := time.Now()
+	nights := func(napMinutes int) []dto.TrackPoint {
+		var pts []dto.TrackPoint
+		for i := 0; i < 3; i++ {
+			p := base
+			p.TS, p.NapMinutes = end.AddDate(0, 0, -i), napMinutes
+			pts = append(pts, p)
+		}
+		return pts
+	}
+	// Three 6-hour nights against the 7.5-hour target; an hour of nap counts as half an hour of sleep.
+	if got := SleepDebt(nights(0)); got != 4.5 {
+		t.Errorf("sleep debt without naps = %v, want 4.5", got)
+	}
+	if got := SleepDebt(nights(60)); got != 3 {
+		t.Errorf("sleep debt with hour naps = %v, want 3", got)
+	}
+	if got := SleepDebt(nights(300)); got != 0 {
+		t.Errorf("sleep debt with long naps = %v, want 0", got)
+	}
+}
@@ -0,0 +1,114 @@
+package analytics
+
+import (
+	"math"
+	"sort"
+
+	"nexus/internal/dto"
+)
+
+const (
+	// outlierMinDays — меньше дней с отметками, и выброс от обычного дня не отличить.
+	outlierMinDays = 7
+	// outlierZ — порог модифицированного z-показателя (по медиане и MAD) дневного energy score.
+	outlierZ = 3.5
+)
+
+// ExcludeDays убирает из разбора логические дни целиком (сутки начинаются в dayStartHour): день выпадает,
+// если хотя бы одна его отметка несёт метку из tags, а при outliers — ещё и если его дневной energy score
+// (по свёрнутой через CollapseToDaily точке) выбивается из остальных дней по модифицированному
+// z-показателю больше outlierZ. Выбросы ищутся среди дней, оставшихся после меток, и только от
+// outlierMinDays дней. Возвращает оставшиеся отметки и число убранных дней.
+// Пример: ExcludeDays(points, 4, []string{"sick"}, false, ep) -> (точки без двух больничных дней, 2).
+func ExcludeDays(pts []dto.TrackPoint, dayStartHour int, tags []string, outliers bool, ep EnergyScoreParams) ([]dto.TrackPoint, int) {
+	if len(tags) == 0 && !outliers {
+		return pts, 0
+	}
+	drop := map[string]bool{}
+	if len(tags) > 0 {
+		excluded := make(map[string]struct{}, len(tags))
+		for _, t := range tags {
+			excluded[t] = struct{}{}
+		}
+		for _, p := range pts {
+			for _, t := range p.Tags {
+				if _, ok := excluded[t]; ok {
+					drop[dayKey(p, dayStartHour)] = true
+					break
+				}
+			}
+		}
+	}
+	if outliers {
+		var kept []dto.TrackPoint
+		for _, p := range pts {
+			if !drop[dayKey(p, dayStartHour)] {
+				kept = append(kept, p)
+			}
+		}
+		for day := range outlierDays(kept, dayStartHour, ep) {
+			drop[day] = true
+		}
+	}
+	if len(drop) == 0 {
+		return pts, 0
+	}
+	out := make([]dto.TrackPoint, 0, len(pts))
+	for _, p := range pts {
+		if !drop[dayKey(p, dayStartHour)] {
+			out = append(out, p)
+		}
+	}
+	return out, len(drop)
+}
+
+// outlierDays returns the days whose energy score has a modified z-score above outlierZ.
+func outlierDays(pts []dto.TrackPoint, dayStartHour int, ep EnergyScoreParams) map[string]bool {
+	scores := DailyEnergyScores(pts, dayStartHour, ep)
+	out := map[string]bool{}
+	if len(scores) < outlierMinDays {
+		return out
+	}
+	vals := make([]float64, 0, len(scores))
+	for _, v := range scores {
+		vals = append(vals, v)
+	}
+	med := medianOf(vals)
+	devs := make([]float64, len(vals))
+	for i, v := range vals {
+		devs[i] = math.Abs(v - med)
+	}
+	// Scale of a normal distribution estimated from the MAD; when most days are identical the MAD is 0
+	// and the mean absolute deviation stands in for it.
+	scale := medianOf(devs) / 0.6745
+	if scale == 0 {
+		sum := 0.0
+		for _, d := range devs {
+			sum += d
+		}
+		scale = 1.253314 * sum / float64(len(devs))
+	}
+	if scale == 0 {
+		return out
+	}
+	for day, v := range scores {
+		if math.Abs(v-med)/scale > outlierZ {
+			out[day] = true
+		}
+	}
+	return out
+}
+
+func dayKey(p dto.TrackPoint, dayStartHour int) string {
+	return logicalDay(p.TS, dayStartHour).Format("2006-01-02")
+}
+
+func medianOf(vals []float64) float64 {
+	s := append([]float64(nil), vals...)
+	sort.Float64s(s)
+	n := len(s)
+	if n%2 == 1 {
+		return s[n/2]
+	}
+	return (s[n/2-1] + s[n/2]) / 2
+}
@@ -0,0 +1,82 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// spikeDays returns days steady noon points starting on 2026-03-02, with the days at sick made into a
+// bad spike (stress 9, mood 2) tagged "sick".
+func spikeDays(days int, sick ...int) []dto.TrackPoint {
+	start := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	pts := make([]dto.TrackPoint, 0, days)
+	for i := 0; i < days; i++ {
+		pts = append(pts, steadyPoint(start.AddDate(0, 0, i)))
+	}
+	for _, i := range sick {
+		pts[i].Stress, pts[i].Mood, pts[i].Energy = 9, 2, 2
+		pts[i].Tags = []string{"sick"}
+	}
+	return pts
+}
+
+func stressOf(pts []dto.TrackPoint) float64 {
+	vals := make([]float64, len(pts))
+	for i, p := range pts {
+		vals[i] = p.Stress
+	}
+	return meanOf(vals)
+}
+
+func TestExcludeTaggedSpikeRestoresTheBaseline(t *testing.T) {
+	pts := spikeDays(10, 3, 4)
+	// Untagged entries of the same logical days: late evening and, with a 04:00 day start, 02:00 the next morning.
+	late := steadyPoint(pts[3].TS.Add(11 * time.Hour))
+	early := steadyPoint(pts[4].TS.Add(14 * time.Hour))
+	pts = append(pts, late, early)
+
+	if got := stressOf(pts); got < 4 {
+		t.Fatalf("stress with the spike = %v, want it pulled above the baseline 3", got)
+	}
+	kept, excluded := ExcludeDays(pts, 4, []string{"sick"}, false, DefaultEnergyScoreParams)
+	if excluded != 2 {
+		t.Fatalf("excluded = %d, want 2", excluded)
+	}
+	if len(kept) != 8 {
+		t.Fatalf("kept %d points, want 8: every entry of a tagged day goes", len(kept))
+	}
+	if got := stressOf(kept); got != 3 {
+		t.Errorf("stress without the spike = %v, want 3", got)
+	}
+	for day, score := range DailyEnergyScores(kept, 4, DefaultEnergyScoreParams) {
+		if want := round2(energyScore(steadyPoint(time.Time{}), DefaultEnergyScoreParams)); score != want {
+			t.Errorf("%s: energy score = %v, want the steady %v", day, score, want)
+		}
+	}
+
+	if got, n := ExcludeDays(pts, 4, []string{"vacation"}, false, DefaultEnergyScoreParams); n != 0 || len(got) != len(pts) {
+		t.Errorf("unused tag: kept %d of %d, excluded %d", len(got), len(pts), n)
+	}
+}
+
+func TestExcludeOutliers(t *testing.T) {
+	// The spike is untagged: only the outlier check can find it.
+	pts := spikeDays(10, 6)
+	pts[6].Tags = nil
+
+	kept, excluded := ExcludeDays(pts, 4, nil, true, DefaultEnergyScoreParams)
+	if excluded != 1 || len(kept) != 9 {
+		t.Fatalf("kept %d, excluded %d; want 9 and 1", len(kept), excluded)
+	}
+	if got := stressOf(kept); got != 3 {
+		t.Errorf("stress without the outlier = %v, want 3", got)
+	}
+
+	short := spikeDays(outlierMinDays-1, 2)
+	short[2].Tags = nil
+	if _, n := ExcludeDays(short, 4, nil, true, DefaultEnergyScoreParams); n != 0 {
+		t.Errorf("%d days: excluded %d, want 0 below outlierMinDays", len(short), n)
+	}
+}
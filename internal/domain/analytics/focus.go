@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// ComputeFocusStats сводит сессии фокуса: число, суммарные часы, среднюю длину и оценку, а также лучшие
+// часы начала по средней оценке сессий (час учитывается, если в нём начиналось хотя бы scheduleMinSamples
+// сессий). Часы считаются в зоне loc.
+// Пример: ComputeFocusStats(sessions, loc).BestHours -> ["09:00", "10:00"].
+func ComputeFocusStats(sessions []dto.FocusSession, loc *time.Location) dto.FocusStats {
+	out := dto.FocusStats{BestHours: []string{}}
+	if len(sessions) == 0 {
+		return out
+	}
+	type hourAgg struct {
+		quality float64
+		count   int
+	}
+	byHour := map[int]*hourAgg{}
+	var minutes, quality float64
+	for _, s := range sessions {
+		minutes += s.EndedAt.Sub(s.StartedAt).Minutes()
+		quality += s.Quality
+		h := s.StartedAt.In(loc).Hour()
+		if byHour[h] == nil {
+			byHour[h] = &hourAgg{}
+		}
+		byHour[h].quality += s.Quality
+		byHour[h].count++
+	}
+	n := float64(len(sessions))
+	out.NumSessions = len(sessions)
+	out.TotalHours = minutes / 60
+	out.AvgSessionMinutes = minutes / n
+	out.AvgQuality = quality / n
+
+	hours := make([]int, 0, len(byHour))
+	for h, agg := range byHour {
+		if agg.count >= scheduleMinSamples {
+			hours = append(hours, h)
+		}
+	}
+	avg := func(h int) float64 { return byHour[h].quality / float64(byHour[h].count) }
+	sort.Slice(hours, func(i, j int) bool {
+		if avg(hours[i]) != avg(hours[j]) {
+			return avg(hours[i]) > avg(hours[j])
+		}
+		return hours[i] < hours[j]
+	})
+	best := map[int]struct{}{}
+	for _, h := range hours {
+		if len(best) == scheduleFocusHours {
+			break
+		}
+		best[h] = struct{}{}
+	}
+	out.BestHours = formatHours(best)
+	return out
+}
@@ -0,0 +1,108 @@
+package analytics
+
+import (
+	"sort"
+
+	"nexus/internal/dto"
+)
+
+// goalWindowDays — за сколько последних дней считается выполнение целей.
+const goalWindowDays = 7
+
+// goalSpec — допустимая цель по метрике: верхняя граница значения и направление.
+type goalSpec struct {
+	max           float64
+	lowerIsBetter bool
+}
+
+// goalMetrics — метрики, по которым можно поставить цель. Для стресса цель — не выше target,
+// для остальных — не ниже.
+var goalMetrics = map[string]goalSpec{
+	"sleep_hours":   {max: 24},
+	"sleep_quality": {max: 10},
+	"mood":          {max: 10},
+	"energy":        {max: 10},
+	"concentration": {max: 10},
+	"productive":    {max: 10},
+	"activity":      {max: 10},
+	"stress":        {max: 10, lowerIsBetter: true},
+}
+
+// ValidGoal сообщает, можно ли поставить цель target по метрике metric.
+// Пример: ValidGoal("sleep_hours", 8) -> true, ValidGoal("mood", 11) -> false.
+func ValidGoal(metric string, target float64) bool {
+	spec, ok := goalMetrics[metric]
+	return ok && target > 0 && target <= spec.max
+}
+
+// GoalLowerIsBetter — цель по metric выполнена, когда значение не выше target (стресс).
+func GoalLowerIsBetter(metric string) bool {
+	return goalMetrics[metric].lowerIsBetter
+}
+
+// GoalAttainment считает, в скольких из последних goalWindowDays логических дней (сутки начинаются
+// в dayStartHour) с заполненной метрикой цель выполнена: среднее за день не ниже target (для стресса —
+// не выше). Цели без таких дней и неизвестные метрики пропускаются; порядок — по имени метрики.
+// Пример: GoalAttainment(points, {"sleep_hours": 8}, 4) -> [{Metric: "sleep_hours", Target: 8, Met: 5, Days: 7}].
+func GoalAttainment(pts []dto.TrackPoint, goals map[string]float64, dayStartHour int) []dto.GoalProgress {
+	if len(pts) == 0 || len(goals) == 0 {
+		return nil
+	}
+	cut := windowEnd(pts).AddDate(0, 0, -goalWindowDays)
+	var out []dto.GoalProgress
+	for metric, target := range goals {
+		spec, ok := goalMetrics[metric]
+		if !ok {
+			continue
+		}
+		sums := map[string]float64{}
+		counts := map[string]int{}
+		for _, p := range pts {
+			if !p.TS.After(cut) || !p.Has(metric) {
+				continue
+			}
+			v, ok := goalValue(p, metric)
+			if !ok {
+				continue
+			}
+			day := dayKey(p, dayStartHour)
+			sums[day] += v
+			counts[day]++
+		}
+		if len(counts) == 0 {
+			continue
+		}
+		g := dto.GoalProgress{Metric: metric, Target: target, Days: len(counts)}
+		for day, n := range counts {
+			avg := sums[day] / float64(n)
+			if (spec.lowerIsBetter && avg <= target) || (!spec.lowerIsBetter && avg >= target) {
+				g.Met++
+			}
+		}
+		out = append(out, g)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Metric < out[j].Metric })
+	return out
+}
+
+func goalValue(p dto.TrackPoint, metric string) (float64, bool) {
+	switch metric {
+	case "sleep_hours":
+		return p.SleepHours, p.SleepHours > 0
+	case "sleep_quality":
+		return p.SleepQuality, true
+	case "mood":
+		return p.Mood, true
+	case "energy":
+		return p.Energy, true
+	case "concentration":
+		return p.Concentration, true
+	case "productive":
+		return p.Productive, true
+	case "activity":
+		return p.Activity, true
+	case "stress":
+		return p.Stress, true
+	}
+	return 0, false
+}
@@ -0,0 +1,32 @@
+package analytics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+func TestGoalAttainmentUsesLogicalDays(t *testing.T) {
+	// A late evening and the small hours after it: one logical day when days start at 04:00.
+	evening := time.Now().Add(-30 * time.Hour).Truncate(time.Hour)
+	evening = time.Date(evening.Year(), evening.Month(), evening.Day(), 23, 0, 0, 0, evening.Location())
+	pts := []dto.TrackPoint{
+		{TS: evening, SleepHours: 8, Stress: 5},
+		{TS: evening.Add(3 * time.Hour), SleepHours: 6, Stress: 2},
+	}
+	goals := map[string]float64{"sleep_hours": 8, "stress": 3, "unknown": 1}
+
+	want := []dto.GoalProgress{{Metric: "sleep_hours", Target: 8, Met: 0, Days: 1}, {Metric: "stress", Target: 3, Met: 0, Days: 1}}
+	if got := GoalAttainment(pts, goals, 4); !reflect.DeepEqual(got, want) {
+		t.Errorf("day start 04:00: %+v, want %+v", got, want)
+	}
+	want = []dto.GoalProgress{{Metric: "sleep_hours", Target: 8, Met: 1, Days: 2}, {Metric: "stress", Target: 3, Met: 1, Days: 2}}
+	if got := GoalAttainment(pts, goals, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("day start 00:00: %+v, want %+v", got, want)
+	}
+	if got := GoalAttainment(pts, nil, 4); got != nil {
+		t.Errorf("no goals: %+v", got)
+	}
+}
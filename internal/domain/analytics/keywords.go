@@ -0,0 +1,111 @@
+package analytics
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"nexus/internal/dto"
+)
+
+// minKeywordRunes отсекает предлоги, союзы и прочие короткие слова, которых нет в стоп-листе.
+const minKeywordRunes = 3
+
+// defaultStopWords — служебные и общие слова русского и английского, не несущие смысла в заметках.
+// Слова хранятся в нижнем регистре, «ё» заменена на «е», как и при разборе заметок.
+var defaultStopWords = []string{
+	// ru
+	"без", "был", "была", "были", "было", "быть", "вам", "вас", "весь", "во", "вот", "все", "всего", "всех",
+	"вчера", "где", "даже", "для", "его", "ее", "если", "есть", "еще", "жду", "за", "здесь", "или", "им", "их",
+	"как", "какой", "когда", "кто", "ли", "мне", "меня", "мной", "может", "мой", "моя", "мы", "над", "надо",
+	"нас", "начала", "него", "нее", "нет", "них", "ничего", "но", "ну", "об", "она", "они", "оно", "опять",
+	"очень", "под", "после", "потом", "почти", "при", "про", "раз", "сам", "себе", "себя", "сегодня", "сейчас",
+	"так", "такой", "там", "тебя", "тем", "то", "того", "тоже", "только", "том", "тут", "уже", "хотя", "чем",
+	"через", "что", "чтобы", "чуть", "эта", "эти", "это", "этот", "день", "дня", "немного", "много", "снова",
+	"будет", "буду", "мало", "сильно", "вообще", "совсем", "просто",
+	// en
+	"about", "after", "again", "all", "also", "and", "any", "are", "because", "been", "before", "but", "can",
+	"could", "day", "did", "does", "for", "from", "had", "has", "have", "her", "him", "his", "how", "into",
+	"its", "just", "like", "more", "most", "much", "not", "now", "only", "other", "our", "out", "over", "really",
+	"she", "some", "than", "that", "the", "their", "them", "then", "there", "these", "they", "this", "today",
+	"too", "very", "was", "were", "what", "when", "which", "while", "who", "will", "with", "would", "yesterday",
+	"you", "your", "bit", "got", "get", "lot",
+}
+
+// StopWords собирает стоп-лист: встроенные слова плюс extra (регистр и «ё» не важны, пустые пропускаются).
+// Пример: StopWords([]string{"работа"}) -> множество со встроенными словами и «работа».
+func StopWords(extra []string) map[string]struct{} {
+	out := make(map[string]struct{}, len(defaultStopWords)+len(extra))
+	for _, w := range defaultStopWords {
+		out[normalizeKeyword(w)] = struct{}{}
+	}
+	for _, w := range extra {
+		if w = normalizeKeyword(strings.TrimSpace(w)); w != "" {
+			out[w] = struct{}{}
+		}
+	}
+	return out
+}
+
+// NoteKeywords разбивает заметки (LLMText) на слова, выбрасывает стоп-слова, числа и слова короче
+// трёх букв и возвращает limit самых частых. Count — сколько раз слово встретилось, Notes — в скольких
+// заметках. При равной частоте выше слово из большего числа заметок, затем по алфавиту.
+// Пример: NoteKeywords(pts, StopWords(nil), 3) -> [{deadline 4 3} {головная 2 2} {gym 2 1}].
+func NoteKeywords(pts []dto.TrackPoint, stop map[string]struct{}, limit int) []dto.NoteKeyword {
+	counts := map[string]*dto.NoteKeyword{}
+	for _, p := range pts {
+		seen := map[string]struct{}{}
+		for _, w := range tokenizeNote(p.LLMText) {
+			if _, ok := stop[w]; ok {
+				continue
+			}
+			kw := counts[w]
+			if kw == nil {
+				kw = &dto.NoteKeyword{Word: w}
+				counts[w] = kw
+			}
+			kw.Count++
+			if _, ok := seen[w]; !ok {
+				seen[w] = struct{}{}
+				kw.Notes++
+			}
+		}
+	}
+	out := make([]dto.NoteKeyword, 0, len(counts))
+	for _, kw := range counts {
+		out = append(out, *kw)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		if out[i].Notes != out[j].Notes {
+			return out[i].Notes > out[j].Notes
+		}
+		return out[i].Word < out[j].Word
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// tokenizeNote режет текст по всему, что не буква, не цифра и не дефис внутри слова, и нормализует слова.
+func tokenizeNote(s string) []string {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-'
+	})
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		w := normalizeKeyword(strings.Trim(f, "-"))
+		if len([]rune(w)) < minKeywordRunes || !strings.ContainsFunc(w, unicode.IsLetter) {
+			continue
+		}
+		out = append(out, w)
+	}
+	return out
+}
+
+func normalizeKeyword(w string) string {
+	return strings.ReplaceAll(strings.ToLower(w), "ё", "е")
+}
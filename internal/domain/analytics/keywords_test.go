@@ -0,0 +1,58 @@
+package analytics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+func TestNoteKeywords(t *testing.T) {
+	notes := []string{
+		"Deadline, deadline! Очень болела голова, головная боль после обеда.",
+		"Ещё один deadline; gym вечером и gym утром.",
+		"Сегодня deadline, головная боль прошла. 2026 год.",
+		"",
+		"Ёлка и елка — это одно слово; we had a day-off.",
+	}
+	var pts []dto.TrackPoint
+	for i, n := range notes {
+		pts = append(pts, dto.TrackPoint{TS: time.Date(2026, 3, 2+i, 9, 0, 0, 0, time.UTC), LLMText: n})
+	}
+
+	got := NoteKeywords(pts, StopWords(nil), 4)
+	want := []dto.NoteKeyword{
+		{Word: "deadline", Count: 4, Notes: 3},
+		{Word: "боль", Count: 2, Notes: 2},
+		{Word: "головная", Count: 2, Notes: 2},
+		{Word: "gym", Count: 2, Notes: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("NoteKeywords = %+v, want %+v", got, want)
+	}
+
+	all := NoteKeywords(pts, StopWords(nil), 0)
+	counts := map[string]int{}
+	for _, kw := range all {
+		counts[kw.Word] = kw.Count
+	}
+	// «ё» folds into «е», an inner hyphen keeps the word whole, and numbers, short words and stop words drop out.
+	if counts["елка"] != 2 || counts["day-off"] != 1 {
+		t.Errorf("елка = %d, day-off = %d, want 2 and 1", counts["елка"], counts["day-off"])
+	}
+	for _, w := range []string{"2026", "we", "очень", "сегодня", "had", "это"} {
+		if _, ok := counts[w]; ok {
+			t.Errorf("keyword %q should have been dropped", w)
+		}
+	}
+
+	// Extra stop words are matched regardless of case and «ё».
+	got = NoteKeywords(pts, StopWords([]string{" DEADLINE ", "Ёлка"}), 1)
+	if len(got) != 1 || got[0].Word != "боль" {
+		t.Errorf("with extra stop words top = %+v, want боль", got)
+	}
+	if NoteKeywords(nil, StopWords(nil), 3) == nil {
+		t.Error("no notes gave nil, want an empty list")
+	}
+}
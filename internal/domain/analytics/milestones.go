@@ -0,0 +1,55 @@
+package analytics
+
+import "time"
+
+// StreakMilestones — длины серий дней с отметками, о которых сообщается друзьям, по возрастанию.
+var StreakMilestones = []int{7, 30, 100, 365}
+
+// StreakRun считает серию подряд идущих дней из days (YYYY-MM-DD), заканчивающуюся днём today или,
+// если за today отметки ещё нет, вчерашним днём, и возвращает её длину и первый день.
+// Пример: StreakRun([]string{"2026-10-14", "2026-10-15"}, "2026-10-16") -> 2, "2026-10-14".
+func StreakRun(days []string, today string) (int, string) {
+	logged := make(map[string]struct{}, len(days))
+	for _, d := range days {
+		logged[d] = struct{}{}
+	}
+	day, err := time.Parse("2006-01-02", today)
+	if err != nil {
+		return 0, ""
+	}
+	if _, ok := logged[today]; !ok {
+		day = day.AddDate(0, 0, -1)
+	}
+	streak, first := 0, ""
+	for {
+		key := day.Format("2006-01-02")
+		if _, ok := logged[key]; !ok {
+			return streak, first
+		}
+		streak++
+		first = key
+		day = day.AddDate(0, 0, -1)
+	}
+}
+
+// StreakMilestone — наибольший порог из StreakMilestones, которого достигла серия streak, или 0.
+// Пример: StreakMilestone(42) -> 30.
+func StreakMilestone(streak int) int {
+	out := 0
+	for _, m := range StreakMilestones {
+		if streak >= m {
+			out = m
+		}
+	}
+	return out
+}
+
+// BurnoutImproved — снизился ли уровень риска выгорания с prev до cur (high > medium > low).
+// Неизвестный или пустой уровень с любой стороны улучшением не считается.
+// Пример: BurnoutImproved("high", "medium") -> true.
+func BurnoutImproved(prev, cur string) bool {
+	rank := map[string]int{"low": 1, "medium": 2, "high": 3}
+	p, okPrev := rank[prev]
+	c, okCur := rank[cur]
+	return okPrev && okCur && c < p
+}
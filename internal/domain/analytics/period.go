@@ -0,0 +1,34 @@
+package analytics
+
+import (
+	"fmt"
+	"time"
+)
+
+var monthsGenitive = [...]string{"января", "февраля", "марта", "апреля", "мая", "июня",
+	"июля", "августа", "сентября", "октября", "ноября", "декабря"}
+
+// PeriodLabel подписывает окно разбора [start, end) логическими днями (сутки начинаются в dayStartHour):
+// год пишется, только если окно захватывает два года, месяц — один раз, если он общий. Нулевой start —
+// окно «всё время».
+// Пример: PeriodLabel(3 марта 04:00, 10 марта 03:00, 4) -> "3–9 марта".
+func PeriodLabel(start, end time.Time, dayStartHour int) string {
+	last := logicalDay(end.Add(-time.Nanosecond), dayStartHour)
+	if start.IsZero() {
+		return "Всё время по " + formatDayMonth(last)
+	}
+	first := logicalDay(start, dayStartHour)
+	switch {
+	case first.Year() != last.Year():
+		return fmt.Sprintf("%s %d – %s %d", formatDayMonth(first), first.Year(), formatDayMonth(last), last.Year())
+	case first.Month() != last.Month():
+		return formatDayMonth(first) + " – " + formatDayMonth(last)
+	case first.Day() != last.Day():
+		return fmt.Sprintf("%d–%s", first.Day(), formatDayMonth(last))
+	}
+	return formatDayMonth(last)
+}
+
+func formatDayMonth(t time.Time) string {
+	return fmt.Sprintf("%d %s", t.Day(), monthsGenitive[t.Month()-1])
+}
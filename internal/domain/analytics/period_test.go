@@ -0,0 +1,30 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriodLabel(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*3600)
+	at := func(y int, m time.Month, d, h int) time.Time { return time.Date(y, m, d, h, 0, 0, 0, msk) }
+	cases := []struct {
+		name       string
+		start, end time.Time
+		dayStart   int
+		want       string
+	}{
+		{"week in one month", at(2026, 3, 3, 4), at(2026, 3, 10, 3), 4, "3–9 марта"},
+		{"week across months", at(2026, 3, 28, 0), at(2026, 4, 3, 12), 0, "28 марта – 3 апреля"},
+		{"month across years", at(2025, 12, 15, 0), at(2026, 1, 15, 12), 0, "15 декабря 2025 – 15 января 2026"},
+		{"single day", at(2026, 3, 9, 4), at(2026, 3, 10, 4), 4, "9 марта"},
+		// 02:00 still belongs to the previous logical day when days start at 04:00.
+		{"late night end", at(2026, 3, 3, 4), at(2026, 3, 10, 2), 4, "3–9 марта"},
+		{"all time", time.Time{}, at(2026, 3, 10, 12), 0, "Всё время по 10 марта"},
+	}
+	for _, c := range cases {
+		if got := PeriodLabel(c.start, c.end, c.dayStart); got != c.want {
+			t.Errorf("%s: label = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
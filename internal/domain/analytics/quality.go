@@ -0,0 +1,149 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+const (
+	// contradictionEnergy/contradictionSleep/contradictionStress — «энергия 9 при 2 ч сна и стрессе 10»:
+	// такое сочетание в одной отметке почти наверняка ошибка ввода.
+	contradictionEnergy = 8.0
+	contradictionSleep  = 4.0
+	contradictionStress = 8.0
+	// sleepMismatchHours — насколько sleep_hours может расходиться с интервалом sleep_start–sleep_end.
+	sleepMismatchHours = 2.0
+	// maxDataQualityWarnings — больше предупреждений клиенту не нужно, берутся самые свежие.
+	maxDataQualityWarnings = 10
+)
+
+// minAnalysisDays — столько дней с полными отметками нужно разбору энергии и выгорания в длинном периоде.
+const minAnalysisDays = 5
+
+// analysisRequirements — поля, без которых день не участвует в разборе данного типа. Тренды сравнивают
+// половины окна горизонта, поэтому им нужна доля minTrendCoverage его дней, а не фиксированное число.
+var analysisRequirements = []struct {
+	analysis string
+	fields   []string
+	trend    bool
+}{
+	{"energy", []string{"sleep_hours", "mood", "energy"}, false},
+	{"burnout", []string{"sleep_hours", "mood", "stress", "energy"}, false},
+	{"trends", []string{"sleep_hours", "mood", "energy", "stress", "productive"}, true},
+}
+
+// coverageMinDays — сколько полных дней нужно разбору: не больше, чем дней в периоде (periodDays <= 0 —
+// период не ограничен), а трендам — доля minTrendCoverage горизонта trendDays.
+// Пример: coverageMinDays(true, 30, 14) -> 7, coverageMinDays(false, 1, 14) -> 1.
+func coverageMinDays(trend bool, periodDays, trendDays int) int {
+	n := minAnalysisDays
+	if trend {
+		if trendDays <= 0 {
+			trendDays = DefaultBurnoutHorizonDays
+		}
+		n = int(math.Ceil(minTrendCoverage * float64(trendDays)))
+	}
+	if periodDays > 0 && n > periodDays {
+		n = periodDays
+	}
+	return n
+}
+
+// ComputeAnalysisCoverage считает для каждого типа разбора дни, в которых есть отметка со всеми нужными
+// полями, и перечисляет поля, которых не хватило остальным дням. Дни — по локальной дате TS.
+// periodDays — длина периода в днях (<= 0 для всей истории), trendDays — окно трендов (горизонт выгорания).
+// Пример: ComputeAnalysisCoverage(points, 7, 14)[1] -> {Analysis: "burnout", Days: 3, MinDays: 5, MissingFields: ["stress"]}.
+func ComputeAnalysisCoverage(pts []dto.TrackPoint, periodDays, trendDays int) []dto.AnalysisCoverage {
+	out := make([]dto.AnalysisCoverage, 0, len(analysisRequirements))
+	for _, req := range analysisRequirements {
+		complete := map[string]struct{}{}
+		for _, p := range pts {
+			if hasAll(p, req.fields) {
+				complete[p.TS.Format("2006-01-02")] = struct{}{}
+			}
+		}
+		missing := map[string]struct{}{}
+		for _, p := range pts {
+			if _, ok := complete[p.TS.Format("2006-01-02")]; ok {
+				continue
+			}
+			for _, f := range req.fields {
+				if !p.Has(f) {
+					missing[f] = struct{}{}
+				}
+			}
+		}
+		c := dto.AnalysisCoverage{
+			Analysis:       req.analysis,
+			Days:           len(complete),
+			MinDays:        coverageMinDays(req.trend, periodDays, trendDays),
+			RequiredFields: append([]string(nil), req.fields...),
+		}
+		for _, f := range req.fields {
+			if _, ok := missing[f]; ok {
+				c.MissingFields = append(c.MissingFields, f)
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func hasAll(p dto.TrackPoint, fields []string) bool {
+	for _, f := range fields {
+		if !p.Has(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// DetectContradictions ищет внутренне противоречивые отметки. Данные не меняются — только помечаются,
+// чтобы клиент мог попросить человека перепроверить ввод.
+// Пример: DetectContradictions(points) -> [{Date: "2026-10-14", Code: "sleep_hours_mismatch", ...}].
+func DetectContradictions(pts []dto.TrackPoint) []dto.DataQualityWarning {
+	var out []dto.DataQualityWarning
+	for _, p := range pts {
+		date := p.TS.Format("2006-01-02")
+		if p.Energy >= contradictionEnergy && p.SleepHours > 0 && p.SleepHours < contradictionSleep && p.Stress >= contradictionStress {
+			out = append(out, dto.DataQualityWarning{
+				Date: date,
+				Code: dto.DataQualityEnergyContradiction,
+				Message: fmt.Sprintf("Энергия %.0f при %.1f ч сна и стрессе %.0f — проверь, верно ли внесена отметка.",
+					p.Energy, p.SleepHours, p.Stress),
+			})
+		}
+		if implied, ok := impliedSleepHours(p.SleepStart, p.SleepEnd); ok && p.SleepHours > 0 &&
+			math.Abs(implied-p.SleepHours) > sleepMismatchHours {
+			out = append(out, dto.DataQualityWarning{
+				Date: date,
+				Code: dto.DataQualitySleepHoursMismatch,
+				Message: fmt.Sprintf("Сон с %s до %s — это %.1f ч, а указано %.1f ч.",
+					strings.TrimSpace(p.SleepStart), strings.TrimSpace(p.SleepEnd), implied, p.SleepHours),
+			})
+		}
+	}
+	if len(out) > maxDataQualityWarnings {
+		out = out[len(out)-maxDataQualityWarnings:]
+	}
+	return out
+}
+
+// impliedSleepHours считает длительность сна по времени отбоя и подъёма (через полночь тоже).
+// Пример: impliedSleepHours("23:30", "07:00") -> 7.5, true.
+func impliedSleepHours(start, end string) (float64, bool) {
+	s, err1 := time.Parse("15:04", strings.TrimSpace(start))
+	e, err2 := time.Parse("15:04", strings.TrimSpace(end))
+	if err1 != nil || err2 != nil {
+		return 0, false
+	}
+	d := e.Sub(s)
+	if d <= 0 {
+		d += 24 * time.Hour
+	}
+	return d.Hours(), true
+}
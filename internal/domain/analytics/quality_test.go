@@ -0,0 +1,120 @@
+package analytics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+func TestDetectContradictions(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 9, 0, 0, 0, time.UTC) }
+
+	consistent := []dto.TrackPoint{
+		steadyPoint(day(1)),
+		// Little sleep and high stress, but low energy: bad, not contradictory.
+		{TS: day(2), SleepHours: 3, Stress: 9, Energy: 3},
+		// High energy after a short night without stress.
+		{TS: day(3), SleepHours: 3, Stress: 2, Energy: 9},
+		// Across midnight and within the tolerance of the reported hours.
+		{TS: day(4), SleepHours: 7, SleepStart: "23:30", SleepEnd: "07:00", Energy: 6},
+		// Unparseable times are not compared.
+		{TS: day(5), SleepHours: 7, SleepStart: "late", SleepEnd: "07:00", Energy: 6},
+	}
+	if w := DetectContradictions(consistent); len(w) != 0 {
+		t.Errorf("consistent points flagged: %+v", w)
+	}
+
+	contradictory := []dto.TrackPoint{
+		{TS: day(6), SleepHours: 2, Stress: 10, Energy: 9},
+		{TS: day(7), SleepHours: 8, SleepStart: "02:00", SleepEnd: "05:00", Energy: 6},
+	}
+	w := DetectContradictions(contradictory)
+	want := []dto.DataQualityWarning{
+		{Date: "2026-10-06", Code: dto.DataQualityEnergyContradiction},
+		{Date: "2026-10-07", Code: dto.DataQualitySleepHoursMismatch},
+	}
+	if len(w) != len(want) {
+		t.Fatalf("warnings = %+v, want %d", w, len(want))
+	}
+	for i := range want {
+		if w[i].Date != want[i].Date || w[i].Code != want[i].Code || w[i].Message == "" {
+			t.Errorf("warning %d = %+v, want %s on %s with a message", i, w[i], want[i].Code, want[i].Date)
+		}
+	}
+	if contradictory[0].Energy != 9 || contradictory[1].SleepHours != 8 {
+		t.Error("DetectContradictions changed the points")
+	}
+}
+
+func TestDetectContradictionsKeepsNewest(t *testing.T) {
+	var pts []dto.TrackPoint
+	for d := 1; d <= 15; d++ {
+		pts = append(pts, dto.TrackPoint{TS: time.Date(2026, 10, d, 9, 0, 0, 0, time.UTC), SleepHours: 2, Stress: 10, Energy: 9})
+	}
+	w := DetectContradictions(pts)
+	if len(w) != maxDataQualityWarnings {
+		t.Fatalf("%d warnings, want the cap of %d", len(w), maxDataQualityWarnings)
+	}
+	if w[0].Date != "2026-10-06" || w[len(w)-1].Date != "2026-10-15" {
+		t.Errorf("kept %s..%s, want the newest days", w[0].Date, w[len(w)-1].Date)
+	}
+}
+
+func TestComputeAnalysisCoverage(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 9, 0, 0, 0, time.UTC) }
+	var pts []dto.TrackPoint
+	for d := 1; d <= 6; d++ {
+		pts = append(pts, steadyPoint(day(d)))
+	}
+	// Sparse days: one without stress, one with only sleep, and a complete second point on day 1.
+	noStress := steadyPoint(day(7))
+	noStress.Missing = map[string]bool{"stress": true}
+	sleepOnly := dto.TrackPoint{TS: day(8), SleepHours: 7, Missing: map[string]bool{
+		"mood": true, "activity": true, "productive": true, "stress": true, "energy": true, "concentration": true, "sleep_quality": true,
+	}}
+	pts = append(pts, noStress, sleepOnly, steadyPoint(day(1).Add(time.Hour)))
+
+	got := ComputeAnalysisCoverage(pts, 30, 14)
+	want := []struct {
+		analysis string
+		days     int
+		minDays  int
+		missing  []string
+	}{
+		{"energy", 7, 5, []string{"mood", "energy"}},
+		{"burnout", 6, 5, []string{"mood", "stress", "energy"}},
+		{"trends", 6, 7, []string{"mood", "energy", "stress", "productive"}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("coverage = %+v", got)
+	}
+	for i, w := range want {
+		c := got[i]
+		if c.Analysis != w.analysis || c.Days != w.days || c.MinDays != w.minDays || strings.Join(c.MissingFields, ",") != strings.Join(w.missing, ",") {
+			t.Errorf("coverage %d = %+v, want %s: %d of %d days, missing %v", i, c, w.analysis, w.days, w.minDays, w.missing)
+		}
+	}
+}
+
+func TestCoverageMinDaysFollowsPeriod(t *testing.T) {
+	cases := []struct {
+		name                  string
+		periodDays, trendDays int
+		want                  map[string]int
+	}{
+		{"single day", 1, 14, map[string]int{"energy": 1, "burnout": 1, "trends": 1}},
+		{"week", 8, 14, map[string]int{"energy": 5, "burnout": 5, "trends": 7}},
+		{"week, 7-day horizon", 8, 7, map[string]int{"energy": 5, "burnout": 5, "trends": 4}},
+		{"month, 28-day horizon", 31, 28, map[string]int{"energy": 5, "burnout": 5, "trends": 14}},
+		{"all history", 0, 0, map[string]int{"energy": 5, "burnout": 5, "trends": 7}},
+	}
+	for _, c := range cases {
+		for _, cov := range ComputeAnalysisCoverage(nil, c.periodDays, c.trendDays) {
+			if cov.MinDays != c.want[cov.Analysis] {
+				t.Errorf("%s: %s needs %d days, want %d", c.name, cov.Analysis, cov.MinDays, c.want[cov.Analysis])
+			}
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+const (
+	// scheduleMinSamples — сколько отметок должно быть в часе, чтобы он попал в расписание.
+	scheduleMinSamples = 2
+	// scheduleFocusHours/scheduleLightHours — сколько часов предлагать под сложные и лёгкие задачи.
+	scheduleFocusHours = 3
+	scheduleLightHours = 2
+	// scheduleTargetSleep — сколько часов сна закладывать в окно, если в среднем человек спит меньше.
+	scheduleTargetSleep = 7.5
+	// chronotypeFocusBonus — прибавка к концентрации часа из окна хронотипа при выборе часов для сложных задач:
+	// она решает близкие случаи, но не перебивает заметно лучший час вне окна.
+	chronotypeFocusBonus = 0.5
+)
+
+// Хронотипы ClassifyChronotype.
+const (
+	ChronotypeMorning = "morning"
+	ChronotypeEvening = "evening"
+	ChronotypeNeutral = "neutral"
+	ChronotypeUnknown = "unknown"
+)
+
+const (
+	// Утреннее (6–11) и вечернее (17–22) окна, включительно.
+	chronotypeMorningFrom, chronotypeMorningTo = 6, 11
+	chronotypeEveningFrom, chronotypeEveningTo = 17, 22
+	// chronotypeMinHours — сколько разных часов с данными нужно в каждом окне.
+	chronotypeMinHours = 2
+	// chronotypeNeutralBand — разница средней энергии окон (баллы 0–100), которая ещё считается ровной.
+	chronotypeNeutralBand = 5.0
+)
+
+// ComputeOptimalSchedule собирает расписание на день: окно сна от привычного подъёма, лучшие часы для
+// сложных задач по концентрации, часы с самой низкой энергией под лёгкие задачи и советы по восстановлению.
+// Часы берутся в пределах рабочего времени c, если оно задано (WorkStartHour < WorkEndHour); часы
+// из окна хронотипа (Chronotype, по всем часам суток) получают небольшую прибавку к концентрации.
+// Пример: ComputeOptimalSchedule(points, c, DefaultEnergyScoreParams).BestFocusHours -> ["10:00", "11:00", "15:00"].
+func ComputeOptimalSchedule(pts []dto.TrackPoint, c dto.Constraints, ep EnergyScoreParams) dto.OptimalSchedule {
+	out := dto.OptimalSchedule{
+		BestFocusHours:      []string{},
+		BestLightTasksHours: []string{},
+		RecoveryTips:        []string{},
+		Chronotype:          ChronotypeUnknown,
+	}
+	if len(pts) == 0 {
+		return out
+	}
+	out.SuggestedSleepWindow = suggestedSleepWindow(pts)
+
+	var hours []dto.HourStat
+	energyByHour := map[int]float64{}
+	for _, st := range ComputeFocusByHour(pts, ep) {
+		if st.Count < scheduleMinSamples {
+			continue
+		}
+		energyByHour[st.Hour] = st.AvgEnergy
+		if c.WorkStartHour < c.WorkEndHour && (st.Hour < c.WorkStartHour || st.Hour >= c.WorkEndHour) {
+			continue
+		}
+		hours = append(hours, st)
+	}
+	out.Chronotype = ClassifyChronotype(energyByHour)
+	focusRank := func(st dto.HourStat) float64 {
+		if inChronotypeWindow(out.Chronotype, st.Hour) {
+			return st.AvgFocus + chronotypeFocusBonus
+		}
+		return st.AvgFocus
+	}
+	sort.Slice(hours, func(i, j int) bool {
+		if fi, fj := focusRank(hours[i]), focusRank(hours[j]); fi != fj {
+			return fi > fj
+		}
+		return hours[i].Hour < hours[j].Hour
+	})
+	focus := map[int]struct{}{}
+	for _, st := range hours {
+		if len(focus) == scheduleFocusHours {
+			break
+		}
+		focus[st.Hour] = struct{}{}
+	}
+	sort.Slice(hours, func(i, j int) bool {
+		if hours[i].AvgEnergy != hours[j].AvgEnergy {
+			return hours[i].AvgEnergy < hours[j].AvgEnergy
+		}
+		return hours[i].Hour < hours[j].Hour
+	})
+	light := map[int]struct{}{}
+	for _, st := range hours {
+		if len(light) == scheduleLightHours {
+			break
+		}
+		if _, ok := focus[st.Hour]; !ok {
+			light[st.Hour] = struct{}{}
+		}
+	}
+	out.BestFocusHours = formatHours(focus)
+	out.BestLightTasksHours = formatHours(light)
+	out.RecoveryTips = recoveryTips(pts)
+	return out
+}
+
+// ClassifyChronotype сравнивает среднюю энергию утреннего (6–11) и вечернего (17–22) окон по часам
+// energyByHour (час -> средняя энергия 0–100). Разница в пределах chronotypeNeutralBand — ChronotypeNeutral;
+// меньше chronotypeMinHours часов с данными в любом из окон — ChronotypeUnknown.
+// Пример: ClassifyChronotype(map[int]float64{7: 72, 9: 70, 18: 55, 20: 52}) -> "morning".
+func ClassifyChronotype(energyByHour map[int]float64) string {
+	var morning, evening float64
+	var nMorning, nEvening int
+	for h, e := range energyByHour {
+		switch {
+		case h >= chronotypeMorningFrom && h <= chronotypeMorningTo:
+			morning += e
+			nMorning++
+		case h >= chronotypeEveningFrom && h <= chronotypeEveningTo:
+			evening += e
+			nEvening++
+		}
+	}
+	if nMorning < chronotypeMinHours || nEvening < chronotypeMinHours {
+		return ChronotypeUnknown
+	}
+	diff := morning/float64(nMorning) - evening/float64(nEvening)
+	switch {
+	case diff > chronotypeNeutralBand:
+		return ChronotypeMorning
+	case diff < -chronotypeNeutralBand:
+		return ChronotypeEvening
+	default:
+		return ChronotypeNeutral
+	}
+}
+
+func inChronotypeWindow(chronotype string, hour int) bool {
+	switch chronotype {
+	case ChronotypeMorning:
+		return hour >= chronotypeMorningFrom && hour <= chronotypeMorningTo
+	case ChronotypeEvening:
+		return hour >= chronotypeEveningFrom && hour <= chronotypeEveningTo
+	}
+	return false
+}
+
+// suggestedSleepWindow строит окно сна "HH:MM–HH:MM": подъём — привычное время пробуждения,
+// отбой — за средний сон до него, но не меньше scheduleTargetSleep часов. Без времени подъёма — "".
+// Пример: suggestedSleepWindow(points) -> "23:30–07:00".
+func suggestedSleepWindow(pts []dto.TrackPoint) string {
+	wake, ok := circularMeanClock(pts, func(p dto.TrackPoint) string { return p.SleepEnd })
+	if !ok {
+		return ""
+	}
+	sleep := math.Max(avgField(pts, func(p dto.TrackPoint) float64 { return p.SleepHours }), scheduleTargetSleep)
+	bed := wake - int(math.Round(sleep*4))*15
+	bed = ((bed % 1440) + 1440) % 1440
+	return fmt.Sprintf("%02d:%02d–%02d:%02d", bed/60, bed%60, wake/60, wake%60)
+}
+
+// recoveryTips подбирает короткие советы по средним значениям сна, стресса и привычек.
+// Пример: recoveryTips(points) -> ["Ложись на 30–40 минут раньше: в среднем сна меньше 7 часов."].
+func recoveryTips(pts []dto.TrackPoint) []string {
+	tips := []string{}
+	if avg := avgField(pts, func(p dto.TrackPoint) float64 { return p.SleepHours }); avg > 0 && avg < 7 {
+		tips = append(tips, "Ложись на 30–40 минут раньше: в среднем сна меньше 7 часов.")
+	}
+	if avgField(pts, func(p dto.TrackPoint) float64 { return p.Stress }) >= 7 {
+		tips = append(tips, "Запланируй 15 минут без экрана после самого напряжённого блока.")
+	}
+	if percentBool(pts, func(p dto.TrackPoint) bool { return p.Caffeine }) > 50 {
+		tips = append(tips, "Последний кофе — не позже чем за 8 часов до сна.")
+	}
+	if percentBool(pts, func(p dto.TrackPoint) bool { return p.Workout }) < 20 {
+		tips = append(tips, "Добавь короткую прогулку или разминку в час с самой низкой энергией.")
+	}
+	return tips
+}
+
+// ClockMean усредняет время "HH:MM" по кругу (23:30 и 00:30 дают 00:00) и возвращает минуты от полуночи.
+// Значения, которые не разбираются как время, пропускаются; ok = false, если не осталось ни одного.
+// Пример: ClockMean(points, func(p dto.TrackPoint) string { return p.SleepStart }) -> 1425, true (23:45).
+func ClockMean(pts []dto.TrackPoint, pick func(dto.TrackPoint) string) (int, bool) {
+	minutes, ok := clockMeanMinutes(pts, pick)
+	if !ok {
+		return 0, false
+	}
+	return int(math.Round(minutes)) % 1440, true
+}
+
+// circularMeanClock — ClockMean, округлённое до 15 минут для расписания.
+// Пример: circularMeanClock(points, wake) -> 420, true (07:00).
+func circularMeanClock(pts []dto.TrackPoint, pick func(dto.TrackPoint) string) (int, bool) {
+	minutes, ok := clockMeanMinutes(pts, pick)
+	if !ok {
+		return 0, false
+	}
+	return int(math.Round(minutes/15)) * 15 % 1440, true
+}
+
+func clockMeanMinutes(pts []dto.TrackPoint, pick func(dto.TrackPoint) string) (float64, bool) {
+	var sumSin, sumCos float64
+	n := 0
+	for _, p := range pts {
+		tm, err := time.Parse("15:04", strings.TrimSpace(pick(p)))
+		if err != nil {
+			continue
+		}
+		angle := 2 * math.Pi * float64(tm.Hour()*60+tm.Minute()) / 1440.0
+		sumSin += math.Sin(angle)
+		sumCos += math.Cos(angle)
+		n++
+	}
+	if n == 0 {
+		return 0, false
+	}
+	angle := math.Atan2(sumSin, sumCos)
+	if angle < 0 {
+		angle += 2 * math.Pi
+	}
+	return angle * 1440.0 / (2 * math.Pi), true
+}
+
+func formatHours(set map[int]struct{}) []string {
+	hours := make([]int, 0, len(set))
+	for h := range set {
+		hours = append(hours, h)
+	}
+	sort.Ints(hours)
+	out := make([]string, 0, len(hours))
+	for _, h := range hours {
+		out = append(out, fmt.Sprintf("%02d:00", h))
+	}
+	return out
+}
+
+const (
+	// socialJetlagMinNights — сколько ночей с известными границами сна нужно и в будни, и в выходные.
+	socialJetlagMinNights = 2
+	// socialJetlagBurnoutHours — с такого сдвига середины сна выходные начинают давать вклад в риск выгорания.
+	socialJetlagBurnoutHours = 2.0
+)
+
+// SocialJetlag — насколько середина сна в выходные (подъём в субботу или воскресенье) позже, чем в будни,
+// в часах; отрицательное значение — в выходные раньше. Середины усредняются по кругу, поэтому 23:30–07:30
+// и 01:00–09:00 сравниваются через полночь правильно. Нужно хотя бы socialJetlagMinNights ночей в каждой
+// группе, иначе 0.
+// Пример: в будни 00:00–07:00, в выходные 02:00–10:30 -> SocialJetlag(points) = 2.75.
+func SocialJetlag(pts []dto.TrackPoint) float64 {
+	var weekday, weekend [2]float64 // sum sin, sum cos
+	var nWeekday, nWeekend int
+	for _, p := range pts {
+		mid, ok := sleepMidpoint(p)
+		if !ok {
+			continue
+		}
+		angle := 2 * math.Pi * float64(mid) / 1440.0
+		sum, n := &weekday, &nWeekday
+		if wd := p.TS.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			sum, n = &weekend, &nWeekend
+		}
+		sum[0] += math.Sin(angle)
+		sum[1] += math.Cos(angle)
+		*n++
+	}
+	if nWeekday < socialJetlagMinNights || nWeekend < socialJetlagMinNights {
+		return 0
+	}
+	diff := math.Atan2(weekend[0], weekend[1]) - math.Atan2(weekday[0], weekday[1])
+	for diff > math.Pi {
+		diff -= 2 * math.Pi
+	}
+	for diff <= -math.Pi {
+		diff += 2 * math.Pi
+	}
+	// Sleep times are whole minutes, so the result is kept to the minute and free of float noise.
+	return math.Round(diff*1440/(2*math.Pi)) / 60
+}
+
+// sleepMidpoint — середина сна в минутах от полуночи по SleepStart/SleepEnd "HH:MM" (сон через полночь учтён).
+func sleepMidpoint(p dto.TrackPoint) (int, bool) {
+	start, err1 := time.Parse("15:04", strings.TrimSpace(p.SleepStart))
+	end, err2 := time.Parse("15:04", strings.TrimSpace(p.SleepEnd))
+	if err1 != nil || err2 != nil {
+		return 0, false
+	}
+	s := start.Hour()*60 + start.Minute()
+	e := end.Hour()*60 + end.Minute()
+	dur := (e - s + 1440) % 1440
+	if dur == 0 {
+		return 0, false
+	}
+	return (s + dur/2) % 1440, true
+}
@@ -0,0 +1,126 @@
+package analytics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// jetlagWeek builds two weeks of nights starting on Monday 2026-03-02: weekday nights use
+// weekdayStart–weekdayEnd, Saturday and Sunday nights use weekendStart–weekendEnd.
+func jetlagWeek(weekdayStart, weekdayEnd, weekendStart, weekendEnd string) []dto.TrackPoint {
+	monday := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	var pts []dto.TrackPoint
+	for i := 0; i < 14; i++ {
+		ts := monday.AddDate(0, 0, i)
+		p := dto.TrackPoint{TS: ts, SleepHours: 7, SleepQuality: 7, Mood: 7, Activity: 7, Productive: 7, Stress: 3, Energy: 7, Concentration: 7, Workout: true}
+		p.SleepStart, p.SleepEnd = weekdayStart, weekdayEnd
+		if wd := ts.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			p.SleepStart, p.SleepEnd = weekendStart, weekendEnd
+		}
+		pts = append(pts, p)
+	}
+	return pts
+}
+
+func TestSocialJetlag(t *testing.T) {
+	cases := []struct {
+		name    string
+		pts     []dto.TrackPoint
+		want    float64
+		burnout bool
+	}{
+		// Midpoints 03:30 on weekdays and 06:15 on weekends.
+		{"clear", jetlagWeek("00:00", "07:00", "02:00", "10:30"), 2.75, true},
+		{"absent", jetlagWeek("23:30", "07:30", "23:30", "07:30"), 0, false},
+		// 23:00–05:00 (02:00) against 01:00–09:00 (05:00) crosses midnight on both sides.
+		{"across midnight", jetlagWeek("23:00", "05:00", "01:00", "09:00"), 3, true},
+		// Weekend midpoint 23:00 against weekday 01:00: earlier on weekends, the short way round.
+		{"earlier on weekends", jetlagWeek("21:00", "05:00", "19:00", "03:00"), -2, true},
+		{"small gap", jetlagWeek("23:30", "07:30", "00:30", "08:30"), 1, false},
+	}
+	for _, c := range cases {
+		if got := SocialJetlag(c.pts); got != c.want {
+			t.Errorf("%s: SocialJetlag = %v, want %v", c.name, got, c.want)
+		}
+		risk := ComputeBurnoutRisk(c.pts, ComputeProductivityModel(c.pts, DefaultEnergyScoreParams), DefaultBurnoutHorizonDays, nil, DefaultEnergyScoreParams)
+		if hasReason(risk, dto.BurnoutReasonSocialJetlag) != c.burnout {
+			t.Errorf("%s: social jetlag burnout reason = %v, want %v", c.name, !c.burnout, c.burnout)
+		}
+	}
+
+	// Weekends alone, or a single weekday night, are too few to compare.
+	pts := jetlagWeek("00:00", "07:00", "02:00", "10:30")
+	var weekends, oneWeekday []dto.TrackPoint
+	for _, p := range pts {
+		if wd := p.TS.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			weekends = append(weekends, p)
+			oneWeekday = append(oneWeekday, p)
+		}
+	}
+	oneWeekday = append(oneWeekday, pts[0])
+	for name, pts := range map[string][]dto.TrackPoint{"weekends only": weekends, "one weekday night": oneWeekday} {
+		if got := SocialJetlag(pts); got != 0 {
+			t.Errorf("%s: SocialJetlag = %v, want 0", name, got)
+		}
+	}
+}
+
+func TestClassifyChronotype(t *testing.T) {
+	cases := []struct {
+		name   string
+		energy map[int]float64
+		want   string
+	}{
+		{"clear morning", map[int]float64{7: 72, 9: 70, 18: 55, 20: 52}, ChronotypeMorning},
+		{"clear evening", map[int]float64{6: 40, 10: 45, 17: 70, 22: 75}, ChronotypeEvening},
+		{"flat", map[int]float64{7: 60, 9: 62, 18: 61, 20: 59}, ChronotypeNeutral},
+		// A gap of exactly the band is still flat.
+		{"band edge", map[int]float64{7: 65, 9: 65, 18: 60, 20: 60}, ChronotypeNeutral},
+		// Hours outside both windows do not count, however high.
+		{"afternoon peak", map[int]float64{7: 50, 9: 50, 14: 95, 15: 95, 18: 50, 20: 50}, ChronotypeNeutral},
+		{"one morning hour", map[int]float64{7: 90, 18: 40, 20: 40}, ChronotypeUnknown},
+		{"no evening", map[int]float64{6: 80, 8: 80, 11: 80}, ChronotypeUnknown},
+		{"empty", nil, ChronotypeUnknown},
+	}
+	for _, c := range cases {
+		if got := ClassifyChronotype(c.energy); got != c.want {
+			t.Errorf("%s: ClassifyChronotype = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestOptimalScheduleFollowsChronotype(t *testing.T) {
+	// Two days with the same concentration at every hour, so only the chronotype bonus orders them;
+	// evening hours carry far more energy.
+	var pts []dto.TrackPoint
+	for d := 0; d < 2; d++ {
+		for _, h := range []int{7, 9, 14, 18, 20} {
+			level := 4.0
+			if h >= 17 {
+				level = 9
+			}
+			pts = append(pts, dto.TrackPoint{
+				TS:         time.Date(2026, 3, 2+d, h, 0, 0, 0, time.UTC),
+				SleepHours: 7, SleepQuality: 7, Mood: level, Activity: level, Energy: level, Concentration: 6,
+			})
+		}
+	}
+
+	s := ComputeOptimalSchedule(pts, dto.Constraints{}, DefaultEnergyScoreParams)
+	if s.Chronotype != ChronotypeEvening {
+		t.Fatalf("chronotype = %q, want evening", s.Chronotype)
+	}
+	// Without the bonus the tie would go to the earliest hours: 07, 09 and 14.
+	if got := strings.Join(s.BestFocusHours, ","); got != "07:00,18:00,20:00" {
+		t.Errorf("focus hours = %s, want the evening hours first", got)
+	}
+
+	// Hours with a single sample do not count, so one morning alone leaves the chronotype unknown.
+	s = ComputeOptimalSchedule(pts[:3], dto.Constraints{}, DefaultEnergyScoreParams)
+	if s.Chronotype != ChronotypeUnknown {
+		t.Errorf("one morning: chronotype = %q, want unknown", s.Chronotype)
+	}
+}
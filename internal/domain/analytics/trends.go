@@ -0,0 +1,224 @@
+package analytics
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// trendMetric описывает ряд для экрана трендов: как достать значение из дневной точки
+// и какое изменение за весь период ещё считается «ровным».
+type trendMetric struct {
+	name     string
+	value    func(dto.TrackPoint, EnergyScoreParams) float64
+	flatSpan float64
+}
+
+var trendMetrics = []trendMetric{
+	{"sleep_hours", func(p dto.TrackPoint, _ EnergyScoreParams) float64 { return p.SleepHours }, 0.5},
+	{"mood", func(p dto.TrackPoint, _ EnergyScoreParams) float64 { return p.Mood }, 0.5},
+	{"energy", func(p dto.TrackPoint, _ EnergyScoreParams) float64 { return p.Energy }, 0.5},
+	{"stress", func(p dto.TrackPoint, _ EnergyScoreParams) float64 { return p.Stress }, 0.5},
+	{"productive", func(p dto.TrackPoint, _ EnergyScoreParams) float64 { return p.Productive }, 0.5},
+	{"energy_score", energyScore, 5},
+}
+
+// CollapseToDaily сворачивает отметки в одну точку на логический день (сутки начинаются в dayStartHour
+// по часовому поясу TS): числовые поля усредняются, дневной сон суммируется, флаги выставлены,
+// если были хотя бы в одной отметке.
+// TS результата — начало логического дня; точки отсортированы по времени.
+// Пример: CollapseToDaily(points, 4) -> 3 точки для трёх дней с отметками.
+func CollapseToDaily(pts []dto.TrackPoint, dayStartHour int) []dto.TrackPoint {
+	type acc struct {
+		sum dto.TrackPoint
+		n   float64
+	}
+	byDay := map[time.Time]*acc{}
+	for _, p := range pts {
+		day := logicalDay(p.TS, dayStartHour)
+		a, ok := byDay[day]
+		if !ok {
+			a = &acc{sum: dto.TrackPoint{TS: day}}
+			byDay[day] = a
+		}
+		a.n++
+		a.sum.SleepHours += p.SleepHours
+		a.sum.Mood += p.Mood
+		a.sum.Activity += p.Activity
+		a.sum.Productive += p.Productive
+		a.sum.Stress += p.Stress
+		a.sum.Energy += p.Energy
+		a.sum.Concentration += p.Concentration
+		a.sum.SleepQuality += p.SleepQuality
+		a.sum.Caffeine = a.sum.Caffeine || p.Caffeine
+		a.sum.Alcohol = a.sum.Alcohol || p.Alcohol
+		a.sum.Workout = a.sum.Workout || p.Workout
+		a.sum.NapMinutes += p.NapMinutes
+	}
+
+	out := make([]dto.TrackPoint, 0, len(byDay))
+	for _, a := range byDay {
+		p := a.sum
+		p.SleepHours /= a.n
+		p.Mood /= a.n
+		p.Activity /= a.n
+		p.Productive /= a.n
+		p.Stress /= a.n
+		p.Energy /= a.n
+		p.Concentration /= a.n
+		p.SleepQuality /= a.n
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].TS.Before(out[j].TS) })
+	return out
+}
+
+// DailyEnergyScores считает energy score каждого логического дня с отметками; ключ — дата дня "2006-01-02".
+// Пример: DailyEnergyScores(points, 4, DefaultEnergyScoreParams)["2026-10-14"] -> 68.2.
+func DailyEnergyScores(pts []dto.TrackPoint, dayStartHour int, ep EnergyScoreParams) map[string]float64 {
+	out := map[string]float64{}
+	for _, p := range CollapseToDaily(pts, dayStartHour) {
+		out[p.TS.Format("2006-01-02")] = round2(energyScore(p, ep))
+	}
+	return out
+}
+
+// MovingAverage сглаживает ряд скользящим средним по последним window значениям
+// (в начале ряда окно короче). window <= 1 возвращает копию ряда.
+// Пример: MovingAverage([]float64{1, 2, 3, 4}, 2) -> [1 1.5 2.5 3.5].
+func MovingAverage(vals []float64, window int) []float64 {
+	out := make([]float64, len(vals))
+	if window <= 1 {
+		copy(out, vals)
+		return out
+	}
+	var sum float64
+	for i, v := range vals {
+		sum += v
+		if i >= window {
+			sum -= vals[i-window]
+		}
+		n := i + 1
+		if n > window {
+			n = window
+		}
+		out[i] = sum / float64(n)
+	}
+	return out
+}
+
+// ComputeTrendSeries строит ряды основных метрик по дневным точкам (см. CollapseToDaily),
+// сглаженные окном window. Наклон — МНК по сырым значениям в единицах метрики за день
+// с учётом пропущенных дней; направление "flat", если изменение за период меньше порога метрики.
+// Пример: ComputeTrendSeries(daily, 3, DefaultEnergyScoreParams)[0].Direction -> "up".
+func ComputeTrendSeries(daily []dto.TrackPoint, window int, ep EnergyScoreParams) []dto.MetricSeries {
+	out := make([]dto.MetricSeries, 0, len(trendMetrics))
+	if len(daily) == 0 {
+		return out
+	}
+	x := make([]float64, len(daily))
+	for i, p := range daily {
+		x[i] = p.TS.Sub(daily[0].TS).Hours() / 24
+	}
+	for _, m := range trendMetrics {
+		raw := make([]float64, len(daily))
+		for i, p := range daily {
+			raw[i] = m.value(p, ep)
+		}
+		smoothed := MovingAverage(raw, window)
+		for i := range smoothed {
+			smoothed[i] = round2(smoothed[i])
+		}
+		slope := linearSlope(x, raw)
+		direction := "flat"
+		if span := slope * x[len(x)-1]; math.Abs(span) >= m.flatSpan {
+			direction = "up"
+			if span < 0 {
+				direction = "down"
+			}
+		}
+		out = append(out, dto.MetricSeries{
+			Metric:    m.name,
+			Values:    smoothed,
+			Slope:     math.Round(slope*1000) / 1000,
+			Direction: direction,
+		})
+	}
+	return out
+}
+
+// linearSlope считает наклон прямой МНК; при меньше чем двух разных x возвращает 0.
+// Пример: linearSlope([]float64{0, 1, 2}, []float64{1, 2, 3}) -> 1.
+func linearSlope(x, y []float64) float64 {
+	n := float64(len(x))
+	if len(x) < 2 {
+		return 0
+	}
+	var sx, sy, sxx, sxy float64
+	for i := range x {
+		sx += x[i]
+		sy += y[i]
+		sxx += x[i] * x[i]
+		sxy += x[i] * y[i]
+	}
+	den := n*sxx - sx*sx
+	if den == 0 {
+		return 0
+	}
+	return (n*sxy - sx*sy) / den
+}
+
+// LoggingStreak считает, сколько логических дней подряд (сутки начинаются в dayStartHour) есть отметки,
+// заканчивая днём now. Если за сегодня отметки ещё нет, серия считается от вчерашнего дня.
+// Пример: LoggingStreak(points, now, 4) -> 5.
+func LoggingStreak(pts []dto.TrackPoint, now time.Time, dayStartHour int) int {
+	logged := map[string]struct{}{}
+	for _, p := range pts {
+		logged[logicalDay(p.TS.In(now.Location()), dayStartHour).Format("2006-01-02")] = struct{}{}
+	}
+	day := logicalDay(now, dayStartHour)
+	if _, ok := logged[day.Format("2006-01-02")]; !ok {
+		day = day.AddDate(0, 0, -1)
+	}
+	streak := 0
+	for {
+		if _, ok := logged[day.Format("2006-01-02")]; !ok {
+			return streak
+		}
+		streak++
+		day = day.AddDate(0, 0, -1)
+	}
+}
+
+// logicalDay возвращает начало логического дня, в который попадает t.
+func logicalDay(t time.Time, dayStartHour int) time.Time {
+	shifted := t.Add(-time.Duration(dayStartHour) * time.Hour)
+	return time.Date(shifted.Year(), shifted.Month(), shifted.Day(), dayStartHour, 0, 0, 0, t.Location())
+}
+
+// averagesFlatDelta — изменение среднего между двумя разборами, меньше которого метрика считается «ровной».
+const averagesFlatDelta = 0.25
+
+// CompareAverages задаёт направление каждой метрики, которая есть в обоих наборах средних:
+// "up", "down" или "flat", если разница меньше averagesFlatDelta.
+// Пример: CompareAverages({"mood": 6}, {"mood": 6.5}) -> {"mood": "up"}.
+func CompareAverages(prev, cur map[string]float64) map[string]string {
+	out := make(map[string]string, len(cur))
+	for metric, v := range cur {
+		p, ok := prev[metric]
+		if !ok {
+			continue
+		}
+		switch d := v - p; {
+		case math.Abs(d) < averagesFlatDelta:
+			out[metric] = "flat"
+		case d > 0:
+			out[metric] = "up"
+		default:
+			out[metric] = "down"
+		}
+	}
+	return out
+}
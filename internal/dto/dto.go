@@ -21,6 +21,28 @@ type TrackPoint struct {
 	Workout       bool      `json:"workout"`
 	LLMText       string    `json:"llm_text"`
 	AnalysisStatus string   `json:"analysis_status"`
+	// SleepStartTS/SleepEndTS — точные границы сна от носимых устройств; nil при ручном вводе HH:MM.
+	SleepStartTS *time.Time `json:"sleep_start_ts,omitempty"`
+	SleepEndTS   *time.Time `json:"sleep_end_ts,omitempty"`
+	// Missing — числовые поля из OptionalTrackFields, которые не были заполнены; их значение в точке — 0.
+	Missing map[string]bool `json:"missing,omitempty"`
+	// Tags — метки дня ("sick", "vacation"): в нижнем регистре, без повторов, отсортированы.
+	Tags []string `json:"tags,omitempty"`
+	// NapMinutes — дневной сон в минутах (0–300); 0 — не спал днём или не отмечено.
+	NapMinutes int `json:"nap_minutes,omitempty"`
+	// Context — внешние факторы дня от пользователя ("weather": "rain"): ключи как у меток, значения — категории.
+	Context map[string]string `json:"context,omitempty"`
+}
+
+// OptionalTrackFields — числовые поля отметки, которые можно не заполнять (имена как в track_points).
+// Порядок задаёт биты track_points.presence (бит i — поле i), его нельзя менять, только дописывать в конец.
+var OptionalTrackFields = []string{
+	"sleep_hours", "mood", "activity", "productive", "stress", "energy", "concentration", "sleep_quality",
+}
+
+// Has сообщает, было ли поле field заполнено; ноль у заполненного поля — настоящая оценка.
+func (p TrackPoint) Has(field string) bool {
+	return !p.Missing[field]
 }
 
 type Period string
@@ -40,12 +62,26 @@ type TrackRequest struct {
 }
 
 type UserProfile struct {
-	UserID  int32  `json:"user_id"`
-	Name    string `json:"name"`
-	Email   string `json:"email"`
-	Emoji   string `json:"emoji"`
-	BgIndex int32  `json:"bg_index"`
-	IsFriend bool  `json:"is_friend"`
+	UserID       int32  `json:"user_id"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	Emoji        string `json:"emoji"`
+	BgIndex      int32  `json:"bg_index"`
+	IsFriend     bool   `json:"is_friend"`
+	DayStartHour int32  `json:"day_start_hour"`
+	Tone         Tone   `json:"tone,omitempty"`
+	// ShareAnalyses — согласие показывать сохранённые разборы друзьям; по умолчанию выключено.
+	ShareAnalyses bool `json:"share_analyses"`
+	// AnalysesEnabled — выключенный разбор оставляет только числа: без фоновых прогонов и без LLM.
+	AnalysesEnabled bool `json:"analyses_enabled"`
+}
+
+// FriendAnalysis — последние сохранённые разборы друга. Без его согласия (Shared = false) заполнен только профиль.
+type FriendAnalysis struct {
+	Profile   UserProfile                `json:"profile"`
+	Shared    bool                       `json:"shared"`
+	Analyses  map[string]AnalyzeResponse `json:"analyses,omitempty"`
+	UpdatedAt map[string]time.Time       `json:"updated_at,omitempty"`
 }
 
 type FriendRequest struct {
@@ -56,12 +92,141 @@ type FriendRequest struct {
 	CreatedAt time.Time   `json:"created_at"`
 }
 
+type FriendComparison struct {
+	Friend          UserProfile        `json:"friend"`
+	Period          string             `json:"period"`
+	Metrics         []MetricComparison `json:"metrics"`
+	Summary         string             `json:"summary"`
+	MyUpdatedAt     time.Time          `json:"my_updated_at"`
+	FriendUpdatedAt time.Time          `json:"friend_updated_at"`
+}
+
+// FriendSummary — друг для экрана друзей: профиль и только флаги состояния, без отметок и заметок.
+// LoggedToday считается от начала дня друга в его часовом поясе, BurnoutLevel — из последнего недельного разбора
+// и пуст, если друг не делится разборами.
+type FriendSummary struct {
+	Profile      UserProfile `json:"profile"`
+	LoggedToday  bool        `json:"logged_today"`
+	BurnoutLevel string      `json:"burnout_level,omitempty"`
+}
+
+// Виды событий ленты друзей.
+const (
+	ActivityKindStreak          = "streak"
+	ActivityKindBurnoutImproved = "burnout_improved"
+)
+
+// FriendActivity — событие ленты друзей: вехи без сырых метрик. Value — длина серии в днях для streak
+// или новый уровень риска для burnout_improved.
+type FriendActivity struct {
+	ID        int64       `json:"id"`
+	Friend    UserProfile `json:"friend"`
+	Kind      string      `json:"kind"`
+	Value     string      `json:"value"`
+	Message   string      `json:"message"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type MetricComparison struct {
+	Metric string  `json:"metric"`
+	Mine   float64 `json:"mine"`
+	Friend float64 `json:"friend"`
+	Delta  float64 `json:"delta"`  // mine - friend
+	Leader string  `json:"leader"` // me | friend | tie
+}
+
+// ProviderStatus — состояние LLM-провайдера для операторов: вызовы за последние Window, последняя ошибка
+// и признак деградации (доля ошибок за окно не меньше половины).
+// Пример: {Name: "api.deepseek.com", Model: "deepseek-chat", Successes: 40, Errors: 2, Degraded: false}.
+type ProviderStatus struct {
+	Name        string        `json:"name"`
+	Model       string        `json:"model"`
+	Window      time.Duration `json:"window"`
+	Successes   int64         `json:"successes"`
+	Errors      int64         `json:"errors"`
+	Degraded    bool          `json:"degraded"`
+	LastError   string        `json:"last_error,omitempty"`
+	LastErrorAt time.Time     `json:"last_error_at,omitempty"`
+}
+
+// InsightDiff — что изменилось между двумя сохранёнными разборами одного периода. FromAt/ToAt — время
+// найденных разборов (последний разбор не позже запрошенного момента), не сами запрошенные моменты.
+type InsightDiff struct {
+	Period  string       `json:"period"`
+	FromAt  time.Time    `json:"from_at"`
+	ToAt    time.Time    `json:"to_at"`
+	Metrics []MetricDiff `json:"metrics"`
+}
+
+type MetricDiff struct {
+	Metric string  `json:"metric"`
+	From   float64 `json:"from"`
+	To     float64 `json:"to"`
+	Delta  float64 `json:"delta"`  // to - from
+	Change string  `json:"change"` // improved | regressed | unchanged
+}
+
+// AggregateStats — обезличенная статистика по всем пользователям; в ней никогда нет идентификаторов.
+type AggregateStats struct {
+	From           time.Time          `json:"from"`
+	To             time.Time          `json:"to"`
+	NumPoints      int64              `json:"num_points"`
+	NumUsers       int64              `json:"num_users"`
+	AvgSleepHours  float64            `json:"avg_sleep_hours"`
+	AvgMood        float64            `json:"avg_mood"`
+	AvgStress      float64            `json:"avg_stress"`
+	AvgEnergy      float64            `json:"avg_energy"`
+	SleepHistogram []HistogramBucket  `json:"sleep_histogram"`
+	ByWeekday      []WeekdayAggregate `json:"by_weekday"`
+}
+
+type HistogramBucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count int64   `json:"count"`
+}
+
+type WeekdayAggregate struct {
+	Weekday   string  `json:"weekday"`
+	NumPoints int64   `json:"num_points"`
+	AvgMood   float64 `json:"avg_mood"`
+	AvgStress float64 `json:"avg_stress"`
+	AvgEnergy float64 `json:"avg_energy"`
+}
+
+// Tone — стиль разбора; пустой — обычный стиль без дополнительной директивы.
+type Tone string
+
+const (
+	ToneDefault  Tone = ""
+	ToneGentle   Tone = "gentle"
+	ToneDirect   Tone = "direct"
+	ToneDataOnly Tone = "data_only"
+)
+
 type AnalyzeRequest struct {
-	UserID      int32       `json:"-"`
-	UserTZ      string      `json:"user_tz"`
-	WeekStarts  string      `json:"week_starts"`
-	Constraints Constraints `json:"constraints"`
-	Period      Period      `json:"period"`
+	UserID             int32       `json:"-"`
+	UserTZ             string      `json:"user_tz"`
+	WeekStarts         string      `json:"week_starts"`
+	Constraints        Constraints `json:"constraints"`
+	Period             Period      `json:"period"`
+	BurnoutHorizonDays int         `json:"burnout_horizon_days,omitempty"`
+	Feedback           string      `json:"feedback,omitempty"`
+	Model              string      `json:"model,omitempty"`
+	Tone               Tone        `json:"tone,omitempty"`
+	// Date (YYYY-MM-DD, локально) — разбор одного прошедшего дня вместо окна, заканчивающегося сейчас.
+	Date        string `json:"date,omitempty"`
+	SkipInsight bool   `json:"skip_insight,omitempty"`
+	// Tags — разбирать только отметки, у которых есть все эти метки.
+	Tags []string `json:"tags,omitempty"`
+	// RollingDays > 0 — окно «последние N дней» до текущего момента вместо календарного Period.
+	RollingDays int `json:"rolling_days,omitempty"`
+	// ExcludeTags — убрать из разбора дни, где хоть одна отметка несёт одну из этих меток (отпуск, болезнь).
+	ExcludeTags []string `json:"exclude_tags,omitempty"`
+	// ExcludeOutliers — убрать дни с выбивающимся energy score (см. analytics.ExcludeDays).
+	ExcludeOutliers bool `json:"exclude_outliers,omitempty"`
+	// Fields — какие блоки ответа нужны клиенту; пусто — все. Неполный ответ не сохраняется как последний разбор.
+	Fields []string `json:"fields,omitempty"`
 }
 
 type Constraints struct {
@@ -70,24 +235,292 @@ type Constraints struct {
 }
 
 type AnalyzeResponse struct {
-	EnergyByWeekday   map[string]float64 `json:"energy_by_weekday"`
-	ProductivityModel ProductivityModel  `json:"productivity_model"`
-	BurnoutRisk       BurnoutRisk        `json:"burnout_risk"`
-	OptimalSchedule   OptimalSchedule    `json:"optimal_schedule"`
-	LLMInsight        string             `json:"llm_insight"`
-	Debug             map[string]any     `json:"debug,omitempty"`
+	EnergyByWeekday map[string]float64 `json:"energy_by_weekday"`
+	// EnergyByWeekdayCI — те же средние с 95% доверительным интервалом для полос погрешности.
+	EnergyByWeekdayCI map[string]EnergyCI `json:"energy_by_weekday_ci,omitempty"`
+	ProductivityModel ProductivityModel   `json:"productivity_model"`
+	BurnoutRisk       BurnoutRisk         `json:"burnout_risk"`
+	OptimalSchedule   OptimalSchedule     `json:"optimal_schedule"`
+	LLMInsight        string              `json:"llm_insight"`
+	InsightSource     string              `json:"insight_source,omitempty"` // llm | summary | onboarding (the last two without AI); empty without insight
+	// InsightUnchanged — новый разбор почти совпал с предыдущим за период, и вместо него отдан прежний текст.
+	InsightUnchanged bool                 `json:"insight_unchanged,omitempty"`
+	DataSufficiency  DataSufficiency      `json:"data_sufficiency"`
+	DataQuality      []DataQualityWarning `json:"data_quality_warnings,omitempty"`
+	FilterTags       []string             `json:"filter_tags,omitempty"`
+	Averages         map[string]float64   `json:"averages,omitempty"`
+	FocusStats       *FocusStats          `json:"focus_stats,omitempty"`
+	// SocialJetlagHours — на сколько часов середина сна в выходные позже, чем в будни; 0 — сдвига нет или мало данных.
+	SocialJetlagHours float64    `json:"social_jetlag_hours,omitempty"`
+	PeriodMeta        PeriodMeta `json:"period_meta"`
+	// ExcludedDays — сколько дней убрано из разбора по ExcludeTags и ExcludeOutliers.
+	ExcludedDays int            `json:"excluded_days,omitempty"`
+	Debug        map[string]any `json:"debug,omitempty"`
+}
+
+// EnergyCI — средняя энергия дня недели с 95% доверительным интервалом [Low, High] по Samples отметкам.
+// При одной отметке StdErr nil (разброс по одной точке не оценить), а интервал — вся шкала 0–100.
+type EnergyCI struct {
+	Mean    float64  `json:"mean"`
+	StdErr  *float64 `json:"std_err,omitempty"`
+	Low     float64  `json:"low"`
+	High    float64  `json:"high"`
+	Samples int      `json:"samples"`
+}
+
+// PeriodMeta — окно, которое на самом деле разобрано: Start и End в часовом поясе пользователя (End не
+// входит в окно, нулевой Start — «всё время»), Label — подпись для интерфейса.
+// Пример: {Start: 2025-03-03 04:00, End: 2025-03-10 03:00, Label: "3–9 марта"}.
+type PeriodMeta struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Label string    `json:"label"`
+}
+
+// Clone возвращает глубокую копию ответа: карты и срезы не разделяются с исходником, поэтому копию
+// можно отдать другим горутинам или в кэш, пока исходный ответ дальше изменяется.
+// Пример: cached := resp.Clone(); cached.LLMInsight = "" — resp не меняется.
+func (r AnalyzeResponse) Clone() AnalyzeResponse {
+	out := r
+	out.EnergyByWeekday = cloneFloatMap(r.EnergyByWeekday)
+	if r.EnergyByWeekdayCI != nil {
+		out.EnergyByWeekdayCI = make(map[string]EnergyCI, len(r.EnergyByWeekdayCI))
+		for k, v := range r.EnergyByWeekdayCI {
+			if v.StdErr != nil {
+				se := *v.StdErr
+				v.StdErr = &se
+			}
+			out.EnergyByWeekdayCI[k] = v
+		}
+	}
+	out.ProductivityModel.Weights = cloneFloatMap(r.ProductivityModel.Weights)
+	out.ProductivityModel.Components = cloneFloatMap(r.ProductivityModel.Components)
+	out.ProductivityModel.Contributions = cloneFloatMap(r.ProductivityModel.Contributions)
+	out.BurnoutRisk.Reasons = cloneSlice(r.BurnoutRisk.Reasons)
+	out.BurnoutRisk.StructuredReasons = cloneSlice(r.BurnoutRisk.StructuredReasons)
+	out.OptimalSchedule.BestFocusHours = cloneSlice(r.OptimalSchedule.BestFocusHours)
+	out.OptimalSchedule.BestLightTasksHours = cloneSlice(r.OptimalSchedule.BestLightTasksHours)
+	out.OptimalSchedule.RecoveryTips = cloneSlice(r.OptimalSchedule.RecoveryTips)
+	out.DataSufficiency.Coverage = cloneSlice(r.DataSufficiency.Coverage)
+	for i, c := range out.DataSufficiency.Coverage {
+		out.DataSufficiency.Coverage[i].RequiredFields = cloneSlice(c.RequiredFields)
+		out.DataSufficiency.Coverage[i].MissingFields = cloneSlice(c.MissingFields)
+	}
+	out.DataQuality = cloneSlice(r.DataQuality)
+	out.FilterTags = cloneSlice(r.FilterTags)
+	out.Averages = cloneFloatMap(r.Averages)
+	if r.FocusStats != nil {
+		fs := *r.FocusStats
+		fs.BestHours = cloneSlice(fs.BestHours)
+		out.FocusStats = &fs
+	}
+	if r.Debug != nil {
+		out.Debug = cloneAny(r.Debug).(map[string]any)
+	}
+	return out
+}
+
+// cloneSlice копирует срез, сохраняя nil, чтобы omitempty в JSON вёл себя как у исходника.
+func cloneSlice[T any](in []T) []T {
+	if in == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(in)), in...)
+}
+
+func cloneFloatMap(in map[string]float64) map[string]float64 {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]float64, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+// cloneAny копирует вложенные map[string]any и []any из Debug; скаляры копируются по значению.
+func cloneAny(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, e := range t {
+			out[k] = cloneAny(e)
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, e := range t {
+			out[i] = cloneAny(e)
+		}
+		return out
+	}
+	return v
+}
+
+const (
+	DataQualityEnergyContradiction = "energy_contradiction"
+	DataQualitySleepHoursMismatch  = "sleep_hours_mismatch"
+)
+
+// DataQualityWarning — нефатальное предупреждение о противоречивой отметке за день Date (YYYY-MM-DD).
+type DataQualityWarning struct {
+	Date    string `json:"date"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+const (
+	DataSufficiencyNone         = "none"
+	DataSufficiencyInsufficient = "insufficient"
+	DataSufficiencySufficient   = "sufficient"
+)
+
+type DataSufficiency struct {
+	Level           string             `json:"level"`
+	NumPoints       int                `json:"num_points"`
+	NumObservedDays int                `json:"num_observed_days"`
+	Coverage        []AnalysisCoverage `json:"coverage"`
+}
+
+// AnalysisCoverage — сколько из залогированных дней пригодны для разбора данного типа (energy, burnout,
+// trends): у дня должна быть отметка со всеми RequiredFields. MissingFields — какие из них не заполнены
+// в остальных днях, то есть что нужно добавить, чтобы дни засчитались.
+type AnalysisCoverage struct {
+	Analysis       string   `json:"analysis"`
+	Days           int      `json:"days"`
+	MinDays        int      `json:"min_days"`
+	RequiredFields []string `json:"required_fields"`
+	MissingFields  []string `json:"missing_fields,omitempty"`
 }
 
 type ProductivityModel struct {
-	Weights map[string]float64 `json:"weights"`
-	Score   float64            `json:"score"`
+	Weights       map[string]float64 `json:"weights"`
+	Score         float64            `json:"score"`
+	Components    map[string]float64 `json:"components,omitempty"`    // raw 0..100 value of each factor
+	Contributions map[string]float64 `json:"contributions,omitempty"` // weight * value; sums to Score before clamping
+	// Insufficient — отметок меньше analytics.MinProductivityPoints: Score = 0 и компонентов нет.
+	Insufficient bool `json:"insufficient,omitempty"`
 }
 
 type BurnoutRisk struct {
-	Score                 float64  `json:"score"`
-	Level                 string   `json:"level"`
-	Reasons               []string `json:"reasons"`
-	PredictionHorizonDays int      `json:"prediction_horizon_days"`
+	Score                 float64         `json:"score"`
+	Level                 string          `json:"level"`
+	Reasons               []string        `json:"reasons"`
+	StructuredReasons     []BurnoutReason `json:"structured_reasons,omitempty"`
+	PredictionHorizonDays int             `json:"prediction_horizon_days"`
+	Dismissed             bool            `json:"dismissed,omitempty"`
+	// CoverageRatio — доля дней с отметками в окне прогноза, считая с первой отметки (0–1).
+	CoverageRatio float64 `json:"coverage_ratio"`
+}
+
+// BurnoutAlert — тело вебхука, когда недельный риск выгорания стал высоким. Reasons — коды сигналов
+// (BurnoutReason*), без сырых метрик и заметок.
+type BurnoutAlert struct {
+	UserID  int32     `json:"user_id"`
+	Level   string    `json:"level"`
+	Score   float64   `json:"score"`
+	Reasons []string  `json:"reasons"`
+	At      time.Time `json:"at"`
+}
+
+// Stable codes of the burnout signals; clients localize and visualize by these.
+const (
+	BurnoutReasonSleepDebt        = "sleep_debt"
+	BurnoutReasonMoodDown         = "mood_down"
+	BurnoutReasonEnergyVolatile   = "energy_volatile"
+	BurnoutReasonLowProductivity  = "low_productivity"
+	BurnoutReasonHighStress       = "high_stress"
+	BurnoutReasonLowSelfEnergy    = "low_self_energy"
+	BurnoutReasonPoorSleepQuality = "poor_sleep_quality"
+	BurnoutReasonAlcoholOften     = "alcohol_often"
+	BurnoutReasonWorkoutRare      = "workout_rare"
+	BurnoutReasonSocialJetlag     = "social_jetlag"
+	BurnoutReasonLowCoverage      = "low_coverage"
+)
+
+// ScorePreview — показатели периода при одном наборе параметров энергии и весов продуктивности.
+type ScorePreview struct {
+	AvgEnergyScore    float64 `json:"avg_energy_score"`
+	ProductivityScore float64 `json:"productivity_score"`
+	BurnoutScore      float64 `json:"burnout_score"`
+	BurnoutLevel      string  `json:"burnout_level"`
+}
+
+// SettingsPreview — те же отметки, посчитанные с текущими (Current) и предложенными (Proposed) настройками.
+// Ничего не сохраняется.
+type SettingsPreview struct {
+	Period    Period       `json:"period"`
+	NumPoints int          `json:"num_points"`
+	Current   ScorePreview `json:"current"`
+	Proposed  ScorePreview `json:"proposed"`
+}
+
+// MetricDefinition — запись справочника метрик для подсказок в интерфейсе. Weight — доля компонента
+// в продуктивности или баллы риска сигнала выгорания; у итоговых показателей 0.
+type MetricDefinition struct {
+	Code        string  `json:"code"`
+	Kind        string  `json:"kind"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Weight      float64 `json:"weight,omitempty"`
+}
+
+// NoteKeyword — слово из заметок за период: Count — сколько раз встретилось, Notes — в скольких заметках.
+type NoteKeyword struct {
+	Word  string `json:"word"`
+	Count int    `json:"count"`
+	Notes int    `json:"notes"`
+}
+
+// ContextGroup — отметки с одним значением ключа контекста: средние настроение (только заполненное) и энергия дня.
+type ContextGroup struct {
+	Value     string  `json:"value"`
+	NumPoints int     `json:"num_points"`
+	AvgMood   float64 `json:"avg_mood"`
+	AvgEnergy float64 `json:"avg_energy"`
+}
+
+// ContextBreakdown — разбивка периода по значениям ключа Key; NumPoints — отметки, где ключ задан.
+type ContextBreakdown struct {
+	Key       string         `json:"key"`
+	NumPoints int            `json:"num_points"`
+	Groups    []ContextGroup `json:"groups"`
+}
+
+// CorrelationCell — корреляция Пирсона показателей A и B на N парах. Strength: strong | moderate | weak |
+// insufficient_data (R = 0); Significant — связь значима при p < 0.05.
+type CorrelationCell struct {
+	A           string  `json:"a"`
+	B           string  `json:"b"`
+	R           float64 `json:"r"`
+	N           int     `json:"n"`
+	Strength    string  `json:"strength"`
+	Significant bool    `json:"significant"`
+}
+
+// CorrelationMatrix — попарные корреляции показателей Metrics за период, по верхнему треугольнику.
+type CorrelationMatrix struct {
+	Metrics   []string          `json:"metrics"`
+	NumPoints int               `json:"num_points"`
+	Cells     []CorrelationCell `json:"cells"`
+}
+
+// BedtimeRecommendation — рекомендованный отбой на сегодня. Status: shift_earlier | maintain |
+// insufficient_data; Bedtime и UsualBedtime — "HH:MM", ShiftMinutes — насколько раньше обычного.
+type BedtimeRecommendation struct {
+	Bedtime        string  `json:"bedtime"`
+	UsualBedtime   string  `json:"usual_bedtime"`
+	SleepDebtHours float64 `json:"sleep_debt_hours"`
+	ShiftMinutes   int     `json:"shift_minutes"`
+	Status         string  `json:"status"`
+	Message        string  `json:"message"`
+}
+
+type BurnoutReason struct {
+	Code     string  `json:"code"`
+	Weight   float64 `json:"weight"`   // points this signal added to the risk score
+	Severity string  `json:"severity"` // low | medium | high
 }
 
 type OptimalSchedule struct {
@@ -95,10 +528,127 @@ type OptimalSchedule struct {
 	BestFocusHours       []string `json:"best_focus_hours"`
 	BestLightTasksHours  []string `json:"best_light_tasks_hours"`
 	RecoveryTips         []string `json:"recovery_tips"`
+	// Chronotype — morning | evening | neutral | unknown (мало данных), см. analytics.ClassifyChronotype.
+	Chronotype string `json:"chronotype,omitempty"`
+}
+
+// FocusSession — отдельно залогированная сессия фокуса; Quality — самооценка 0–10.
+type FocusSession struct {
+	ID        int64     `json:"id"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	Quality   float64   `json:"quality"`
+}
+
+// FocusStats — сводка по сессиям фокуса за окно разбора. BestHours — часы начала ("HH:00") с лучшей
+// средней оценкой сессий; в отличие от OptimalSchedule они считаются по реальным сессиям, а не по энергии.
+type FocusStats struct {
+	NumSessions       int      `json:"num_sessions"`
+	TotalHours        float64  `json:"total_hours"`
+	AvgSessionMinutes float64  `json:"avg_session_minutes"`
+	AvgQuality        float64  `json:"avg_quality"`
+	BestHours         []string `json:"best_hours"`
+}
+
+// StreakCalendar — по дню на каждую дату диапазона From..To (YYYY-MM-DD, включительно, по часовому поясу
+// пользователя) для календаря регулярности.
+type StreakCalendar struct {
+	From string        `json:"from"`
+	To   string        `json:"to"`
+	Days []CalendarDay `json:"days"`
+}
+
+// CalendarDay — был ли день залогирован; EnergyScore заполнен только для залогированных дней и по запросу.
+type CalendarDay struct {
+	Date        string   `json:"date"`
+	Logged      bool     `json:"logged"`
+	EnergyScore *float64 `json:"energy_score,omitempty"`
+}
+
+// FailedAnalysis — день, фоновый разбор которого упал и ждёт повтора. Dead — попытки исчерпаны.
+type FailedAnalysis struct {
+	UserID        int32     `json:"user_id"`
+	UserTZ        string    `json:"user_tz"`
+	DayStart      time.Time `json:"day_start"`
+	DayEnd        time.Time `json:"day_end"`
+	Attempts      int       `json:"attempts"`
+	LastError     string    `json:"last_error"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	Dead          bool      `json:"dead"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 // ====== scheduling helper ======
 
+type HourStat struct {
+	Hour      int
+	AvgFocus  float64
+	AvgEnergy float64
+	Count     int
+}
+
+// BestTime — одна рекомендация «когда делать сложное завтра» и лучший слот для восстановления.
+type BestTime struct {
+	EnoughData         bool    `json:"enough_data"`
+	FocusHour          string  `json:"focus_hour,omitempty"`
+	FocusConfidence    float64 `json:"focus_confidence"`
+	RecoveryHour       string  `json:"recovery_hour,omitempty"`
+	RecoveryConfidence float64 `json:"recovery_confidence"`
+	Message            string  `json:"message"`
+}
+
+// TomorrowSchedule — заранее посчитанное расписание на следующий логический день (Date, YYYY-MM-DD локально)
+// вместе с временем напоминания. NumPoints — сколько отметок легло в расчёт.
+type TomorrowSchedule struct {
+	Date       string             `json:"date"`
+	Schedule   OptimalSchedule    `json:"schedule"`
+	Reminder   ReminderSuggestion `json:"reminder"`
+	NumPoints  int                `json:"num_points"`
+	ComputedAt time.Time          `json:"computed_at"`
+}
+
+// WeeklyReport — итоги последних 7 дней для карточки «поделиться». Собирается из отметок и сохранённого
+// недельного разбора без вызова LLM. Лучший/худший день недели — по средней энергии.
+type WeeklyReport struct {
+	WeekStart    string             `json:"week_start"`
+	WeekEnd      string             `json:"week_end"`
+	NumDays      int                `json:"num_days"`
+	Averages     map[string]float64 `json:"averages"`
+	BestWeekday  string             `json:"best_weekday,omitempty"`
+	WorstWeekday string             `json:"worst_weekday,omitempty"`
+	BurnoutLevel string             `json:"burnout_level,omitempty"`
+	StreakDays   int                `json:"streak_days"`
+	TopHabit     string             `json:"top_habit,omitempty"`
+	TopHabitDays int                `json:"top_habit_days"`
+	Summary      string             `json:"summary,omitempty"`
+}
+
+// ReminderSuggestion — время ежедневного напоминания (HH:MM, локально). Habitual=false — привычного
+// времени отметок нет и Time — время по умолчанию.
+type ReminderSuggestion struct {
+	Time       string  `json:"time"`
+	Habitual   bool    `json:"habitual"`
+	Confidence float64 `json:"confidence"`
+	NumLogs    int     `json:"num_logs"`
+}
+
+// Trends — дневные ряды основных метрик для экрана графиков, без обращения к LLM.
+type Trends struct {
+	From   time.Time      `json:"from"`
+	To     time.Time      `json:"to"`
+	Window int            `json:"window"`
+	Days   []string       `json:"days"`
+	Series []MetricSeries `json:"series"`
+}
+
+// MetricSeries — ряд одной метрики по дням из Trends.Days; Slope — изменение в день.
+type MetricSeries struct {
+	Metric    string    `json:"metric"`
+	Values    []float64 `json:"values"`
+	Slope     float64   `json:"slope"`
+	Direction string    `json:"direction"` // up | down | flat
+}
+
 type Win struct {
 	Start int
 	Val   float64
@@ -120,7 +670,12 @@ type AIPrompt struct {
 	NumObservedWeekdays  int
 	NumObservedDays      int
 	ObservedWeekdaysList string
+	TrendsReliable       bool
+	HiddenMetrics        []string
+	Model                string // пусто — модель клиента по умолчанию
+	Tone                 Tone
 	UserNotes            string
+	Feedback             string
 	AvgSleepHours        float64
 	AvgSleepQuality      float64
 	AvgMood              float64
@@ -137,6 +692,29 @@ type AIPrompt struct {
 	MaxStress            float64
 	MinSleepHours        float64
 	MaxSleepHours        float64
+	// SocialJetlagHours — см. AnalyzeResponse.SocialJetlagHours.
+	SocialJetlagHours float64
+	// RecentWindowDays > 0 — история длинная: заметки взяты только за последние RecentWindowDays дней,
+	// Recent* — средние за это окно рядом со средними за весь период. Более ранняя история сведена к сводке:
+	// OlderNotes — сколько заметок раньше окна не попало в промпт, OlderKeywords — самые частые слова в них.
+	RecentWindowDays    int
+	RecentAvgSleepHours float64
+	RecentAvgMood       float64
+	RecentAvgStress     float64
+	RecentAvgEnergy     float64
+	OlderNotes          int
+	OlderKeywords       []string
+	// Goals — цели пользователя и их выполнение за последнюю неделю; пусто, если целей нет.
+	Goals []GoalProgress
+}
+
+// GoalProgress — выполнение цели по метрике: в Met из Days последних дней с заполненной метрикой
+// среднее за день было не ниже Target (для стресса — не выше).
+type GoalProgress struct {
+	Metric string  `json:"metric"`
+	Target float64 `json:"target"`
+	Met    int     `json:"met"`
+	Days   int     `json:"days"`
 }
 
 // ====== AI chat API payloads ======
@@ -158,9 +736,12 @@ type AIChatRequest struct {
 // В твоём коде это было анонимными struct{} внутри Choices.
 // Чтобы "все структуры" были явными — выносим:
 
+// ReasoningContent заполняет только deepseek-reasoner: ход рассуждений модели отдельно от ответа.
+// Пользователю он не показывается.
 type AIChatChoiceMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role             string `json:"role"`
+	Content          string `json:"content"`
+	ReasoningContent string `json:"reasoning_content,omitempty"`
 }
 
 type AIChatChoice struct {
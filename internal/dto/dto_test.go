@@ -0,0 +1,124 @@
+package dto
+
+import (
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func fullResponse() AnalyzeResponse {
+	return AnalyzeResponse{
+		EnergyByWeekday:   map[string]float64{"Mon": 60},
+		EnergyByWeekdayCI: map[string]EnergyCI{"Mon": {Mean: 60, Samples: 3}},
+		ProductivityModel: ProductivityModel{
+			Weights:       map[string]float64{"sleep": 0.3},
+			Components:    map[string]float64{"sleep": 70},
+			Contributions: map[string]float64{"sleep": 21},
+		},
+		BurnoutRisk: BurnoutRisk{
+			Reasons:           []string{"стресс"},
+			StructuredReasons: []BurnoutReason{{Code: "stress", Weight: 10}},
+		},
+		OptimalSchedule: OptimalSchedule{
+			BestFocusHours:      []string{"10:00"},
+			BestLightTasksHours: []string{"15:00"},
+			RecoveryTips:        []string{"прогулка"},
+		},
+		DataSufficiency: DataSufficiency{Coverage: []AnalysisCoverage{{
+			RequiredFields: []string{"energy"},
+			MissingFields:  []string{"stress"},
+		}}},
+		DataQuality: []DataQualityWarning{{Code: "gap"}},
+		FilterTags:  []string{"work"},
+		Averages:    map[string]float64{"mood": 7},
+		FocusStats:  &FocusStats{NumSessions: 2, BestHours: []string{"09:00"}},
+		Debug:       map[string]any{"stage": map[string]any{"ms": 12}, "list": []any{"a"}},
+	}
+}
+
+func TestAnalyzeResponseCloneIsolation(t *testing.T) {
+	orig := fullResponse()
+	clone := orig.Clone()
+	if !reflect.DeepEqual(clone, orig) {
+		t.Fatalf("clone differs from the original:\n%+v\n%+v", clone, orig)
+	}
+
+	clone.EnergyByWeekday["Mon"] = 0
+	clone.EnergyByWeekdayCI["Tue"] = EnergyCI{}
+	clone.ProductivityModel.Weights["sleep"] = 0
+	clone.ProductivityModel.Components["sleep"] = 0
+	clone.ProductivityModel.Contributions["sleep"] = 0
+	clone.BurnoutRisk.Reasons[0] = ""
+	clone.BurnoutRisk.StructuredReasons[0].Code = ""
+	clone.OptimalSchedule.BestFocusHours[0] = ""
+	clone.OptimalSchedule.BestLightTasksHours[0] = ""
+	clone.OptimalSchedule.RecoveryTips[0] = ""
+	clone.DataSufficiency.Coverage[0].RequiredFields[0] = ""
+	clone.DataSufficiency.Coverage[0].MissingFields[0] = ""
+	clone.DataQuality[0].Code = ""
+	clone.FilterTags[0] = ""
+	clone.Averages["mood"] = 0
+	clone.FocusStats.BestHours[0] = ""
+	clone.FocusStats.NumSessions = 0
+	clone.Debug["stage"].(map[string]any)["ms"] = 0
+	clone.Debug["list"].([]any)[0] = ""
+
+	if want := fullResponse(); !reflect.DeepEqual(orig, want) {
+		t.Errorf("mutating the clone changed the original:\n%+v\nwant\n%+v", orig, want)
+	}
+
+	if got := (AnalyzeResponse{}).Clone(); !reflect.DeepEqual(got, AnalyzeResponse{}) {
+		t.Errorf("clone of an empty response = %+v, want nil maps and slices kept", got)
+	}
+}
+
+// Run with -race: clones handed to readers must not share memory with the writer.
+func TestAnalyzeResponseCloneConcurrent(t *testing.T) {
+	orig := fullResponse()
+	clones := make([]AnalyzeResponse, 8)
+	for i := range clones {
+		clones[i] = orig.Clone()
+	}
+	var wg sync.WaitGroup
+	for _, c := range clones {
+		wg.Add(1)
+		go func(c AnalyzeResponse) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				_ = c.EnergyByWeekday["Mon"] + c.Averages["mood"]
+				_ = c.Debug["stage"].(map[string]any)["ms"]
+			}
+		}(c)
+	}
+	for i := 0; i < 100; i++ {
+		orig.EnergyByWeekday["Mon"] = float64(i)
+		orig.Averages["mood"] = float64(i)
+		orig.Debug["stage"].(map[string]any)["ms"] = i
+	}
+	wg.Wait()
+}
+
+// TestPresenceBitsFollowOptionalTrackFields pins the track_points.presence bitmask of the migration to
+// the order of OptionalTrackFields: bit i must stand for field i.
+func TestPresenceBitsFollowOptionalTrackFields(t *testing.T) {
+	sql, err := os.ReadFile("../../migrations/202610150016_add_track_presence.sql")
+	if err != nil {
+		t.Fatalf("read migration: %v", err)
+	}
+	bits := map[string]int{}
+	for _, m := range regexp.MustCompile(`when (\w+) is not null then (\d+)`).FindAllStringSubmatch(string(sql), -1) {
+		bit, _ := strconv.Atoi(m[2])
+		bits[m[1]] = bit
+	}
+	if len(bits) != len(OptionalTrackFields) {
+		t.Fatalf("migration sets %d bits, OptionalTrackFields has %d fields", len(bits), len(OptionalTrackFields))
+	}
+	for i, field := range OptionalTrackFields {
+		if got, want := bits[field], 1<<i; got != want {
+			t.Errorf("%s: bit %d, want %d", field, got, want)
+		}
+	}
+}
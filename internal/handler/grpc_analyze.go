@@ -3,27 +3,112 @@ package handler
 import (
 	authpb "auth_service/proto"
 	"context"
+	"crypto/subtle"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
+	"math"
 	"nexus/internal/dto"
+	"nexus/internal/hepler"
+	"nexus/internal/llm"
+	"nexus/internal/middleware"
 	"nexus/internal/usecase"
 	nexusai "nexus/proto/nexusai/v1"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	maxBurnoutHorizonDays = 60
+	maxDayStartHour       = 12
+	maxSleepHours         = 20
+	maxNapMinutes         = 300
+	maxContextKeys        = 10
+	maxContextValueLen    = 64
+	maxContextBytes       = 1024
+	maxTagLen             = 32
+	maxTagsPerPoint       = 10
+	maxRollingDays        = 365
+	// defaultResponsePrecision is the decimals of every mapped analysis unless Analyze asks otherwise;
+	// analytics keep full precision, so up to maxResponsePrecision decimals are meaningful.
+	defaultResponsePrecision = 2
+	maxResponsePrecision     = 6
+)
+
+const (
+	defaultAuthTimeout = 2 * time.Second
+	// defaultMaxNoteRunes caps a single point's llm_text on ingest.
+	defaultMaxNoteRunes = 2000
+	// truncatedNoteMarker ends a note that was cut to the cap.
+	truncatedNoteMarker = " […]"
+	// defaultFutureTolerance is the client clock skew accepted on point timestamps.
+	defaultFutureTolerance = 5 * time.Minute
+)
+
+// NoFutureTolerance set as GRPCHandlerConfig.FutureTolerance rejects every point ahead of the server clock.
+const NoFutureTolerance time.Duration = -1
+
+type GRPCHandlerConfig struct {
+	// AdminToken guards admin-only RPCs (sent as x-admin-token metadata); empty disables them.
+	AdminToken string
+	// AuthTimeout bounds the auth service Me call; <= 0 means defaultAuthTimeout.
+	AuthTimeout time.Duration
+	// MaxNoteRunes caps llm_text per point; <= 0 means defaultMaxNoteRunes.
+	MaxNoteRunes int
+	// TruncateNotes cuts over-long notes with a marker instead of rejecting the request.
+	TruncateNotes bool
+	// FutureTolerance is how far ahead of the server clock a point's ts may be (client clock skew);
+	// 0 means defaultFutureTolerance and NoFutureTolerance accepts no skew at all.
+	FutureTolerance time.Duration
+}
+
 type GRPCAnalyzeHandler struct {
 	nexusai.UnimplementedAnalyzerServiceServer
-	analyzer   *usecase.Analyzer
-	authClient authpb.AuthServiceClient
+	analyzer    *usecase.Analyzer
+	authClient  authpb.AuthServiceClient
+	adminToken  string
+	authTimeout time.Duration
+	notes       noteLimit
+	futureSkew  time.Duration
+}
+
+type noteLimit struct {
+	maxRunes int
+	truncate bool
 }
 
-func NewGRPCAnalyzeHandler(analyzer *usecase.Analyzer, authClient authpb.AuthServiceClient) *GRPCAnalyzeHandler {
-	return &GRPCAnalyzeHandler{analyzer: analyzer, authClient: authClient}
+func NewGRPCAnalyzeHandler(analyzer *usecase.Analyzer, authClient authpb.AuthServiceClient, cfg GRPCHandlerConfig) *GRPCAnalyzeHandler {
+	if cfg.AuthTimeout <= 0 {
+		cfg.AuthTimeout = defaultAuthTimeout
+	}
+	if cfg.MaxNoteRunes <= 0 {
+		cfg.MaxNoteRunes = defaultMaxNoteRunes
+	}
+	switch {
+	case cfg.FutureTolerance == 0:
+		cfg.FutureTolerance = defaultFutureTolerance
+	case cfg.FutureTolerance < 0:
+		cfg.FutureTolerance = 0
+	}
+	return &GRPCAnalyzeHandler{
+		analyzer:    analyzer,
+		authClient:  authClient,
+		adminToken:  cfg.AdminToken,
+		authTimeout: cfg.AuthTimeout,
+		notes:       noteLimit{maxRunes: cfg.MaxNoteRunes, truncate: cfg.TruncateNotes},
+		futureSkew:  cfg.FutureTolerance,
+	}
 }
 
 func (h *GRPCAnalyzeHandler) Track(ctx context.Context, req *nexusai.TrackRequest) (*nexusai.TrackResponse, error) {
@@ -32,7 +117,7 @@ func (h *GRPCAnalyzeHandler) Track(ctx context.Context, req *nexusai.TrackReques
 		return nil, err
 	}
 
-	dtoReq, err := mapTrackRequest(req, userID)
+	dtoReq, err := mapTrackRequest(req, userID, h.notes, h.analyzer.ResolveLocation(req.GetUserTz()), time.Now().Add(h.futureSkew))
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -55,13 +140,122 @@ func (h *GRPCAnalyzeHandler) Analyze(ctx context.Context, req *nexusai.AnalyzeRe
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	precision := int(req.GetPrecision())
+	if req.Precision == nil {
+		precision = defaultResponsePrecision
+	}
+	if precision < 0 || precision > maxResponsePrecision {
+		return nil, status.Errorf(codes.InvalidArgument, "precision must be between 0 and %d", maxResponsePrecision)
+	}
 
 	resp, err := h.analyzer.Analyze(ctx, dtoReq)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	out, err := mapAnalyzeResponse(resp)
+	out, err := mapAnalyzeResponse(resp, precision)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	applyFieldMask(out, dtoReq.Fields)
+	return out, nil
+}
+
+// normalizeFields validates a field mask against the top-level AnalyzeResponse fields and returns it
+// lowercased, deduplicated and sorted.
+func normalizeFields(in []string) ([]string, error) {
+	fields := (&nexusai.AnalyzeResponse{}).ProtoReflect().Descriptor().Fields()
+	seen := map[string]struct{}{}
+	var out []string
+	for _, f := range in {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if fields.ByName(protoreflect.Name(f)) == nil {
+			return nil, fmt.Errorf("unknown field %q", f)
+		}
+		if _, ok := seen[f]; ok {
+			continue
+		}
+		seen[f] = struct{}{}
+		out = append(out, f)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// applyFieldMask clears every top-level field of out that is not in fields; an empty mask keeps all.
+func applyFieldMask(out *nexusai.AnalyzeResponse, fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+	keep := make(map[protoreflect.Name]struct{}, len(fields))
+	for _, f := range fields {
+		keep[protoreflect.Name(f)] = struct{}{}
+	}
+	m := out.ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if _, ok := keep[fd.Name()]; !ok {
+			m.Clear(fd)
+		}
+		return true
+	})
+}
+
+func (h *GRPCAnalyzeHandler) GetAnalysisForDate(ctx context.Context, req *nexusai.AnalysisForDateRequest) (*nexusai.AnalyzeResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || strings.TrimSpace(req.GetDate()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	resp, err := h.analyzer.Analyze(ctx, dto.AnalyzeRequest{
+		UserID:      userID,
+		UserTZ:      req.GetUserTz(),
+		Date:        strings.TrimSpace(req.GetDate()),
+		SkipInsight: !req.GetIncludeInsight(),
+	})
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidDate) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out, err := mapAnalyzeResponse(resp, defaultResponsePrecision)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) RegenerateInsight(ctx context.Context, req *nexusai.RegenerateInsightRequest) (*nexusai.AnalyzeResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	feedback := strings.TrimSpace(req.GetFeedback())
+	if feedback == "" {
+		return nil, status.Error(codes.InvalidArgument, "feedback is required")
+	}
+	if utf8.RuneCountInString(feedback) > hepler.MaxFeedbackRunes {
+		return nil, status.Errorf(codes.InvalidArgument, "feedback must be at most %d characters", hepler.MaxFeedbackRunes)
+	}
+
+	resp, err := h.analyzer.RegenerateInsight(ctx, dto.AnalyzeRequest{
+		UserID:   userID,
+		UserTZ:   req.GetUserTz(),
+		Period:   mapPeriod(req.GetPeriod()),
+		Feedback: feedback,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out, err := mapAnalyzeResponse(resp, defaultResponsePrecision)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -86,27 +280,91 @@ func (h *GRPCAnalyzeHandler) GetTodayTrack(ctx context.Context, req *nexusai.Tod
 	}
 	return &nexusai.TodayTrackResponse{
 		Exists: true,
-		Point: &nexusai.TrackPoint{
-			Ts:            timestamppb.New(p.TS),
-			SleepHours:    p.SleepHours,
-			SleepStart:    p.SleepStart,
-			SleepEnd:      p.SleepEnd,
-			Mood:          p.Mood,
-			Activity:      p.Activity,
-			Productive:    p.Productive,
-			Stress:        p.Stress,
-			Energy:        p.Energy,
-			Concentration: p.Concentration,
-			SleepQuality:  p.SleepQuality,
-			Caffeine:      p.Caffeine,
-			Alcohol:       p.Alcohol,
-			Workout:       p.Workout,
-			LlmText:       p.LLMText,
-			AnalysisStatus: p.AnalysisStatus,
-		},
+		Point:  mapTrackPoint(p),
+	}, nil
+}
+
+func (h *GRPCAnalyzeHandler) PatchTodayTrack(ctx context.Context, req *nexusai.PatchTodayTrackRequest) (*nexusai.PatchTodayTrackResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := mapPatchTrackFields(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	p, ok, err := h.analyzer.PatchTodayTrack(ctx, userID, req.GetUserTz(), fields)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "no track point for today")
+	}
+	return &nexusai.PatchTodayTrackResponse{
+		Updated: true,
+		Point:   mapTrackPoint(p),
 	}, nil
 }
 
+func (h *GRPCAnalyzeHandler) DismissBurnoutWarning(ctx context.Context, req *nexusai.DismissBurnoutWarningRequest) (*nexusai.DismissBurnoutWarningResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	risk, err := h.analyzer.DismissBurnoutWarning(ctx, userID, mapPeriod(req.GetPeriod()))
+	if err != nil {
+		if errors.Is(err, usecase.ErrNoBurnoutWarning) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.DismissBurnoutWarningResponse{BurnoutRisk: mapBurnoutRisk(risk)}, nil
+}
+
+func (h *GRPCAnalyzeHandler) LogFocusSession(ctx context.Context, req *nexusai.LogFocusSessionRequest) (*nexusai.LogFocusSessionResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetStartedAt() == nil || req.GetEndedAt() == nil {
+		return nil, status.Error(codes.InvalidArgument, "started_at and ended_at are required")
+	}
+	fs, err := h.analyzer.LogFocusSession(ctx, userID, dto.FocusSession{
+		StartedAt: req.GetStartedAt().AsTime(),
+		EndedAt:   req.GetEndedAt().AsTime(),
+		Quality:   req.GetQuality(),
+	})
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidFocusSession) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.LogFocusSessionResponse{Session: &nexusai.FocusSession{
+		Id:        fs.ID,
+		StartedAt: timestamppb.New(fs.StartedAt),
+		EndedAt:   timestamppb.New(fs.EndedAt),
+		Quality:   fs.Quality,
+	}}, nil
+}
+
+func (h *GRPCAnalyzeHandler) DeleteFocusSession(ctx context.Context, req *nexusai.DeleteFocusSessionRequest) (*nexusai.DeleteFocusSessionResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if err := h.analyzer.DeleteFocusSession(ctx, userID, req.GetId()); err != nil {
+		if errors.Is(err, usecase.ErrFocusSessionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.DeleteFocusSessionResponse{Ok: true}, nil
+}
+
 func (h *GRPCAnalyzeHandler) GetLastAnalyses(ctx context.Context, _ *nexusai.LastAnalysesRequest) (*nexusai.LastAnalysesResponse, error) {
 	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
@@ -116,22 +374,149 @@ func (h *GRPCAnalyzeHandler) GetLastAnalyses(ctx context.Context, _ *nexusai.Las
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	stale := h.analyzer.StalePeriods(ctx, userID, meta)
+	inProgress := h.analyzer.AnalysesInProgress(ctx, userID, meta)
+	trends := h.analyzer.PeriodTrends(ctx, userID, m)
 	out := &nexusai.LastAnalysesResponse{}
 	for period, resp := range m {
 		updatedAt := meta[period]
-		pb, err := mapAnalyzeResponse(&resp)
+		pb, err := mapAnalyzeResponse(&resp, defaultResponsePrecision)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 		out.Entries = append(out.Entries, &nexusai.LastAnalysisEntry{
-			Period:    period,
-			Response:  pb,
-			UpdatedAt: timestamppb.New(updatedAt),
+			Period:             period,
+			Response:           pb,
+			UpdatedAt:          timestamppb.New(updatedAt),
+			Stale:              stale[period],
+			Trends:             trends[period],
+			AnalysisInProgress: inProgress[period],
+		})
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetBestTimeTomorrow(ctx context.Context, req *nexusai.BestTimeTomorrowRequest) (*nexusai.BestTimeTomorrowResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bt, err := h.analyzer.BestTimeTomorrow(ctx, userID, req.GetUserTz())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.BestTimeTomorrowResponse{
+		EnoughData:         bt.EnoughData,
+		FocusHour:          bt.FocusHour,
+		FocusConfidence:    bt.FocusConfidence,
+		RecoveryHour:       bt.RecoveryHour,
+		RecoveryConfidence: bt.RecoveryConfidence,
+		Message:            bt.Message,
+	}, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetTrends(ctx context.Context, req *nexusai.TrendsRequest) (*nexusai.TrendsResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var from, to time.Time
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+	tr, err := h.analyzer.GetTrends(ctx, userID, req.GetUserTz(), mapPeriod(req.GetPeriod()), from, to, int(req.GetSmoothingWindow()))
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidTimeRange) || errors.Is(err, usecase.ErrInvalidSmoothingWindow) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.TrendsResponse{
+		From:            timestamppb.New(tr.From),
+		To:              timestamppb.New(tr.To),
+		Days:            tr.Days,
+		SmoothingWindow: int32(tr.Window),
+	}
+	for _, s := range tr.Series {
+		out.Series = append(out.Series, &nexusai.MetricSeries{
+			Metric:    s.Metric,
+			Values:    s.Values,
+			Slope:     s.Slope,
+			Direction: s.Direction,
 		})
 	}
 	return out, nil
 }
 
+func (h *GRPCAnalyzeHandler) GetSuggestedReminderTime(ctx context.Context, req *nexusai.SuggestedReminderTimeRequest) (*nexusai.SuggestedReminderTimeResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := h.analyzer.SuggestedReminderTime(ctx, userID, req.GetUserTz())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return mapReminderSuggestion(rs), nil
+}
+
+func (h *GRPCAnalyzeHandler) GetTomorrowSchedule(ctx context.Context, req *nexusai.TomorrowScheduleRequest) (*nexusai.TomorrowScheduleResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := h.analyzer.GetTomorrowSchedule(ctx, userID, req.GetUserTz())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.TomorrowScheduleResponse{
+		Date:       ts.Date,
+		Schedule:   mapOptimalSchedule(ts.Schedule),
+		Reminder:   mapReminderSuggestion(ts.Reminder),
+		NumPoints:  int32(ts.NumPoints),
+		ComputedAt: timestamppb.New(ts.ComputedAt),
+	}, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetWeeklyReport(ctx context.Context, req *nexusai.WeeklyReportRequest) (*nexusai.WeeklyReportResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := h.analyzer.GetWeeklyReport(ctx, userID, req.GetUserTz())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.WeeklyReportResponse{
+		WeekStart:    r.WeekStart,
+		WeekEnd:      r.WeekEnd,
+		NumDays:      int32(r.NumDays),
+		Averages:     copyFloatMap(r.Averages),
+		BestWeekday:  r.BestWeekday,
+		WorstWeekday: r.WorstWeekday,
+		BurnoutLevel: r.BurnoutLevel,
+		StreakDays:   int32(r.StreakDays),
+		TopHabit:     r.TopHabit,
+		TopHabitDays: int32(r.TopHabitDays),
+		Summary:      r.Summary,
+	}, nil
+}
+
+func mapReminderSuggestion(rs dto.ReminderSuggestion) *nexusai.SuggestedReminderTimeResponse {
+	return &nexusai.SuggestedReminderTimeResponse{
+		Time:       rs.Time,
+		Habitual:   rs.Habitual,
+		Confidence: rs.Confidence,
+		NumLogs:    int32(rs.NumLogs),
+	}
+}
+
 func (h *GRPCAnalyzeHandler) GetUserLastAnalyses(ctx context.Context, req *nexusai.GetUserLastAnalysesRequest) (*nexusai.LastAnalysesResponse, error) {
 	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
@@ -151,7 +536,7 @@ func (h *GRPCAnalyzeHandler) GetUserLastAnalyses(ctx context.Context, req *nexus
 	out := &nexusai.LastAnalysesResponse{}
 	for period, resp := range m {
 		updatedAt := meta[period]
-		pb, err := mapAnalyzeResponse(&resp)
+		pb, err := mapAnalyzeResponse(&resp, defaultResponsePrecision)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -164,19 +549,7 @@ func (h *GRPCAnalyzeHandler) GetUserLastAnalyses(ctx context.Context, req *nexus
 	return out, nil
 }
 
-func (h *GRPCAnalyzeHandler) GetMyProfile(ctx context.Context, _ *nexusai.GetMyProfileRequest) (*nexusai.GetMyProfileResponse, error) {
-	userID, err := h.userIDFromContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-	p, err := h.analyzer.GetMyProfile(ctx, userID)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	return &nexusai.GetMyProfileResponse{Profile: mapUserProfile(p)}, nil
-}
-
-func (h *GRPCAnalyzeHandler) GetUserProfile(ctx context.Context, req *nexusai.GetUserProfileRequest) (*nexusai.GetUserProfileResponse, error) {
+func (h *GRPCAnalyzeHandler) GetFriendAnalysis(ctx context.Context, req *nexusai.GetFriendAnalysisRequest) (*nexusai.GetFriendAnalysisResponse, error) {
 	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -185,157 +558,849 @@ func (h *GRPCAnalyzeHandler) GetUserProfile(ctx context.Context, req *nexusai.Ge
 	if targetID <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
-	p, err := h.analyzer.GetUserProfileForViewer(ctx, userID, targetID)
+	fa, err := h.analyzer.GetFriendAnalysis(ctx, userID, targetID)
 	if err != nil {
 		if err.Error() == "forbidden" {
 			return nil, status.Error(codes.PermissionDenied, "profile is private")
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &nexusai.GetUserProfileResponse{Profile: mapUserProfile(p)}, nil
+	out := &nexusai.GetFriendAnalysisResponse{Profile: mapUserProfile(fa.Profile), Shared: fa.Shared}
+	for period, resp := range fa.Analyses {
+		pb, err := mapAnalyzeResponse(&resp, defaultResponsePrecision)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		out.Entries = append(out.Entries, &nexusai.LastAnalysisEntry{
+			Period:    period,
+			Response:  pb,
+			UpdatedAt: timestamppb.New(fa.UpdatedAt[period]),
+		})
+	}
+	return out, nil
 }
 
-func (h *GRPCAnalyzeHandler) UpdateMyProfile(ctx context.Context, req *nexusai.UpdateProfileRequest) (*nexusai.UpdateProfileResponse, error) {
+func (h *GRPCAnalyzeHandler) PreviewWithSettings(ctx context.Context, req *nexusai.PreviewWithSettingsRequest) (*nexusai.PreviewWithSettingsResponse, error) {
 	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if req == nil {
-		return nil, status.Error(codes.InvalidArgument, "empty request")
-	}
-	p, err := h.analyzer.UpdateMyProfile(ctx, userID, req.GetEmoji(), req.GetBgIndex())
+	pv, err := h.analyzer.PreviewWithSettings(ctx, userID, req.GetUserTz(), mapPeriod(req.GetPeriod()),
+		req.GetEnergyParams(), req.GetProductivityWeights())
 	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidPreviewSettings) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &nexusai.UpdateProfileResponse{Profile: mapUserProfile(p)}, nil
+	return &nexusai.PreviewWithSettingsResponse{
+		NumPoints: int32(pv.NumPoints),
+		Current:   mapScorePreview(pv.Current),
+		Proposed:  mapScorePreview(pv.Proposed),
+	}, nil
 }
 
-func (h *GRPCAnalyzeHandler) SearchUsers(ctx context.Context, req *nexusai.SearchUsersRequest) (*nexusai.SearchUsersResponse, error) {
+func mapScorePreview(s dto.ScorePreview) *nexusai.ScorePreview {
+	return &nexusai.ScorePreview{
+		AvgEnergyScore:    s.AvgEnergyScore,
+		ProductivityScore: s.ProductivityScore,
+		BurnoutScore:      s.BurnoutScore,
+		BurnoutLevel:      s.BurnoutLevel,
+	}
+}
+
+// GetMetricDefinitions serves a static catalog, so it needs no user beyond the interceptor's auth check.
+func (h *GRPCAnalyzeHandler) GetMetricDefinitions(_ context.Context, req *nexusai.MetricDefinitionsRequest) (*nexusai.MetricDefinitionsResponse, error) {
+	defs, lang := h.analyzer.GetMetricDefinitions(req.GetLanguage())
+	out := &nexusai.MetricDefinitionsResponse{Language: lang}
+	for _, d := range defs {
+		out.Definitions = append(out.Definitions, &nexusai.MetricDefinition{
+			Code:        d.Code,
+			Kind:        d.Kind,
+			Name:        d.Name,
+			Description: d.Description,
+			Weight:      d.Weight,
+		})
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetNoteKeywords(ctx context.Context, req *nexusai.NoteKeywordsRequest) (*nexusai.NoteKeywordsResponse, error) {
 	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	query := ""
-	if req != nil {
-		query = req.GetQuery()
-	}
-	users, err := h.analyzer.SearchUsers(ctx, userID, query)
+	keywords, notes, err := h.analyzer.GetNoteKeywords(ctx, userID, req.GetUserTz(), mapPeriod(req.GetPeriod()), int(req.GetLimit()))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	out := &nexusai.SearchUsersResponse{}
-	for _, u := range users {
-		out.Users = append(out.Users, mapUserProfile(u))
+	out := &nexusai.NoteKeywordsResponse{NumNotes: int32(notes)}
+	for _, kw := range keywords {
+		out.Keywords = append(out.Keywords, &nexusai.NoteKeyword{
+			Word:  kw.Word,
+			Count: int32(kw.Count),
+			Notes: int32(kw.Notes),
+		})
 	}
 	return out, nil
 }
 
-func (h *GRPCAnalyzeHandler) ListFriends(ctx context.Context, _ *nexusai.ListFriendsRequest) (*nexusai.ListFriendsResponse, error) {
+func (h *GRPCAnalyzeHandler) GetContextBreakdown(ctx context.Context, req *nexusai.ContextBreakdownRequest) (*nexusai.ContextBreakdownResponse, error) {
 	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	users, err := h.analyzer.ListFriends(ctx, userID)
+	key, err := normalizeContextKey(req.GetKey())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	br, err := h.analyzer.GetContextBreakdown(ctx, userID, req.GetUserTz(), mapPeriod(req.GetPeriod()), key)
 	if err != nil {
+		if errors.Is(err, usecase.ErrContextNotCategorical) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	out := &nexusai.ListFriendsResponse{}
-	for _, u := range users {
-		out.Friends = append(out.Friends, mapUserProfile(u))
+	out := &nexusai.ContextBreakdownResponse{Key: br.Key, NumPoints: int32(br.NumPoints)}
+	for _, g := range br.Groups {
+		out.Groups = append(out.Groups, &nexusai.ContextGroup{
+			Value:     g.Value,
+			NumPoints: int32(g.NumPoints),
+			AvgMood:   g.AvgMood,
+			AvgEnergy: g.AvgEnergy,
+		})
 	}
 	return out, nil
 }
 
-func (h *GRPCAnalyzeHandler) ListFriendRequests(ctx context.Context, req *nexusai.ListFriendRequestsRequest) (*nexusai.ListFriendRequestsResponse, error) {
+func (h *GRPCAnalyzeHandler) GetCorrelationMatrix(ctx context.Context, req *nexusai.CorrelationMatrixRequest) (*nexusai.CorrelationMatrixResponse, error) {
 	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	statusFilter := "pending"
-	if req != nil && req.GetStatus() != "" {
-		statusFilter = req.GetStatus()
-	}
-	reqs, err := h.analyzer.ListFriendRequests(ctx, userID, statusFilter)
+	m, err := h.analyzer.GetCorrelationMatrix(ctx, userID, req.GetUserTz(), mapPeriod(req.GetPeriod()))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	out := &nexusai.ListFriendRequestsResponse{}
-	for _, r := range reqs {
-		out.Requests = append(out.Requests, mapFriendRequest(r))
+	out := &nexusai.CorrelationMatrixResponse{
+		Metrics:   append([]string(nil), m.Metrics...),
+		NumPoints: int32(m.NumPoints),
+	}
+	for _, c := range m.Cells {
+		out.Cells = append(out.Cells, &nexusai.CorrelationCell{
+			A:           c.A,
+			B:           c.B,
+			R:           c.R,
+			N:           int32(c.N),
+			Strength:    c.Strength,
+			Significant: c.Significant,
+		})
 	}
 	return out, nil
 }
 
-func (h *GRPCAnalyzeHandler) SendFriendRequest(ctx context.Context, req *nexusai.SendFriendRequestRequest) (*nexusai.SendFriendRequestResponse, error) {
+func (h *GRPCAnalyzeHandler) GetBedtimeTonight(ctx context.Context, req *nexusai.BedtimeTonightRequest) (*nexusai.BedtimeTonightResponse, error) {
 	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if req == nil || req.GetToUserId() <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "to_user_id required")
-	}
-	r, err := h.analyzer.SendFriendRequest(ctx, userID, req.GetToUserId())
+	b, err := h.analyzer.GetBedtimeTonight(ctx, userID, req.GetUserTz())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &nexusai.SendFriendRequestResponse{Request: mapFriendRequest(r)}, nil
+	return &nexusai.BedtimeTonightResponse{
+		Bedtime:        b.Bedtime,
+		UsualBedtime:   b.UsualBedtime,
+		SleepDebtHours: b.SleepDebtHours,
+		ShiftMinutes:   int32(b.ShiftMinutes),
+		Status:         b.Status,
+		Message:        b.Message,
+	}, nil
 }
 
-func (h *GRPCAnalyzeHandler) RespondFriendRequest(ctx context.Context, req *nexusai.RespondFriendRequestRequest) (*nexusai.RespondFriendRequestResponse, error) {
+func (h *GRPCAnalyzeHandler) GetStreakCalendar(ctx context.Context, req *nexusai.StreakCalendarRequest) (*nexusai.StreakCalendarResponse, error) {
 	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if req == nil || req.GetRequestId() <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "request_id required")
-	}
-	if err := h.analyzer.RespondFriendRequest(ctx, userID, req.GetRequestId(), req.GetAction()); err != nil {
+	cal, err := h.analyzer.GetStreakCalendar(ctx, userID, req.GetUserTz(),
+		strings.TrimSpace(req.GetFrom()), strings.TrimSpace(req.GetTo()), req.GetIncludeEnergy())
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidCalendarRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &nexusai.RespondFriendRequestResponse{Ok: true}, nil
+	out := &nexusai.StreakCalendarResponse{From: cal.From, To: cal.To}
+	for _, d := range cal.Days {
+		out.Days = append(out.Days, &nexusai.CalendarDay{
+			Date:        d.Date,
+			Logged:      d.Logged,
+			EnergyScore: d.EnergyScore,
+		})
+	}
+	return out, nil
 }
 
-func mapTrackRequest(in *nexusai.TrackRequest, userID int32) (dto.TrackRequest, error) {
-	if in == nil {
-		return dto.TrackRequest{}, errors.New("empty request")
+func (h *GRPCAnalyzeHandler) GetMyProfile(ctx context.Context, _ *nexusai.GetMyProfileRequest) (*nexusai.GetMyProfileResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
+	p, err := h.analyzer.GetMyProfile(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.GetMyProfileResponse{Profile: mapUserProfile(p)}, nil
+}
 
-	points := make([]dto.TrackPoint, 0, len(in.Points))
-	loc := time.UTC
-	if in.UserTz != "" {
-		if l, err := time.LoadLocation(in.UserTz); err == nil {
-			loc = l
+func (h *GRPCAnalyzeHandler) GetUserProfile(ctx context.Context, req *nexusai.GetUserProfileRequest) (*nexusai.GetUserProfileResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targetID := req.GetUserId()
+	if targetID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	p, err := h.analyzer.GetUserProfileForViewer(ctx, userID, targetID)
+	if err != nil {
+		if err.Error() == "forbidden" {
+			return nil, status.Error(codes.PermissionDenied, "profile is private")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.GetUserProfileResponse{Profile: mapUserProfile(p)}, nil
+}
+
+func (h *GRPCAnalyzeHandler) UpdateMyProfile(ctx context.Context, req *nexusai.UpdateProfileRequest) (*nexusai.UpdateProfileResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var dayStartHour *int
+	if req.DayStartHour != nil {
+		v := int(req.GetDayStartHour())
+		if v < 0 || v > maxDayStartHour {
+			return nil, status.Errorf(codes.InvalidArgument, "day_start_hour must be between 0 and %d", maxDayStartHour)
+		}
+		dayStartHour = &v
+	}
+	var tone *dto.Tone
+	if req.Tone != nil {
+		t := mapTone(req.GetTone())
+		tone = &t
+	}
+	p, err := h.analyzer.UpdateMyProfile(ctx, userID, req.GetEmoji(), req.GetBgIndex(), dayStartHour, tone, req.ShareAnalyses, req.AnalysesEnabled)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.UpdateProfileResponse{Profile: mapUserProfile(p)}, nil
+}
+
+func (h *GRPCAnalyzeHandler) UpdateHiddenMetrics(ctx context.Context, req *nexusai.UpdateHiddenMetricsRequest) (*nexusai.UpdateHiddenMetricsResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	metrics, err := h.analyzer.UpdateHiddenMetrics(ctx, userID, req.GetMetrics())
+	if err != nil {
+		if errors.Is(err, usecase.ErrUnknownMetric) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.UpdateHiddenMetricsResponse{Metrics: metrics}, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetGoals(ctx context.Context, _ *nexusai.GetGoalsRequest) (*nexusai.GoalsResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	goals, err := h.analyzer.GetGoals(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.GoalsResponse{Goals: goals}, nil
+}
+
+func (h *GRPCAnalyzeHandler) UpdateGoals(ctx context.Context, req *nexusai.UpdateGoalsRequest) (*nexusai.GoalsResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	goals, err := h.analyzer.UpdateGoals(ctx, userID, req.GetGoals())
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidGoal) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.GoalsResponse{Goals: goals}, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetBurnoutWebhook(ctx context.Context, _ *nexusai.GetBurnoutWebhookRequest) (*nexusai.BurnoutWebhookResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	url, err := h.analyzer.GetBurnoutWebhook(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.BurnoutWebhookResponse{Url: url}, nil
+}
+
+func (h *GRPCAnalyzeHandler) SetBurnoutWebhook(ctx context.Context, req *nexusai.SetBurnoutWebhookRequest) (*nexusai.BurnoutWebhookResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	url, err := h.analyzer.SetBurnoutWebhook(ctx, userID, req.GetUrl())
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidWebhookURL) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.BurnoutWebhookResponse{Url: url}, nil
+}
+
+func (h *GRPCAnalyzeHandler) SearchUsers(ctx context.Context, req *nexusai.SearchUsersRequest) (*nexusai.SearchUsersResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := ""
+	if req != nil {
+		query = req.GetQuery()
+	}
+	users, err := h.analyzer.SearchUsers(ctx, userID, query)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.SearchUsersResponse{}
+	for _, u := range users {
+		out.Users = append(out.Users, mapUserProfile(u))
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) FindUserByEmail(ctx context.Context, req *nexusai.FindUserByEmailRequest) (*nexusai.FindUserByEmailResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	email := strings.TrimSpace(req.GetEmail())
+	if email == "" || len(email) > 254 || !strings.Contains(email, "@") {
+		return nil, status.Error(codes.InvalidArgument, "valid email is required")
+	}
+	u, ok, err := h.analyzer.FindUserByEmail(ctx, userID, email)
+	if err != nil {
+		if errors.Is(err, usecase.ErrRateLimited) {
+			return nil, status.Error(codes.ResourceExhausted, "too many lookups, try again later")
 		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &nexusai.FindUserByEmailResponse{User: &nexusai.UserProfile{
+		UserId: u.UserID,
+		Name:   u.Name,
+		Emoji:  u.Emoji,
+	}}, nil
+}
+
+func (h *GRPCAnalyzeHandler) ListFriends(ctx context.Context, _ *nexusai.ListFriendsRequest) (*nexusai.ListFriendsResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := h.analyzer.ListFriends(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.ListFriendsResponse{}
+	for _, u := range users {
+		out.Friends = append(out.Friends, mapUserProfile(u))
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) ListFriendsEnriched(ctx context.Context, _ *nexusai.ListFriendsEnrichedRequest) (*nexusai.ListFriendsEnrichedResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	friends, err := h.analyzer.ListFriendsEnriched(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.ListFriendsEnrichedResponse{}
+	for _, f := range friends {
+		out.Friends = append(out.Friends, &nexusai.FriendSummary{
+			Friend:       mapUserProfile(f.Profile),
+			LoggedToday:  f.LoggedToday,
+			BurnoutLevel: f.BurnoutLevel,
+		})
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetActivityFeed(ctx context.Context, req *nexusai.ActivityFeedRequest) (*nexusai.ActivityFeedResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events, err := h.analyzer.GetActivityFeed(ctx, userID, int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.ActivityFeedResponse{}
+	for _, ev := range events {
+		out.Events = append(out.Events, &nexusai.FriendActivity{
+			Id:        ev.ID,
+			Friend:    mapUserProfile(ev.Friend),
+			Kind:      ev.Kind,
+			Value:     ev.Value,
+			Message:   ev.Message,
+			CreatedAt: timestamppb.New(ev.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) ListFriendRequests(ctx context.Context, req *nexusai.ListFriendRequestsRequest) (*nexusai.ListFriendRequestsResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statusFilter := "pending"
+	if req != nil && req.GetStatus() != "" {
+		statusFilter = req.GetStatus()
+	}
+	reqs, err := h.analyzer.ListFriendRequests(ctx, userID, statusFilter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.ListFriendRequestsResponse{}
+	for _, r := range reqs {
+		out.Requests = append(out.Requests, mapFriendRequest(r))
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) SendFriendRequest(ctx context.Context, req *nexusai.SendFriendRequestRequest) (*nexusai.SendFriendRequestResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.GetToUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "to_user_id required")
+	}
+	r, err := h.analyzer.SendFriendRequest(ctx, userID, req.GetToUserId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.SendFriendRequestResponse{Request: mapFriendRequest(r)}, nil
+}
+
+func (h *GRPCAnalyzeHandler) RespondFriendRequest(ctx context.Context, req *nexusai.RespondFriendRequestRequest) (*nexusai.RespondFriendRequestResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.GetRequestId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "request_id required")
+	}
+	if err := h.analyzer.RespondFriendRequest(ctx, userID, req.GetRequestId(), req.GetAction()); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrFriendRequestNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, usecase.ErrFriendRequestResolved):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &nexusai.RespondFriendRequestResponse{Ok: true}, nil
+}
+
+func (h *GRPCAnalyzeHandler) CompareWithFriend(ctx context.Context, req *nexusai.CompareWithFriendRequest) (*nexusai.CompareWithFriendResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	friendID := req.GetFriendUserId()
+	if friendID <= 0 || friendID == userID {
+		return nil, status.Error(codes.InvalidArgument, "friend_user_id is required")
+	}
+	cmp, err := h.analyzer.CompareWithFriend(ctx, userID, friendID, mapPeriod(req.GetPeriod()))
+	if err != nil {
+		if err.Error() == "forbidden" {
+			return nil, status.Error(codes.PermissionDenied, "not friends")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.CompareWithFriendResponse{
+		Friend:  mapUserProfile(cmp.Friend),
+		Period:  cmp.Period,
+		Summary: cmp.Summary,
+	}
+	if !cmp.MyUpdatedAt.IsZero() {
+		out.MyUpdatedAt = timestamppb.New(cmp.MyUpdatedAt)
 	}
+	if !cmp.FriendUpdatedAt.IsZero() {
+		out.FriendUpdatedAt = timestamppb.New(cmp.FriendUpdatedAt)
+	}
+	for _, m := range cmp.Metrics {
+		out.Metrics = append(out.Metrics, &nexusai.MetricComparison{
+			Metric: m.Metric,
+			Mine:   m.Mine,
+			Friend: m.Friend,
+			Delta:  m.Delta,
+			Leader: m.Leader,
+		})
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetInsightDiff(ctx context.Context, req *nexusai.InsightDiffRequest) (*nexusai.InsightDiffResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetFrom() == nil || req.GetTo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+	diff, err := h.analyzer.GetInsightDiff(ctx, userID, mapPeriod(req.GetPeriod()), req.GetFrom().AsTime(), req.GetTo().AsTime())
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidDiffRange):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, usecase.ErrAnalysisNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.InsightDiffResponse{
+		Period: diff.Period,
+		FromAt: timestamppb.New(diff.FromAt),
+		ToAt:   timestamppb.New(diff.ToAt),
+	}
+	for _, m := range diff.Metrics {
+		out.Metrics = append(out.Metrics, &nexusai.MetricDiff{
+			Metric: m.Metric,
+			From:   m.From,
+			To:     m.To,
+			Delta:  m.Delta,
+			Change: m.Change,
+		})
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetAggregateStats(ctx context.Context, req *nexusai.AggregateStatsRequest) (*nexusai.AggregateStatsResponse, error) {
+	if _, err := h.userIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var from, to time.Time
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+	st, err := h.analyzer.GetAggregateStats(ctx, from, to)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidTimeRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.AggregateStatsResponse{
+		From:          timestamppb.New(st.From),
+		To:            timestamppb.New(st.To),
+		NumPoints:     st.NumPoints,
+		NumUsers:      st.NumUsers,
+		AvgSleepHours: st.AvgSleepHours,
+		AvgMood:       st.AvgMood,
+		AvgStress:     st.AvgStress,
+		AvgEnergy:     st.AvgEnergy,
+	}
+	for _, b := range st.SleepHistogram {
+		out.SleepHistogram = append(out.SleepHistogram, &nexusai.HistogramBucket{Lower: b.Lower, Upper: b.Upper, Count: b.Count})
+	}
+	for _, w := range st.ByWeekday {
+		out.ByWeekday = append(out.ByWeekday, &nexusai.WeekdayAggregate{
+			Weekday:   w.Weekday,
+			NumPoints: w.NumPoints,
+			AvgMood:   w.AvgMood,
+			AvgStress: w.AvgStress,
+			AvgEnergy: w.AvgEnergy,
+		})
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) ListDeadAnalyses(ctx context.Context, req *nexusai.ListDeadAnalysesRequest) (*nexusai.ListDeadAnalysesResponse, error) {
+	if _, err := h.userIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	items, err := h.analyzer.ListDeadAnalyses(ctx, int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.ListDeadAnalysesResponse{}
+	for _, f := range items {
+		out.Items = append(out.Items, &nexusai.FailedAnalysis{
+			UserId:    f.UserID,
+			DayStart:  timestamppb.New(f.DayStart),
+			Attempts:  int32(f.Attempts),
+			LastError: f.LastError,
+			UpdatedAt: timestamppb.New(f.UpdatedAt),
+		})
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetProviderStatus(ctx context.Context, _ *nexusai.ProviderStatusRequest) (*nexusai.ProviderStatusResponse, error) {
+	if _, err := h.userIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	out := &nexusai.ProviderStatusResponse{}
+	for _, p := range h.analyzer.GetProviderStatus() {
+		ps := &nexusai.ProviderStatus{
+			Name:          p.Name,
+			Model:         p.Model,
+			WindowSeconds: int32(p.Window.Seconds()),
+			Successes:     p.Successes,
+			Errors:        p.Errors,
+			Degraded:      p.Degraded,
+			LastError:     p.LastError,
+		}
+		if !p.LastErrorAt.IsZero() {
+			ps.LastErrorAt = timestamppb.New(p.LastErrorAt)
+		}
+		out.Providers = append(out.Providers, ps)
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) GetPromptAggregates(ctx context.Context, req *nexusai.PromptAggregatesRequest) (*nexusai.PromptAggregatesResponse, error) {
+	callerID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targetID := req.GetUserId()
+	if targetID < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	self := targetID == 0 || targetID == callerID
+	if self {
+		targetID = callerID
+	} else if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetBurnoutHorizonDays() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "burnout_horizon_days must not be negative")
+	}
+
+	p, err := h.analyzer.GetPromptAggregates(ctx, dto.AnalyzeRequest{
+		UserID:             targetID,
+		UserTZ:             req.GetUserTz(),
+		Period:             mapPeriod(req.GetPeriod()),
+		BurnoutHorizonDays: int(req.GetBurnoutHorizonDays()),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &nexusai.PromptAggregatesResponse{
+		UserTz:               p.UserTZ,
+		Period:               string(p.Period),
+		PeriodStart:          timestamppb.New(p.PeriodStart),
+		PeriodEnd:            timestamppb.New(p.PeriodEnd),
+		EnergyByWeekday:      copyFloatMap(p.EnergyByWeekday),
+		ProductivityScore:    p.ProductivityScore,
+		BurnoutScore:         p.BurnoutScore,
+		BurnoutLevel:         p.BurnoutLevel,
+		BurnoutReasons:       append([]string(nil), p.BurnoutReasons...),
+		NumPoints:            int32(p.NumPoints),
+		NumObservedWeekdays:  int32(p.NumObservedWeekdays),
+		NumObservedDays:      int32(p.NumObservedDays),
+		ObservedWeekdaysList: p.ObservedWeekdaysList,
+		TrendsReliable:       p.TrendsReliable,
+		HiddenMetrics:        append([]string(nil), p.HiddenMetrics...),
+		Tone:                 toneToProto(p.Tone),
+		AvgSleepHours:        p.AvgSleepHours,
+		AvgSleepQuality:      p.AvgSleepQuality,
+		AvgMood:              p.AvgMood,
+		AvgActivity:          p.AvgActivity,
+		AvgProductive:        p.AvgProductive,
+		AvgStress:            p.AvgStress,
+		AvgEnergy:            p.AvgEnergy,
+		AvgConcentration:     p.AvgConcentration,
+		AvgSleepStart:        p.AvgSleepStart,
+		AvgSleepEnd:          p.AvgSleepEnd,
+		MinEnergy:            p.MinEnergy,
+		MaxEnergy:            p.MaxEnergy,
+		MinStress:            p.MinStress,
+		MaxStress:            p.MaxStress,
+		MinSleepHours:        p.MinSleepHours,
+		MaxSleepHours:        p.MaxSleepHours,
+		SocialJetlagHours:    p.SocialJetlagHours,
+		RecentWindowDays:     int32(p.RecentWindowDays),
+		RecentAvgSleepHours:  p.RecentAvgSleepHours,
+		RecentAvgMood:        p.RecentAvgMood,
+		RecentAvgStress:      p.RecentAvgStress,
+		RecentAvgEnergy:      p.RecentAvgEnergy,
+		OlderNotes:           int32(p.OlderNotes),
+	}
+	for _, g := range p.Goals {
+		out.Goals = append(out.Goals, &nexusai.GoalProgress{Metric: g.Metric, Target: g.Target, Met: int32(g.Met), Days: int32(g.Days)})
+	}
+	// Notes are the user's own words; an admin debugging the numbers does not get them.
+	if self {
+		out.UserNotes = p.UserNotes
+		out.OlderKeywords = append([]string(nil), p.OlderKeywords...)
+	}
+	return out, nil
+}
+
+func (h *GRPCAnalyzeHandler) requireAdmin(ctx context.Context) error {
+	if h.adminToken == "" {
+		return status.Error(codes.PermissionDenied, "admin access disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get("x-admin-token")
+	if len(vals) == 0 || subtle.ConstantTimeCompare([]byte(vals[0]), []byte(h.adminToken)) != 1 {
+		return status.Error(codes.PermissionDenied, "admin token required")
+	}
+	return nil
+}
+
+// mapTrackRequest converts a Track request; loc is the already resolved zone for in.UserTz
+// and places HH:MM sleep times on the entry's local day. Points stamped after latest are rejected.
+func mapTrackRequest(in *nexusai.TrackRequest, userID int32, notes noteLimit, loc *time.Location, latest time.Time) (dto.TrackRequest, error) {
+	if in == nil {
+		return dto.TrackRequest{}, errors.New("empty request")
+	}
+
+	points := make([]dto.TrackPoint, 0, len(in.Points))
 	for _, p := range in.Points {
 		if p == nil || p.Ts == nil {
 			return dto.TrackRequest{}, errors.New("point timestamp is required")
 		}
+		if p.Ts.AsTime().After(latest) {
+			return dto.TrackRequest{}, errors.New("point timestamp must not be in the future")
+		}
 		sleepHours := p.SleepHours
+		if sleepHours != nil && *sleepHours == 0 {
+			// Older clients always sent sleep_hours; 0 alongside HH:MM meant "derive it".
+			sleepHours = nil
+		}
 		sleepStart := p.GetSleepStart()
 		sleepEnd := p.GetSleepEnd()
-		if sleepHours == 0 && (sleepStart != "" || sleepEnd != "") {
+		var sleepStartTS, sleepEndTS *time.Time
+		if p.SleepStartTs != nil || p.SleepEndTs != nil {
+			if p.SleepStartTs == nil || p.SleepEndTs == nil {
+				return dto.TrackRequest{}, errors.New("sleep_start_ts and sleep_end_ts must be set together")
+			}
+			start, end := p.SleepStartTs.AsTime(), p.SleepEndTs.AsTime()
+			if !end.After(start) {
+				return dto.TrackRequest{}, errors.New("sleep_end_ts must be after sleep_start_ts")
+			}
+			dur := end.Sub(start).Hours()
+			if dur > maxSleepHours {
+				return dto.TrackRequest{}, fmt.Errorf("sleep interval must be at most %d hours", maxSleepHours)
+			}
+			sleepStartTS, sleepEndTS = &start, &end
+			sleepHours = &dur
+			sleepStart = start.In(loc).Format("15:04")
+			sleepEnd = end.In(loc).Format("15:04")
+		} else if sleepHours == nil && (sleepStart != "" || sleepEnd != "") {
 			if v, ok := calcSleepHours(p.Ts.AsTime().In(loc), sleepStart, sleepEnd); ok {
-				sleepHours = v
+				sleepHours = &v
 			}
 		}
-		points = append(points, dto.TrackPoint{
-			TS:            p.Ts.AsTime(),
-			SleepHours:    sleepHours,
-			SleepStart:    sleepStart,
-			SleepEnd:      sleepEnd,
-			Mood:          p.Mood,
-			Activity:      p.Activity,
-			Productive:    p.Productive,
-			Stress:        p.Stress,
-			Energy:        p.Energy,
-			Concentration: p.Concentration,
-			SleepQuality:  p.SleepQuality,
-			Caffeine:      p.Caffeine,
-			Alcohol:       p.Alcohol,
-			Workout:       p.Workout,
-			LLMText:       p.LlmText,
-		})
+		if sleepHours == nil && p.SleepHours != nil {
+			sleepHours = p.SleepHours
+		}
+		note, err := notes.apply(p.LlmText)
+		if err != nil {
+			return dto.TrackRequest{}, err
+		}
+		tags, err := normalizeTags(p.Tags)
+		if err != nil {
+			return dto.TrackRequest{}, err
+		}
+		if p.NapMinutes < 0 || p.NapMinutes > maxNapMinutes {
+			return dto.TrackRequest{}, fmt.Errorf("nap_minutes must be between 0 and %d", maxNapMinutes)
+		}
+		pointContext, err := normalizeContext(p.Context)
+		if err != nil {
+			return dto.TrackRequest{}, err
+		}
+		pt := dto.TrackPoint{
+			TS:           p.Ts.AsTime(),
+			SleepStart:   sleepStart,
+			SleepEnd:     sleepEnd,
+			Caffeine:     p.Caffeine,
+			Alcohol:      p.Alcohol,
+			Workout:      p.Workout,
+			LLMText:      note,
+			SleepStartTS: sleepStartTS,
+			SleepEndTS:   sleepEndTS,
+			Tags:         tags,
+			NapMinutes:   int(p.NapMinutes),
+			Context:      pointContext,
+		}
+		for _, f := range []struct {
+			name string
+			src  *float64
+			dst  *float64
+		}{
+			{"sleep_hours", sleepHours, &pt.SleepHours},
+			{"mood", p.Mood, &pt.Mood},
+			{"activity", p.Activity, &pt.Activity},
+			{"productive", p.Productive, &pt.Productive},
+			{"stress", p.Stress, &pt.Stress},
+			{"energy", p.Energy, &pt.Energy},
+			{"concentration", p.Concentration, &pt.Concentration},
+			{"sleep_quality", p.SleepQuality, &pt.SleepQuality},
+		} {
+			if f.src != nil {
+				*f.dst = *f.src
+				continue
+			}
+			if pt.Missing == nil {
+				pt.Missing = map[string]bool{}
+			}
+			pt.Missing[f.name] = true
+		}
+		points = append(points, pt)
 	}
 
 	return dto.TrackRequest{
@@ -345,14 +1410,209 @@ func mapTrackRequest(in *nexusai.TrackRequest, userID int32) (dto.TrackRequest,
 	}, nil
 }
 
+func mapTrackPoint(p dto.TrackPoint) *nexusai.TrackPoint {
+	out := &nexusai.TrackPoint{
+		Ts:             timestamppb.New(p.TS),
+		SleepHours:     presentValue(p, "sleep_hours", p.SleepHours),
+		SleepStart:     p.SleepStart,
+		SleepEnd:       p.SleepEnd,
+		Mood:           presentValue(p, "mood", p.Mood),
+		Activity:       presentValue(p, "activity", p.Activity),
+		Productive:     presentValue(p, "productive", p.Productive),
+		Stress:         presentValue(p, "stress", p.Stress),
+		Energy:         presentValue(p, "energy", p.Energy),
+		Concentration:  presentValue(p, "concentration", p.Concentration),
+		SleepQuality:   presentValue(p, "sleep_quality", p.SleepQuality),
+		Caffeine:       p.Caffeine,
+		Alcohol:        p.Alcohol,
+		Workout:        p.Workout,
+		LlmText:        p.LLMText,
+		AnalysisStatus: p.AnalysisStatus,
+		Tags:           append([]string(nil), p.Tags...),
+		NapMinutes:     int32(p.NapMinutes),
+		Context:        copyStringMap(p.Context),
+	}
+	if p.SleepStartTS != nil && p.SleepEndTS != nil {
+		out.SleepStartTs = timestamppb.New(*p.SleepStartTS)
+		out.SleepEndTs = timestamppb.New(*p.SleepEndTS)
+	}
+	return out
+}
+
+// normalizeTags lowercases and trims tags, drops duplicates and returns them sorted.
+// Tags may contain only letters, digits, '-' and '_'.
+func normalizeTags(in []string) ([]string, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	seen := make(map[string]struct{}, len(in))
+	out := make([]string, 0, len(in))
+	for _, raw := range in {
+		tag := strings.ToLower(strings.TrimSpace(raw))
+		if tag == "" {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxTagLen {
+			return nil, fmt.Errorf("tag %q is longer than %d characters", tag, maxTagLen)
+		}
+		for _, r := range tag {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+				return nil, fmt.Errorf("tag %q may contain only letters, digits, '-' and '_'", tag)
+			}
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+	if len(out) > maxTagsPerPoint {
+		return nil, fmt.Errorf("at most %d tags are allowed", maxTagsPerPoint)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// normalizeContext validates a point's context: keys are normalized by normalizeContextKey, values are
+// trimmed and pairs with an empty value are dropped.
+func normalizeContext(in map[string]string) (map[string]string, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]string, len(in))
+	for rawKey, rawValue := range in {
+		key, err := normalizeContextKey(rawKey)
+		if err != nil {
+			return nil, err
+		}
+		value := strings.TrimSpace(rawValue)
+		if value == "" {
+			continue
+		}
+		if utf8.RuneCountInString(value) > maxContextValueLen {
+			return nil, fmt.Errorf("context value of %q is longer than %d characters", key, maxContextValueLen)
+		}
+		out[key] = value
+	}
+	if len(out) > maxContextKeys {
+		return nil, fmt.Errorf("at most %d context keys are allowed", maxContextKeys)
+	}
+	if raw, _ := json.Marshal(out); len(raw) > maxContextBytes {
+		return nil, fmt.Errorf("context must be at most %d bytes", maxContextBytes)
+	}
+	return out, nil
+}
+
+// normalizeContextKey lowercases and trims a context key; like a tag, it may contain only letters,
+// digits, '-' and '_' and be at most maxTagLen characters.
+func normalizeContextKey(raw string) (string, error) {
+	key := strings.ToLower(strings.TrimSpace(raw))
+	if key == "" {
+		return "", errors.New("context key must not be empty")
+	}
+	if utf8.RuneCountInString(key) > maxTagLen {
+		return "", fmt.Errorf("context key %q is longer than %d characters", key, maxTagLen)
+	}
+	for _, r := range key {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return "", fmt.Errorf("context key %q may contain only letters, digits, '-' and '_'", key)
+		}
+	}
+	return key, nil
+}
+
+// apply enforces the per-note cap in runes, either rejecting the note or cutting it with a marker.
+func (l noteLimit) apply(text string) (string, error) {
+	if utf8.RuneCountInString(text) <= l.maxRunes {
+		return text, nil
+	}
+	if !l.truncate {
+		return "", fmt.Errorf("llm_text must be at most %d characters", l.maxRunes)
+	}
+	keep := l.maxRunes - utf8.RuneCountInString(truncatedNoteMarker)
+	if keep < 0 {
+		keep = 0
+	}
+	return string([]rune(text)[:keep]) + truncatedNoteMarker, nil
+}
+
+// presentValue returns nil for a field the point does not have, leaving it unset on the wire.
+func presentValue(p dto.TrackPoint, field string, v float64) *float64 {
+	if !p.Has(field) {
+		return nil
+	}
+	return &v
+}
+
+func mapPatchTrackFields(in *nexusai.PatchTodayTrackRequest) (map[string]any, error) {
+	if in == nil {
+		return nil, errors.New("empty request")
+	}
+	fields := map[string]any{}
+	if in.SleepHours != nil {
+		if *in.SleepHours < 0 || *in.SleepHours > 24 {
+			return nil, errors.New("sleep_hours must be between 0 and 24")
+		}
+		fields["sleep_hours"] = *in.SleepHours
+	}
+	for name, v := range map[string]*string{"sleep_start": in.SleepStart, "sleep_end": in.SleepEnd} {
+		if v == nil {
+			continue
+		}
+		if *v != "" {
+			if _, err := time.Parse("15:04", *v); err != nil {
+				return nil, fmt.Errorf("%s must be in HH:MM format", name)
+			}
+		}
+		fields[name] = *v
+	}
+	ratings := map[string]*float64{
+		"mood":          in.Mood,
+		"activity":      in.Activity,
+		"productive":    in.Productive,
+		"stress":        in.Stress,
+		"energy":        in.Energy,
+		"concentration": in.Concentration,
+		"sleep_quality": in.SleepQuality,
+	}
+	for name, v := range ratings {
+		if v == nil {
+			continue
+		}
+		if *v < 0 || *v > 10 {
+			return nil, fmt.Errorf("%s must be between 0 and 10", name)
+		}
+		fields[name] = *v
+	}
+	for name, v := range map[string]*bool{"caffeine": in.Caffeine, "alcohol": in.Alcohol, "workout": in.Workout} {
+		if v != nil {
+			fields[name] = *v
+		}
+	}
+	if in.NapMinutes != nil {
+		if *in.NapMinutes < 0 || *in.NapMinutes > maxNapMinutes {
+			return nil, fmt.Errorf("nap_minutes must be between 0 and %d", maxNapMinutes)
+		}
+		fields["nap_minutes"] = int(*in.NapMinutes)
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+	return fields, nil
+}
+
 func mapUserProfile(p dto.UserProfile) *nexusai.UserProfile {
 	return &nexusai.UserProfile{
-		UserId:  p.UserID,
-		Name:    p.Name,
-		Email:   p.Email,
-		Emoji:   p.Emoji,
-		BgIndex: p.BgIndex,
-		IsFriend: p.IsFriend,
+		UserId:          p.UserID,
+		Name:            p.Name,
+		Email:           p.Email,
+		Emoji:           p.Emoji,
+		BgIndex:         p.BgIndex,
+		IsFriend:        p.IsFriend,
+		DayStartHour:    p.DayStartHour,
+		Tone:            toneToProto(p.Tone),
+		ShareAnalyses:   p.ShareAnalyses,
+		AnalysesEnabled: p.AnalysesEnabled,
 	}
 }
 
@@ -379,16 +1639,119 @@ func mapAnalyzeRequest(in *nexusai.AnalyzeRequest, userID int32) (dto.AnalyzeReq
 		}
 	}
 
+	horizon := int(in.BurnoutHorizonDays)
+	if horizon < 0 || horizon > maxBurnoutHorizonDays {
+		return dto.AnalyzeRequest{}, fmt.Errorf("burnout_horizon_days must be between 0 and %d", maxBurnoutHorizonDays)
+	}
+
+	model := strings.TrimSpace(in.Model)
+	if model != "" && !llm.IsAllowedModel(model) {
+		return dto.AnalyzeRequest{}, fmt.Errorf("model %q is not allowed", model)
+	}
+
+	tags, err := normalizeTags(in.Tags)
+	if err != nil {
+		return dto.AnalyzeRequest{}, err
+	}
+
+	rolling := int(in.RollingDays)
+	if rolling < 0 || rolling > maxRollingDays {
+		return dto.AnalyzeRequest{}, fmt.Errorf("rolling_days must be between 0 and %d", maxRollingDays)
+	}
+
+	excludeTags, err := normalizeTags(in.ExcludeTags)
+	if err != nil {
+		return dto.AnalyzeRequest{}, err
+	}
+
+	fields, err := normalizeFields(in.Fields)
+	if err != nil {
+		return dto.AnalyzeRequest{}, err
+	}
+	// The insight is the expensive part; a mask without it skips the LLM call.
+	skipInsight := len(fields) > 0
+	for _, f := range fields {
+		if f == "llm_insight" {
+			skipInsight = false
+		}
+	}
+
 	return dto.AnalyzeRequest{
-		UserID:      userID,
-		UserTZ:      in.UserTz,
-		WeekStarts:  in.WeekStarts,
-		Constraints: c,
-		Period:      mapPeriod(in.Period),
+		UserID:             userID,
+		UserTZ:             in.UserTz,
+		WeekStarts:         in.WeekStarts,
+		Constraints:        c,
+		Period:             mapPeriod(in.Period),
+		BurnoutHorizonDays: horizon,
+		Model:              model,
+		Tone:               mapTone(in.Tone),
+		Tags:               tags,
+		RollingDays:        rolling,
+		ExcludeTags:        excludeTags,
+		ExcludeOutliers:    in.ExcludeOutliers,
+		Fields:             fields,
+		SkipInsight:        skipInsight,
 	}, nil
 }
 
-func mapAnalyzeResponse(in *dto.AnalyzeResponse) (*nexusai.AnalyzeResponse, error) {
+func mapEnergyCI(in map[string]dto.EnergyCI) map[string]*nexusai.EnergyCI {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]*nexusai.EnergyCI, len(in))
+	for d, ci := range in {
+		out[d] = &nexusai.EnergyCI{
+			Mean:    ci.Mean,
+			StdErr:  ci.StdErr,
+			Low:     ci.Low,
+			High:    ci.High,
+			Samples: int32(ci.Samples),
+		}
+	}
+	return out
+}
+
+// mapPeriodMeta returns nil for analyses saved before the window was recorded.
+func mapPeriodMeta(m dto.PeriodMeta) *nexusai.PeriodMeta {
+	if m.End.IsZero() {
+		return nil
+	}
+	out := &nexusai.PeriodMeta{End: timestamppb.New(m.End), Label: m.Label}
+	if !m.Start.IsZero() {
+		out.Start = timestamppb.New(m.Start)
+	}
+	return out
+}
+
+func mapDataSufficiency(ds dto.DataSufficiency) *nexusai.DataSufficiency {
+	out := &nexusai.DataSufficiency{
+		Level:           ds.Level,
+		NumPoints:       int32(ds.NumPoints),
+		NumObservedDays: int32(ds.NumObservedDays),
+	}
+	for _, c := range ds.Coverage {
+		out.Coverage = append(out.Coverage, &nexusai.AnalysisCoverage{
+			Analysis:       c.Analysis,
+			Days:           int32(c.Days),
+			MinDays:        int32(c.MinDays),
+			RequiredFields: append([]string(nil), c.RequiredFields...),
+			MissingFields:  append([]string(nil), c.MissingFields...),
+		})
+	}
+	return out
+}
+
+func mapOptimalSchedule(s dto.OptimalSchedule) *nexusai.OptimalSchedule {
+	return &nexusai.OptimalSchedule{
+		SuggestedSleepWindow: s.SuggestedSleepWindow,
+		BestFocusHours:       append([]string(nil), s.BestFocusHours...),
+		BestLightTasksHours:  append([]string(nil), s.BestLightTasksHours...),
+		RecoveryTips:         append([]string(nil), s.RecoveryTips...),
+		Chronotype:           s.Chronotype,
+	}
+}
+
+func mapAnalyzeResponse(in *dto.AnalyzeResponse, precision int) (*nexusai.AnalyzeResponse, error) {
 	if in == nil {
 		return nil, errors.New("empty response")
 	}
@@ -399,29 +1762,16 @@ func mapAnalyzeResponse(in *dto.AnalyzeResponse) (*nexusai.AnalyzeResponse, erro
 	}
 
 	model := &nexusai.ProductivityModel{
-		Score: in.ProductivityModel.Score,
-		Weights: func() map[string]float64 {
-			out := make(map[string]float64, len(in.ProductivityModel.Weights))
-			for k, v := range in.ProductivityModel.Weights {
-				out[k] = v
-			}
-			return out
-		}(),
+		Score:         in.ProductivityModel.Score,
+		Weights:       copyFloatMap(in.ProductivityModel.Weights),
+		Components:    copyFloatMap(in.ProductivityModel.Components),
+		Contributions: copyFloatMap(in.ProductivityModel.Contributions),
+		Insufficient:  in.ProductivityModel.Insufficient,
 	}
 
-	burnout := &nexusai.BurnoutRisk{
-		Score:                 in.BurnoutRisk.Score,
-		Level:                 in.BurnoutRisk.Level,
-		Reasons:               append([]string(nil), in.BurnoutRisk.Reasons...),
-		PredictionHorizonDays: int32(in.BurnoutRisk.PredictionHorizonDays),
-	}
+	burnout := mapBurnoutRisk(in.BurnoutRisk)
 
-	schedule := &nexusai.OptimalSchedule{
-		SuggestedSleepWindow: in.OptimalSchedule.SuggestedSleepWindow,
-		BestFocusHours:       append([]string(nil), in.OptimalSchedule.BestFocusHours...),
-		BestLightTasksHours:  append([]string(nil), in.OptimalSchedule.BestLightTasksHours...),
-		RecoveryTips:         append([]string(nil), in.OptimalSchedule.RecoveryTips...),
-	}
+	schedule := mapOptimalSchedule(in.OptimalSchedule)
 
 	out := &nexusai.AnalyzeResponse{
 		EnergyByWeekday:   energyByWeekday,
@@ -429,20 +1779,188 @@ func mapAnalyzeResponse(in *dto.AnalyzeResponse) (*nexusai.AnalyzeResponse, erro
 		BurnoutRisk:       burnout,
 		OptimalSchedule:   schedule,
 		LlmInsight:        in.LLMInsight,
+		InsightSource:     in.InsightSource,
+		InsightUnchanged:  in.InsightUnchanged,
+		SocialJetlagHours: in.SocialJetlagHours,
+		FilterTags:        append([]string(nil), in.FilterTags...),
+		Averages:          copyFloatMap(in.Averages),
+		EnergyByWeekdayCi: mapEnergyCI(in.EnergyByWeekdayCI),
+		DataSufficiency:   mapDataSufficiency(in.DataSufficiency),
+		PeriodMeta:        mapPeriodMeta(in.PeriodMeta),
+		ExcludedDays:      int32(in.ExcludedDays),
+	}
+	if in.FocusStats != nil {
+		out.FocusStats = &nexusai.FocusStats{
+			NumSessions:       int32(in.FocusStats.NumSessions),
+			TotalHours:        in.FocusStats.TotalHours,
+			AvgSessionMinutes: in.FocusStats.AvgSessionMinutes,
+			AvgQuality:        in.FocusStats.AvgQuality,
+			BestHours:         append([]string(nil), in.FocusStats.BestHours...),
+		}
+	}
+
+	for _, w := range in.DataQuality {
+		out.DataQualityWarnings = append(out.DataQualityWarnings, &nexusai.DataQualityWarning{
+			Date:    w.Date,
+			Code:    w.Code,
+			Message: w.Message,
+		})
 	}
 
 	if in.Debug != nil {
-		s, err := structpb.NewStruct(in.Debug)
+		s, err := structpb.NewStruct(sanitizeDebug(in.Debug))
 		if err != nil {
 			return nil, err
 		}
 		out.Debug = s
 	}
 
+	roundAnalyzeResponse(out, precision)
 	return out, nil
 }
 
+// roundAnalyzeResponse rounds the scores, averages and durations of an already mapped response to
+// precision decimals. Weights, counts and debug values are left as they are. Rounding happens only here,
+// so cached and stored analyses keep the full precision analytics produced.
+func roundAnalyzeResponse(out *nexusai.AnalyzeResponse, precision int) {
+	if out == nil {
+		return
+	}
+	pow := math.Pow(10, float64(precision))
+	round := func(v float64) float64 { return math.Round(v*pow) / pow }
+	roundMap := func(m map[string]float64) {
+		for k, v := range m {
+			m[k] = round(v)
+		}
+	}
+
+	roundMap(out.EnergyByWeekday)
+	for _, ci := range out.EnergyByWeekdayCi {
+		ci.Mean = round(ci.Mean)
+		if ci.StdErr != nil {
+			se := round(*ci.StdErr)
+			ci.StdErr = &se
+		}
+		ci.Low = round(ci.Low)
+		ci.High = round(ci.High)
+	}
+	roundMap(out.Averages)
+	out.SocialJetlagHours = round(out.SocialJetlagHours)
+	if pm := out.ProductivityModel; pm != nil {
+		pm.Score = round(pm.Score)
+		roundMap(pm.Components)
+		roundMap(pm.Contributions)
+	}
+	if br := out.BurnoutRisk; br != nil {
+		br.Score = round(br.Score)
+	}
+	if fs := out.FocusStats; fs != nil {
+		fs.TotalHours = round(fs.TotalHours)
+		fs.AvgSessionMinutes = round(fs.AvgSessionMinutes)
+		fs.AvgQuality = round(fs.AvgQuality)
+	}
+}
+
+// sanitizeDebug coerces debug values into types structpb accepts. Values that
+// cannot be represented are dropped and logged so they never fail the response.
+func sanitizeDebug(in map[string]any) map[string]any {
+	out := make(map[string]any, len(in))
+	for k, v := range in {
+		sv, ok := debugValue(v)
+		if !ok {
+			log.Printf("debug: dropping key %q of type %T", k, v)
+			continue
+		}
+		out[k] = sv
+	}
+	return out
+}
+
+func debugValue(v any) (any, bool) {
+	switch t := v.(type) {
+	case nil, bool, string, float64:
+		return t, true
+	case float32:
+		return float64(t), true
+	case int:
+		return float64(t), true
+	case int32:
+		return float64(t), true
+	case int64:
+		return float64(t), true
+	case uint32:
+		return float64(t), true
+	case uint64:
+		return float64(t), true
+	case time.Time:
+		return t.Format(time.RFC3339), true
+	case time.Duration:
+		return t.String(), true
+	case map[string]any:
+		return sanitizeDebug(t), true
+	case []any:
+		out := make([]any, 0, len(t))
+		for _, e := range t {
+			if ev, ok := debugValue(e); ok {
+				out = append(out, ev)
+			}
+		}
+		return out, true
+	}
+	// Anything else (typed slices/maps, structs) goes through a JSON round trip.
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, false
+	}
+	return generic, true
+}
+
+func mapBurnoutRisk(in dto.BurnoutRisk) *nexusai.BurnoutRisk {
+	out := &nexusai.BurnoutRisk{
+		Score:                 in.Score,
+		Level:                 in.Level,
+		Reasons:               append([]string(nil), in.Reasons...),
+		PredictionHorizonDays: int32(in.PredictionHorizonDays),
+		Dismissed:             in.Dismissed,
+		CoverageRatio:         in.CoverageRatio,
+	}
+	for _, r := range in.StructuredReasons {
+		out.StructuredReasons = append(out.StructuredReasons, &nexusai.BurnoutReason{
+			Code:     r.Code,
+			Weight:   r.Weight,
+			Severity: r.Severity,
+		})
+	}
+	return out
+}
+
+func copyStringMap(in map[string]string) map[string]string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func copyFloatMap(in map[string]float64) map[string]float64 {
+	out := make(map[string]float64, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
 func (h *GRPCAnalyzeHandler) userIDFromContext(ctx context.Context) (int32, error) {
+	if id, ok := middleware.TrustedUserID(ctx); ok {
+		return id, nil
+	}
 	if h.authClient == nil {
 		return 0, status.Error(codes.Internal, "auth client not configured")
 	}
@@ -450,10 +1968,17 @@ func (h *GRPCAnalyzeHandler) userIDFromContext(ctx context.Context) (int32, erro
 	if authHeader == "" {
 		return 0, status.Error(codes.Unauthenticated, "missing authorization")
 	}
-	outCtx := metadata.AppendToOutgoingContext(ctx, "authorization", authHeader)
+	meCtx, cancel := context.WithTimeout(ctx, h.authTimeout)
+	defer cancel()
+	outCtx := metadata.AppendToOutgoingContext(meCtx, "authorization", authHeader)
 	resp, err := h.authClient.Me(outCtx, &authpb.MeRequest{})
 	if err != nil {
-		return 0, status.Error(codes.Unauthenticated, "unauthorized")
+		switch status.Code(err) {
+		case codes.Unauthenticated, codes.PermissionDenied, codes.InvalidArgument, codes.NotFound:
+			return 0, status.Error(codes.Unauthenticated, "unauthorized")
+		default:
+			return 0, status.Error(codes.Unavailable, "auth service unavailable")
+		}
 	}
 	if resp == nil || resp.Id == 0 {
 		return 0, status.Error(codes.Unauthenticated, "unauthorized")
@@ -473,6 +1998,32 @@ func authFromMetadata(ctx context.Context) string {
 	return vals[0]
 }
 
+func mapTone(t nexusai.Tone) dto.Tone {
+	switch t {
+	case nexusai.Tone_TONE_GENTLE:
+		return dto.ToneGentle
+	case nexusai.Tone_TONE_DIRECT:
+		return dto.ToneDirect
+	case nexusai.Tone_TONE_DATA_ONLY:
+		return dto.ToneDataOnly
+	default:
+		return dto.ToneDefault
+	}
+}
+
+func toneToProto(t dto.Tone) nexusai.Tone {
+	switch t {
+	case dto.ToneGentle:
+		return nexusai.Tone_TONE_GENTLE
+	case dto.ToneDirect:
+		return nexusai.Tone_TONE_DIRECT
+	case dto.ToneDataOnly:
+		return nexusai.Tone_TONE_DATA_ONLY
+	default:
+		return nexusai.Tone_TONE_UNSPECIFIED
+	}
+}
+
 func mapPeriod(p nexusai.Period) dto.Period {
 	switch p {
 	case nexusai.Period_PERIOD_DAY:
@@ -504,7 +2055,7 @@ func calcSleepHours(day time.Time, sleepStart, sleepEnd string) (float64, bool)
 		endAt = endAt.Add(24 * time.Hour)
 	}
 	dur := endAt.Sub(startAt).Hours()
-	if dur < 0 || dur > 20 {
+	if dur < 0 || dur > maxSleepHours {
 		return 0, false
 	}
 	return dur, true
@@ -0,0 +1,554 @@
+package handler
+
+import (
+	authpb "auth_service/proto"
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"nexus/internal/dto"
+	"nexus/internal/usecase"
+	nexusai "nexus/proto/nexusai/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testUserID = 42
+
+// fakeAuth answers Me with a fixed user, or with err when it is set.
+type fakeAuth struct {
+	id    int32
+	err   error
+	delay time.Duration
+}
+
+func (f fakeAuth) Me(ctx context.Context, _ *authpb.MeRequest, _ ...grpc.CallOption) (*authpb.MeResponse, error) {
+	if f.delay > 0 {
+		select {
+		case <-time.After(f.delay):
+		case <-ctx.Done():
+			return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+		}
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &authpb.MeResponse{Id: f.id}, nil
+}
+
+// fakeRepo keeps track points in memory. Methods the tests do not reach are left to the embedded
+// nil interface and panic when called.
+type fakeRepo struct {
+	usecase.AnalysisRepository
+
+	mu      sync.Mutex
+	points  []dto.TrackPoint
+	patched []map[string]any
+}
+
+func (r *fakeRepo) GetUserSettings(context.Context, int32) (string, error) { return "UTC", nil }
+func (r *fakeRepo) GetDayStartHour(context.Context, int32) (int, error)    { return 0, nil }
+func (r *fakeRepo) GetAnalysesEnabled(context.Context, int32) (bool, error) {
+	return false, nil
+}
+
+// latestIn returns the index of the newest point in [from, to), or -1.
+func (r *fakeRepo) latestIn(from, to time.Time) int {
+	idx := -1
+	for i, p := range r.points {
+		if !p.TS.Before(from) && p.TS.Before(to) && (idx < 0 || p.TS.After(r.points[idx].TS)) {
+			idx = i
+		}
+	}
+	return idx
+}
+
+func (r *fakeRepo) GetTrackPointForDay(_ context.Context, _ int32, from, to time.Time) (dto.TrackPoint, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if i := r.latestIn(from, to); i >= 0 {
+		return r.points[i], true, nil
+	}
+	return dto.TrackPoint{}, false, nil
+}
+
+func (r *fakeRepo) PatchTrackPointForDay(_ context.Context, _ int32, from, to time.Time, fields map[string]any) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.patched = append(r.patched, fields)
+	i := r.latestIn(from, to)
+	if i < 0 {
+		return false, nil
+	}
+	p := &r.points[i]
+	for k, v := range fields {
+		switch k {
+		case "sleep_hours":
+			p.SleepHours = v.(float64)
+		case "mood":
+			p.Mood = v.(float64)
+		case "stress":
+			p.Stress = v.(float64)
+		case "energy":
+			p.Energy = v.(float64)
+		case "workout":
+			p.Workout = v.(bool)
+		case "nap_minutes":
+			p.NapMinutes = v.(int)
+		}
+	}
+	return true, nil
+}
+
+func newTestHandler(repo usecase.AnalysisRepository, auth authpb.AuthServiceClient, cfg GRPCHandlerConfig) *GRPCAnalyzeHandler {
+	return NewGRPCAnalyzeHandler(usecase.NewAnalyzer(nil, repo, usecase.Config{}), auth, cfg)
+}
+
+func authedContext() context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestPatchTodayTrackChangesOnlySetFields(t *testing.T) {
+	now := time.Now().UTC()
+	repo := &fakeRepo{points: []dto.TrackPoint{{
+		TS: now, SleepHours: 6, Mood: 4, Stress: 7, Energy: 5, LLMText: "тяжёлый день", Tags: []string{"work"},
+	}}}
+	h := newTestHandler(repo, fakeAuth{id: testUserID}, GRPCHandlerConfig{})
+
+	resp, err := h.PatchTodayTrack(authedContext(), &nexusai.PatchTodayTrackRequest{
+		UserTz:     "UTC",
+		SleepHours: ptr(7.5),
+		Workout:    ptr(true),
+	})
+	if err != nil {
+		t.Fatalf("PatchTodayTrack: %v", err)
+	}
+	if len(repo.patched) != 1 {
+		t.Fatalf("patch calls = %d, want 1", len(repo.patched))
+	}
+	var keys []string
+	for k := range repo.patched[0] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(keys) != 2 || keys[0] != "sleep_hours" || keys[1] != "workout" {
+		t.Fatalf("patched columns = %v, want [sleep_hours workout]", keys)
+	}
+	p := resp.GetPoint()
+	if p.GetSleepHours() != 7.5 || !p.GetWorkout() {
+		t.Errorf("patched values not applied: sleep=%v workout=%v", p.GetSleepHours(), p.GetWorkout())
+	}
+	if p.GetMood() != 4 || p.GetStress() != 7 || p.GetEnergy() != 5 {
+		t.Errorf("untouched ratings changed: mood=%v stress=%v energy=%v", p.GetMood(), p.GetStress(), p.GetEnergy())
+	}
+	if p.GetLlmText() != "тяжёлый день" || len(p.GetTags()) != 1 {
+		t.Errorf("untouched note or tags changed: %q %v", p.GetLlmText(), p.GetTags())
+	}
+}
+
+func TestPatchTodayTrackRejectsOtherDays(t *testing.T) {
+	repo := &fakeRepo{points: []dto.TrackPoint{{TS: time.Now().UTC().AddDate(0, 0, -1), Mood: 4}}}
+	h := newTestHandler(repo, fakeAuth{id: testUserID}, GRPCHandlerConfig{})
+
+	_, err := h.PatchTodayTrack(authedContext(), &nexusai.PatchTodayTrackRequest{UserTz: "UTC", Mood: ptr(8.0)})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("code = %v, want NotFound (%v)", status.Code(err), err)
+	}
+	if repo.points[0].Mood != 4 {
+		t.Errorf("yesterday's point was patched: mood = %v", repo.points[0].Mood)
+	}
+}
+
+func TestPatchTodayTrackCannotPatchNotes(t *testing.T) {
+	desc := (&nexusai.PatchTodayTrackRequest{}).ProtoReflect().Descriptor()
+	if desc.Fields().ByName("llm_text") != nil {
+		t.Fatal("PatchTodayTrackRequest exposes llm_text")
+	}
+
+	every := &nexusai.PatchTodayTrackRequest{
+		SleepHours: ptr(7.0), SleepStart: ptr("23:00"), SleepEnd: ptr("07:00"),
+		Mood: ptr(5.0), Activity: ptr(5.0), Productive: ptr(5.0), Stress: ptr(5.0), Energy: ptr(5.0),
+		Concentration: ptr(5.0), SleepQuality: ptr(5.0),
+		Caffeine: ptr(true), Alcohol: ptr(false), Workout: ptr(true), NapMinutes: ptr(int32(20)),
+	}
+	fields, err := mapPatchTrackFields(every)
+	if err != nil {
+		t.Fatalf("mapPatchTrackFields: %v", err)
+	}
+	if _, ok := fields["llm_text"]; ok {
+		t.Error("llm_text is in the patched columns")
+	}
+}
+
+func TestMapPatchTrackFieldsValidates(t *testing.T) {
+	for name, req := range map[string]*nexusai.PatchTodayTrackRequest{
+		"empty":        {},
+		"mood range":   {Mood: ptr(11.0)},
+		"sleep range":  {SleepHours: ptr(25.0)},
+		"sleep format": {SleepStart: ptr("11pm")},
+		"nap range":    {NapMinutes: ptr(int32(maxNapMinutes + 1))},
+	} {
+		if _, err := mapPatchTrackFields(req); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
+
+func (r *fakeRepo) GetAggregateStats(_ context.Context, from, to time.Time, _ int) (dto.AggregateStats, error) {
+	return dto.AggregateStats{From: from, To: to, NumPoints: 30, NumUsers: 12}, nil
+}
+
+func TestGetAggregateStatsRequiresAdmin(t *testing.T) {
+	withAdmin := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("authorization", "Bearer token", "x-admin-token", "secret"))
+	wrongAdmin := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("authorization", "Bearer token", "x-admin-token", "guess"))
+
+	cases := []struct {
+		name  string
+		token string
+		ctx   context.Context
+		want  codes.Code
+	}{
+		{"admin disabled", "", withAdmin, codes.PermissionDenied},
+		{"no admin token", "secret", authedContext(), codes.PermissionDenied},
+		{"wrong admin token", "secret", wrongAdmin, codes.PermissionDenied},
+		{"admin", "secret", withAdmin, codes.OK},
+	}
+	for _, c := range cases {
+		h := newTestHandler(&fakeRepo{}, fakeAuth{id: testUserID}, GRPCHandlerConfig{AdminToken: c.token})
+		_, err := h.GetAggregateStats(c.ctx, &nexusai.AggregateStatsRequest{})
+		if status.Code(err) != c.want {
+			t.Errorf("%s: code = %v, want %v", c.name, status.Code(err), c.want)
+		}
+	}
+}
+
+func TestAggregateStatsResponseHasNoUserFields(t *testing.T) {
+	// Only aggregates may leave the service: no message reachable from the response names a user.
+	seen := map[protoreflect.FullName]bool{}
+	var walk func(md protoreflect.MessageDescriptor)
+	walk = func(md protoreflect.MessageDescriptor) {
+		if seen[md.FullName()] {
+			return
+		}
+		seen[md.FullName()] = true
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			f := fields.Get(i)
+			if name := string(f.Name()); strings.Contains(name, "user_id") || strings.Contains(name, "email") {
+				t.Errorf("%s.%s identifies a user", md.FullName(), name)
+			}
+			if f.Message() != nil {
+				walk(f.Message())
+			}
+		}
+	}
+	walk((&nexusai.AggregateStatsResponse{}).ProtoReflect().Descriptor())
+}
+
+func TestUserIDFromContextAuthTimeout(t *testing.T) {
+	h := newTestHandler(&fakeRepo{}, fakeAuth{id: testUserID, delay: time.Second}, GRPCHandlerConfig{AuthTimeout: 50 * time.Millisecond})
+
+	start := time.Now()
+	_, err := h.userIDFromContext(authedContext())
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("code = %v, want Unavailable for a hanging auth service", status.Code(err))
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("a hanging auth service blocked the call for %s", elapsed)
+	}
+}
+
+func TestUserIDFromContextStatusMapping(t *testing.T) {
+	cases := []struct {
+		name string
+		auth fakeAuth
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"ok", fakeAuth{id: testUserID}, authedContext(), codes.OK},
+		{"no header", fakeAuth{id: testUserID}, context.Background(), codes.Unauthenticated},
+		{"invalid token", fakeAuth{err: status.Error(codes.Unauthenticated, "bad token")}, authedContext(), codes.Unauthenticated},
+		{"forbidden", fakeAuth{err: status.Error(codes.PermissionDenied, "no")}, authedContext(), codes.Unauthenticated},
+		{"unknown user", fakeAuth{id: 0}, authedContext(), codes.Unauthenticated},
+		{"auth down", fakeAuth{err: status.Error(codes.Unavailable, "connection refused")}, authedContext(), codes.Unavailable},
+		{"auth failing", fakeAuth{err: status.Error(codes.Internal, "boom")}, authedContext(), codes.Unavailable},
+	}
+	for _, c := range cases {
+		h := newTestHandler(&fakeRepo{}, c.auth, GRPCHandlerConfig{})
+		id, err := h.userIDFromContext(c.ctx)
+		if status.Code(err) != c.want {
+			t.Errorf("%s: code = %v, want %v", c.name, status.Code(err), c.want)
+		}
+		if c.want == codes.OK && id != testUserID {
+			t.Errorf("%s: user id = %d, want %d", c.name, id, testUserID)
+		}
+	}
+}
+
+func TestMapAnalyzeResponseCoercesDebug(t *testing.T) {
+	at := time.Date(2026, 3, 10, 8, 30, 0, 0, time.UTC)
+	resp := &dto.AnalyzeResponse{Debug: map[string]any{
+		"generated_at": at,
+		"max_gap_days": 3,
+		"took":         1500 * time.Millisecond,
+		"nested":       map[string]any{"points": int64(12), "seen": at},
+		"weekdays":     []string{"Mon", "Tue"},
+		"window":       struct{ Days int }{Days: 7},
+		"broken":       make(chan int),
+	}}
+
+	out, err := mapAnalyzeResponse(resp, defaultResponsePrecision)
+	if err != nil {
+		t.Fatalf("mapAnalyzeResponse: %v", err)
+	}
+	got := out.GetDebug().AsMap()
+	want := map[string]any{
+		"generated_at": "2026-03-10T08:30:00Z",
+		"max_gap_days": 3.0,
+		"took":         "1.5s",
+		"nested":       map[string]any{"points": 12.0, "seen": "2026-03-10T08:30:00Z"},
+		"weekdays":     []any{"Mon", "Tue"},
+		"window":       map[string]any{"Days": 7.0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("debug = %#v, want %#v", got, want)
+	}
+	if _, ok := got["broken"]; ok {
+		t.Error("an unrepresentable value was kept instead of dropped")
+	}
+}
+
+func TestMapAnalyzeResponsePrecision(t *testing.T) {
+	resp := &dto.AnalyzeResponse{
+		EnergyByWeekday:   map[string]float64{"Mon": 63.456789},
+		EnergyByWeekdayCI: map[string]dto.EnergyCI{"Mon": {Mean: 63.456789, StdErr: ptr(4.123456), Low: 54.987654, High: 71.925924, Samples: 5}},
+		ProductivityModel: dto.ProductivityModel{Score: 72.345678, Weights: map[string]float64{"energy_mean": 0.123456}},
+		BurnoutRisk:       dto.BurnoutRisk{Score: 41.666666},
+		Averages:          map[string]float64{"mood": 6.857142},
+		SocialJetlagHours: 1.583333,
+	}
+	values := func(out *nexusai.AnalyzeResponse) []float64 {
+		ci := out.GetEnergyByWeekdayCi()["Mon"]
+		return []float64{
+			out.GetEnergyByWeekday()["Mon"], ci.GetMean(), ci.GetStdErr(), ci.GetLow(), ci.GetHigh(),
+			out.GetProductivityModel().GetScore(), out.GetBurnoutRisk().GetScore(), out.GetAverages()["mood"],
+			out.GetSocialJetlagHours(),
+		}
+	}
+
+	cases := []struct {
+		precision int
+		want      []float64
+	}{
+		{0, []float64{63, 63, 4, 55, 72, 72, 42, 7, 2}},
+		{defaultResponsePrecision, []float64{63.46, 63.46, 4.12, 54.99, 71.93, 72.35, 41.67, 6.86, 1.58}},
+		// Analytics keep full precision, so a finer hint really returns more decimals.
+		{4, []float64{63.4568, 63.4568, 4.1235, 54.9877, 71.9259, 72.3457, 41.6667, 6.8571, 1.5833}},
+	}
+	for _, c := range cases {
+		out, err := mapAnalyzeResponse(resp, c.precision)
+		if err != nil {
+			t.Fatalf("mapAnalyzeResponse: %v", err)
+		}
+		if got := values(out); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("precision %d: values = %v, want %v", c.precision, got, c.want)
+		}
+		if w := out.GetProductivityModel().GetWeights()["energy_mean"]; w != 0.123456 {
+			t.Errorf("precision %d: weight = %v, weights are not rounded", c.precision, w)
+		}
+	}
+	if resp.ProductivityModel.Score != 72.345678 {
+		t.Errorf("mapping rounded the source response: %v", resp.ProductivityModel.Score)
+	}
+}
+
+func TestAnalyzePrecisionBounds(t *testing.T) {
+	h := newTestHandler(&fakeRepo{}, fakeAuth{id: testUserID}, GRPCHandlerConfig{})
+	for _, p := range []int32{-1, maxResponsePrecision + 1} {
+		_, err := h.Analyze(authedContext(), &nexusai.AnalyzeRequest{UserTz: "UTC", Precision: ptr(p)})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("precision %d: code = %v, want InvalidArgument", p, status.Code(err))
+		}
+	}
+}
+
+func TestNoteLimitCountsRunes(t *testing.T) {
+	ten := strings.Repeat("ж", 9) + "🙂" // 10 runes, 22 bytes
+	strict := noteLimit{maxRunes: 10}
+	cut := noteLimit{maxRunes: 10, truncate: true}
+
+	for _, l := range []noteLimit{strict, cut} {
+		if got, err := l.apply(ten); err != nil || got != ten {
+			t.Errorf("truncate=%v: a note at the cap = %q, %v; want it unchanged", l.truncate, got, err)
+		}
+	}
+	if _, err := strict.apply(ten + "ы"); err == nil {
+		t.Error("a note one rune over the cap was accepted")
+	}
+	got, err := cut.apply(ten + "ы")
+	if err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	if !utf8.ValidString(got) || utf8.RuneCountInString(got) != 10 || !strings.HasSuffix(got, truncatedNoteMarker) {
+		t.Errorf("truncated note = %q, want 10 valid runes ending in the marker", got)
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	got, err := normalizeTags([]string{" Отпуск ", "sick_day", "COFFEE", "отпуск", "", "late-night", "4h"})
+	if err != nil {
+		t.Fatalf("normalizeTags: %v", err)
+	}
+	if want := []string{"4h", "coffee", "late-night", "sick_day", "отпуск"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tags = %v, want %v", got, want)
+	}
+	for _, bad := range [][]string{
+		{"two words"},
+		{"a/b"},
+		{"emoji🙂"},
+		{strings.Repeat("я", maxTagLen+1)},
+		{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"},
+	} {
+		if _, err := normalizeTags(bad); err == nil {
+			t.Errorf("tags %q were accepted", bad)
+		}
+	}
+	if _, err := normalizeTags([]string{strings.Repeat("я", maxTagLen)}); err != nil {
+		t.Errorf("a tag of exactly %d letters was rejected: %v", maxTagLen, err)
+	}
+}
+
+func TestNormalizeContext(t *testing.T) {
+	got, err := normalizeContext(map[string]string{" Weather ": " rain ", "Место": "дом", "menstrual_phase": "luteal", "mood-note": "  "})
+	if err != nil {
+		t.Fatalf("normalizeContext: %v", err)
+	}
+	if want := map[string]string{"weather": "rain", "место": "дом", "menstrual_phase": "luteal"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("context = %v, want %v", got, want)
+	}
+	if got, err := normalizeContext(nil); got != nil || err != nil {
+		t.Errorf("empty context = %v, %v; want nil", got, err)
+	}
+
+	tooMany := map[string]string{}
+	for i := 0; i <= maxContextKeys; i++ {
+		tooMany["k"+strconv.Itoa(i)] = "v"
+	}
+	tooBig := map[string]string{}
+	for i := 0; i < maxContextKeys; i++ {
+		tooBig["key"+strconv.Itoa(i)] = strings.Repeat("я", maxContextValueLen) // 128 bytes each
+	}
+	for name, bad := range map[string]map[string]string{
+		"empty key":      {" ": "rain"},
+		"space in key":   {"two words": "x"},
+		"slash in key":   {"a/b": "x"},
+		"long key":       {strings.Repeat("я", maxTagLen+1): "x"},
+		"long value":     {"weather": strings.Repeat("я", maxContextValueLen+1)},
+		"too many keys":  tooMany,
+		"too many bytes": tooBig,
+	} {
+		if _, err := normalizeContext(bad); err == nil {
+			t.Errorf("%s: context was accepted", name)
+		}
+	}
+	if _, err := normalizeContext(map[string]string{strings.Repeat("я", maxTagLen): strings.Repeat("x", maxContextValueLen)}); err != nil {
+		t.Errorf("a key and value at the limits were rejected: %v", err)
+	}
+}
+
+func TestMapAnalyzeRequestRollingDaysBounds(t *testing.T) {
+	for days, ok := range map[int32]bool{0: true, 1: true, maxRollingDays: true, maxRollingDays + 1: false, -1: false} {
+		req, err := mapAnalyzeRequest(&nexusai.AnalyzeRequest{RollingDays: days}, testUserID)
+		if (err == nil) != ok {
+			t.Errorf("rolling_days %d: err = %v, want ok=%v", days, err, ok)
+		}
+		if ok && req.RollingDays != int(days) {
+			t.Errorf("rolling_days %d mapped to %d", days, req.RollingDays)
+		}
+	}
+}
+
+func TestTrackRejectsFuturePoints(t *testing.T) {
+	cases := []struct {
+		name      string
+		tolerance time.Duration
+		want      time.Duration
+	}{
+		{"unset", 0, defaultFutureTolerance},
+		{"configured", 2 * time.Minute, 2 * time.Minute},
+		{"none", NoFutureTolerance, 0},
+	}
+	for _, c := range cases {
+		h := newTestHandler(&fakeRepo{}, nil, GRPCHandlerConfig{FutureTolerance: c.tolerance})
+		if h.futureSkew != c.want {
+			t.Errorf("%s: tolerance = %s, want %s", c.name, h.futureSkew, c.want)
+		}
+	}
+
+	now := time.Now()
+	req := func(ahead time.Duration) *nexusai.TrackRequest {
+		return &nexusai.TrackRequest{Points: []*nexusai.TrackPoint{{Ts: timestamppb.New(now.Add(ahead)), Mood: ptr(6.0)}}}
+	}
+	for _, c := range []struct {
+		ahead, skew time.Duration
+		ok          bool
+	}{
+		{-time.Minute, 0, true},
+		{time.Minute, 0, false},
+		{time.Minute, 2 * time.Minute, true},
+		{3 * time.Minute, 2 * time.Minute, false},
+	} {
+		_, err := mapTrackRequest(req(c.ahead), testUserID, noteLimit{maxRunes: defaultMaxNoteRunes}, time.UTC, now.Add(c.skew))
+		if (err == nil) != c.ok {
+			t.Errorf("point %s ahead with %s skew: err = %v, want ok=%v", c.ahead, c.skew, err, c.ok)
+		}
+	}
+}
+
+func TestFieldMaskKeepsOnlyRequestedBlocks(t *testing.T) {
+	req, err := mapAnalyzeRequest(&nexusai.AnalyzeRequest{Fields: []string{" Energy_By_Weekday"}}, testUserID)
+	if err != nil {
+		t.Fatalf("mapAnalyzeRequest: %v", err)
+	}
+	if !reflect.DeepEqual(req.Fields, []string{"energy_by_weekday"}) || !req.SkipInsight {
+		t.Fatalf("fields = %v, skip insight %v; want the normalized mask and no insight", req.Fields, req.SkipInsight)
+	}
+	if _, err := mapAnalyzeRequest(&nexusai.AnalyzeRequest{Fields: []string{"heatmap"}}, testUserID); err == nil {
+		t.Error("unknown field accepted")
+	}
+
+	out, err := mapAnalyzeResponse(&dto.AnalyzeResponse{
+		EnergyByWeekday: map[string]float64{"Mon": 61.5},
+		BurnoutRisk:     dto.BurnoutRisk{Score: 40, Level: "medium"},
+		OptimalSchedule: dto.OptimalSchedule{BestFocusHours: []string{"10:00"}},
+		LLMInsight:      "Энергия",
+		Averages:        map[string]float64{"mood": 7},
+	}, defaultResponsePrecision)
+	if err != nil {
+		t.Fatalf("mapAnalyzeResponse: %v", err)
+	}
+	applyFieldMask(out, req.Fields)
+	var set []string
+	out.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		set = append(set, string(fd.Name()))
+		return true
+	})
+	if !reflect.DeepEqual(set, []string{"energy_by_weekday"}) {
+		t.Errorf("fields left after the mask = %v, want only energy_by_weekday", set)
+	}
+}
@@ -5,12 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"expvar"
 	"fmt"
 	"net/http"
 	"nexus/internal/dto"
 	"nexus/internal/hepler"
-	"regexp"
 	"strings"
+	"time"
 )
 
 const (
@@ -18,6 +19,34 @@ const (
 	defaultAIModel = "deepseek-chat"
 )
 
+// Reasoning of deepseek-reasoner is decoded only to be counted here (served on METRICS_ADDR for operators);
+// it never becomes part of the insight.
+var (
+	reasoningResponses = expvar.NewInt("llm_reasoning_responses_total")
+	reasoningChars     = expvar.NewInt("llm_reasoning_chars_total")
+)
+
+// Insights whose format issues were all soft and fixed locally, versus those sent to the repair call.
+var (
+	softFixes   = expvar.NewInt("llm_insight_soft_fixes_total")
+	repairCalls = expvar.NewInt("llm_insight_repair_calls_total")
+)
+
+// allowedModels — модели провайдера, которые можно запросить для отдельного вызова.
+var allowedModels = map[string]struct{}{
+	"deepseek-chat":     {},
+	"deepseek-reasoner": {},
+}
+
+// IsAllowedModel reports whether model may be used as a per-call override.
+func IsAllowedModel(model string) bool {
+	_, ok := allowedModels[model]
+	return ok
+}
+
+// DefaultFastPeriods — частые и дешёвые разборы идут одним вызовом, редкие месячные/общие — с дозапросом и ремонтом.
+var DefaultFastPeriods = []dto.Period{dto.PeriodDay, dto.PeriodWeek}
+
 func NewAIClient(cfg AIConfig) *AIClient {
 	if cfg.URL == "" {
 		cfg.URL = defaultAIURL
@@ -37,39 +66,71 @@ func NewAIClient(cfg AIConfig) *AIClient {
 	if cfg.HTTPClient == nil {
 		cfg.HTTPClient = http.DefaultClient
 	}
+	if cfg.FastPeriods == nil {
+		cfg.FastPeriods = DefaultFastPeriods
+	}
+	if _, ok := DefaultBlocklists[cfg.Language]; !ok {
+		cfg.Language = defaultLanguage
+	}
+	if cfg.Blocklist == nil {
+		cfg.Blocklist = DefaultBlocklists[cfg.Language]
+	}
+	fastPeriods := make(map[dto.Period]bool, len(cfg.FastPeriods))
+	for _, p := range cfg.FastPeriods {
+		fastPeriods[p] = true
+	}
 
 	return &AIClient{
-		url:        cfg.URL,
-		token:      cfg.Token,
-		model:      cfg.Model,
-		system:     cfg.SystemPrompt,
-		fast:       cfg.Fast,
-		maxTokens:  cfg.MaxTokens,
-		httpClient: cfg.HTTPClient,
+		url:         cfg.URL,
+		token:       cfg.Token,
+		model:       cfg.Model,
+		system:      cfg.SystemPrompt,
+		fastPeriods: fastPeriods,
+		maxTokens:   cfg.MaxTokens,
+		httpClient:  cfg.HTTPClient,
+		blocklist:   normalizeBlocklist(cfg.Blocklist),
+		rules:       ruInsightRules.withActions(cfg.MinActions, cfg.MaxActions),
+		limiter:     newRateLimiter(cfg.RPM),
+
+		initialTimeout:  cfg.InitialTimeout,
+		continueTimeout: cfg.ContinueTimeout,
+		repairTimeout:   cfg.RepairTimeout,
 	}
 }
 
 func (c *AIClient) CallInsight(ctx context.Context, p dto.AIPrompt) (string, error) {
+	model := c.model
+	if p.Model != "" {
+		if !IsAllowedModel(p.Model) {
+			return "", fmt.Errorf("ai model %q is not allowed", p.Model)
+		}
+		model = p.Model
+	}
 	userPrompt := hepler.BuildRussianPrompt(p)
 
 	system := c.system
 	if p.Period == dto.PeriodMonth || p.Period == dto.PeriodAll {
 		system = hepler.SystemPromptRUPeriod
 	}
+	rules := c.rules.forPrompt(system)
+	system = rules.askActions(system)
+	if d := hepler.ToneDirective(p.Tone); d != "" {
+		system += "\n\n" + d
+	}
 
 	maxTokens := c.maxTokens
 	if maxTokens <= 0 {
 		maxTokens = 1200
 	}
 
-	text1, finish1, err := c.aiChatOnce(ctx, c.url, c.token, c.model, system, userPrompt, maxTokens)
+	text1, finish1, err := c.aiChatStage(ctx, c.initialTimeout, model, system, userPrompt, maxTokens)
 	if err != nil {
 		return "", err
 	}
-	text1 = toPlainText(text1)
-	text1 = sanitizeInsight(text1, p)
+	text1 = toPlainText(text1, rules)
+	text1 = c.sanitize(text1, p)
 
-	if c.fast {
+	if c.isFast(p.Period) {
 		if strings.TrimSpace(text1) == "" {
 			return "", errors.New("ai empty content after cleaning")
 		}
@@ -79,10 +140,10 @@ func (c *AIClient) CallInsight(ctx context.Context, p dto.AIPrompt) (string, err
 	if isTruncated(finish1, text1) {
 		contPrompt := fmt.Sprintf(hepler.ContinuePromptTmplRU, text1)
 
-		text2, _, err2 := c.aiChatOnce(ctx, c.url, c.token, c.model, system, contPrompt, 900)
+		text2, _, err2 := c.aiChatStage(ctx, c.continueTimeout, model, system, contPrompt, 900)
 		if err2 == nil {
-			text2 = toPlainText(text2)
-			text2 = sanitizeInsight(text2, p)
+			text2 = toPlainText(text2, rules)
+			text2 = c.sanitize(text2, p)
 			merged := strings.TrimSpace(text1 + "\n" + text2)
 			if merged != "" {
 				text1 = merged
@@ -90,7 +151,12 @@ func (c *AIClient) CallInsight(ctx context.Context, p dto.AIPrompt) (string, err
 		}
 	}
 
-	if !validateInsight(text1, p) {
+	issues := validateInsight(text1, p, rules)
+	if len(issues) > 0 && !hasHardIssue(issues) {
+		softFixes.Add(1)
+		text1 = fixSoftIssues(text1, rules)
+	} else if hasHardIssue(issues) {
+		repairCalls.Add(1)
 		var rep string
 		if p.Period == dto.PeriodMonth || p.Period == dto.PeriodAll {
 			rep = fmt.Sprintf(
@@ -111,12 +177,13 @@ func (c *AIClient) CallInsight(ctx context.Context, p dto.AIPrompt) (string, err
 			)
 		}
 
-		fixed, _, err3 := c.aiChatOnce(ctx, c.url, c.token, c.model, system, rep, 1200)
+		rep = rules.askActions(rep)
+		fixed, _, err3 := c.aiChatStage(ctx, c.repairTimeout, model, system, rep, 1200)
 		if err3 == nil {
-			fixed = toPlainText(fixed)
-			fixed = sanitizeInsight(fixed, p)
-			if validateInsight(fixed, p) {
-				return fixed, nil
+			fixed = toPlainText(fixed, rules)
+			fixed = c.sanitize(fixed, p)
+			if !hasHardIssue(validateInsight(fixed, p, rules)) {
+				return fixSoftIssues(fixed, rules), nil
 			}
 		}
 	}
@@ -127,6 +194,35 @@ func (c *AIClient) CallInsight(ctx context.Context, p dto.AIPrompt) (string, err
 	return text1, nil
 }
 
+// isFast reports whether period skips the continuation and repair calls. An unspecified period is the daily review.
+func (c *AIClient) isFast(period dto.Period) bool {
+	if period == dto.PeriodUnspecified {
+		period = dto.PeriodDay
+	}
+	return c.fastPeriods[period]
+}
+
+// aiChatStage makes one call of a CallInsight stage under its own deadline; timeout 0 leaves only ctx
+// and the HTTP client's timeout in force.
+func (c *AIClient) aiChatStage(ctx context.Context, timeout time.Duration, model, system, user string, maxTokens int) (string, string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	parent := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	text, fr, err := c.chat(ctx, model, system, user, maxTokens)
+	// A caller that went away or ran out of its own time says nothing about the provider; the stage's
+	// timeout does.
+	if err == nil || !callerGaveUp(parent, err) {
+		c.stats.record(err)
+	}
+	return text, fr, err
+}
+
 func (c *AIClient) aiChatOnce(ctx context.Context, url, token, model, system, user string, maxTokens int) (text string, finishReason string, err error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -157,7 +253,7 @@ func (c *AIClient) aiChatOnce(ctx context.Context, url, token, model, system, us
 	if resp.StatusCode >= 400 {
 		var b bytes.Buffer
 		_, _ = b.ReadFrom(resp.Body)
-		return "", "", fmt.Errorf("ai status %d: %s", resp.StatusCode, b.String())
+		return "", "", &StatusError{StatusCode: resp.StatusCode, Body: b.String()}
 	}
 
 	var out dto.AIChatResponse
@@ -168,319 +264,16 @@ func (c *AIClient) aiChatOnce(ctx context.Context, url, token, model, system, us
 		return "", "", errors.New("ai empty response (no choices)")
 	}
 
-	t := strings.TrimSpace(out.Choices[0].Message.Content)
+	msg := out.Choices[0].Message
+	t := strings.TrimSpace(msg.Content)
 	fr := strings.TrimSpace(out.Choices[0].FinishReason)
-	return t, fr, nil
-}
-
-func isTruncated(finishReason, text string) bool {
-	if strings.EqualFold(finishReason, "length") {
-		return true
-	}
-
-	if text == "" {
-		return false
-	}
-	last := strings.TrimSpace(text)
-	if strings.HasSuffix(last, ":") || strings.HasSuffix(last, "-") || strings.HasSuffix(last, "–") {
-		return true
-	}
-
-	if len(last) >= 2 && last[len(last)-1] == ':' {
-		return true
-	}
-	return false
-}
-
-func cleanLLMText(s string) string {
-	s = strings.TrimSpace(s)
-	if s == "" {
-		return s
-	}
-
-	low := strings.ToLower(s)
-
-	if idxEnd := strings.LastIndex(low, "</think>"); idxEnd != -1 {
-		after := strings.TrimSpace(s[idxEnd+len("</think>"):])
-		if after != "" {
-			return after
-		}
-	}
-
-	s = strings.ReplaceAll(s, "<think>", "")
-	s = strings.ReplaceAll(s, "</think>", "")
-	s = strings.ReplaceAll(s, "<THINK>", "")
-	s = strings.ReplaceAll(s, "</THINK>", "")
-
-	s = strings.TrimSpace(s)
-	s = strings.TrimPrefix(s, "```")
-	s = strings.TrimSuffix(s, "```")
-	s = strings.TrimSpace(s)
-
-	s = strings.TrimLeft(s, "\n\r\t ")
-
-	s = strings.TrimSpace(s)
-
-	// If the model returned reasoning or preamble, keep only the final 4-block answer.
-	if idx := strings.Index(s, "\nЭнергия"); idx >= 0 {
-		s = strings.TrimSpace(s[idx+1:])
-	} else if strings.HasPrefix(s, "Энергия") == false {
-		if idx := strings.Index(s, "Энергия"); idx >= 0 {
-			s = strings.TrimSpace(s[idx:])
-		}
-	}
-
-	return strings.TrimSpace(s)
-}
-
-var (
-	reBold       = regexp.MustCompile(`\*\*(.*?)\*\*`)
-	reInlineCode = regexp.MustCompile("`([^`]*)`")
-	reHeading    = regexp.MustCompile(`(?m)^\s{0,3}#{1,6}\s+`)
-	reListNum    = regexp.MustCompile(`(?m)^\s*\d+\.\s+`)
-	reListDash   = regexp.MustCompile(`(?m)^\s*[-•]\s+`)
-	reMultiSpace = regexp.MustCompile(`[ \t]{2,}`)
-)
-
-func toPlainText(s string) string {
-	s = cleanLLMText(s)
-
-	s = reHeading.ReplaceAllString(s, "")
-	s = reBold.ReplaceAllString(s, "$1")
-	s = reInlineCode.ReplaceAllString(s, "$1")
-	s = strings.ReplaceAll(s, "**", "")
-	s = strings.ReplaceAll(s, "__", "")
-	s = strings.ReplaceAll(s, "*", "")
-	s = strings.ReplaceAll(s, "_", "")
-
-	s = reListNum.ReplaceAllString(s, "")
-	s = reListDash.ReplaceAllString(s, "")
-
-	s = strings.ReplaceAll(s, "\r\n", "\n")
-	s = reMultiSpace.ReplaceAllString(s, " ")
-	s = strings.TrimSpace(s)
-
-	lines := strings.Split(s, "\n")
-	out := make([]string, 0, len(lines))
-	empty := 0
-	for _, ln := range lines {
-		ln = strings.TrimSpace(ln)
-		if ln == "" {
-			empty++
-			if empty > 1 {
-				continue
-			}
-			out = append(out, "")
-			continue
-		}
-		empty = 0
-		out = append(out, ln)
-	}
-	return strings.TrimSpace(strings.Join(out, "\n"))
-}
-
-func sanitizeInsight(text string, p dto.AIPrompt) string {
-	t := strings.TrimSpace(text)
-
-	bad := []string{
-		"глюкоз", "гормон", "биоритм", "биолог", "физиолог", "в крови",
-		"<think>", "</think>", "analysis", "thoughts",
-	}
-
-	lines := strings.Split(t, "\n")
-	out := make([]string, 0, len(lines))
-	for _, ln := range lines {
-		ll := strings.ToLower(ln)
-		skip := false
-		for _, b := range bad {
-			if strings.Contains(ll, b) {
-				skip = true
-				break
-			}
-		}
-		if !skip {
-			out = append(out, strings.TrimSpace(ln))
-		}
-	}
-	t = strings.TrimSpace(strings.Join(out, "\n"))
-
-	obsDays := p.NumObservedDays
-	if obsDays == 0 {
-		obsDays = p.NumObservedWeekdays
-	}
-
-	if p.NumPoints >= 5 && obsDays >= 5 {
-		t = removeLinesContaining(t, []string{"данных мало", "вывод предварител"})
-	}
-
-	t = strings.ReplaceAll(t, "\r\n", "\n")
-	rows := strings.Split(t, "\n")
-	final := make([]string, 0, len(rows))
-	empty := 0
-	for _, r := range rows {
-		r = strings.TrimSpace(r)
-		if r == "" {
-			empty++
-			if empty > 1 {
-				continue
-			}
-			final = append(final, "")
-			continue
-		}
-		empty = 0
-		final = append(final, r)
-	}
-	return strings.TrimSpace(strings.Join(final, "\n"))
-}
-
-func removeLinesContaining(s string, needles []string) string {
-	lines := strings.Split(s, "\n")
-	out := make([]string, 0, len(lines))
-	for _, ln := range lines {
-		ll := strings.ToLower(ln)
-		skip := false
-		for _, n := range needles {
-			if strings.Contains(ll, n) {
-				skip = true
-				break
-			}
-		}
-		if !skip {
-			out = append(out, ln)
+	if reasoning := strings.TrimSpace(msg.ReasoningContent); reasoning != "" {
+		reasoningResponses.Add(1)
+		reasoningChars.Add(int64(len([]rune(reasoning))))
+		if t == "" {
+			// The reasoner spent the whole budget thinking; its reasoning is not an answer.
+			return "", fr, errors.New("ai empty content (reasoning only)")
 		}
 	}
-	return strings.TrimSpace(strings.Join(out, "\n"))
-}
-
-func validateInsight(text string, p dto.AIPrompt) bool {
-	t := strings.TrimSpace(text)
-	if t == "" {
-		return false
-	}
-
-	required := []string{"Энергия", "Выгорание", "Что делать завтра"}
-	for _, h := range required {
-		if !strings.Contains(t, "\n"+h+"\n") && !strings.HasPrefix(t, h+"\n") {
-			return false
-		}
-	}
-
-	needUnknown := "Риск выгорания пока неизвестен из-за недостатка данных."
-	if p.BurnoutLevel == "unknown" || p.BurnoutLevel == "недостаточно данных" {
-		if !strings.Contains(t, needUnknown) {
-			return false
-		}
-	} else {
-		if strings.Contains(t, needUnknown) {
-			return false
-		}
-	}
-
-	obsDays := p.NumObservedDays
-	if obsDays == 0 {
-		obsDays = p.NumObservedWeekdays
-	}
-
-	if p.NumPoints >= 5 && obsDays >= 5 {
-		low := strings.ToLower(t)
-		if strings.Contains(low, "данных мало") || strings.Contains(low, "вывод предварител") {
-			return false
-		}
-	}
-
-	banned := []string{"<think>", "</think>", "analysis", "thoughts"}
-	low := strings.ToLower(t)
-	for _, b := range banned {
-		if strings.Contains(low, b) {
-			return false
-		}
-	}
-
-	block := extractBlock(t, "Что делать завтра", "")
-	if strings.TrimSpace(block) == "" {
-		return false
-	}
-
-	actions := splitActions(block)
-	if len(actions) != 3 {
-		return false
-	}
-
-	if strings.TrimSpace(p.UserNotes) != "" {
-		if !strings.Contains(t, "Заметки:") {
-			return false
-		}
-	}
-
-	return true
-}
-
-func extractBlock(full, startTitle, endTitle string) string {
-	start := strings.Index(full, "\n"+startTitle+"\n")
-	if start == -1 {
-		if strings.HasPrefix(full, startTitle+"\n") {
-			start = 0
-		} else {
-			return ""
-		}
-	} else {
-		start += len("\n" + startTitle + "\n")
-	}
-
-	if strings.TrimSpace(endTitle) == "" {
-		return strings.TrimSpace(full[start:])
-	}
-
-	end := strings.Index(full[start:], "\n"+endTitle+"\n")
-	if end == -1 {
-		return strings.TrimSpace(full[start:])
-	}
-	return strings.TrimSpace(full[start : start+end])
-}
-
-func splitActions(block string) []string {
-	lines := strings.Split(block, "\n")
-	out := make([]string, 0, 3)
-	for _, ln := range lines {
-		ln = strings.TrimSpace(ln)
-		if ln == "" {
-			continue
-		}
-		out = append(out, ln)
-	}
-	if len(out) == 1 {
-		parts := splitBySentence(out[0])
-		out = out[:0]
-		for _, p := range parts {
-			p = strings.TrimSpace(p)
-			if p != "" {
-				out = append(out, p)
-			}
-		}
-	}
-	return out
-}
-
-func splitBySentence(s string) []string {
-	seps := []rune{'.', '!', '?'}
-	var res []string
-	cur := strings.Builder{}
-	for _, r := range s {
-		cur.WriteRune(r)
-		for _, sep := range seps {
-			if r == sep {
-				part := strings.TrimSpace(cur.String())
-				if part != "" {
-					res = append(res, part)
-				}
-				cur.Reset()
-				break
-			}
-		}
-	}
-	last := strings.TrimSpace(cur.String())
-	if last != "" {
-		res = append(res, last)
-	}
-	return res
+	return t, fr, nil
 }
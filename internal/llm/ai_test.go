@@ -0,0 +1,355 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+	"nexus/internal/hepler"
+)
+
+// chatServer answers every chat completion with content, or with the status codes in statuses first.
+func chatServer(t *testing.T, content string, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		if n <= len(statuses) {
+			http.Error(w, "slow down", statuses[n-1])
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{"message": map[string]any{"content": content}, "finish_reason": "stop"}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestChatPacesEveryRequest(t *testing.T) {
+	srv, calls := chatServer(t, "ok")
+	c := NewAIClient(AIConfig{URL: srv.URL, RPM: 600}) // one request per 100ms
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, _, err := c.chat(context.Background(), "deepseek-chat", "sys", "user", 10); err != nil {
+			t.Fatalf("chat %d: %v", i, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("3 requests at 600 rpm took %s, want at least 200ms", elapsed)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("provider calls = %d, want 3", calls.Load())
+	}
+}
+
+func TestChatReturns429WithoutLimiter(t *testing.T) {
+	srv, calls := chatServer(t, "ok", http.StatusTooManyRequests)
+	c := NewAIClient(AIConfig{URL: srv.URL})
+
+	_, _, err := c.chat(context.Background(), "deepseek-chat", "sys", "user", 10)
+	var se *StatusError
+	if !errors.As(err, &se) || se.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("err = %v, want a 429 StatusError", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("provider calls = %d, want 1 (no retry without RPM)", calls.Load())
+	}
+}
+
+func TestChatRetries429WithLimiter(t *testing.T) {
+	if testing.Short() {
+		t.Skip("waits out the 429 backoff")
+	}
+	srv, calls := chatServer(t, "ok", http.StatusTooManyRequests)
+	c := NewAIClient(AIConfig{URL: srv.URL, RPM: 6000})
+
+	text, _, err := c.chat(context.Background(), "deepseek-chat", "sys", "user", 10)
+	if err != nil || text != "ok" {
+		t.Fatalf("chat = %q, %v; want the retried answer", text, err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("provider calls = %d, want 2", calls.Load())
+	}
+}
+
+// modelServer answers every chat completion with content and records the model each request asked for.
+func modelServer(t *testing.T, content string) (*httptest.Server, *[]string) {
+	t.Helper()
+	var models []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Model string `json:"model"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		models = append(models, body.Model)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{"message": map[string]any{"content": content}, "finish_reason": "stop"}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &models
+}
+
+func TestCallInsightModelOverride(t *testing.T) {
+	srv, models := modelServer(t, "Короткий разбор недели.")
+	c := NewAIClient(AIConfig{URL: srv.URL, Model: "deepseek-chat"})
+	p := dto.AIPrompt{Period: dto.PeriodWeek, NumPoints: 7}
+
+	if _, err := c.CallInsight(context.Background(), p); err != nil {
+		t.Fatalf("CallInsight: %v", err)
+	}
+	p.Model = "deepseek-reasoner"
+	if _, err := c.CallInsight(context.Background(), p); err != nil {
+		t.Fatalf("CallInsight with override: %v", err)
+	}
+	if got := strings.Join(*models, ","); got != "deepseek-chat,deepseek-reasoner" {
+		t.Errorf("models sent = %s, want the default then the override", got)
+	}
+	if c.model != "deepseek-chat" {
+		t.Errorf("the override changed the client's model to %q", c.model)
+	}
+
+	p.Model = "gpt-4o"
+	if _, err := c.CallInsight(context.Background(), p); err == nil {
+		t.Error("a model outside the allow-list was accepted")
+	}
+	if len(*models) != 2 {
+		t.Errorf("a disallowed model reached the provider: %v", *models)
+	}
+}
+
+func TestFastModeDependsOnPeriod(t *testing.T) {
+	// Every answer is cut off and misses the required blocks, so a thorough call continues and repairs it.
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{"message": map[string]any{"content": "Итоги периода:"}, "finish_reason": "length"}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := []struct {
+		name   string
+		fast   []dto.Period
+		period dto.Period
+		want   int32
+	}{
+		{"day is fast by default", nil, dto.PeriodDay, 1},
+		{"week is fast by default", nil, dto.PeriodWeek, 1},
+		{"month is thorough by default", nil, dto.PeriodMonth, 3},
+		{"all is thorough by default", nil, dto.PeriodAll, 3},
+		{"no fast periods", []dto.Period{}, dto.PeriodDay, 3},
+		{"month made fast", []dto.Period{dto.PeriodMonth}, dto.PeriodMonth, 1},
+	}
+	for _, c := range cases {
+		calls.Store(0)
+		cl := NewAIClient(AIConfig{URL: srv.URL, FastPeriods: c.fast})
+		_, _ = cl.CallInsight(context.Background(), dto.AIPrompt{Period: c.period, NumPoints: 20})
+		if got := calls.Load(); got != c.want {
+			t.Errorf("%s: %d provider calls, want %d", c.name, got, c.want)
+		}
+	}
+}
+
+func TestCallInsightToneDirective(t *testing.T) {
+	var systems []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Role    string `json:"role"`
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, m := range body.Messages {
+			if m.Role == "system" {
+				systems = append(systems, m.Content)
+			}
+		}
+		// No blocks at all: the format check must still send it for repair whatever the tone.
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{"message": map[string]any{"content": "Всё отлично."}, "finish_reason": "stop"}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	c := NewAIClient(AIConfig{URL: srv.URL})
+
+	for _, tone := range []dto.Tone{dto.ToneDefault, dto.ToneGentle, dto.ToneDirect, dto.ToneDataOnly} {
+		systems = nil
+		_, _ = c.CallInsight(context.Background(), dto.AIPrompt{Period: dto.PeriodMonth, NumPoints: 20, Tone: tone})
+		if len(systems) != 2 {
+			t.Errorf("tone %q: %d provider calls, want the answer and its repair", tone, len(systems))
+			continue
+		}
+		for _, s := range systems {
+			if !strings.HasPrefix(s, hepler.SystemPromptRUPeriod) {
+				t.Errorf("tone %q: the format rules are not the start of the system prompt", tone)
+			}
+			d := hepler.ToneDirective(tone)
+			if tone == dto.ToneDefault && s != hepler.SystemPromptRUPeriod {
+				t.Errorf("default tone added a directive: %q", strings.TrimPrefix(s, hepler.SystemPromptRUPeriod))
+			}
+			if tone != dto.ToneDefault && (d == "" || !strings.HasSuffix(s, d)) {
+				t.Errorf("tone %q: directive missing from the system prompt", tone)
+			}
+		}
+	}
+}
+
+// reasonerServer answers like deepseek-reasoner: the reasoning in its own field next to content.
+func reasonerServer(t *testing.T, content, reasoning string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{
+				"message":       map[string]any{"role": "assistant", "content": content, "reasoning_content": reasoning},
+				"finish_reason": "stop",
+			}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestReasoningContentNeverReachesTheInsight(t *testing.T) {
+	reasoning := "<think>Энергия\nВыгорание\nЧто делать завтра — сначала посчитаю **средние**.</think> analysis: done"
+	srv, calls := reasonerServer(t, validInsight, reasoning)
+	c := NewAIClient(AIConfig{URL: srv.URL})
+	before := reasoningResponses.Value()
+
+	got, err := c.CallInsight(context.Background(), dto.AIPrompt{
+		Period: dto.PeriodWeek, NumPoints: 10, NumObservedDays: 7, BurnoutLevel: "low", Model: "deepseek-reasoner",
+	})
+	if err != nil {
+		t.Fatalf("CallInsight: %v", err)
+	}
+	if got != validInsight {
+		t.Errorf("insight = %q, want only the content", got)
+	}
+	for _, leak := range []string{"<think>", "средние", "analysis"} {
+		if strings.Contains(got, leak) {
+			t.Errorf("insight leaks the reasoning (%q): %q", leak, got)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("provider calls = %d, want 1: the reasoning must not trigger a repair", calls.Load())
+	}
+	if reasoningResponses.Value() != before+1 {
+		t.Errorf("reasoning responses counter = %d, want %d", reasoningResponses.Value(), before+1)
+	}
+
+	// Reasoning without an answer is an error, not an insight.
+	srv, _ = reasonerServer(t, "  ", reasoning)
+	if _, _, err := NewAIClient(AIConfig{URL: srv.URL}).chat(context.Background(), "deepseek-reasoner", "sys", "user", 10); err == nil {
+		t.Error("a reasoning-only answer was accepted")
+	}
+}
+
+func TestStageDeadlinesKeepTheFirstText(t *testing.T) {
+	// The first answer is cut off and misses the actions block, so CallInsight continues and then repairs;
+	// both later stages hang until their deadline cancels the request.
+	first := "Энергия\nРовная днём.\n\nВыгорание\nРиск низкий."
+	var calls atomic.Int32
+	waited := make(chan time.Duration, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The server notices a cancelled request only once the body has been read.
+		_, _ = io.Copy(io.Discard, r.Body)
+		n := int(calls.Add(1))
+		if n == 1 {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"choices": []map[string]any{{"message": map[string]any{"content": first}, "finish_reason": "length"}},
+			})
+			return
+		}
+		start := time.Now()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		waited <- time.Since(start)
+	}))
+	t.Cleanup(srv.Close)
+
+	const continueTimeout, repairTimeout = 50 * time.Millisecond, 200 * time.Millisecond
+	c := NewAIClient(AIConfig{
+		URL:             srv.URL,
+		InitialTimeout:  5 * time.Second,
+		ContinueTimeout: continueTimeout,
+		RepairTimeout:   repairTimeout,
+	})
+	start := time.Now()
+	got, err := c.CallInsight(context.Background(), dto.AIPrompt{Period: dto.PeriodMonth, NumPoints: 10, NumObservedDays: 7, BurnoutLevel: "low"})
+	if err != nil {
+		t.Fatalf("CallInsight: %v", err)
+	}
+	if got != first {
+		t.Errorf("insight = %q, want the first stage's text", got)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("provider calls = %d, want initial, continue and repair", calls.Load())
+	}
+	// Each hung stage is cut at its own deadline, not at the next one's or the HTTP client's; the server
+	// starts its clock after the request arrived, so it sees a little less.
+	for _, want := range []time.Duration{continueTimeout, repairTimeout} {
+		if w := <-waited; w < want*2/3 || w > want+300*time.Millisecond {
+			t.Errorf("stage waited %s, want about %s", w, want)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("CallInsight took %s, the stage deadlines were not enforced", elapsed)
+	}
+}
+
+func TestSoftIssuesAreFixedWithoutRepair(t *testing.T) {
+	p := dto.AIPrompt{Period: dto.PeriodMonth, NumPoints: 20, NumObservedDays: 10, BurnoutLevel: "low"}
+	cases := []struct {
+		name    string
+		content string
+		calls   int32
+		want    string
+	}{
+		{
+			name: "extra blank lines and a fifth action",
+			content: "Энергия\nРовная днём.\n\n\n\nВыгорание\nРиск низкий.\n\nЧто делать завтра\n" +
+				"Лечь до полуночи.\nВыйти на прогулку.\nВыпить воды.\nСделать зарядку.\nПочитать.",
+			calls: 1,
+			want: "Энергия\nРовная днём.\n\nВыгорание\nРиск низкий.\n\nЧто делать завтра\n" +
+				"Лечь до полуночи.\nВыйти на прогулку.\nВыпить воды.\nСделать зарядку.",
+		},
+		{
+			name:    "missing block",
+			content: "Энергия\nРовная днём.\n\nЧто делать завтра\nЛечь до полуночи.\nВыйти на прогулку.",
+			calls:   2,
+			// The repair answer is just as broken, so the first text is kept.
+			want: "Энергия\nРовная днём.\n\nЧто делать завтра\nЛечь до полуночи.\nВыйти на прогулку.",
+		},
+	}
+	for _, c := range cases {
+		srv, calls := chatServer(t, c.content)
+		got, err := NewAIClient(AIConfig{URL: srv.URL}).CallInsight(context.Background(), p)
+		if err != nil {
+			t.Fatalf("%s: CallInsight: %v", c.name, err)
+		}
+		if calls.Load() != c.calls {
+			t.Errorf("%s: %d provider calls, want %d", c.name, calls.Load(), c.calls)
+		}
+		if got != c.want {
+			t.Errorf("%s: insight = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
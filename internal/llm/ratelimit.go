@@ -0,0 +1,92 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var (
+	throttleWaitSeconds = expvar.NewFloat("llm_throttle_wait_seconds_total")
+	throttledCalls      = expvar.NewInt("llm_throttled_calls_total")
+)
+
+const (
+	rateLimitMaxRetries  = 3
+	rateLimitBaseBackoff = 2 * time.Second
+)
+
+// rateLimiter paces provider requests under the requests-per-minute quota (leaky bucket). It is shared
+// by every request of one AIClient, so live traffic and the nightly batch draw on the same budget.
+// A nil limiter does not pace.
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	slot time.Time
+}
+
+// newRateLimiter returns a limiter for rpm requests per minute, or nil when rpm <= 0.
+func newRateLimiter(rpm int) *rateLimiter {
+	if rpm <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Minute / time.Duration(rpm)}
+}
+
+// wait reserves the next free slot and blocks until it comes up.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	if l.slot.Before(now) {
+		l.slot = now
+	}
+	d := l.slot.Sub(now)
+	l.slot = l.slot.Add(l.interval)
+	l.mu.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+	throttledCalls.Add(1)
+	throttleWaitSeconds.Add(d.Seconds())
+	return sleepCtx(ctx, d)
+}
+
+// chat makes one provider request of a CallInsight stage. Each HTTP request (the answer, the
+// continuation and the repair alike) waits for its own rate-limit slot, and with a limiter configured
+// a 429 is retried with exponential backoff, every retry taking a new slot.
+func (c *AIClient) chat(ctx context.Context, model, system, user string, maxTokens int) (string, string, error) {
+	backoff := rateLimitBaseBackoff
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter.wait(ctx); err != nil {
+			return "", "", err
+		}
+		text, fr, err := c.aiChatOnce(ctx, c.url, c.token, model, system, user, maxTokens)
+		var se *StatusError
+		if err == nil || c.limiter == nil || !errors.As(err, &se) || se.StatusCode != http.StatusTooManyRequests || attempt >= rateLimitMaxRetries {
+			return text, fr, err
+		}
+		if err := sleepCtx(ctx, backoff); err != nil {
+			return "", "", err
+		}
+		backoff *= 2
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+package llm
+
+import (
+	"strings"
+
+	"nexus/internal/dto"
+)
+
+const defaultLanguage = "ru"
+
+// DefaultBlocklists — подстроки медицинских тем, которые модель не должна обсуждать, по языку ответа.
+// Строка ответа с любой из них отбрасывается целиком.
+var DefaultBlocklists = map[string][]string{
+	"ru": {"глюкоз", "гормон", "биоритм", "биолог", "физиолог", "в крови"},
+	"en": {"glucose", "hormone", "biorhythm", "biolog", "physiolog", "in the blood"},
+}
+
+// reasoningMarkers выдают служебные рассуждения модели; они вырезаются при любом языке.
+var reasoningMarkers = []string{"<think>", "</think>", "analysis", "thoughts"}
+
+// sanitize вырезает из ответа строки с запрещёнными темами клиента и служебными рассуждениями,
+// снимает оговорку о нехватке данных, если данных достаточно, и схлопывает пустые строки.
+func (c *AIClient) sanitize(text string, p dto.AIPrompt) string {
+	return sanitizeInsight(text, p, c.blocklist, c.rules.lowDataCaveats)
+}
+
+func sanitizeInsight(text string, p dto.AIPrompt, blocklist, lowDataCaveats []string) string {
+	bad := make([]string, 0, len(blocklist)+len(reasoningMarkers))
+	bad = append(bad, blocklist...)
+	bad = append(bad, reasoningMarkers...)
+	t := removeLinesContaining(strings.TrimSpace(text), bad)
+
+	obsDays := p.NumObservedDays
+	if obsDays == 0 {
+		obsDays = p.NumObservedWeekdays
+	}
+
+	if p.NumPoints >= 5 && obsDays >= 5 {
+		t = removeLinesContaining(t, lowDataCaveats)
+	}
+
+	return collapseBlankLines(strings.ReplaceAll(t, "\r\n", "\n"))
+}
+
+// normalizeBlocklist приводит подстроки к нижнему регистру и отбрасывает пустые.
+func normalizeBlocklist(in []string) []string {
+	out := make([]string, 0, len(in))
+	for _, b := range in {
+		if b = strings.ToLower(strings.TrimSpace(b)); b != "" {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func removeLinesContaining(s string, needles []string) string {
+	lines := strings.Split(s, "\n")
+	out := make([]string, 0, len(lines))
+	for _, ln := range lines {
+		ll := strings.ToLower(ln)
+		skip := false
+		for _, n := range needles {
+			if strings.Contains(ll, n) {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			out = append(out, ln)
+		}
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
+
+// collapseBlankLines обрезает пробелы по краям строк и оставляет не больше одной пустой строки подряд.
+func collapseBlankLines(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	out := make([]string, 0, len(lines))
+	empty := 0
+	for _, ln := range lines {
+		ln = strings.TrimSpace(ln)
+		if ln == "" {
+			empty++
+			if empty > 1 {
+				continue
+			}
+			out = append(out, "")
+			continue
+		}
+		empty = 0
+		out = append(out, ln)
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
@@ -0,0 +1,38 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+
+	"nexus/internal/dto"
+)
+
+func TestSanitizeBlocklistPerLanguage(t *testing.T) {
+	text := "Сон ровный.\nУровень глюкозы мог упасть.\nGlucose may have dropped.\nSleep was steady."
+	cases := []struct {
+		language string
+		dropped  string
+		kept     string
+	}{
+		{"ru", "глюкоз", "Glucose"},
+		{"en", "Glucose", "глюкоз"},
+		{"", "глюкоз", "Glucose"},
+		// An unknown language must not leave the answer unfiltered.
+		{"de", "глюкоз", "Glucose"},
+	}
+	for _, c := range cases {
+		cl := NewAIClient(AIConfig{Language: c.language})
+		got := cl.sanitize(text, dto.AIPrompt{})
+		if strings.Contains(got, c.dropped) || !strings.Contains(got, c.kept) {
+			t.Errorf("language %q: sanitized = %q, want %q dropped and %q kept", c.language, got, c.dropped, c.kept)
+		}
+		if len(cl.blocklist) == 0 {
+			t.Errorf("language %q: empty blocklist", c.language)
+		}
+	}
+
+	custom := NewAIClient(AIConfig{Language: "en", Blocklist: []string{"  Sleep "}})
+	if got := custom.sanitize(text, dto.AIPrompt{}); strings.Contains(got, "Sleep was") || !strings.Contains(got, "Glucose") {
+		t.Errorf("custom blocklist: sanitized = %q", got)
+	}
+}
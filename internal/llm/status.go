@@ -0,0 +1,96 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+const (
+	// statusWindow — за какое время считаются «недавние» вызовы провайдера.
+	statusWindow = 15 * time.Minute
+	// statusDegradedErrorRate — доля ошибок за окно, с которой провайдер считается деградировавшим.
+	statusDegradedErrorRate = 0.5
+	// statusMaxErrorLen — сколько символов последней ошибки отдаётся (тело ответа провайдера бывает длинным).
+	statusMaxErrorLen = 300
+)
+
+// providerStats counts one provider's HTTP calls in per-minute buckets over statusWindow and keeps
+// the last error.
+type providerStats struct {
+	mu          sync.Mutex
+	buckets     map[int64][2]int64 // unix minute -> {successes, errors}
+	lastError   string
+	lastErrorAt time.Time
+}
+
+func (s *providerStats) record(err error) {
+	now := time.Now()
+	minute := now.Unix() / 60
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.buckets == nil {
+		s.buckets = map[int64][2]int64{}
+	}
+	b := s.buckets[minute]
+	if err == nil {
+		b[0]++
+	} else {
+		b[1]++
+		msg := []rune(err.Error())
+		if len(msg) > statusMaxErrorLen {
+			msg = msg[:statusMaxErrorLen]
+		}
+		s.lastError = string(msg)
+		s.lastErrorAt = now
+	}
+	s.buckets[minute] = b
+	oldest := minute - int64(statusWindow/time.Minute)
+	for m := range s.buckets {
+		if m <= oldest {
+			delete(s.buckets, m)
+		}
+	}
+}
+
+// callerGaveUp reports whether err is the cancellation or deadline of the caller's ctx rather than a
+// failure of the provider.
+func callerGaveUp(ctx context.Context, err error) bool {
+	return ctx.Err() != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded))
+}
+
+func (s *providerStats) snapshot(name, model string) dto.ProviderStatus {
+	oldest := time.Now().Unix()/60 - int64(statusWindow/time.Minute)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := dto.ProviderStatus{
+		Name:        name,
+		Model:       model,
+		Window:      statusWindow,
+		LastError:   s.lastError,
+		LastErrorAt: s.lastErrorAt,
+	}
+	for m, b := range s.buckets {
+		if m > oldest {
+			out.Successes += b[0]
+			out.Errors += b[1]
+		}
+	}
+	if total := out.Successes + out.Errors; total > 0 {
+		out.Degraded = float64(out.Errors)/float64(total) >= statusDegradedErrorRate
+	}
+	return out
+}
+
+// ProviderStatus reports the single provider this client calls, named by the host of its URL.
+func (c *AIClient) ProviderStatus() []dto.ProviderStatus {
+	name := c.url
+	if u, err := url.Parse(c.url); err == nil && u.Host != "" {
+		name = u.Host
+	}
+	return []dto.ProviderStatus{c.stats.snapshot(name, c.model)}
+}
@@ -0,0 +1,85 @@
+package llm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProviderStatusReportsFailures(t *testing.T) {
+	srv, _ := chatServer(t, "ok", http.StatusInternalServerError, http.StatusInternalServerError, http.StatusBadGateway)
+	c := NewAIClient(AIConfig{URL: srv.URL, Model: "deepseek-chat"})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := c.aiChatStage(ctx, 0, "deepseek-chat", "sys", "user", 10); err == nil {
+			t.Fatalf("call %d succeeded against a failing provider", i)
+		}
+	}
+	st := c.ProviderStatus()
+	if len(st) != 1 {
+		t.Fatalf("providers = %d, want 1", len(st))
+	}
+	u, _ := url.Parse(srv.URL)
+	p := st[0]
+	if p.Name != u.Host || p.Model != "deepseek-chat" || p.Window != statusWindow {
+		t.Errorf("provider = %q %q %s, want %q deepseek-chat %s", p.Name, p.Model, p.Window, u.Host, statusWindow)
+	}
+	if p.Errors != 3 || p.Successes != 0 || !p.Degraded {
+		t.Errorf("after 3 failures: errors=%d successes=%d degraded=%v", p.Errors, p.Successes, p.Degraded)
+	}
+	if !strings.Contains(p.LastError, "502") || p.LastErrorAt.IsZero() {
+		t.Errorf("last error = %q at %v, want the 502", p.LastError, p.LastErrorAt)
+	}
+
+	// The provider recovers: enough successes bring the error rate under statusDegradedErrorRate.
+	for i := 0; i < 4; i++ {
+		if _, _, err := c.aiChatStage(ctx, 0, "deepseek-chat", "sys", "user", 10); err != nil {
+			t.Fatalf("call after recovery: %v", err)
+		}
+	}
+	if p := c.ProviderStatus()[0]; p.Successes != 4 || p.Errors != 3 || p.Degraded {
+		t.Errorf("after recovery: errors=%d successes=%d degraded=%v", p.Errors, p.Successes, p.Degraded)
+	}
+}
+
+func TestProviderStatusIgnoresTheCallerGivingUp(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+	c := NewAIClient(AIConfig{URL: srv.URL})
+
+	// The client disconnects.
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	if _, _, err := c.aiChatStage(ctx, time.Second, "deepseek-chat", "sys", "user", 10); err == nil {
+		t.Fatal("cancelled call succeeded")
+	}
+	// The caller's own deadline runs out before the stage's.
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, _, err := c.aiChatStage(ctx, time.Second, "deepseek-chat", "sys", "user", 10); err == nil {
+		t.Fatal("call past the caller's deadline succeeded")
+	}
+	if p := c.ProviderStatus()[0]; p.Errors != 0 || p.LastError != "" {
+		t.Fatalf("caller cancellations counted: errors=%d last=%q", p.Errors, p.LastError)
+	}
+
+	// The stage's own timeout is the provider being slow.
+	if _, _, err := c.aiChatStage(context.Background(), 20*time.Millisecond, "deepseek-chat", "sys", "user", 10); err == nil {
+		t.Fatal("call past the stage timeout succeeded")
+	}
+	if p := c.ProviderStatus()[0]; p.Errors != 1 {
+		t.Errorf("stage timeout: errors = %d, want 1", p.Errors)
+	}
+}
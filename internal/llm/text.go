@@ -0,0 +1,447 @@
+package llm
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"nexus/internal/dto"
+	"nexus/internal/hepler"
+)
+
+// insightRules описывает формат ответа, который ждёт клиент: заголовки блоков по порядку,
+// блок с действиями и допустимое число действий, обязательную фразу о неизвестном риске выгорания,
+// оговорки о нехватке данных и префикс упоминания заметок.
+type insightRules struct {
+	blocks         []string
+	actionsBlock   string
+	minActions     int
+	maxActions     int
+	unknownBurnout string
+	lowDataCaveats []string
+	notesPrefix    string
+}
+
+// forPrompt берёт обязательные блоки из перечня в system prompt (см. hepler.PromptBlocks), чтобы добавление
+// или удаление блока в шаблоне сразу меняло проверку. Если блока действий в перечне нет, действия не проверяются;
+// без перечня правила не меняются.
+func (r insightRules) forPrompt(system string) insightRules {
+	blocks := hepler.PromptBlocks(system)
+	if len(blocks) == 0 {
+		return r
+	}
+	r.blocks = blocks
+	if !slices.Contains(blocks, r.actionsBlock) {
+		r.actionsBlock = ""
+	}
+	return r
+}
+
+// withActions задаёт допустимое число действий [minActions, maxActions]: диапазон можно и расширить,
+// и сузить. Ноль оставляет границу промпта; пара с minActions > maxActions игнорируется целиком.
+// Пример: ruInsightRules.withActions(1, 5) принимает от 1 до 5 действий; withActions(3, 3) — ровно 3.
+func (r insightRules) withActions(minActions, maxActions int) insightRules {
+	lo, hi := r.minActions, r.maxActions
+	if minActions > 0 {
+		lo = minActions
+	}
+	if maxActions > 0 {
+		hi = maxActions
+	}
+	if lo > hi {
+		return r
+	}
+	r.minActions, r.maxActions = lo, hi
+	return r
+}
+
+// askActions переписывает в промпте просьбу "от 2 до 4" действий под диапазон правил, чтобы модель
+// просили ровно о том, что примет проверка; совет "(лучше 3)" вне диапазона убирается.
+// Пример: при withActions(1, 2) "от 2 до 4 конкретных действий (лучше 3)" -> "от 1 до 2 конкретных действий".
+func (r insightRules) askActions(prompt string) string {
+	if r.minActions == hepler.PromptMinActions && r.maxActions == hepler.PromptMaxActions {
+		return prompt
+	}
+	prompt = strings.ReplaceAll(prompt,
+		fmt.Sprintf("от %d до %d", hepler.PromptMinActions, hepler.PromptMaxActions),
+		fmt.Sprintf("от %d до %d", r.minActions, r.maxActions))
+	if r.minActions > 3 || r.maxActions < 3 {
+		prompt = strings.ReplaceAll(prompt, " (лучше 3)", "")
+	}
+	return prompt
+}
+
+// ruInsightRules — формат русских промптов из hepler (SystemPromptRU и SystemPromptRUPeriod).
+var ruInsightRules = insightRules{
+	blocks:         []string{"Энергия", "Выгорание", "Что делать завтра"},
+	actionsBlock:   "Что делать завтра",
+	minActions:     hepler.PromptMinActions,
+	maxActions:     hepler.PromptMaxActions,
+	unknownBurnout: "Риск выгорания пока неизвестен из-за недостатка данных.",
+	lowDataCaveats: []string{"данных мало", "вывод предварител"},
+	notesPrefix:    "Заметки:",
+}
+
+func isTruncated(finishReason, text string) bool {
+	if strings.EqualFold(finishReason, "length") {
+		return true
+	}
+
+	if text == "" {
+		return false
+	}
+	last := strings.TrimSpace(text)
+	if strings.HasSuffix(last, ":") || strings.HasSuffix(last, "-") || strings.HasSuffix(last, "–") {
+		return true
+	}
+
+	if len(last) >= 2 && last[len(last)-1] == ':' {
+		return true
+	}
+	return false
+}
+
+// cleanLLMText убирает рассуждения модели и обёртку ```, а если перед ответом есть преамбула —
+// обрезает всё до первого заголовка блока firstBlock.
+func cleanLLMText(s, firstBlock string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return s
+	}
+
+	low := strings.ToLower(s)
+
+	if idxEnd := strings.LastIndex(low, "</think>"); idxEnd != -1 {
+		after := strings.TrimSpace(s[idxEnd+len("</think>"):])
+		if after != "" {
+			return after
+		}
+	}
+
+	s = strings.ReplaceAll(s, "<think>", "")
+	s = strings.ReplaceAll(s, "</think>", "")
+	s = strings.ReplaceAll(s, "<THINK>", "")
+	s = strings.ReplaceAll(s, "</THINK>", "")
+
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "```")
+	s = strings.TrimSuffix(s, "```")
+	s = strings.TrimSpace(s)
+
+	s = strings.TrimLeft(s, "\n\r\t ")
+
+	s = strings.TrimSpace(s)
+
+	// If the model returned reasoning or preamble, keep only the final block answer.
+	if firstBlock == "" {
+		return strings.TrimSpace(s)
+	}
+	if idx := strings.Index(s, "\n"+firstBlock); idx >= 0 {
+		s = strings.TrimSpace(s[idx+1:])
+	} else if !strings.HasPrefix(s, firstBlock) {
+		if idx := strings.Index(s, firstBlock); idx >= 0 {
+			s = strings.TrimSpace(s[idx:])
+		}
+	}
+
+	return strings.TrimSpace(s)
+}
+
+var (
+	reBold       = regexp.MustCompile(`\*\*(.*?)\*\*`)
+	reInlineCode = regexp.MustCompile("`([^`]*)`")
+	reHeading    = regexp.MustCompile(`(?m)^\s{0,3}#{1,6}\s+`)
+	reListNum    = regexp.MustCompile(`(?m)^\s*\d+\.\s+`)
+	reListDash   = regexp.MustCompile(`(?m)^\s*[-•]\s+`)
+	reMultiSpace = regexp.MustCompile(`[ \t]{2,}`)
+)
+
+func toPlainText(s string, rules insightRules) string {
+	first := ""
+	if len(rules.blocks) > 0 {
+		first = rules.blocks[0]
+	}
+	s = cleanLLMText(s, first)
+
+	s = reHeading.ReplaceAllString(s, "")
+	s = reBold.ReplaceAllString(s, "$1")
+	s = reInlineCode.ReplaceAllString(s, "$1")
+	s = strings.ReplaceAll(s, "**", "")
+	s = strings.ReplaceAll(s, "__", "")
+	s = strings.ReplaceAll(s, "*", "")
+	s = strings.ReplaceAll(s, "_", "")
+
+	s = reListNum.ReplaceAllString(s, "")
+	s = reListDash.ReplaceAllString(s, "")
+
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = reMultiSpace.ReplaceAllString(s, " ")
+	return collapseBlankLines(s)
+}
+
+// insightIssue — нарушение формата ответа. Мягкие (soft) нарушения косметические и чинятся локально
+// в fixSoftIssues, жёсткие требуют ремонтного запроса к LLM.
+type insightIssue struct {
+	code string
+	soft bool
+}
+
+// Коды insightIssue.
+const (
+	issueEmpty          = "empty"
+	issueMissingBlock   = "missing_block"
+	issueUnknownBurnout = "unknown_burnout"
+	issueLowDataCaveat  = "low_data_caveat"
+	issueReasoning      = "reasoning"
+	issueNoActions      = "no_actions"
+	issueActionCount    = "action_count"
+	issueNotesMissing   = "notes_missing"
+	issueWhitespace     = "whitespace"
+	issueInventedGoal   = "invented_goal"
+)
+
+// reGoalMention находит слово «цель» в любом падеже; «в целом» и «целиком» не совпадают.
+var reGoalMention = regexp.MustCompile(`(^|[^\p{L}])цел(ь|и|ью|ей|ям|ями|ях)([^\p{L}]|$)`)
+
+// reGoalClaim — признаки утверждения о выполнении цели рядом со словом «цель»: число или глагол
+// достижения. «Поставь себе цель ложиться раньше» утверждением не считается.
+var reGoalClaim = regexp.MustCompile(`\d|выполн|достиг|дости[чгж]|соблюд|удерж`)
+
+// goalMetricWords — как метрики целей называются в тексте разбора. «Сон» подходит и к длительности,
+// и к качеству сна.
+var goalMetricWords = []struct {
+	re      *regexp.Regexp
+	metrics []string
+}{
+	{regexp.MustCompile(`(^|[^\p{L}])(сон|сна|сну|сном|сне)([^\p{L}]|$)`), []string{"sleep_hours", "sleep_quality"}},
+	{regexp.MustCompile(`(^|[^\p{L}])настроени`), []string{"mood"}},
+	{regexp.MustCompile(`(^|[^\p{L}])энерги`), []string{"energy"}},
+	{regexp.MustCompile(`(^|[^\p{L}])(концентрац|фокус)`), []string{"concentration"}},
+	{regexp.MustCompile(`(^|[^\p{L}])продуктивн`), []string{"productive"}},
+	{regexp.MustCompile(`(^|[^\p{L}])активност`), []string{"activity"}},
+	{regexp.MustCompile(`(^|[^\p{L}])стресс`), []string{"stress"}},
+}
+
+// inventsGoal сообщает, есть ли в low (текст в нижнем регистре) утверждение о цели, которой нет в goals:
+// предложение со словом «цель» и метрикой, числом или глаголом достижения. Без целей такое утверждение
+// выдумано целиком; с целями выдумана цель по метрике, которой среди goals нет.
+// Пример: inventsGoal("цель по сну выполнена 5 из 7 дней.", [{Metric: "stress"}]) -> true.
+func inventsGoal(low string, goals []dto.GoalProgress) bool {
+	set := make(map[string]bool, len(goals))
+	for _, g := range goals {
+		set[g.Metric] = true
+	}
+	for _, line := range strings.Split(low, "\n") {
+		for _, sentence := range splitBySentence(line) {
+			if !reGoalMention.MatchString(sentence) {
+				continue
+			}
+			claim := reGoalClaim.MatchString(sentence)
+			for _, w := range goalMetricWords {
+				if !w.re.MatchString(sentence) {
+					continue
+				}
+				claim = true
+				known := false
+				for _, m := range w.metrics {
+					known = known || set[m]
+				}
+				if !known {
+					return true
+				}
+			}
+			if claim && len(goals) == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// actionCountTolerance — на сколько число действий может выйти за [minActions, maxActions], оставаясь
+// мягким нарушением: лишнее действие отрезается локально, одного недостающего не хватает не настолько,
+// чтобы тратить ремонтный запрос.
+const actionCountTolerance = 1
+
+// validateInsight проверяет, что ответ следует формату rules: все блоки на месте, фраза о неизвестном
+// риске выгорания есть ровно тогда, когда он неизвестен, нет оговорки о нехватке данных при достаточных
+// данных и служебных рассуждений, нет утверждений о целях, которых не было в промпте (inventsGoal),
+// в блоке действий от rules.minActions до rules.maxActions пунктов, заметки упомянуты.
+// Лишние пустые строки и пробелы и выход числа действий за границы на actionCountTolerance — мягкие нарушения,
+// остальное — жёсткие. Проверки после пробелов идут по тексту с уже схлопнутыми пробелами.
+func validateInsight(text string, p dto.AIPrompt, rules insightRules) []insightIssue {
+	var issues []insightIssue
+	hard := func(code string) { issues = append(issues, insightIssue{code: code}) }
+
+	t := strings.TrimSpace(text)
+	if t == "" {
+		return []insightIssue{{code: issueEmpty}}
+	}
+	if norm := collapseBlankLines(t); norm != t {
+		issues = append(issues, insightIssue{code: issueWhitespace, soft: true})
+		t = norm
+	}
+
+	for _, h := range rules.blocks {
+		if !strings.Contains(t, "\n"+h+"\n") && !strings.HasPrefix(t, h+"\n") {
+			hard(issueMissingBlock)
+			break
+		}
+	}
+
+	if rules.unknownBurnout != "" {
+		if p.BurnoutLevel == "unknown" || p.BurnoutLevel == "недостаточно данных" {
+			if !strings.Contains(t, rules.unknownBurnout) {
+				hard(issueUnknownBurnout)
+			}
+		} else if strings.Contains(t, rules.unknownBurnout) {
+			hard(issueUnknownBurnout)
+		}
+	}
+
+	obsDays := p.NumObservedDays
+	if obsDays == 0 {
+		obsDays = p.NumObservedWeekdays
+	}
+
+	low := strings.ToLower(t)
+	if p.NumPoints >= 5 && obsDays >= 5 {
+		for _, c := range rules.lowDataCaveats {
+			if strings.Contains(low, c) {
+				hard(issueLowDataCaveat)
+				break
+			}
+		}
+	}
+
+	for _, b := range reasoningMarkers {
+		if strings.Contains(low, b) {
+			hard(issueReasoning)
+			break
+		}
+	}
+
+	// Goal claims may only cover the goals the prompt listed (hepler.goalsBlock).
+	if inventsGoal(low, p.Goals) {
+		hard(issueInventedGoal)
+	}
+
+	if rules.actionsBlock != "" {
+		block := extractBlock(t, rules.actionsBlock, "")
+		if strings.TrimSpace(block) == "" {
+			hard(issueNoActions)
+		} else if n := len(splitActions(block)); n < rules.minActions || n > rules.maxActions {
+			soft := n >= rules.minActions-actionCountTolerance && n <= rules.maxActions+actionCountTolerance
+			issues = append(issues, insightIssue{code: issueActionCount, soft: soft})
+		}
+	}
+
+	if rules.notesPrefix != "" && strings.TrimSpace(p.UserNotes) != "" {
+		if !strings.Contains(t, rules.notesPrefix) {
+			hard(issueNotesMissing)
+		}
+	}
+
+	return issues
+}
+
+// hasHardIssue — есть ли среди issues нарушение, которое чинит только ремонтный запрос.
+func hasHardIssue(issues []insightIssue) bool {
+	for _, is := range issues {
+		if !is.soft {
+			return true
+		}
+	}
+	return false
+}
+
+// fixSoftIssues чинит мягкие нарушения без LLM: схлопывает пустые строки и пробелы и отрезает действия
+// сверх rules.maxActions. Недостающее действие не досочиняется — ответ принимается как есть.
+// Пример: блок действий из 5 пунктов при maxActions = 4 -> первые 4 пункта, каждый с новой строки.
+func fixSoftIssues(text string, rules insightRules) string {
+	t := collapseBlankLines(text)
+	if rules.actionsBlock == "" || rules.maxActions <= 0 {
+		return t
+	}
+	block := extractBlock(t, rules.actionsBlock, "")
+	actions := splitActions(block)
+	if len(actions) <= rules.maxActions {
+		return t
+	}
+	head := strings.TrimSuffix(t, block)
+	if head == t {
+		return t
+	}
+	return head + strings.Join(actions[:rules.maxActions], "\n")
+}
+
+func extractBlock(full, startTitle, endTitle string) string {
+	start := strings.Index(full, "\n"+startTitle+"\n")
+	if start == -1 {
+		if strings.HasPrefix(full, startTitle+"\n") {
+			start = 0
+		} else {
+			return ""
+		}
+	} else {
+		start += len("\n" + startTitle + "\n")
+	}
+
+	if strings.TrimSpace(endTitle) == "" {
+		return strings.TrimSpace(full[start:])
+	}
+
+	end := strings.Index(full[start:], "\n"+endTitle+"\n")
+	if end == -1 {
+		return strings.TrimSpace(full[start:])
+	}
+	return strings.TrimSpace(full[start : start+end])
+}
+
+func splitActions(block string) []string {
+	lines := strings.Split(block, "\n")
+	out := make([]string, 0, 3)
+	for _, ln := range lines {
+		ln = strings.TrimSpace(ln)
+		if ln == "" {
+			continue
+		}
+		out = append(out, ln)
+	}
+	if len(out) == 1 {
+		parts := splitBySentence(out[0])
+		out = out[:0]
+		for _, p := range parts {
+			p = strings.TrimSpace(p)
+			if p != "" {
+				out = append(out, p)
+			}
+		}
+	}
+	return out
+}
+
+func splitBySentence(s string) []string {
+	seps := []rune{'.', '!', '?'}
+	var res []string
+	cur := strings.Builder{}
+	for _, r := range s {
+		cur.WriteRune(r)
+		for _, sep := range seps {
+			if r == sep {
+				part := strings.TrimSpace(cur.String())
+				if part != "" {
+					res = append(res, part)
+				}
+				cur.Reset()
+				break
+			}
+		}
+	}
+	last := strings.TrimSpace(cur.String())
+	if last != "" {
+		res = append(res, last)
+	}
+	return res
+}
@@ -0,0 +1,178 @@
+package llm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"nexus/internal/dto"
+	"nexus/internal/hepler"
+)
+
+const validInsight = "Энергия\nРовная днём.\n\nВыгорание\nРиск низкий.\n\nЧто делать завтра\nЛечь до полуночи.\nВыйти на прогулку."
+
+func TestValidateInsightRules(t *testing.T) {
+	enough := dto.AIPrompt{NumPoints: 10, NumObservedDays: 7, BurnoutLevel: "low"}
+	notes := enough
+	notes.UserNotes = "плохо спал"
+	unknown := enough
+	unknown.BurnoutLevel = "unknown"
+
+	// hfRules — правила без проверки заметок и фразы о выгорании, как у прежнего HF-клиента.
+	hfRules := ruInsightRules
+	hfRules.notesPrefix = ""
+	hfRules.unknownBurnout = ""
+
+	cases := []struct {
+		name  string
+		text  string
+		p     dto.AIPrompt
+		rules insightRules
+		want  []insightIssue
+	}{
+		{"valid", validInsight, enough, ruInsightRules, nil},
+		{"empty", "  ", enough, ruInsightRules, []insightIssue{{code: issueEmpty}}},
+		{"missing block", "Энергия\nРовная.\n\nЧто делать завтра\nЛечь рано.\nГулять.", enough, ruInsightRules,
+			[]insightIssue{{code: issueMissingBlock}}},
+		{"notes required", validInsight, notes, ruInsightRules, []insightIssue{{code: issueNotesMissing}}},
+		{"notes optional", validInsight, notes, hfRules, nil},
+		{"unknown burnout required", validInsight, unknown, ruInsightRules, []insightIssue{{code: issueUnknownBurnout}}},
+		{"unknown burnout optional", validInsight, unknown, hfRules, nil},
+		{"low-data caveat", validInsight + "\nДанных мало.", enough, ruInsightRules,
+			[]insightIssue{{code: issueLowDataCaveat}}},
+		{"extra blank lines", "Энергия\nРовная днём.\n\n\n\nВыгорание\nРиск низкий.\n\nЧто делать завтра\nЛечь до полуночи.\nВыйти на прогулку.",
+			enough, ruInsightRules, []insightIssue{{code: issueWhitespace, soft: true}}},
+		{"one action short", "Энергия\nРовная.\n\nВыгорание\nНизкий.\n\nЧто делать завтра\nЛечь до полуночи.", enough, ruInsightRules,
+			[]insightIssue{{code: issueActionCount, soft: true}}},
+		{"far too many actions", validInsight + "\nВыпить воды.\nСделать зарядку.\nПочитать.\nПозвонить другу.", enough, ruInsightRules,
+			[]insightIssue{{code: issueActionCount}}},
+		{"no actions block", "Энергия\nРовная.\n\nВыгорание\nНизкий.", enough, insightRules{blocks: []string{"Энергия", "Выгорание"}}, nil},
+	}
+	for _, c := range cases {
+		if got := validateInsight(c.text, c.p, c.rules); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: issues = %+v, want %+v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRulesFollowPromptBlocks(t *testing.T) {
+	want := []string{"Энергия", "Выгорание", "Что делать завтра"}
+	for _, system := range []string{hepler.SystemPromptRU, hepler.SystemPromptRUPeriod} {
+		if got := ruInsightRules.forPrompt(system); !reflect.DeepEqual(got.blocks, want) || got.actionsBlock != "Что делать завтра" {
+			t.Errorf("forPrompt blocks = %q, actions = %q", got.blocks, got.actionsBlock)
+		}
+	}
+
+	// Dropping the actions block from the template drops the check along with it.
+	system := strings.Replace(hepler.SystemPromptRU, "\nЧто делать завтра\n", "\n", 1)
+	got := ruInsightRules.forPrompt(system)
+	if !reflect.DeepEqual(got.blocks, want[:2]) || got.actionsBlock != "" {
+		t.Fatalf("without actions: blocks = %q, actions = %q", got.blocks, got.actionsBlock)
+	}
+	if issues := validateInsight("Энергия\nРовная.\n\nВыгорание\nНизкий.", dto.AIPrompt{BurnoutLevel: "low"}, got); issues != nil {
+		t.Errorf("without actions: issues = %+v", issues)
+	}
+
+	// Adding a block makes it required.
+	system = strings.Replace(hepler.SystemPromptRU, "\nВыгорание\n", "\nВыгорание\nСон\n", 1)
+	got = ruInsightRules.forPrompt(system)
+	if !reflect.DeepEqual(got.blocks, []string{"Энергия", "Выгорание", "Сон", "Что делать завтра"}) {
+		t.Fatalf("with sleep: blocks = %q", got.blocks)
+	}
+	if issues := validateInsight(validInsight, dto.AIPrompt{BurnoutLevel: "low"}, got); !reflect.DeepEqual(issues, []insightIssue{{code: issueMissingBlock}}) {
+		t.Errorf("with sleep: issues = %+v", issues)
+	}
+
+	if got := ruInsightRules.forPrompt("no block list"); !reflect.DeepEqual(got, ruInsightRules) {
+		t.Errorf("without list: rules = %+v", got)
+	}
+}
+
+func TestInsightActionBounds(t *testing.T) {
+	enough := dto.AIPrompt{NumPoints: 10, NumObservedDays: 7, BurnoutLevel: "low"}
+	insight := func(n int) string {
+		actions := make([]string, n)
+		for i := range actions {
+			actions[i] = "Действие номер " + string(rune('А'+i)) + "."
+		}
+		return "Энергия\nРовная днём.\n\nВыгорание\nРиск низкий.\n\nЧто делать завтра\n" + strings.Join(actions, "\n")
+	}
+	accepts := func(r insightRules, n int) bool { return validateInsight(insight(n), enough, r) == nil }
+
+	cases := []struct {
+		name     string
+		min, max int
+		lo, hi   int
+	}{
+		{"prompt default", 0, 0, hepler.PromptMinActions, hepler.PromptMaxActions},
+		{"widen", 1, 5, 1, 5},
+		{"narrow", 3, 3, 3, 3},
+		{"narrow max only", 0, 3, 2, 3},
+		{"inverted pair ignored", 5, 2, hepler.PromptMinActions, hepler.PromptMaxActions},
+	}
+	for _, c := range cases {
+		r := ruInsightRules.withActions(c.min, c.max)
+		if r.minActions != c.lo || r.maxActions != c.hi {
+			t.Errorf("%s: range = %d..%d, want %d..%d", c.name, r.minActions, r.maxActions, c.lo, c.hi)
+		}
+		for n := 1; n <= 5; n++ {
+			if want := n >= c.lo && n <= c.hi; accepts(r, n) != want {
+				t.Errorf("%s: %d actions accepted = %v, want %v", c.name, n, !want, want)
+			}
+		}
+	}
+}
+
+func TestAskActionsMatchesValidator(t *testing.T) {
+	if got := ruInsightRules.askActions(hepler.SystemPromptRU); got != hepler.SystemPromptRU {
+		t.Error("default range rewrote the prompt")
+	}
+
+	narrow := ruInsightRules.withActions(3, 3)
+	for _, prompt := range []string{hepler.SystemPromptRU, hepler.SystemPromptRUPeriod, hepler.RepairPromptTmplRU, hepler.RepairPromptTmplRUPeriod} {
+		got := narrow.askActions(prompt)
+		if strings.Contains(got, "от 2 до 4") || !strings.Contains(got, "от 3 до 3") {
+			t.Errorf("narrowed prompt still asks for the default range:\n%s", got)
+		}
+	}
+
+	few := ruInsightRules.withActions(1, 2).askActions(hepler.SystemPromptRU)
+	if strings.Contains(few, "лучше 3") {
+		t.Error("prompt suggests 3 actions while at most 2 are accepted")
+	}
+}
+
+func TestInventedGoals(t *testing.T) {
+	sleepGoal := []dto.GoalProgress{{Metric: "sleep_hours", Target: 8, Met: 5, Days: 7}}
+	cases := []struct {
+		name  string
+		text  string
+		goals []dto.GoalProgress
+		want  bool
+	}{
+		{"advice without goals", "Поставь себе цель ложиться раньше.", nil, false},
+		{"in general", "В целом неделя ровная, цели можно обсудить позже.", nil, false},
+		{"claim without goals", "Цель по сну выполнена 5 из 7 дней.", nil, true},
+		{"number without goals", "Твоя цель — 8 часов.", nil, true},
+		{"listed goal", "Цель по сну ты выполнил 5 из 7 дней.", sleepGoal, false},
+		{"goal on another metric", "Цель по стрессу выполнена 4 из 7 дней.", sleepGoal, true},
+		{"listed and invented", "Цель по сну выполнена.\nЦель по настроению тоже.", sleepGoal, true},
+		{"goal word without a claim", "Это хорошая цель на будущее.", sleepGoal, false},
+	}
+	for _, c := range cases {
+		if got := inventsGoal(strings.ToLower(c.text), c.goals); got != c.want {
+			t.Errorf("%s: inventsGoal(%q) = %v, want %v", c.name, c.text, got, c.want)
+		}
+	}
+
+	// The validator reports it as a hard issue, so a normal answer with a claim goes to repair.
+	p := dto.AIPrompt{NumPoints: 10, NumObservedDays: 7, BurnoutLevel: "low"}
+	text := strings.Replace(validInsight, "Ровная днём.", "Ровная днём. Цель по сну выполнена 5 из 7 дней.", 1)
+	if issues := validateInsight(text, p, ruInsightRules); !reflect.DeepEqual(issues, []insightIssue{{code: issueInventedGoal}}) {
+		t.Errorf("issues without goals = %+v", issues)
+	}
+	p.Goals = sleepGoal
+	if issues := validateInsight(text, p, ruInsightRules); issues != nil {
+		t.Errorf("issues with the sleep goal = %+v", issues)
+	}
+}
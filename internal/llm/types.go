@@ -1,23 +1,66 @@
 package llm
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+	"nexus/internal/dto"
+	"time"
+)
 
 type AIConfig struct {
 	URL          string
 	Token        string
 	Model        string
 	SystemPrompt string
-	Fast         bool
-	MaxTokens    int
-	HTTPClient   *http.Client
+	// FastPeriods — периоды, для которых пропускается дозапрос и ремонт ответа; nil — DefaultFastPeriods.
+	FastPeriods []dto.Period
+	MaxTokens   int
+	HTTPClient  *http.Client
+	// Language выбирает список запрещённых тем из DefaultBlocklists; пустой или неизвестный язык — "ru".
+	Language string
+	// Blocklist заменяет список для Language: строки ответа с этими подстроками (без учёта регистра) отбрасываются.
+	Blocklist []string
+	// MinActions/MaxActions — сколько действий в блоке "Что делать завтра" принимается без ремонта ответа.
+	// Диапазон может быть и шире, и уже hepler.PromptMinActions..PromptMaxActions: промпт просит ровно его; 0 — как в промпте.
+	MinActions int
+	MaxActions int
+	// InitialTimeout/ContinueTimeout/RepairTimeout — дедлайн отдельного вызова каждого этапа CallInsight
+	// (ответ, дозапрос, ремонт); 0 — этап ограничен только таймаутом HTTPClient. Истёкший дозапрос
+	// или ремонт не отменяет уже полученный ответ первого этапа.
+	InitialTimeout  time.Duration
+	ContinueTimeout time.Duration
+	RepairTimeout   time.Duration
+	// RPM — квота провайдера в запросах в минуту: каждый HTTP-запрос ждёт своей очереди, а ответ 429
+	// повторяется с паузой; 0 — без ограничения и без повторов.
+	RPM int
 }
 
 type AIClient struct {
-	url        string
-	token      string
-	model      string
-	system     string
-	fast       bool
-	maxTokens  int
-	httpClient *http.Client
+	url         string
+	token       string
+	model       string
+	system      string
+	fastPeriods map[dto.Period]bool
+	maxTokens   int
+	httpClient  *http.Client
+	blocklist   []string
+	rules       insightRules
+	// Stage deadlines, see AIConfig.InitialTimeout.
+	initialTimeout  time.Duration
+	continueTimeout time.Duration
+	repairTimeout   time.Duration
+	// stats feeds ProviderStatus.
+	stats providerStats
+	// limiter paces every HTTP request under AIConfig.RPM; nil does not pace.
+	limiter *rateLimiter
+}
+
+// StatusError is returned when the provider answers with an HTTP error status.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("ai status %d: %s", e.StatusCode, e.Body)
 }
@@ -15,16 +15,24 @@ import (
 type AuthGRPCMiddleware struct {
 	authURL string
 	client  *http.Client
+	// tokens caches successful validations; nil when cacheTTL is 0.
+	tokens *tokenCache
 }
 
-func NewAuthGRPCMiddleware(authURL string, client *http.Client) *AuthGRPCMiddleware {
+// NewAuthGRPCMiddleware validates every call against authURL. With cacheTTL > 0 a successful validation
+// is reused for cacheTTL, keeping at most cacheSize tokens (0 means the default size).
+func NewAuthGRPCMiddleware(authURL string, client *http.Client, cacheTTL time.Duration, cacheSize int) *AuthGRPCMiddleware {
 	if client == nil {
 		client = &http.Client{Timeout: 3 * time.Second}
 	}
-	return &AuthGRPCMiddleware{
+	m := &AuthGRPCMiddleware{
 		authURL: strings.TrimSpace(authURL),
 		client:  client,
 	}
+	if cacheTTL > 0 {
+		m.tokens = newTokenCache(cacheTTL, cacheSize)
+	}
+	return m
 }
 
 func (m *AuthGRPCMiddleware) Unary() grpc.UnaryServerInterceptor {
@@ -43,27 +51,54 @@ func (m *AuthGRPCMiddleware) Unary() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		reqHTTP, err := http.NewRequestWithContext(ctx, http.MethodPost, m.authURL, nil)
-		if err != nil {
-			return nil, status.Error(codes.Internal, "auth request build failed")
+		key := tokenCacheKey(authHeader)
+		if m.tokens == nil || !m.tokens.valid(key) {
+			if err := m.validate(ctx, md, authHeader); err != nil {
+				return nil, err
+			}
+			if m.tokens != nil {
+				m.tokens.add(key)
+			}
 		}
-		reqHTTP.Header.Set("Authorization", authHeader)
-		if rid := firstMeta(md, "x-request-id"); rid != "" {
-			reqHTTP.Header.Set("X-Request-Id", rid)
+		resp, err := handler(ctx, req)
+		// A 401 further down (e.g. the token was revoked within the TTL) drops the cached validation.
+		if m.tokens != nil && status.Code(err) == codes.Unauthenticated {
+			m.tokens.remove(key)
 		}
+		return resp, err
+	}
+}
 
-		resp, err := m.client.Do(reqHTTP)
-		if err != nil {
-			return nil, status.Error(codes.Unavailable, "auth service unavailable")
-		}
-		defer resp.Body.Close()
+// validate asks the auth service whether authHeader is valid.
+func (m *AuthGRPCMiddleware) validate(ctx context.Context, md metadata.MD, authHeader string) error {
+	reqHTTP, err := http.NewRequestWithContext(ctx, http.MethodPost, m.authURL, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "auth request build failed")
+	}
+	reqHTTP.Header.Set("Authorization", authHeader)
+	if rid := firstMeta(md, "x-request-id"); rid != "" {
+		reqHTTP.Header.Set("X-Request-Id", rid)
+	}
 
-		if resp.StatusCode != http.StatusOK {
-			return nil, status.Error(codes.Unauthenticated, "unauthorized")
-		}
+	resp, err := m.client.Do(reqHTTP)
+	if err != nil {
+		return status.Error(codes.Unavailable, "auth service unavailable")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return authStatusError(resp.StatusCode)
+	}
+	return nil
+}
 
-		return handler(ctx, req)
+// authStatusError maps a non-200 auth response: an auth outage (5xx, 429) is retryable
+// and must not look like a bad token, which would force clients to log out.
+func authStatusError(code int) error {
+	if code >= http.StatusInternalServerError || code == http.StatusTooManyRequests {
+		return status.Error(codes.Unavailable, "auth service unavailable")
 	}
+	return status.Error(codes.Unauthenticated, "unauthorized")
 }
 
 func isHealthMethod(fullMethod string) bool {
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func callAuth(m *AuthGRPCMiddleware, md metadata.MD) error {
+	_, err := m.Unary()(metadata.NewIncomingContext(context.Background(), md), nil, &grpc.UnaryServerInfo{FullMethod: testMethod},
+		func(context.Context, any) (any, error) { return nil, nil })
+	return err
+}
+
+func TestAuthStatusClasses(t *testing.T) {
+	cases := []struct {
+		status int
+		want   codes.Code
+	}{
+		{http.StatusOK, codes.OK},
+		{http.StatusUnauthorized, codes.Unauthenticated},
+		{http.StatusForbidden, codes.Unauthenticated},
+		{http.StatusBadRequest, codes.Unauthenticated},
+		{http.StatusTooManyRequests, codes.Unavailable},
+		{http.StatusInternalServerError, codes.Unavailable},
+		{http.StatusBadGateway, codes.Unavailable},
+		{http.StatusServiceUnavailable, codes.Unavailable},
+	}
+	for _, c := range cases {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(c.status)
+		}))
+		err := callAuth(NewAuthGRPCMiddleware(srv.URL, nil, 0, 0), metadata.Pairs("authorization", "Bearer t"))
+		srv.Close()
+		if status.Code(err) != c.want {
+			t.Errorf("auth HTTP %d: code = %v, want %v", c.status, status.Code(err), c.want)
+		}
+	}
+}
+
+func TestAuthOutageIsUnavailable(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer slow.Close()
+	m := NewAuthGRPCMiddleware(slow.URL, &http.Client{Timeout: 50 * time.Millisecond}, 0, 0)
+	if err := callAuth(m, metadata.Pairs("authorization", "Bearer t")); status.Code(err) != codes.Unavailable {
+		t.Errorf("timeout: code = %v, want Unavailable", status.Code(err))
+	}
+
+	down := httptest.NewServer(http.NotFoundHandler())
+	url := down.URL
+	down.Close()
+	if err := callAuth(NewAuthGRPCMiddleware(url, nil, 0, 0), metadata.Pairs("authorization", "Bearer t")); status.Code(err) != codes.Unavailable {
+		t.Errorf("connection refused: code = %v, want Unavailable", status.Code(err))
+	}
+
+	if err := callAuth(NewAuthGRPCMiddleware(url, nil, 0, 0), metadata.MD{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("missing header: code = %v, want Unauthenticated", status.Code(err))
+	}
+}
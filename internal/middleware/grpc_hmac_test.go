@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	testSecret = "s3cret"
+	testMethod = "/nexusai.v1.AnalyzeService/Analyze"
+)
+
+// signedCall returns the incoming metadata a correctly signing client sends for req.
+func signedCall(t *testing.T, at time.Time, nonce string, req proto.Message) metadata.MD {
+	t.Helper()
+	body, err := BodyHash(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := strconv.FormatInt(at.Unix(), 10)
+	sig := SignRequest([]byte(testSecret), testMethod, ts, "42", nonce, body)
+	return metadata.Pairs("x-timestamp", ts, "x-user-id", "42", "x-nonce", nonce, "x-signature", hex.EncodeToString(sig))
+}
+
+func callHMAC(m *HMACGRPCMiddleware, md metadata.MD, req proto.Message) (int32, error) {
+	var got int32
+	_, err := m.Unary()(metadata.NewIncomingContext(context.Background(), md), req, &grpc.UnaryServerInfo{FullMethod: testMethod},
+		func(ctx context.Context, _ any) (any, error) {
+			got, _ = TrustedUserID(ctx)
+			return nil, nil
+		})
+	return got, err
+}
+
+func TestHMACSignatures(t *testing.T) {
+	now := time.Unix(1_780_000_000, 0)
+	req := wrapperspb.String("week")
+	newMiddleware := func() *HMACGRPCMiddleware {
+		m := NewHMACGRPCMiddleware(testSecret, time.Minute)
+		m.now = func() time.Time { return now }
+		return m
+	}
+
+	if id, err := callHMAC(newMiddleware(), signedCall(t, now, "n1", req), req); err != nil || id != 42 {
+		t.Fatalf("valid signature: id=%d err=%v", id, err)
+	}
+
+	tampered := signedCall(t, now, "n2", req)
+	tampered.Set("x-user-id", "43")
+	otherBody := signedCall(t, now, "n3", wrapperspb.String("month"))
+	cases := []struct {
+		name string
+		md   metadata.MD
+	}{
+		{"stale", signedCall(t, now.Add(-2*time.Minute), "n4", req)},
+		{"from the future", signedCall(t, now.Add(2*time.Minute), "n5", req)},
+		{"tampered user", tampered},
+		{"signed for another body", otherBody},
+		{"wrong secret", func() metadata.MD {
+			md := signedCall(t, now, "n6", req)
+			md.Set("x-signature", hex.EncodeToString(SignRequest([]byte("other"), testMethod, md.Get("x-timestamp")[0], "42", "n6", "")))
+			return md
+		}()},
+		{"no nonce", func() metadata.MD {
+			md := signedCall(t, now, "n7", req)
+			md.Delete("x-nonce")
+			return md
+		}()},
+	}
+	for _, c := range cases {
+		if _, err := callHMAC(newMiddleware(), c.md, req); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: code = %v, want Unauthenticated", c.name, status.Code(err))
+		}
+	}
+}
+
+func TestHMACRejectsReplayedNonce(t *testing.T) {
+	now := time.Unix(1_780_000_000, 0)
+	m := NewHMACGRPCMiddleware(testSecret, time.Minute)
+	m.now = func() time.Time { return now }
+	req := wrapperspb.String("week")
+	md := signedCall(t, now, "once", req)
+
+	if _, err := callHMAC(m, md, req); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if _, err := callHMAC(m, md, req); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("replay: code = %v, want Unauthenticated", status.Code(err))
+	}
+	// A forged call must not burn a nonce the real client has yet to use.
+	forged := signedCall(t, now, "fresh", req)
+	forged.Set("x-signature", "00")
+	callHMAC(m, forged, req)
+	if _, err := callHMAC(m, signedCall(t, now, "fresh", req), req); err != nil {
+		t.Errorf("nonce of a rejected call is unusable: %v", err)
+	}
+
+	// Nonces are forgotten once their timestamps could no longer pass the skew check.
+	now = now.Add(3 * time.Minute)
+	callHMAC(m, signedCall(t, now, "later", req), req)
+	if len(m.nonces) != 1 {
+		t.Errorf("%d nonces kept after the window passed, want 1", len(m.nonces))
+	}
+}
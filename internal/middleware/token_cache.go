@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+	"time"
+)
+
+const defaultTokenCacheSize = 1024
+
+// tokenCache remembers recently validated authorization headers for a short TTL so chatty
+// clients skip the auth round trip. Keys are SHA-256 hashes, never the raw token; the oldest
+// entry is evicted once maxEntries is reached (LRU).
+type tokenCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	order      *list.List
+	entries    map[[sha256.Size]byte]*list.Element
+	now        func() time.Time
+}
+
+type tokenCacheEntry struct {
+	key       [sha256.Size]byte
+	expiresAt time.Time
+}
+
+func newTokenCache(ttl time.Duration, maxEntries int) *tokenCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultTokenCacheSize
+	}
+	return &tokenCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    map[[sha256.Size]byte]*list.Element{},
+		now:        time.Now,
+	}
+}
+
+func tokenCacheKey(authHeader string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(authHeader))
+}
+
+// valid reports whether the header was validated within the TTL; an expired entry is dropped.
+func (c *tokenCache) valid(key [sha256.Size]byte) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	if !c.now().Before(el.Value.(*tokenCacheEntry).expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		return false
+	}
+	c.order.MoveToFront(el)
+	return true
+}
+
+func (c *tokenCache) add(key [sha256.Size]byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := c.now().Add(c.ttl)
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*tokenCacheEntry).expiresAt = expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&tokenCacheEntry{key: key, expiresAt: expiresAt})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*tokenCacheEntry).key)
+	}
+}
+
+func (c *tokenCache) remove(key [sha256.Size]byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.order.Remove(el)
+		delete(c.entries, key)
+	}
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthTokenCache(t *testing.T) {
+	var calls atomic.Int32
+	var code atomic.Int32
+	code.Store(http.StatusOK)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(int(code.Load()))
+	}))
+	defer srv.Close()
+
+	m := NewAuthGRPCMiddleware(srv.URL, nil, 10*time.Second, 2)
+	now := time.Now()
+	m.tokens.now = func() time.Time { return now }
+	call := func(token string) error {
+		return callAuth(m, metadata.Pairs("authorization", "Bearer "+token))
+	}
+	expect := func(what string, err error, wantCode codes.Code, wantCalls int32) {
+		t.Helper()
+		if status.Code(err) != wantCode || calls.Load() != wantCalls {
+			t.Errorf("%s: code %v after %d auth calls, want %v after %d", what, status.Code(err), calls.Load(), wantCode, wantCalls)
+		}
+	}
+
+	expect("first call", call("a"), codes.OK, 1)
+	expect("hit", call("a"), codes.OK, 1)
+
+	now = now.Add(10 * time.Second)
+	expect("expired", call("a"), codes.OK, 2)
+
+	// A rejected token is not cached: the next call asks the auth service again.
+	code.Store(http.StatusUnauthorized)
+	expect("rejected", call("b"), codes.Unauthenticated, 3)
+	code.Store(http.StatusOK)
+	expect("after rejection", call("b"), codes.OK, 4)
+	code.Store(http.StatusServiceUnavailable)
+	expect("outage", call("c"), codes.Unavailable, 5)
+	code.Store(http.StatusOK)
+	expect("after outage", call("c"), codes.OK, 6)
+
+	// At most two tokens are kept: adding c evicted the least recently used a.
+	expect("b still cached", call("b"), codes.OK, 6)
+	expect("a evicted", call("a"), codes.OK, 7)
+
+	// A 401 from the handler drops the cached validation.
+	_, err := m.Unary()(metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer a")), nil,
+		&grpc.UnaryServerInfo{FullMethod: testMethod},
+		func(context.Context, any) (any, error) { return nil, status.Error(codes.Unauthenticated, "revoked") })
+	expect("handler 401", err, codes.Unauthenticated, 7)
+	expect("after handler 401", call("a"), codes.OK, 8)
+}
@@ -0,0 +1,109 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// WebhookNotifier posts burnout alerts as JSON to the user's endpoint. Each request is signed so the
+// receiver can check it came from this service:
+//
+//	X-Timestamp: unix seconds
+//	X-Signature: hex(HMAC-SHA256(secret, timestamp + "\n" + body))
+type WebhookNotifier struct {
+	secret []byte
+	client *http.Client
+}
+
+// ErrForbiddenAddress — the webhook host resolved to an address inside a private network.
+var ErrForbiddenAddress = errors.New("notify: webhook address is not public")
+
+// cgnat is the shared address space of carrier-grade NAT (RFC 6598), not covered by net.IP.IsPrivate.
+var cgnat = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// PublicIP reports whether ip may receive webhooks: not loopback, private, link-local (which includes
+// the 169.254.169.254 metadata endpoint), CGNAT, multicast or unspecified.
+func PublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || cgnat.Contains(ip))
+}
+
+// dialPublicOnly is a net.Dialer Control hook: it runs after DNS resolution, on the address actually
+// dialed, so a hostname pointing into the service's network is refused too.
+func dialPublicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !PublicIP(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	return nil
+}
+
+// NewWebhookNotifier signs with secret; timeout bounds each delivery (0 means 10s). Redirects are not
+// followed, so a validated endpoint cannot bounce the request elsewhere, and only public addresses are
+// dialed (see PublicIP). Proxies from the environment are ignored, since they would dial for us.
+func NewWebhookNotifier(secret string, timeout time.Duration) *WebhookNotifier {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second, Control: dialPublicOnly}).DialContext
+	return &WebhookNotifier{
+		secret: []byte(secret),
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// NotifyBurnout delivers one alert; any non-2xx answer is an error.
+func (n *WebhookNotifier) NotifyBurnout(ctx context.Context, url string, alert dto.BurnoutAlert) error {
+	if len(n.secret) == 0 {
+		return errors.New("notify: webhook secret not configured")
+	}
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, n.secret)
+	mac.Write([]byte(ts + "\n"))
+	mac.Write(body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Timestamp", ts)
+	req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notify: webhook answered %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"nexus/internal/dto"
+)
+
+func TestPublicIP(t *testing.T) {
+	cases := map[string]bool{
+		"93.184.216.34":     true,
+		"2606:4700::1111":   true,
+		"127.0.0.1":         false,
+		"10.1.2.3":          false,
+		"172.16.0.1":        false,
+		"192.168.1.1":       false,
+		"169.254.169.254":   false,
+		"100.64.0.1":        false,
+		"100.127.255.254":   false,
+		"100.128.0.1":       true,
+		"0.0.0.0":           false,
+		"::1":               false,
+		"fd00::1":           false,
+		"fe80::1":           false,
+		"::ffff:10.0.0.1":   false,
+		"::ffff:100.64.9.9": false,
+		"224.0.0.1":         false,
+	}
+	for addr, want := range cases {
+		if got := PublicIP(net.ParseIP(addr)); got != want {
+			t.Errorf("PublicIP(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestWebhookRefusesInternalAddresses(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+	}))
+	t.Cleanup(srv.Close)
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(srv.URL, "https://"))
+
+	n := NewWebhookNotifier("secret", 0)
+	// An address literal and a hostname that resolves to loopback are both stopped at dial time.
+	for _, url := range []string{srv.URL, "https://localhost:" + port} {
+		err := n.NotifyBurnout(context.Background(), url, dto.BurnoutAlert{UserID: 42, Level: "high"})
+		if !errors.Is(err, ErrForbiddenAddress) {
+			t.Errorf("%s: err = %v, want ErrForbiddenAddress", url, err)
+		}
+	}
+	if hits.Load() != 0 {
+		t.Errorf("the internal endpoint received %d requests", hits.Load())
+	}
+}
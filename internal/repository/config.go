@@ -1,8 +1,41 @@
 package repository
 
+import "time"
+
+const (
+	defaultMaxConns        = 20
+	defaultMinConns        = 2
+	defaultMaxConnLifetime = 30 * time.Minute
+	defaultMaxConnIdleTime = 5 * time.Minute
+	defaultMaxTrackPoints  = 20000
+)
+
 type Config struct {
-	PostgresURL   string
+	PostgresURL string
+	// ReadReplicaURL, when set, serves the read-heavy queries (history, calendar, friends, aggregate stats)
+	// from a replica with the same pool sizing; writes and everything else stay on PostgresURL.
+	ReadReplicaURL string
+
 	RedisAddr     string
 	RedisPassword string
 	RedisDB       int
+
+	// Pool sizing for pgxpool; zero values fall back to the defaults above, not to pgx's own.
+	MaxConns        int32
+	MinConns        int32
+	MaxConnLifetime time.Duration
+	MaxConnIdleTime time.Duration
+
+	// AnalysisHistoryLimit keeps only the newest N saved analyses per user and period; 0 keeps all.
+	AnalysisHistoryLimit int
+
+	// MaxTrackPoints caps the points GetTrackPoints loads for one range; 0 means defaultMaxTrackPoints.
+	// Past the cap the newest points are kept, or one point per day with DownsampleTrackPoints.
+	MaxTrackPoints int
+	// DownsampleTrackPoints answers an over-cap range with the latest point of each logical day instead
+	// of cutting off the oldest points.
+	DownsampleTrackPoints bool
+	// DefaultTZ is the IANA zone the downsampled days are cut in for users without a valid saved zone;
+	// empty means UTC. It should match the usecase's DefaultLocation.
+	DefaultTZ string
 }
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisLocker takes short-lived exclusive locks with SET NX. Each lock carries a random token so an
+// unlock after the TTL expired cannot release a lock another process has taken since.
+type RedisLocker struct {
+	rdb *redis.Client
+}
+
+var redisUnlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+// RedisLocker returns nil when Redis is not configured.
+func (r *Repository) RedisLocker() *RedisLocker {
+	if r.redis == nil {
+		return nil
+	}
+	return &RedisLocker{rdb: r.redis}
+}
+
+// TryLock does not wait: ok is false when the key is already held. The lock expires after ttl.
+func (l *RedisLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	var raw [16]byte
+	if _, err := rand.Read(raw[:]); err != nil {
+		return nil, false, err
+	}
+	token := hex.EncodeToString(raw[:])
+	ok, err := l.rdb.SetNX(ctx, lockKey(key), token, ttl).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	unlock := func() {
+		// The caller's context may already be done; releasing the lock must not depend on it.
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_ = redisUnlockScript.Run(ctx, l.rdb, []string{lockKey(key)}, token).Err()
+	}
+	return unlock, true, nil
+}
+
+// PostgresLocker takes session-level advisory locks on a hash of the key, for deployments without Redis.
+// The lock holds one pooled connection until unlock; it is released by Postgres if that connection dies.
+type PostgresLocker struct {
+	pg *pgxpool.Pool
+}
+
+// PostgresLocker returns nil when Postgres is not configured.
+func (r *Repository) PostgresLocker() *PostgresLocker {
+	if r.pg == nil {
+		return nil
+	}
+	return &PostgresLocker{pg: r.pg}
+}
+
+// TryLock does not wait: ok is false when the key is already held. ttl is ignored; the lock lasts
+// until unlock is called.
+func (l *PostgresLocker) TryLock(ctx context.Context, key string, _ time.Duration) (func(), bool, error) {
+	conn, err := l.pg.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	var ok bool
+	if err := conn.QueryRow(ctx, `select pg_try_advisory_lock(hashtextextended($1, 0))`, lockKey(key)).Scan(&ok); err != nil {
+		conn.Release()
+		return nil, false, err
+	}
+	if !ok {
+		conn.Release()
+		return nil, false, nil
+	}
+	unlock := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		var released bool
+		err := conn.QueryRow(ctx, `select pg_advisory_unlock(hashtextextended($1, 0))`, lockKey(key)).Scan(&released)
+		if err != nil || !released {
+			// Never hand a connection that may still hold the lock back to the pool.
+			_ = conn.Conn().Close(ctx)
+		}
+		conn.Release()
+	}
+	return unlock, true, nil
+}
+
+func lockKey(key string) string {
+	return "lock:" + key
+}
@@ -4,34 +4,63 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"nexus/internal/dto"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 )
 
 type Repository struct {
-	pg    *pgxpool.Pool
-	redis *redis.Client
+	pg *pgxpool.Pool
+	// readPG is the replica pool for read-heavy queries; nil reads from pg. See readPool.
+	readPG       *pgxpool.Pool
+	recentWrites sync.Map     // user id -> time of the user's last track write through this instance
+	lastPrune    atomic.Int64 // unix nanos of the last sweep of expired recentWrites entries
+	redis        *redis.Client
+	historyLimit int
+	maxPoints    int
+	downsample   bool
+	defaultTZ    string
 }
 
 func NewRepository(ctx context.Context, cfg Config) (*Repository, error) {
-	repo := &Repository{}
+	repo := &Repository{
+		historyLimit: cfg.AnalysisHistoryLimit,
+		maxPoints:    cfg.MaxTrackPoints,
+		downsample:   cfg.DownsampleTrackPoints,
+		defaultTZ:    cfg.DefaultTZ,
+	}
+	if repo.maxPoints <= 0 {
+		repo.maxPoints = defaultMaxTrackPoints
+	}
+	if repo.defaultTZ == "" {
+		repo.defaultTZ = "UTC"
+	}
 
 	if cfg.PostgresURL != "" {
-		pg, err := pgxpool.New(ctx, cfg.PostgresURL)
+		pg, err := newPool(ctx, "postgres", cfg.PostgresURL, cfg)
 		if err != nil {
 			return nil, err
 		}
-		if err := pg.Ping(ctx); err != nil {
-			pg.Close()
-			return nil, err
-		}
 		repo.pg = pg
+		if cfg.ReadReplicaURL != "" {
+			readPG, err := newPool(ctx, "postgres read replica", cfg.ReadReplicaURL, cfg)
+			if err != nil {
+				pg.Close()
+				return nil, err
+			}
+			repo.readPG = readPG
+		}
 	}
 
 	if cfg.RedisAddr != "" {
@@ -53,10 +82,124 @@ func NewRepository(ctx context.Context, cfg Config) (*Repository, error) {
 	return repo, nil
 }
 
+func newPool(ctx context.Context, name, url string, cfg Config) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		return nil, err
+	}
+	applyPoolConfig(poolCfg, cfg)
+	log.Printf("%s pool: max_conns=%d min_conns=%d max_conn_lifetime=%s max_conn_idle_time=%s",
+		name, poolCfg.MaxConns, poolCfg.MinConns, poolCfg.MaxConnLifetime, poolCfg.MaxConnIdleTime)
+	pg, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := pg.Ping(ctx); err != nil {
+		pg.Close()
+		return nil, err
+	}
+	return pg, nil
+}
+
+// replicaStickiness is how long a user's reads stay on the primary after a track write, so the
+// re-analysis that Track starts (bounded by two minutes) does not miss the new point to replica lag.
+const replicaStickiness = 2 * time.Minute
+
+// readPool returns the pool for read-only queries that tolerate replica lag: the replica when one
+// is configured, the primary otherwise. Writes always go to r.pg.
+func (r *Repository) readPool() *pgxpool.Pool {
+	if r.readPG != nil {
+		return r.readPG
+	}
+	return r.pg
+}
+
+// readPoolFor is readPool for the user's own data: it stays on the primary for replicaStickiness
+// after the user's last track write through this instance.
+func (r *Repository) readPoolFor(userID int32) *pgxpool.Pool {
+	if r.readPG == nil {
+		return r.pg
+	}
+	if v, ok := r.recentWrites.Load(userID); ok {
+		if time.Since(v.(time.Time)) < replicaStickiness {
+			return r.pg
+		}
+		r.recentWrites.Delete(userID)
+	}
+	return r.readPG
+}
+
+// markWrite pins the user's reads to the primary for replicaStickiness; a no-op without a replica.
+// Entries of users who never read again would stay forever, so at most once per replicaStickiness a
+// write also sweeps the expired ones; the map then holds only the writers of the last two windows.
+func (r *Repository) markWrite(userID int32) {
+	if r.readPG == nil {
+		return
+	}
+	now := time.Now()
+	r.recentWrites.Store(userID, now)
+	last := r.lastPrune.Load()
+	if now.Sub(time.Unix(0, last)) < replicaStickiness || !r.lastPrune.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	r.recentWrites.Range(func(k, v any) bool {
+		if now.Sub(v.(time.Time)) >= replicaStickiness {
+			r.recentWrites.CompareAndDelete(k, v)
+		}
+		return true
+	})
+}
+
+func applyPoolConfig(pc *pgxpool.Config, cfg Config) {
+	pc.MaxConns = defaultMaxConns
+	if cfg.MaxConns > 0 {
+		pc.MaxConns = cfg.MaxConns
+	}
+	pc.MinConns = defaultMinConns
+	if cfg.MinConns > 0 {
+		pc.MinConns = cfg.MinConns
+	}
+	if pc.MinConns > pc.MaxConns {
+		pc.MinConns = pc.MaxConns
+	}
+	pc.MaxConnLifetime = defaultMaxConnLifetime
+	if cfg.MaxConnLifetime > 0 {
+		pc.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	pc.MaxConnIdleTime = defaultMaxConnIdleTime
+	if cfg.MaxConnIdleTime > 0 {
+		pc.MaxConnIdleTime = cfg.MaxConnIdleTime
+	}
+}
+
+// Ping checks every configured backend: the primary pool, the read replica and Redis. It backs the
+// readiness probes, so a backend that is not configured is not an error.
+func (r *Repository) Ping(ctx context.Context) error {
+	if r.pg != nil {
+		if err := r.pg.Ping(ctx); err != nil {
+			return fmt.Errorf("postgres: %w", err)
+		}
+	}
+	if r.readPG != nil {
+		if err := r.readPG.Ping(ctx); err != nil {
+			return fmt.Errorf("postgres read replica: %w", err)
+		}
+	}
+	if r.redis != nil {
+		if err := r.redis.Ping(ctx).Err(); err != nil {
+			return fmt.Errorf("redis: %w", err)
+		}
+	}
+	return nil
+}
+
 func (r *Repository) Close() {
 	if r.pg != nil {
 		r.pg.Close()
 	}
+	if r.readPG != nil {
+		r.readPG.Close()
+	}
 	if r.redis != nil {
 		_ = r.redis.Close()
 	}
@@ -91,6 +234,79 @@ func (r *Repository) CacheResponse(ctx context.Context, key string, resp dto.Ana
 	return r.redis.Set(ctx, cacheKey(key), raw, ttl).Err()
 }
 
+func (r *Repository) GetSharedInsight(ctx context.Context, hash string) (string, bool, error) {
+	if r.redis == nil || hash == "" {
+		return "", false, nil
+	}
+	text, err := r.redis.Get(ctx, sharedInsightKey(hash)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return text, true, nil
+}
+
+func (r *Repository) CacheSharedInsight(ctx context.Context, hash, text string, ttl time.Duration) error {
+	if r.redis == nil || hash == "" || text == "" || ttl <= 0 {
+		return nil
+	}
+	return r.redis.Set(ctx, sharedInsightKey(hash), text, ttl).Err()
+}
+
+// GetUserInsight reads a per-user cached insight; the hash covers the notes, so it is never shared across users.
+func (r *Repository) GetUserInsight(ctx context.Context, userID int32, hash string) (string, bool, error) {
+	if r.redis == nil || userID <= 0 || hash == "" {
+		return "", false, nil
+	}
+	text, err := r.redis.Get(ctx, userInsightKey(userID, hash)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return text, true, nil
+}
+
+func (r *Repository) CacheUserInsight(ctx context.Context, userID int32, hash, text string, ttl time.Duration) error {
+	if r.redis == nil || userID <= 0 || hash == "" || text == "" || ttl <= 0 {
+		return nil
+	}
+	return r.redis.Set(ctx, userInsightKey(userID, hash), text, ttl).Err()
+}
+
+// GetTomorrowSchedule reads the precomputed next-day schedule; a miss is (nil, false, nil).
+func (r *Repository) GetTomorrowSchedule(ctx context.Context, userID int32) (*dto.TomorrowSchedule, bool, error) {
+	if r.redis == nil || userID <= 0 {
+		return nil, false, nil
+	}
+	raw, err := r.redis.Get(ctx, tomorrowScheduleKey(userID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	var s dto.TomorrowSchedule
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, false, err
+	}
+	return &s, true, nil
+}
+
+func (r *Repository) CacheTomorrowSchedule(ctx context.Context, userID int32, s dto.TomorrowSchedule, ttl time.Duration) error {
+	if r.redis == nil || userID <= 0 || ttl <= 0 {
+		return nil
+	}
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return r.redis.Set(ctx, tomorrowScheduleKey(userID), raw, ttl).Err()
+}
+
 func (r *Repository) SaveAnalysis(ctx context.Context, key string, req dto.AnalyzeRequest, resp dto.AnalyzeResponse) error {
 	if r.pg == nil || key == "" {
 		return nil
@@ -104,17 +320,50 @@ func (r *Repository) SaveAnalysis(ctx context.Context, key string, req dto.Analy
 		return err
 	}
 
-	_, err = r.pg.Exec(ctx, `
-		insert into analyses (id, request, response, created_at)
-		values ($1, $2, $3, now())
-		on conflict (id) do update
-		set request = excluded.request,
-		    response = excluded.response,
-		    created_at = excluded.created_at
-	`, key, reqJSON, respJSON)
-	return err
+	period := string(req.Period)
+	if period == "" {
+		period = "all"
+	}
+	var userID *int32
+	if req.UserID > 0 {
+		userID = &req.UserID
+	}
+
+	if userID == nil || r.historyLimit <= 0 {
+		_, err = r.pg.Exec(ctx, saveAnalysisSQL, key, userID, period, reqJSON, respJSON)
+		return err
+	}
+	return r.WithTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, saveAnalysisSQL, key, userID, period, reqJSON, respJSON); err != nil {
+			return err
+		}
+		// Evict the user's oldest analyses for this period beyond the configured history limit.
+		_, err := tx.Exec(ctx, `
+			delete from analyses
+			where user_id = $1 and period = $2
+			  and id not in (
+				select id from analyses
+				where user_id = $1 and period = $2
+				order by created_at desc, id
+				limit $3
+			  )
+		`, *userID, period, r.historyLimit)
+		return err
+	})
 }
 
+// saveAnalysisSQL upserts one analysis row; the cache key is the id, so a re-run refreshes created_at.
+const saveAnalysisSQL = `
+	insert into analyses (id, user_id, period, request, response, created_at)
+	values ($1, $2, $3, $4, $5, now())
+	on conflict (id) do update
+	set user_id = excluded.user_id,
+	    period = excluded.period,
+	    request = excluded.request,
+	    response = excluded.response,
+	    created_at = excluded.created_at
+`
+
 func (r *Repository) SaveTrackPoints(ctx context.Context, userID int32, pts []dto.TrackPoint) (int, error) {
 	if r.pg == nil {
 		return 0, errors.New("repository: postgres not configured")
@@ -122,6 +371,7 @@ func (r *Repository) SaveTrackPoints(ctx context.Context, userID int32, pts []dt
 	if userID <= 0 || len(pts) == 0 {
 		return 0, nil
 	}
+	r.markWrite(userID)
 
 	batch := &pgx.Batch{}
 	for _, p := range pts {
@@ -130,13 +380,17 @@ func (r *Repository) SaveTrackPoints(ctx context.Context, userID int32, pts []dt
 			insert into track_points (
 				user_id, ts, sleep_hours, sleep_start, sleep_end, mood, activity, productive,
 				stress, energy, concentration, sleep_quality,
-				caffeine, alcohol, workout, llm_text, time_bucket_5m
+				caffeine, alcohol, workout, llm_text, time_bucket_5m,
+				sleep_start_ts, sleep_end_ts, tags, nap_minutes, context
 			)
-			values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+			values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
 			on conflict (user_id, time_bucket_5m) do nothing
-		`, userID, p.TS, p.SleepHours, p.SleepStart, p.SleepEnd, p.Mood, p.Activity, p.Productive,
-			p.Stress, p.Energy, p.Concentration, p.SleepQuality,
-			p.Caffeine, p.Alcohol, p.Workout, p.LLMText, bucket)
+		`, userID, p.TS, optionalArg(p, "sleep_hours", p.SleepHours), p.SleepStart, p.SleepEnd,
+			optionalArg(p, "mood", p.Mood), optionalArg(p, "activity", p.Activity), optionalArg(p, "productive", p.Productive),
+			optionalArg(p, "stress", p.Stress), optionalArg(p, "energy", p.Energy),
+			optionalArg(p, "concentration", p.Concentration), optionalArg(p, "sleep_quality", p.SleepQuality),
+			p.Caffeine, p.Alcohol, p.Workout, p.LLMText, bucket,
+			p.SleepStartTS, p.SleepEndTS, tagsArg(p.Tags), p.NapMinutes, contextArg(p.Context))
 	}
 
 	br := r.pg.SendBatch(ctx, batch)
@@ -153,7 +407,84 @@ func (r *Repository) SaveTrackPoints(ctx context.Context, userID int32, pts []dt
 	return inserted, nil
 }
 
-func (r *Repository) GetTrackPoints(ctx context.Context, userID int32, from, to time.Time) ([]dto.TrackPoint, error) {
+const trackPointColumns = `ts, sleep_hours, sleep_start, sleep_end, mood, activity, productive,
+		       stress, energy, concentration, sleep_quality,
+		       caffeine, alcohol, workout, llm_text, analysis_status,
+		       sleep_start_ts, sleep_end_ts, tags, nap_minutes, context`
+
+// scanTrackPoint reads a row selected with trackPointColumns. NULL ratings come back as 0
+// and are listed in TrackPoint.Missing so they are not mistaken for a real 0.
+func scanTrackPoint(row pgx.Row) (dto.TrackPoint, error) {
+	var p dto.TrackPoint
+	var sleepHours, mood, activity, productive, stress, energy, concentration, sleepQuality pgtype.Float8
+	if err := row.Scan(
+		&p.TS, &sleepHours, &p.SleepStart, &p.SleepEnd, &mood, &activity, &productive,
+		&stress, &energy, &concentration, &sleepQuality,
+		&p.Caffeine, &p.Alcohol, &p.Workout, &p.LLMText, &p.AnalysisStatus,
+		&p.SleepStartTS, &p.SleepEndTS, &p.Tags, &p.NapMinutes, &p.Context,
+	); err != nil {
+		return dto.TrackPoint{}, err
+	}
+	for _, f := range []struct {
+		name string
+		src  pgtype.Float8
+		dst  *float64
+	}{
+		{"sleep_hours", sleepHours, &p.SleepHours},
+		{"mood", mood, &p.Mood},
+		{"activity", activity, &p.Activity},
+		{"productive", productive, &p.Productive},
+		{"stress", stress, &p.Stress},
+		{"energy", energy, &p.Energy},
+		{"concentration", concentration, &p.Concentration},
+		{"sleep_quality", sleepQuality, &p.SleepQuality},
+	} {
+		if f.src.Valid {
+			*f.dst = f.src.Float64
+			continue
+		}
+		if p.Missing == nil {
+			p.Missing = map[string]bool{}
+		}
+		p.Missing[f.name] = true
+	}
+	return p, nil
+}
+
+// tagsArg stores a point without tags as an empty array, never NULL.
+func tagsArg(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// contextArg stores a point without context as an empty object, never NULL or JSON null.
+func contextArg(c map[string]string) map[string]string {
+	if c == nil {
+		return map[string]string{}
+	}
+	return c
+}
+
+// nullableTags turns "no filter" into NULL for the tags @> $n condition.
+func nullableTags(tags []string) any {
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// optionalArg returns nil for a field the point does not have, so it is stored as NULL.
+func optionalArg(p dto.TrackPoint, field string, v float64) any {
+	if !p.Has(field) {
+		return nil
+	}
+	return v
+}
+
+// GetTrackPoints returns the user's points in [from, to]; non-empty tags keeps only points carrying all of them.
+func (r *Repository) GetTrackPoints(ctx context.Context, userID int32, from, to time.Time, tags []string) ([]dto.TrackPoint, error) {
 	if r.pg == nil {
 		return nil, errors.New("repository: postgres not configured")
 	}
@@ -161,14 +492,52 @@ func (r *Repository) GetTrackPoints(ctx context.Context, userID int32, from, to
 		return nil, errors.New("repository: invalid user id")
 	}
 
-	rows, err := r.pg.Query(ctx, `
-		select ts, sleep_hours, sleep_start, sleep_end, mood, activity, productive,
-		       stress, energy, concentration, sleep_quality,
-		       caffeine, alcohol, workout, llm_text, analysis_status
-		from track_points
-		where user_id = $1 and ts >= $2 and ts <= $3
+	// One row past the cap tells a full range from an oversized one.
+	out, err := r.queryTrackPoints(ctx, userID, `
+		select `+trackPointColumns+`
+		from (
+			select * from track_points
+			where user_id = $1 and ts >= $2 and ts <= $3
+			  and ($4::text[] is null or tags @> $4)
+			order by ts desc
+			limit $5
+		) t
 		order by ts asc
-	`, userID, from, to)
+	`, userID, from, to, nullableTags(tags), r.maxPoints+1)
+	if err != nil || len(out) <= r.maxPoints {
+		return out, err
+	}
+	if !r.downsample {
+		log.Printf("repository: user %d has more than %d track points in [%s, %s]; keeping the newest",
+			userID, r.maxPoints, from.Format(time.RFC3339), to.Format(time.RFC3339))
+		return out[1:], nil
+	}
+	log.Printf("repository: user %d has more than %d track points in [%s, %s]; downsampling to one per day",
+		userID, r.maxPoints, from.Format(time.RFC3339), to.Format(time.RFC3339))
+	// The latest point of each logical day in the user's zone, the newest maxPoints days at most. A missing
+	// or unknown saved zone falls back to the default one instead of failing the cast.
+	return r.queryTrackPoints(ctx, userID, `
+		with s as (
+			select coalesce((select u.user_tz from user_settings u join pg_timezone_names z on z.name = u.user_tz
+			                 where u.user_id = $1), $6) as tz,
+			       coalesce((select day_start_hour from user_settings where user_id = $1), 0) as day_start
+		)
+		select `+trackPointColumns+`
+		from (
+			select distinct on (local_day) t.*,
+			       ((t.ts at time zone s.tz) - make_interval(hours => s.day_start))::date as local_day
+			from track_points t cross join s
+			where t.user_id = $1 and t.ts >= $2 and t.ts <= $3
+			  and ($4::text[] is null or t.tags @> $4)
+			order by local_day desc, t.ts desc
+			limit $5
+		) d
+		order by ts asc
+	`, userID, from, to, nullableTags(tags), r.maxPoints, r.defaultTZ)
+}
+
+func (r *Repository) queryTrackPoints(ctx context.Context, userID int32, sql string, args ...any) ([]dto.TrackPoint, error) {
+	rows, err := r.readPoolFor(userID).Query(ctx, sql, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -176,12 +545,8 @@ func (r *Repository) GetTrackPoints(ctx context.Context, userID int32, from, to
 
 	var out []dto.TrackPoint
 	for rows.Next() {
-		var p dto.TrackPoint
-		if err := rows.Scan(
-			&p.TS, &p.SleepHours, &p.SleepStart, &p.SleepEnd, &p.Mood, &p.Activity, &p.Productive,
-			&p.Stress, &p.Energy, &p.Concentration, &p.SleepQuality,
-			&p.Caffeine, &p.Alcohol, &p.Workout, &p.LLMText, &p.AnalysisStatus,
-		); err != nil {
+		p, err := scanTrackPoint(rows)
+		if err != nil {
 			return nil, err
 		}
 		out = append(out, p)
@@ -192,6 +557,36 @@ func (r *Repository) GetTrackPoints(ctx context.Context, userID int32, from, to
 	return out, nil
 }
 
+// GetTrackDays returns the distinct logical days (YYYY-MM-DD in tz, days starting at dayStartHour)
+// that have points in [from, to), in ascending order.
+func (r *Repository) GetTrackDays(ctx context.Context, userID int32, from, to time.Time, tz string, dayStartHour int) ([]string, error) {
+	if r.pg == nil {
+		return nil, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("repository: invalid user id")
+	}
+	rows, err := r.readPoolFor(userID).Query(ctx, `
+		select distinct to_char((ts at time zone $4) - make_interval(hours => $5), 'YYYY-MM-DD') as day
+		from track_points
+		where user_id = $1 and ts >= $2 and ts < $3
+		order by day asc
+	`, userID, from, to, tz, dayStartHour)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []string
+	for rows.Next() {
+		var day string
+		if err := rows.Scan(&day); err != nil {
+			return nil, err
+		}
+		out = append(out, day)
+	}
+	return out, rows.Err()
+}
+
 func (r *Repository) GetTrackPointForDay(ctx context.Context, userID int32, from, to time.Time) (dto.TrackPoint, bool, error) {
 	if r.pg == nil {
 		return dto.TrackPoint{}, false, errors.New("repository: postgres not configured")
@@ -199,20 +594,13 @@ func (r *Repository) GetTrackPointForDay(ctx context.Context, userID int32, from
 	if userID <= 0 {
 		return dto.TrackPoint{}, false, errors.New("repository: invalid user id")
 	}
-	var p dto.TrackPoint
-	err := r.pg.QueryRow(ctx, `
-		select ts, sleep_hours, sleep_start, sleep_end, mood, activity, productive,
-		       stress, energy, concentration, sleep_quality,
-		       caffeine, alcohol, workout, llm_text, analysis_status
+	p, err := scanTrackPoint(r.pg.QueryRow(ctx, `
+		select `+trackPointColumns+`
 		from track_points
 		where user_id = $1 and ts >= $2 and ts < $3
 		order by ts desc
 		limit 1
-	`, userID, from, to).Scan(
-		&p.TS, &p.SleepHours, &p.SleepStart, &p.SleepEnd, &p.Mood, &p.Activity, &p.Productive,
-		&p.Stress, &p.Energy, &p.Concentration, &p.SleepQuality,
-		&p.Caffeine, &p.Alcohol, &p.Workout, &p.LLMText, &p.AnalysisStatus,
-	)
+	`, userID, from, to))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return dto.TrackPoint{}, false, nil
@@ -229,6 +617,7 @@ func (r *Repository) UpsertTrackPointForDay(ctx context.Context, userID int32, p
 	if userID <= 0 {
 		return false, errors.New("repository: invalid user id")
 	}
+	r.markWrite(userID)
 	var id int64
 	err := r.pg.QueryRow(ctx, `
 		select id from track_points
@@ -256,13 +645,21 @@ func (r *Repository) UpsertTrackPointForDay(ctx context.Context, userID int32, p
 			    workout = $15,
 			    llm_text = $16,
 			    time_bucket_5m = $17,
+			    sleep_start_ts = $18,
+			    sleep_end_ts = $19,
+			    tags = $20,
+			    nap_minutes = $21,
+			    context = $22,
 			    analysis_status = 'pending',
 			    analysis_updated_at = now(),
 			    analysis_error = ''
 			where id = $1
-		`, id, p.TS, p.SleepHours, p.SleepStart, p.SleepEnd, p.Mood, p.Activity, p.Productive,
-			p.Stress, p.Energy, p.Concentration, p.SleepQuality,
-			p.Caffeine, p.Alcohol, p.Workout, p.LLMText, bucket)
+		`, id, p.TS, optionalArg(p, "sleep_hours", p.SleepHours), p.SleepStart, p.SleepEnd,
+			optionalArg(p, "mood", p.Mood), optionalArg(p, "activity", p.Activity), optionalArg(p, "productive", p.Productive),
+			optionalArg(p, "stress", p.Stress), optionalArg(p, "energy", p.Energy),
+			optionalArg(p, "concentration", p.Concentration), optionalArg(p, "sleep_quality", p.SleepQuality),
+			p.Caffeine, p.Alcohol, p.Workout, p.LLMText, bucket,
+			p.SleepStartTS, p.SleepEndTS, tagsArg(p.Tags), p.NapMinutes, contextArg(p.Context))
 		if err != nil {
 			return false, err
 		}
@@ -276,18 +673,93 @@ func (r *Repository) UpsertTrackPointForDay(ctx context.Context, userID int32, p
 			user_id, ts, sleep_hours, sleep_start, sleep_end, mood, activity, productive,
 			stress, energy, concentration, sleep_quality,
 			caffeine, alcohol, workout, llm_text, time_bucket_5m,
+			sleep_start_ts, sleep_end_ts, tags, nap_minutes, context,
 			analysis_status, analysis_updated_at, analysis_error
 		)
-		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, 'pending', now(), '')
-	`, userID, p.TS, p.SleepHours, p.SleepStart, p.SleepEnd, p.Mood, p.Activity, p.Productive,
-		p.Stress, p.Energy, p.Concentration, p.SleepQuality,
-		p.Caffeine, p.Alcohol, p.Workout, p.LLMText, bucket)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, 'pending', now(), '')
+	`, userID, p.TS, optionalArg(p, "sleep_hours", p.SleepHours), p.SleepStart, p.SleepEnd,
+		optionalArg(p, "mood", p.Mood), optionalArg(p, "activity", p.Activity), optionalArg(p, "productive", p.Productive),
+		optionalArg(p, "stress", p.Stress), optionalArg(p, "energy", p.Energy),
+		optionalArg(p, "concentration", p.Concentration), optionalArg(p, "sleep_quality", p.SleepQuality),
+		p.Caffeine, p.Alcohol, p.Workout, p.LLMText, bucket,
+		p.SleepStartTS, p.SleepEndTS, tagsArg(p.Tags), p.NapMinutes, contextArg(p.Context))
 	if err != nil {
 		return false, err
 	}
 	return false, nil
 }
 
+// patchableTrackColumns is the whitelist of track_points columns PatchTrackPointForDay may touch.
+// llm_text is left out on purpose: a note reaches the LLM, so it is only written through Track.
+var patchableTrackColumns = map[string]struct{}{
+	"sleep_hours":   {},
+	"sleep_start":   {},
+	"sleep_end":     {},
+	"mood":          {},
+	"activity":      {},
+	"productive":    {},
+	"stress":        {},
+	"energy":        {},
+	"concentration": {},
+	"sleep_quality": {},
+	"caffeine":      {},
+	"alcohol":       {},
+	"workout":       {},
+	"nap_minutes":   {},
+}
+
+func (r *Repository) PatchTrackPointForDay(ctx context.Context, userID int32, from, to time.Time, fields map[string]any) (bool, error) {
+	if r.pg == nil {
+		return false, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return false, errors.New("repository: invalid user id")
+	}
+	if len(fields) == 0 {
+		return false, errors.New("repository: no fields to patch")
+	}
+	r.markWrite(userID)
+
+	cols := make([]string, 0, len(fields))
+	for col := range fields {
+		if _, ok := patchableTrackColumns[col]; !ok {
+			return false, fmt.Errorf("repository: field %q is not patchable", col)
+		}
+		cols = append(cols, col)
+	}
+	sort.Strings(cols)
+
+	args := []any{userID, from, to}
+	set := make([]string, 0, len(cols)+3)
+	for _, col := range cols {
+		args = append(args, fields[col])
+		set = append(set, fmt.Sprintf("%s = $%d", col, len(args)))
+	}
+	for _, col := range []string{"sleep_hours", "sleep_start", "sleep_end"} {
+		if _, ok := fields[col]; ok {
+			// A manual sleep correction supersedes the wearable interval.
+			set = append(set, "sleep_start_ts = null", "sleep_end_ts = null")
+			break
+		}
+	}
+	set = append(set, "analysis_status = 'pending'", "analysis_updated_at = now()", "analysis_error = ''")
+
+	ct, err := r.pg.Exec(ctx, `
+		update track_points
+		set `+strings.Join(set, ",\n\t\t    ")+`
+		where id = (
+		  select id from track_points
+		  where user_id = $1 and ts >= $2 and ts < $3
+		  order by ts desc
+		  limit 1
+		)
+	`, args...)
+	if err != nil {
+		return false, err
+	}
+	return ct.RowsAffected() > 0, nil
+}
+
 func (r *Repository) ListUsersWithTrackPoints(ctx context.Context) ([]int32, error) {
 	if r.pg == nil {
 		return nil, errors.New("repository: postgres not configured")
@@ -311,6 +783,58 @@ func (r *Repository) ListUsersWithTrackPoints(ctx context.Context) ([]int32, err
 	return out, nil
 }
 
+// GetLatestTrackTS returns the timestamp of the user's most recent track point; ok is false when there are none.
+func (r *Repository) GetLatestTrackTS(ctx context.Context, userID int32) (time.Time, bool, error) {
+	if r.pg == nil {
+		return time.Time{}, false, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return time.Time{}, false, errors.New("repository: invalid user id")
+	}
+	var ts *time.Time
+	if err := r.pg.QueryRow(ctx, `select max(ts) from track_points where user_id = $1`, userID).Scan(&ts); err != nil {
+		return time.Time{}, false, err
+	}
+	if ts == nil {
+		return time.Time{}, false, nil
+	}
+	return *ts, true, nil
+}
+
+// GetFirstTrackTS returns the timestamp of the user's first track point; ok is false when there are none.
+func (r *Repository) GetFirstTrackTS(ctx context.Context, userID int32) (time.Time, bool, error) {
+	if r.pg == nil {
+		return time.Time{}, false, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return time.Time{}, false, errors.New("repository: invalid user id")
+	}
+	var ts *time.Time
+	if err := r.pg.QueryRow(ctx, `select min(ts) from track_points where user_id = $1`, userID).Scan(&ts); err != nil {
+		return time.Time{}, false, err
+	}
+	if ts == nil {
+		return time.Time{}, false, nil
+	}
+	return *ts, true, nil
+}
+
+// CountTrackPoints counts the user's track points, stopping at limit: callers only need to know whether
+// the user has reached a threshold, and a long history is not scanned to the end.
+func (r *Repository) CountTrackPoints(ctx context.Context, userID int32, limit int) (int, error) {
+	if r.pg == nil {
+		return 0, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return 0, errors.New("repository: invalid user id")
+	}
+	var n int
+	err := r.pg.QueryRow(ctx, `
+		select count(*) from (select 1 from track_points where user_id = $1 limit $2) p
+	`, userID, limit).Scan(&n)
+	return n, err
+}
+
 func (r *Repository) SetAnalysisStatusForDay(ctx context.Context, userID int32, from, to time.Time, status, errText string) error {
 	if r.pg == nil {
 		return errors.New("repository: postgres not configured")
@@ -331,58 +855,185 @@ func (r *Repository) SetAnalysisStatusForDay(ctx context.Context, userID int32,
 	return err
 }
 
-func (r *Repository) GetUserProfile(ctx context.Context, userID int32) (dto.UserProfile, error) {
+// HasPendingAnalysis reports whether any of the user's days is marked pending since the given time.
+func (r *Repository) HasPendingAnalysis(ctx context.Context, userID int32, since time.Time) (bool, error) {
 	if r.pg == nil {
-		return dto.UserProfile{}, errors.New("repository: postgres not configured")
+		return false, errors.New("repository: postgres not configured")
 	}
 	if userID <= 0 {
-		return dto.UserProfile{}, errors.New("repository: invalid user id")
+		return false, errors.New("repository: invalid user id")
 	}
-	var p dto.UserProfile
+	var pending bool
 	err := r.pg.QueryRow(ctx, `
-		select u.id, u.name, u.email,
-		       coalesce(s.avatar_emoji, '') as emoji,
-		       coalesce(s.avatar_bg, 0) as bg
-		from users u
-		left join user_settings s on s.user_id = u.id
-		where u.id = $1
-	`, userID).Scan(&p.UserID, &p.Name, &p.Email, &p.Emoji, &p.BgIndex)
-	if err != nil {
-		return dto.UserProfile{}, err
-	}
-	return p, nil
+		select exists (
+			select 1 from track_points
+			where user_id = $1 and analysis_status = 'pending' and analysis_updated_at >= $2
+		)
+	`, userID, since).Scan(&pending)
+	return pending, err
 }
 
-func (r *Repository) UpdateUserProfile(ctx context.Context, userID int32, emoji string, bgIndex int32) (dto.UserProfile, error) {
+// EnqueueFailedAnalysis queues a day whose async analysis failed. A fresh failure of an already queued day
+// (e.g. the user logged again) restarts its retry budget.
+func (r *Repository) EnqueueFailedAnalysis(ctx context.Context, userID int32, userTZ string, from, to, nextAttempt time.Time, errText string) error {
 	if r.pg == nil {
-		return dto.UserProfile{}, errors.New("repository: postgres not configured")
+		return errors.New("repository: postgres not configured")
 	}
 	if userID <= 0 {
-		return dto.UserProfile{}, errors.New("repository: invalid user id")
+		return errors.New("repository: invalid user id")
 	}
 	_, err := r.pg.Exec(ctx, `
-		insert into user_settings (user_id, avatar_emoji, avatar_bg, updated_at)
-		values ($1, $2, $3, now())
-		on conflict (user_id) do update
-		set avatar_emoji = excluded.avatar_emoji,
-		    avatar_bg = excluded.avatar_bg,
+		insert into failed_analyses (user_id, day_start, day_end, user_tz, attempts, last_error, next_attempt_at, dead, updated_at)
+		values ($1, $2, $3, $4, 0, $5, $6, false, now())
+		on conflict (user_id, day_start) do update
+		set day_end = excluded.day_end,
+		    user_tz = excluded.user_tz,
+		    attempts = 0,
+		    last_error = excluded.last_error,
+		    next_attempt_at = excluded.next_attempt_at,
+		    dead = false,
 		    updated_at = excluded.updated_at
-	`, userID, emoji, bgIndex)
-	if err != nil {
-		return dto.UserProfile{}, err
+	`, userID, from, to, userTZ, errText, nextAttempt)
+	return err
+}
+
+// ListDueFailedAnalyses returns live queue entries whose next attempt is at or before now, oldest first.
+func (r *Repository) ListDueFailedAnalyses(ctx context.Context, now time.Time, limit int) ([]dto.FailedAnalysis, error) {
+	if r.pg == nil {
+		return nil, errors.New("repository: postgres not configured")
 	}
-	return r.GetUserProfile(ctx, userID)
+	return r.listFailedAnalyses(ctx, `
+		select user_id, user_tz, day_start, day_end, attempts, last_error, next_attempt_at, dead, updated_at
+		from failed_analyses
+		where not dead and next_attempt_at <= $1
+		order by next_attempt_at asc
+		limit $2
+	`, now, limit)
 }
 
-func (r *Repository) GetUserProfileForViewer(ctx context.Context, viewerID, targetID int32) (dto.UserProfile, error) {
+// ListDeadFailedAnalyses returns entries that ran out of retries, most recent first.
+func (r *Repository) ListDeadFailedAnalyses(ctx context.Context, limit int) ([]dto.FailedAnalysis, error) {
 	if r.pg == nil {
-		return dto.UserProfile{}, errors.New("repository: postgres not configured")
+		return nil, errors.New("repository: postgres not configured")
 	}
-	if viewerID <= 0 || targetID <= 0 {
-		return dto.UserProfile{}, errors.New("repository: invalid user id")
+	return r.listFailedAnalyses(ctx, `
+		select user_id, user_tz, day_start, day_end, attempts, last_error, next_attempt_at, dead, updated_at
+		from failed_analyses
+		where dead
+		order by updated_at desc
+		limit $1
+	`, limit)
+}
+
+func (r *Repository) listFailedAnalyses(ctx context.Context, query string, args ...any) ([]dto.FailedAnalysis, error) {
+	rows, err := r.pg.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
 	}
-	if viewerID == targetID {
-		return r.GetUserProfile(ctx, targetID)
+	defer rows.Close()
+	var out []dto.FailedAnalysis
+	for rows.Next() {
+		var f dto.FailedAnalysis
+		if err := rows.Scan(&f.UserID, &f.UserTZ, &f.DayStart, &f.DayEnd, &f.Attempts, &f.LastError, &f.NextAttemptAt, &f.Dead, &f.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecordFailedAnalysisRetry stores the outcome of a failed retry: the new attempt count, when to try next
+// and whether the entry is now dead.
+func (r *Repository) RecordFailedAnalysisRetry(ctx context.Context, userID int32, dayStart time.Time, attempts int, nextAttempt time.Time, errText string, dead bool) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		update failed_analyses
+		set attempts = $3,
+		    next_attempt_at = $4,
+		    last_error = $5,
+		    dead = $6,
+		    updated_at = now()
+		where user_id = $1 and day_start = $2
+	`, userID, dayStart, attempts, nextAttempt, errText, dead)
+	return err
+}
+
+// DeleteFailedAnalysis removes a queued day once its analysis succeeded; a missing entry is not an error.
+func (r *Repository) DeleteFailedAnalysis(ctx context.Context, userID int32, dayStart time.Time) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `delete from failed_analyses where user_id = $1 and day_start = $2`, userID, dayStart)
+	return err
+}
+
+func (r *Repository) GetUserProfile(ctx context.Context, userID int32) (dto.UserProfile, error) {
+	if r.pg == nil {
+		return dto.UserProfile{}, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return dto.UserProfile{}, errors.New("repository: invalid user id")
+	}
+	var p dto.UserProfile
+	err := r.pg.QueryRow(ctx, `
+		select u.id, u.name, u.email,
+		       coalesce(s.avatar_emoji, '') as emoji,
+		       coalesce(s.avatar_bg, 0) as bg,
+		       coalesce(s.day_start_hour, 0) as day_start_hour,
+		       coalesce(s.insight_tone, '') as insight_tone,
+		       coalesce(s.share_analyses, false) as share_analyses,
+		       coalesce(s.analyses_enabled, true) as analyses_enabled
+		from users u
+		left join user_settings s on s.user_id = u.id
+		where u.id = $1
+	`, userID).Scan(&p.UserID, &p.Name, &p.Email, &p.Emoji, &p.BgIndex, &p.DayStartHour, &p.Tone, &p.ShareAnalyses, &p.AnalysesEnabled)
+	if err != nil {
+		return dto.UserProfile{}, err
+	}
+	return p, nil
+}
+
+func (r *Repository) UpdateUserProfile(ctx context.Context, userID int32, emoji string, bgIndex int32) (dto.UserProfile, error) {
+	if r.pg == nil {
+		return dto.UserProfile{}, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return dto.UserProfile{}, errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into user_settings (user_id, avatar_emoji, avatar_bg, updated_at)
+		values ($1, $2, $3, now())
+		on conflict (user_id) do update
+		set avatar_emoji = excluded.avatar_emoji,
+		    avatar_bg = excluded.avatar_bg,
+		    updated_at = excluded.updated_at
+	`, userID, emoji, bgIndex)
+	if err != nil {
+		return dto.UserProfile{}, err
+	}
+	return r.GetUserProfile(ctx, userID)
+}
+
+func (r *Repository) GetUserProfileForViewer(ctx context.Context, viewerID, targetID int32) (dto.UserProfile, error) {
+	if r.pg == nil {
+		return dto.UserProfile{}, errors.New("repository: postgres not configured")
+	}
+	if viewerID <= 0 || targetID <= 0 {
+		return dto.UserProfile{}, errors.New("repository: invalid user id")
+	}
+	if viewerID == targetID {
+		return r.GetUserProfile(ctx, targetID)
 	}
 	var isFriend bool
 	err := r.pg.QueryRow(ctx, `
@@ -413,7 +1064,7 @@ func (r *Repository) SearchUsers(ctx context.Context, query string, excludeUserI
 		limit = 20
 	}
 	q := "%" + query + "%"
-	rows, err := r.pg.Query(ctx, `
+	rows, err := r.readPool().Query(ctx, `
 		select u.id, u.name, u.email,
 		       coalesce(s.avatar_emoji, '') as emoji,
 		       coalesce(s.avatar_bg, 0) as bg,
@@ -446,11 +1097,32 @@ func (r *Repository) SearchUsers(ctx context.Context, query string, excludeUserI
 	return out, rows.Err()
 }
 
+func (r *Repository) GetUserByEmail(ctx context.Context, email string, excludeUserID int32) (dto.UserProfile, bool, error) {
+	if r.pg == nil {
+		return dto.UserProfile{}, false, errors.New("repository: postgres not configured")
+	}
+	var p dto.UserProfile
+	err := r.pg.QueryRow(ctx, `
+		select u.id, u.name, coalesce(s.avatar_emoji, '') as emoji
+		from users u
+		left join user_settings s on s.user_id = u.id
+		where lower(u.email) = lower($1) and u.id <> $2
+		limit 1
+	`, email, excludeUserID).Scan(&p.UserID, &p.Name, &p.Emoji)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dto.UserProfile{}, false, nil
+		}
+		return dto.UserProfile{}, false, err
+	}
+	return p, true, nil
+}
+
 func (r *Repository) ListFriends(ctx context.Context, userID int32) ([]dto.UserProfile, error) {
 	if r.pg == nil {
 		return nil, errors.New("repository: postgres not configured")
 	}
-	rows, err := r.pg.Query(ctx, `
+	rows, err := r.readPool().Query(ctx, `
 		select u.id, u.name, u.email,
 		       coalesce(s.avatar_emoji, '') as emoji,
 		       coalesce(s.avatar_bg, 0) as bg
@@ -476,45 +1148,232 @@ func (r *Repository) ListFriends(ctx context.Context, userID int32) ([]dto.UserP
 	return out, rows.Err()
 }
 
-func (r *Repository) CreateFriendRequest(ctx context.Context, fromUserID, toUserID int32) (dto.FriendRequest, error) {
+// ListFriendsEnriched lists friends with whether they logged since the start of their local day
+// (user_tz and day_start_hour) and the burnout level of their latest weekly analysis. Only the
+// level is read from the stored response, and only for friends who share their analyses, so no
+// entries or notes leave the friend's account.
+// A friend whose user_tz is empty or not a zone Postgres knows is placed in defaultTZ, so one
+// bad setting does not fail the whole list.
+func (r *Repository) ListFriendsEnriched(ctx context.Context, userID int32, defaultTZ string) ([]dto.FriendSummary, error) {
 	if r.pg == nil {
-		return dto.FriendRequest{}, errors.New("repository: postgres not configured")
+		return nil, errors.New("repository: postgres not configured")
 	}
-	if fromUserID <= 0 || toUserID <= 0 || fromUserID == toUserID {
-		return dto.FriendRequest{}, errors.New("repository: invalid user id")
+	if userID <= 0 {
+		return nil, errors.New("repository: invalid user id")
+	}
+	if defaultTZ == "" {
+		defaultTZ = "UTC"
+	}
+	rows, err := r.readPool().Query(ctx, `
+		with zones as (
+			select name from pg_timezone_names
+		)
+		select u.id, u.name, u.email,
+		       coalesce(s.avatar_emoji, '') as emoji,
+		       coalesce(s.avatar_bg, 0) as bg,
+		       coalesce(s.day_start_hour, 0) as day_start_hour,
+		       exists (
+		           select 1
+		           from track_points t
+		           where t.user_id = u.id
+		             and t.ts >= (date_trunc('day', now() at time zone tz.name
+		                                 - make_interval(hours => coalesce(s.day_start_hour, 0)))
+		                          + make_interval(hours => coalesce(s.day_start_hour, 0)))
+		                         at time zone tz.name
+		       ) as logged_today,
+		       case when coalesce(s.share_analyses, false)
+		            then coalesce(la.response->'burnout_risk'->>'level', '')
+		            else ''
+		       end as burnout_level
+		from friends f
+		join users u on u.id = f.friend_id
+		left join user_settings s on s.user_id = u.id
+		cross join lateral (
+			select coalesce((select z.name from zones z where z.name = s.user_tz), $2) as name
+		) tz
+		left join last_analyses la on la.user_id = u.id and la.period = 'week'
+		where f.user_id = $1
+		order by u.name asc
+	`, userID, defaultTZ)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dto.FriendSummary
+	for rows.Next() {
+		var f dto.FriendSummary
+		if err := rows.Scan(
+			&f.Profile.UserID, &f.Profile.Name, &f.Profile.Email, &f.Profile.Emoji, &f.Profile.BgIndex,
+			&f.Profile.DayStartHour, &f.LoggedToday, &f.BurnoutLevel,
+		); err != nil {
+			return nil, err
+		}
+		f.Profile.IsFriend = true
+		out = append(out, f)
+	}
+	return out, rows.Err()
+}
+
+// RecordFriendActivity stores a milestone event for the user's friends; an event with the same
+// dedupe key is recorded only once.
+func (r *Repository) RecordFriendActivity(ctx context.Context, userID int32, kind, value, dedupeKey string) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into friend_activity (user_id, kind, value, dedupe_key)
+		values ($1, $2, $3, $4)
+		on conflict (user_id, dedupe_key) do nothing
+	`, userID, kind, value, dedupeKey)
+	return err
+}
+
+// ListFriendActivity returns the newest events since the given time from the user's friends who
+// currently share their analyses; events of friends who turned sharing off are not returned.
+func (r *Repository) ListFriendActivity(ctx context.Context, userID int32, since time.Time, limit int) ([]dto.FriendActivity, error) {
+	if r.pg == nil {
+		return nil, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("repository: invalid user id")
+	}
+	rows, err := r.readPool().Query(ctx, `
+		select a.id, a.kind, a.value, a.created_at,
+		       u.id, u.name,
+		       coalesce(s.avatar_emoji, '') as emoji,
+		       coalesce(s.avatar_bg, 0) as bg
+		from friends f
+		join friend_activity a on a.user_id = f.friend_id
+		join users u on u.id = f.friend_id
+		join user_settings s on s.user_id = u.id and s.share_analyses
+		where f.user_id = $1 and a.created_at >= $2
+		order by a.created_at desc, a.id desc
+		limit $3
+	`, userID, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []dto.FriendActivity{}
+	for rows.Next() {
+		var ev dto.FriendActivity
+		if err := rows.Scan(
+			&ev.ID, &ev.Kind, &ev.Value, &ev.CreatedAt,
+			&ev.Friend.UserID, &ev.Friend.Name, &ev.Friend.Emoji, &ev.Friend.BgIndex,
+		); err != nil {
+			return nil, err
+		}
+		ev.Friend.IsFriend = true
+		out = append(out, ev)
+	}
+	return out, rows.Err()
+}
+
+// GetLastBurnoutLevel reads only the burnout level of the user's stored analysis for period; empty if none.
+func (r *Repository) GetLastBurnoutLevel(ctx context.Context, userID int32, period string) (string, error) {
+	if r.pg == nil {
+		return "", errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return "", errors.New("repository: invalid user id")
 	}
+	var level string
+	err := r.pg.QueryRow(ctx, `
+		select coalesce(response->'burnout_risk'->>'level', '')
+		from last_analyses
+		where user_id = $1 and period = $2
+	`, userID, period).Scan(&level)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	return level, err
+}
 
+// WithTx runs fn in a transaction: it commits when fn returns nil and rolls back on an error or panic.
+func (r *Repository) WithTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
 	tx, err := r.pg.Begin(ctx)
 	if err != nil {
-		return dto.FriendRequest{}, err
+		return err
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	// already friends?
-	var exists int
-	if err := tx.QueryRow(ctx, `
-		select 1 from friends where user_id=$1 and friend_id=$2
-	`, fromUserID, toUserID).Scan(&exists); err == nil {
-		return dto.FriendRequest{}, errors.New("already friends")
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *Repository) CreateFriendRequest(ctx context.Context, fromUserID, toUserID int32) (dto.FriendRequest, error) {
+	if r.pg == nil {
+		return dto.FriendRequest{}, errors.New("repository: postgres not configured")
+	}
+	if fromUserID <= 0 || toUserID <= 0 || fromUserID == toUserID {
+		return dto.FriendRequest{}, errors.New("repository: invalid user id")
 	}
 
 	var id int64
-	err = tx.QueryRow(ctx, `
-		insert into friend_requests (from_user_id, to_user_id, status)
-		values ($1, $2, 'pending')
-		on conflict (from_user_id, to_user_id) do update
-		set status = 'pending', created_at = now()
-		returning id
-	`, fromUserID, toUserID).Scan(&id)
-	if err != nil {
-		return dto.FriendRequest{}, err
-	}
+	status := "pending"
+	err := r.WithTx(ctx, func(tx pgx.Tx) error {
+		// Serialize requests between the same two users so two opposite requests sent at
+		// the same time cannot both miss each other and stay pending.
+		if _, err := tx.Exec(ctx, `
+			select pg_advisory_xact_lock(least($1::int, $2::int), greatest($1::int, $2::int))
+		`, fromUserID, toUserID); err != nil {
+			return err
+		}
+
+		// already friends?
+		var exists int
+		if err := tx.QueryRow(ctx, `
+			select 1 from friends where user_id=$1 and friend_id=$2
+		`, fromUserID, toUserID).Scan(&exists); err == nil {
+			return errors.New("already friends")
+		}
 
-	if err := tx.Commit(ctx); err != nil {
+		// A pending request the other way means both want it: accept instead of adding a mirror row.
+		var reverseID int64
+		err := tx.QueryRow(ctx, `
+			select id from friend_requests
+			where from_user_id = $1 and to_user_id = $2 and status = 'pending'
+		`, toUserID, fromUserID).Scan(&reverseID)
+		switch {
+		case err == nil:
+			status = "accepted"
+			if _, err := tx.Exec(ctx, `
+				insert into friends (user_id, friend_id)
+				values ($1, $2), ($2, $1)
+				on conflict do nothing
+			`, fromUserID, toUserID); err != nil {
+				return err
+			}
+			if _, err := tx.Exec(ctx, `
+				update friend_requests set status = 'accepted' where id = $1
+			`, reverseID); err != nil {
+				return err
+			}
+		case !errors.Is(err, pgx.ErrNoRows):
+			return err
+		}
+
+		return tx.QueryRow(ctx, `
+			insert into friend_requests (from_user_id, to_user_id, status)
+			values ($1, $2, $3)
+			on conflict (from_user_id, to_user_id) do update
+			set status = excluded.status, created_at = now()
+			returning id
+		`, fromUserID, toUserID, status).Scan(&id)
+	})
+	if err != nil {
 		return dto.FriendRequest{}, err
 	}
 
-	reqs, err := r.ListFriendRequests(ctx, toUserID, "pending")
+	reqs, err := r.ListFriendRequests(ctx, toUserID, status)
 	if err != nil {
 		return dto.FriendRequest{}, err
 	}
@@ -523,7 +1382,7 @@ func (r *Repository) CreateFriendRequest(ctx context.Context, fromUserID, toUser
 			return fr, nil
 		}
 	}
-	return dto.FriendRequest{ID: id, Status: "pending"}, nil
+	return dto.FriendRequest{ID: id, Status: status}, nil
 }
 
 func (r *Repository) ListFriendRequests(ctx context.Context, userID int32, status string) ([]dto.FriendRequest, error) {
@@ -568,62 +1427,80 @@ func (r *Repository) ListFriendRequests(ctx context.Context, userID int32, statu
 	return out, rows.Err()
 }
 
-func (r *Repository) RespondFriendRequest(ctx context.Context, userID int32, requestID int64, action string) error {
+// RespondFriendRequest accepts or declines a request addressed to userID and returns the status the
+// request had before: "" when it does not exist, "pending" when this call resolved it. An already
+// resolved request is left untouched. The request row is locked first, so concurrent responses
+// (a double click reaching two instances, or an accept racing a cancel) resolve it exactly once.
+func (r *Repository) RespondFriendRequest(ctx context.Context, userID int32, requestID int64, action string) (string, error) {
 	if r.pg == nil {
-		return errors.New("repository: postgres not configured")
+		return "", errors.New("repository: postgres not configured")
 	}
 	if userID <= 0 || requestID <= 0 {
-		return errors.New("repository: invalid input")
+		return "", errors.New("repository: invalid input")
 	}
 	action = strings.ToLower(strings.TrimSpace(action))
 	if action != "accept" && action != "decline" {
-		return errors.New("invalid action")
+		return "", errors.New("invalid action")
 	}
 
-	tx, err := r.pg.Begin(ctx)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
+	var prevStatus string
+	err := r.WithTx(ctx, func(tx pgx.Tx) error {
+		var fromID, toID int32
+		err := tx.QueryRow(ctx, `
+			select from_user_id, to_user_id, status
+			from friend_requests
+			where id = $1
+			for update
+		`, requestID).Scan(&fromID, &toID, &prevStatus)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				prevStatus = ""
+				return nil
+			}
+			return err
+		}
+		if toID != userID {
+			return errors.New("forbidden")
+		}
+		if prevStatus != "pending" {
+			return nil
+		}
 
-	var fromID, toID int32
-	err = tx.QueryRow(ctx, `
-		select from_user_id, to_user_id
-		from friend_requests
-		where id = $1 and status = 'pending'
-	`, requestID).Scan(&fromID, &toID)
-	if err != nil {
-		return err
-	}
-	if toID != userID {
-		return errors.New("forbidden")
-	}
+		if action == "accept" {
+			_, err = tx.Exec(ctx, `
+				insert into friends (user_id, friend_id)
+				values ($1, $2), ($2, $1)
+				on conflict do nothing
+			`, fromID, toID)
+			if err != nil {
+				return err
+			}
+		}
 
-	if action == "accept" {
+		newStatus := "declined"
+		if action == "accept" {
+			newStatus = "accepted"
+		}
 		_, err = tx.Exec(ctx, `
-			insert into friends (user_id, friend_id)
-			values ($1, $2), ($2, $1)
-			on conflict do nothing
-		`, fromID, toID)
-		if err != nil {
+			update friend_requests
+			set status = $1
+			where id = $2
+		`, newStatus, requestID)
+		if err != nil || action != "accept" {
 			return err
 		}
-	}
-
-	newStatus := "declined"
-	if action == "accept" {
-		newStatus = "accepted"
-	}
-	_, err = tx.Exec(ctx, `
-		update friend_requests
-		set status = $1
-		where id = $2
-	`, newStatus, requestID)
-	if err != nil {
+		// Resolve a mirror request left pending from before mutual requests were auto-accepted.
+		_, err = tx.Exec(ctx, `
+			update friend_requests
+			set status = 'accepted'
+			where from_user_id = $1 and to_user_id = $2 and status = 'pending'
+		`, toID, fromID)
 		return err
+	})
+	if err != nil {
+		return "", err
 	}
-
-	return tx.Commit(ctx)
+	return prevStatus, nil
 }
 
 func (r *Repository) UpsertLastAnalysis(ctx context.Context, userID int32, period string, resp dto.AnalyzeResponse) error {
@@ -641,12 +1518,49 @@ func (r *Repository) UpsertLastAnalysis(ctx context.Context, userID int32, perio
 		insert into last_analyses (user_id, period, response, updated_at)
 		values ($1, $2, $3, now())
 		on conflict (user_id, period) do update
-		set response = excluded.response,
+		set previous_averages = last_analyses.response->'averages',
+		    response = excluded.response,
 		    updated_at = excluded.updated_at
 	`, userID, period, b)
 	return err
 }
 
+// GetPreviousAverages returns, per period, the metric averages of the analysis that the latest one
+// replaced. Periods analyzed only once, or last replaced before averages were stored, are absent.
+func (r *Repository) GetPreviousAverages(ctx context.Context, userID int32) (map[string]map[string]float64, error) {
+	if r.pg == nil {
+		return nil, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("repository: invalid user id")
+	}
+	rows, err := r.pg.Query(ctx, `
+		select period, previous_averages
+		from last_analyses
+		where user_id = $1 and previous_averages is not null and previous_averages <> 'null'::jsonb
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make(map[string]map[string]float64)
+	for rows.Next() {
+		var period string
+		var b []byte
+		if err := rows.Scan(&period, &b); err != nil {
+			return nil, err
+		}
+		var avgs map[string]float64
+		if err := json.Unmarshal(b, &avgs); err != nil {
+			return nil, err
+		}
+		if len(avgs) > 0 {
+			out[period] = avgs
+		}
+	}
+	return out, rows.Err()
+}
+
 func (r *Repository) GetLastAnalyses(ctx context.Context, userID int32) (map[string]dto.AnalyzeResponse, map[string]time.Time, error) {
 	if r.pg == nil {
 		return nil, nil, errors.New("repository: postgres not configured")
@@ -685,15 +1599,107 @@ func (r *Repository) GetLastAnalyses(ctx context.Context, userID int32) (map[str
 	return out, meta, nil
 }
 
-func (r *Repository) UpsertUserSettings(ctx context.Context, userID int32, userTZ string) error {
+// GetLastAnalysesForUsers loads the last analysis for period of every user in userIDs in one query.
+// Users without one are absent from the maps.
+func (r *Repository) GetLastAnalysesForUsers(ctx context.Context, userIDs []int32, period string) (map[int32]dto.AnalyzeResponse, map[int32]time.Time, error) {
 	if r.pg == nil {
-		return errors.New("repository: postgres not configured")
+		return nil, nil, errors.New("repository: postgres not configured")
 	}
-	if userID <= 0 {
+	if period == "" {
+		return nil, nil, errors.New("repository: period is required")
+	}
+	out := make(map[int32]dto.AnalyzeResponse, len(userIDs))
+	meta := make(map[int32]time.Time, len(userIDs))
+	if len(userIDs) == 0 {
+		return out, meta, nil
+	}
+	rows, err := r.readPool().Query(ctx, `
+		select user_id, response, updated_at
+		from last_analyses
+		where user_id = any($1) and period = $2
+	`, userIDs, period)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var userID int32
+		var b []byte
+		var ts time.Time
+		if err := rows.Scan(&userID, &b, &ts); err != nil {
+			return nil, nil, err
+		}
+		var resp dto.AnalyzeResponse
+		if err := json.Unmarshal(b, &resp); err != nil {
+			return nil, nil, err
+		}
+		out[userID] = resp
+		meta[userID] = ts
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return out, meta, nil
+}
+
+// GetLastAnalysisTimes returns when each period's latest analysis was stored, without the responses.
+func (r *Repository) GetLastAnalysisTimes(ctx context.Context, userID int32) (map[string]time.Time, error) {
+	if r.pg == nil {
+		return nil, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("repository: invalid user id")
+	}
+	rows, err := r.pg.Query(ctx, `
+		select period, updated_at
+		from last_analyses
+		where user_id = $1
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make(map[string]time.Time)
+	for rows.Next() {
+		var period string
+		var ts time.Time
+		if err := rows.Scan(&period, &ts); err != nil {
+			return nil, err
+		}
+		out[period] = ts
+	}
+	return out, rows.Err()
+}
+
+func (r *Repository) SaveInsightFeedback(ctx context.Context, userID int32, period, feedback string) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 || period == "" || feedback == "" {
+		return errors.New("repository: invalid input")
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into insight_feedback (user_id, period, feedback, created_at)
+		values ($1, $2, $3, now())
+	`, userID, period, feedback)
+	return err
+}
+
+// UpsertUserSettings saves the user's timezone. An empty userTZ saves nothing, so the user keeps
+// following the configured default zone instead of being pinned to UTC.
+func (r *Repository) UpsertUserSettings(ctx context.Context, userID int32, userTZ string) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
 		return errors.New("repository: invalid user id")
 	}
 	if userTZ == "" {
-		userTZ = "UTC"
+		return nil
+	}
+	// Queries convert times with user_tz, so a name Go cannot load never reaches the table.
+	if _, err := time.LoadLocation(userTZ); err != nil || userTZ == "Local" {
+		return fmt.Errorf("repository: invalid timezone %q", userTZ)
 	}
 	_, err := r.pg.Exec(ctx, `
 		insert into user_settings (user_id, user_tz, updated_at)
@@ -705,6 +1711,8 @@ func (r *Repository) UpsertUserSettings(ctx context.Context, userID int32, userT
 	return err
 }
 
+// GetUserSettings returns the user's saved timezone, or "" when none is saved; callers resolve ""
+// to the configured default zone.
 func (r *Repository) GetUserSettings(ctx context.Context, userID int32) (string, error) {
 	if r.pg == nil {
 		return "", errors.New("repository: postgres not configured")
@@ -713,19 +1721,564 @@ func (r *Repository) GetUserSettings(ctx context.Context, userID int32) (string,
 		return "", errors.New("repository: invalid user id")
 	}
 	var tz string
-	err := r.pg.QueryRow(ctx, `select user_tz from user_settings where user_id = $1`, userID).Scan(&tz)
+	err := r.pg.QueryRow(ctx, `select coalesce(user_tz, '') from user_settings where user_id = $1`, userID).Scan(&tz)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return "UTC", nil
+			return "", nil
 		}
 		return "", err
 	}
-	if tz == "" {
-		tz = "UTC"
-	}
 	return tz, nil
 }
 
+func (r *Repository) GetDayStartHour(ctx context.Context, userID int32) (int, error) {
+	if r.pg == nil {
+		return 0, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return 0, errors.New("repository: invalid user id")
+	}
+	var hour int
+	err := r.pg.QueryRow(ctx, `select day_start_hour from user_settings where user_id = $1`, userID).Scan(&hour)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return hour, nil
+}
+
+func (r *Repository) UpdateDayStartHour(ctx context.Context, userID int32, hour int) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into user_settings (user_id, day_start_hour, updated_at)
+		values ($1, $2, now())
+		on conflict (user_id) do update
+		set day_start_hour = excluded.day_start_hour,
+		    updated_at = excluded.updated_at
+	`, userID, hour)
+	return err
+}
+
+func (r *Repository) GetInsightTone(ctx context.Context, userID int32) (dto.Tone, error) {
+	if r.pg == nil {
+		return "", errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return "", errors.New("repository: invalid user id")
+	}
+	var tone string
+	err := r.pg.QueryRow(ctx, `select insight_tone from user_settings where user_id = $1`, userID).Scan(&tone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return dto.Tone(tone), nil
+}
+
+func (r *Repository) UpdateInsightTone(ctx context.Context, userID int32, tone dto.Tone) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into user_settings (user_id, insight_tone, updated_at)
+		values ($1, $2, now())
+		on conflict (user_id) do update
+		set insight_tone = excluded.insight_tone,
+		    updated_at = excluded.updated_at
+	`, userID, string(tone))
+	return err
+}
+
+func (r *Repository) UpdateShareAnalyses(ctx context.Context, userID int32, share bool) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into user_settings (user_id, share_analyses, updated_at)
+		values ($1, $2, now())
+		on conflict (user_id) do update
+		set share_analyses = excluded.share_analyses,
+		    updated_at = excluded.updated_at
+	`, userID, share)
+	return err
+}
+
+// GetAnalysesEnabled reports the user's analyses switch; users without settings have it on.
+func (r *Repository) GetAnalysesEnabled(ctx context.Context, userID int32) (bool, error) {
+	if r.pg == nil {
+		return false, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return false, errors.New("repository: invalid user id")
+	}
+	var enabled bool
+	err := r.pg.QueryRow(ctx, `select analyses_enabled from user_settings where user_id = $1`, userID).Scan(&enabled)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return true, nil
+		}
+		return false, err
+	}
+	return enabled, nil
+}
+
+func (r *Repository) UpdateAnalysesEnabled(ctx context.Context, userID int32, enabled bool) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into user_settings (user_id, analyses_enabled, updated_at)
+		values ($1, $2, now())
+		on conflict (user_id) do update
+		set analyses_enabled = excluded.analyses_enabled,
+		    updated_at = excluded.updated_at
+	`, userID, enabled)
+	return err
+}
+
+// GetAggregateStats считает агрегаты по track_points всех пользователей за [from, to).
+// Бакеты, в которые попало меньше minUsers разных пользователей, отбрасываются, чтобы по ним нельзя было
+// восстановить данные отдельного человека.
+func (r *Repository) GetAggregateStats(ctx context.Context, from, to time.Time, minUsers int) (dto.AggregateStats, error) {
+	if r.pg == nil {
+		return dto.AggregateStats{}, errors.New("repository: postgres not configured")
+	}
+	if !to.After(from) {
+		return dto.AggregateStats{}, errors.New("repository: invalid time range")
+	}
+	out := dto.AggregateStats{From: from, To: to}
+	err := r.readPool().QueryRow(ctx, `
+		select count(*), count(distinct user_id),
+		       coalesce(avg(sleep_hours), 0), coalesce(avg(mood), 0),
+		       coalesce(avg(stress), 0), coalesce(avg(energy), 0)
+		from track_points
+		where ts >= $1 and ts < $2
+	`, from, to).Scan(&out.NumPoints, &out.NumUsers, &out.AvgSleepHours, &out.AvgMood, &out.AvgStress, &out.AvgEnergy)
+	if err != nil {
+		return dto.AggregateStats{}, err
+	}
+	if out.NumUsers < int64(minUsers) {
+		return dto.AggregateStats{From: from, To: to}, nil
+	}
+
+	rows, err := r.readPool().Query(ctx, `
+		select least(floor(sleep_hours)::int, 12) as bucket, count(*)
+		from track_points
+		where ts >= $1 and ts < $2 and sleep_hours is not null
+		group by bucket
+		having count(distinct user_id) >= $3
+		order by bucket
+	`, from, to, minUsers)
+	if err != nil {
+		return dto.AggregateStats{}, err
+	}
+	for rows.Next() {
+		var bucket int
+		var b dto.HistogramBucket
+		if err := rows.Scan(&bucket, &b.Count); err != nil {
+			rows.Close()
+			return dto.AggregateStats{}, err
+		}
+		b.Lower = float64(bucket)
+		b.Upper = float64(bucket + 1)
+		if bucket == 12 {
+			b.Upper = 24
+		}
+		out.SleepHistogram = append(out.SleepHistogram, b)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return dto.AggregateStats{}, err
+	}
+
+	rows, err = r.readPool().Query(ctx, `
+		select extract(isodow from ts)::int as dow, count(*),
+		       coalesce(avg(mood), 0), coalesce(avg(stress), 0), coalesce(avg(energy), 0)
+		from track_points
+		where ts >= $1 and ts < $2
+		group by dow
+		having count(distinct user_id) >= $3
+		order by dow
+	`, from, to, minUsers)
+	if err != nil {
+		return dto.AggregateStats{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var dow int
+		var w dto.WeekdayAggregate
+		if err := rows.Scan(&dow, &w.NumPoints, &w.AvgMood, &w.AvgStress, &w.AvgEnergy); err != nil {
+			return dto.AggregateStats{}, err
+		}
+		w.Weekday = time.Weekday(dow % 7).String()[:3]
+		out.ByWeekday = append(out.ByWeekday, w)
+	}
+	return out, rows.Err()
+}
+
+func (r *Repository) GetHiddenMetrics(ctx context.Context, userID int32) ([]string, error) {
+	if r.pg == nil {
+		return nil, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("repository: invalid user id")
+	}
+	var metrics []string
+	err := r.pg.QueryRow(ctx, `select hidden_metrics from user_settings where user_id = $1`, userID).Scan(&metrics)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return metrics, nil
+}
+
+func (r *Repository) UpdateHiddenMetrics(ctx context.Context, userID int32, metrics []string) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	if metrics == nil {
+		metrics = []string{}
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into user_settings (user_id, hidden_metrics, updated_at)
+		values ($1, $2, now())
+		on conflict (user_id) do update
+		set hidden_metrics = excluded.hidden_metrics,
+		    updated_at = excluded.updated_at
+	`, userID, metrics)
+	return err
+}
+
+// GetGoals returns the user's goals (metric -> target); users without settings have none.
+func (r *Repository) GetGoals(ctx context.Context, userID int32) (map[string]float64, error) {
+	if r.pg == nil {
+		return nil, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("repository: invalid user id")
+	}
+	var raw []byte
+	err := r.pg.QueryRow(ctx, `select goals from user_settings where user_id = $1`, userID).Scan(&raw)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return map[string]float64{}, nil
+		}
+		return nil, err
+	}
+	goals := map[string]float64{}
+	if err := json.Unmarshal(raw, &goals); err != nil {
+		return nil, err
+	}
+	return goals, nil
+}
+
+// UpdateGoals replaces the user's goals.
+func (r *Repository) UpdateGoals(ctx context.Context, userID int32, goals map[string]float64) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	if goals == nil {
+		goals = map[string]float64{}
+	}
+	raw, err := json.Marshal(goals)
+	if err != nil {
+		return err
+	}
+	_, err = r.pg.Exec(ctx, `
+		insert into user_settings (user_id, goals, updated_at)
+		values ($1, $2, now())
+		on conflict (user_id) do update
+		set goals = excluded.goals,
+		    updated_at = excluded.updated_at
+	`, userID, raw)
+	return err
+}
+
+// SaveBurnoutDismissal records that the user dismissed the burnout warning of period at score.
+func (r *Repository) SaveBurnoutDismissal(ctx context.Context, userID int32, period string, score float64, level string) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into burnout_dismissals (user_id, period, score, level, dismissed_at)
+		values ($1, $2, $3, $4, now())
+		on conflict (user_id, period) do update
+		set score = excluded.score,
+		    level = excluded.level,
+		    dismissed_at = excluded.dismissed_at
+	`, userID, period, score, level)
+	return err
+}
+
+// GetBurnoutDismissal returns the score at which the user dismissed the burnout warning of period.
+func (r *Repository) GetBurnoutDismissal(ctx context.Context, userID int32, period string) (float64, bool, error) {
+	if r.pg == nil {
+		return 0, false, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return 0, false, errors.New("repository: invalid user id")
+	}
+	var score float64
+	err := r.pg.QueryRow(ctx, `select score from burnout_dismissals where user_id = $1 and period = $2`, userID, period).Scan(&score)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return score, true, nil
+}
+
 func cacheKey(key string) string {
 	return "analysis:cache:" + key
 }
+
+func sharedInsightKey(hash string) string {
+	return "insight:shared:" + hash
+}
+
+func userInsightKey(userID int32, hash string) string {
+	return fmt.Sprintf("insight:user:%d:%s", userID, hash)
+}
+
+func tomorrowScheduleKey(userID int32) string {
+	return fmt.Sprintf("schedule:tomorrow:%d", userID)
+}
+
+func (r *Repository) CreateFocusSession(ctx context.Context, userID int32, fs dto.FocusSession) (dto.FocusSession, error) {
+	if r.pg == nil {
+		return dto.FocusSession{}, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return dto.FocusSession{}, errors.New("repository: invalid user id")
+	}
+	err := r.pg.QueryRow(ctx, `
+		insert into focus_sessions (user_id, started_at, ended_at, quality)
+		values ($1, $2, $3, $4)
+		returning id
+	`, userID, fs.StartedAt, fs.EndedAt, fs.Quality).Scan(&fs.ID)
+	if err != nil {
+		return dto.FocusSession{}, err
+	}
+	return fs, nil
+}
+
+// ListFocusSessions returns the sessions that started in [from, to), oldest first.
+func (r *Repository) ListFocusSessions(ctx context.Context, userID int32, from, to time.Time) ([]dto.FocusSession, error) {
+	if r.pg == nil {
+		return nil, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("repository: invalid user id")
+	}
+	rows, err := r.pg.Query(ctx, `
+		select id, started_at, ended_at, quality
+		from focus_sessions
+		where user_id = $1 and started_at >= $2 and started_at < $3
+		order by started_at asc
+	`, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dto.FocusSession
+	for rows.Next() {
+		var fs dto.FocusSession
+		if err := rows.Scan(&fs.ID, &fs.StartedAt, &fs.EndedAt, &fs.Quality); err != nil {
+			return nil, err
+		}
+		out = append(out, fs)
+	}
+	return out, rows.Err()
+}
+
+// DeleteFocusSession removes one of the user's sessions; false means there was no such session.
+func (r *Repository) DeleteFocusSession(ctx context.Context, userID int32, id int64) (bool, error) {
+	if r.pg == nil {
+		return false, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 || id <= 0 {
+		return false, errors.New("repository: invalid input")
+	}
+	ct, err := r.pg.Exec(ctx, `
+		delete from focus_sessions where user_id = $1 and id = $2
+	`, userID, id)
+	if err != nil {
+		return false, err
+	}
+	return ct.RowsAffected() > 0, nil
+}
+
+// GetBurnoutWebhook returns the user's burnout alert endpoint; empty means alerts are off.
+func (r *Repository) GetBurnoutWebhook(ctx context.Context, userID int32) (string, error) {
+	if r.pg == nil {
+		return "", errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return "", errors.New("repository: invalid user id")
+	}
+	var url string
+	err := r.pg.QueryRow(ctx, `select burnout_webhook_url from user_settings where user_id = $1`, userID).Scan(&url)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return url, nil
+}
+
+// UpdateBurnoutWebhook sets the user's burnout alert endpoint; an empty url turns alerts off.
+// Changing the endpoint re-arms the alert so the new receiver hears about an ongoing high risk.
+func (r *Repository) UpdateBurnoutWebhook(ctx context.Context, userID int32, url string) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		insert into user_settings (user_id, burnout_webhook_url, burnout_alert_active, updated_at)
+		values ($1, $2, false, now())
+		on conflict (user_id) do update
+		set burnout_webhook_url = excluded.burnout_webhook_url,
+		    burnout_alert_active = false,
+		    updated_at = excluded.updated_at
+	`, userID, url)
+	return err
+}
+
+// ClaimBurnoutAlert marks the user's burnout alert as sent and returns the endpoint to send it to.
+// ok is false when alerts are off or an alert for the current high streak was already claimed; the
+// conditional update makes concurrent analyses fire at most once.
+func (r *Repository) ClaimBurnoutAlert(ctx context.Context, userID int32) (string, bool, error) {
+	if r.pg == nil {
+		return "", false, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return "", false, errors.New("repository: invalid user id")
+	}
+	var url string
+	err := r.pg.QueryRow(ctx, `
+		update user_settings
+		set burnout_alert_active = true
+		where user_id = $1 and burnout_webhook_url <> '' and not burnout_alert_active
+		returning burnout_webhook_url
+	`, userID).Scan(&url)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return url, true, nil
+}
+
+// ResetBurnoutAlert re-arms the burnout alert once the risk is no longer high.
+func (r *Repository) ResetBurnoutAlert(ctx context.Context, userID int32) error {
+	if r.pg == nil {
+		return errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return errors.New("repository: invalid user id")
+	}
+	_, err := r.pg.Exec(ctx, `
+		update user_settings set burnout_alert_active = false
+		where user_id = $1 and burnout_alert_active
+	`, userID)
+	return err
+}
+
+// GetAnalysisAt returns the user's newest saved analysis of period created at or before at, and its
+// creation time. Single-day, tag-filtered, day-excluding, rolling-window and field-masked analyses are skipped:
+// they do not describe the period as a whole.
+func (r *Repository) GetAnalysisAt(ctx context.Context, userID int32, period string, at time.Time) (dto.AnalyzeResponse, time.Time, bool, error) {
+	if r.pg == nil {
+		return dto.AnalyzeResponse{}, time.Time{}, false, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return dto.AnalyzeResponse{}, time.Time{}, false, errors.New("repository: invalid user id")
+	}
+	var b []byte
+	var createdAt time.Time
+	err := r.readPool().QueryRow(ctx, `
+		select response, created_at
+		from analyses
+		where user_id = $1 and period = $2 and created_at <= $3
+		  and coalesce(request->>'date', '') = ''
+		  and coalesce(jsonb_array_length(request->'tags'), 0) = 0
+		  and coalesce((request->>'rolling_days')::int, 0) = 0
+		  and coalesce(jsonb_array_length(request->'exclude_tags'), 0) = 0
+		  and not coalesce((request->>'exclude_outliers')::boolean, false)
+		  and coalesce(jsonb_array_length(request->'fields'), 0) = 0
+		order by created_at desc
+		limit 1
+	`, userID, period, at).Scan(&b, &createdAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dto.AnalyzeResponse{}, time.Time{}, false, nil
+		}
+		return dto.AnalyzeResponse{}, time.Time{}, false, err
+	}
+	var resp dto.AnalyzeResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return dto.AnalyzeResponse{}, time.Time{}, false, err
+	}
+	return resp, createdAt, true, nil
+}
+
+// GetLastAnalysis returns the user's latest stored analysis of period, as shown on the period's card.
+func (r *Repository) GetLastAnalysis(ctx context.Context, userID int32, period string) (dto.AnalyzeResponse, bool, error) {
+	if r.pg == nil {
+		return dto.AnalyzeResponse{}, false, errors.New("repository: postgres not configured")
+	}
+	if userID <= 0 {
+		return dto.AnalyzeResponse{}, false, errors.New("repository: invalid user id")
+	}
+	var b []byte
+	err := r.pg.QueryRow(ctx, `
+		select response
+		from last_analyses
+		where user_id = $1 and period = $2
+	`, userID, period).Scan(&b)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dto.AnalyzeResponse{}, false, nil
+		}
+		return dto.AnalyzeResponse{}, false, err
+	}
+	var resp dto.AnalyzeResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return dto.AnalyzeResponse{}, false, err
+	}
+	return resp, true, nil
+}
@@ -0,0 +1,924 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+)
+
+// testDatabaseEnv names the Postgres DSN the repository tests run against. The database is migrated
+// and its tables are emptied, so it must be a throwaway one; without it the tests are skipped.
+const testDatabaseEnv = "NEXUS_TEST_DATABASE_URL"
+
+// testRepository returns a Repository on a freshly migrated, empty test database.
+func testRepository(t *testing.T) *Repository {
+	t.Helper()
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s not set", testDatabaseEnv)
+	}
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+	if err := goose.SetDialect("postgres"); err != nil {
+		t.Fatal(err)
+	}
+	goose.SetTableName("nexus_ai_goose_db_version")
+	if err := goose.Up(db, "../../migrations"); err != nil {
+		t.Fatalf("migrations: %v", err)
+	}
+	if _, err := db.Exec(`truncate analyses, last_analyses, user_settings, track_points, friend_requests, friends,
+		insight_feedback, burnout_dismissals, failed_analyses, focus_sessions, friend_activity restart identity`); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	repo, err := NewRepository(ctx, Config{PostgresURL: dsn})
+	if err != nil {
+		t.Fatalf("NewRepository: %v", err)
+	}
+	t.Cleanup(repo.Close)
+	return repo
+}
+
+// seedPoints stores one point per day for the last days days, ending an hour ago.
+func seedPoints(t *testing.T, repo *Repository, userID int32, days int, fill func(i int, p *dto.TrackPoint)) []dto.TrackPoint {
+	t.Helper()
+	end := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	pts := make([]dto.TrackPoint, 0, days)
+	for i := days - 1; i >= 0; i-- {
+		p := dto.TrackPoint{TS: end.AddDate(0, 0, -i), SleepHours: 7, Mood: 6, Stress: 4, Energy: 6, SleepQuality: 7}
+		if fill != nil {
+			fill(len(pts), &p)
+		}
+		pts = append(pts, p)
+	}
+	if _, err := repo.SaveTrackPoints(context.Background(), userID, pts); err != nil {
+		t.Fatalf("SaveTrackPoints: %v", err)
+	}
+	return pts
+}
+
+// seedUsers adds the users to the users table. The table belongs to the auth service; when the test
+// database lacks it, a bare stand-in with the columns the joins read is created.
+func seedUsers(t *testing.T, repo *Repository, ids ...int32) {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := repo.pg.Exec(ctx, `create table if not exists users (id integer primary key, name text not null, email text not null)`); err != nil {
+		t.Fatalf("users: %v", err)
+	}
+	for _, id := range ids {
+		if _, err := repo.pg.Exec(ctx, `insert into users (id, name, email) values ($1, $2, $2 || '@example.com')
+			on conflict (id) do nothing`, id, "u"+strconv.Itoa(int(id))); err != nil {
+			t.Fatalf("insert user: %v", err)
+		}
+	}
+}
+
+func TestGetAggregateStatsIsAnonymous(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	users := []int32{700001, 700002, 700003}
+	for _, id := range users {
+		seedPoints(t, repo, id, 5, nil)
+	}
+	from, to := time.Now().AddDate(0, 0, -7), time.Now()
+
+	// Below the threshold nothing but the window comes back.
+	st, err := repo.GetAggregateStats(ctx, from, to, len(users)+1)
+	if err != nil {
+		t.Fatalf("GetAggregateStats: %v", err)
+	}
+	if st.NumPoints != 0 || st.NumUsers != 0 || len(st.SleepHistogram) != 0 || len(st.ByWeekday) != 0 {
+		t.Errorf("stats under the user threshold = %+v, want empty", st)
+	}
+
+	st, err = repo.GetAggregateStats(ctx, from, to, len(users))
+	if err != nil {
+		t.Fatalf("GetAggregateStats: %v", err)
+	}
+	if st.NumUsers != int64(len(users)) || st.NumPoints != 15 {
+		t.Errorf("users=%d points=%d, want 3 and 15", st.NumUsers, st.NumPoints)
+	}
+	raw, _ := json.Marshal(st)
+	for _, id := range users {
+		if strings.Contains(string(raw), strconv.Itoa(int(id))) {
+			t.Errorf("user id %d appears in the aggregates: %s", id, raw)
+		}
+	}
+}
+
+func TestGetAggregateStatsSkipsMissingRatings(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	seedPoints(t, repo, 700001, 5, nil)
+	// Points logged without sleep, mood, stress or energy, 20 days back.
+	nulls := seedPoints(t, repo, 700002, 2, func(i int, p *dto.TrackPoint) {
+		p.TS = p.TS.AddDate(0, 0, -20)
+		p.Missing = map[string]bool{"sleep_hours": true, "mood": true, "stress": true, "energy": true}
+	})
+
+	st, err := repo.GetAggregateStats(ctx, time.Now().AddDate(0, 0, -30), time.Now(), 1)
+	if err != nil {
+		t.Fatalf("GetAggregateStats: %v", err)
+	}
+	var counted int64
+	for _, b := range st.SleepHistogram {
+		counted += b.Count
+	}
+	if st.NumPoints != 7 || counted != 5 {
+		t.Errorf("points=%d, in the sleep histogram %d; want 7 and only the 5 with sleep", st.NumPoints, counted)
+	}
+
+	// A weekday with only unrated points averages to zeros instead of failing the scan.
+	from := nulls[0].TS.Add(-time.Hour)
+	st, err = repo.GetAggregateStats(ctx, from, from.Add(2*time.Hour), 1)
+	if err != nil {
+		t.Fatalf("GetAggregateStats over unrated points: %v", err)
+	}
+	if len(st.SleepHistogram) != 0 || len(st.ByWeekday) != 1 || st.ByWeekday[0].AvgMood != 0 {
+		t.Errorf("unrated points: histogram %v, weekdays %+v", st.SleepHistogram, st.ByWeekday)
+	}
+}
+
+func TestBurnoutDismissalIsPerPeriod(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	if err := repo.SaveBurnoutDismissal(ctx, 700001, "week", 62, "high"); err != nil {
+		t.Fatalf("SaveBurnoutDismissal: %v", err)
+	}
+	if err := repo.SaveBurnoutDismissal(ctx, 700001, "week", 71, "high"); err != nil {
+		t.Fatalf("SaveBurnoutDismissal again: %v", err)
+	}
+	if score, ok, err := repo.GetBurnoutDismissal(ctx, 700001, "week"); err != nil || !ok || score != 71 {
+		t.Errorf("week dismissal = %v, %v, %v; want the latest score 71", score, ok, err)
+	}
+	if _, ok, err := repo.GetBurnoutDismissal(ctx, 700001, "month"); err != nil || ok {
+		t.Errorf("month dismissal found = %v, %v; want none", ok, err)
+	}
+}
+
+func TestWithTxRollsBackOnError(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	insert := func(userID int32) func(tx pgx.Tx) error {
+		return func(tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `insert into user_settings (user_id, user_tz) values ($1, 'Europe/Berlin')`, userID)
+			return err
+		}
+	}
+	exists := func(userID int32) bool {
+		var n int
+		if err := repo.pg.QueryRow(ctx, `select count(*) from user_settings where user_id = $1`, userID).Scan(&n); err != nil {
+			t.Fatalf("count: %v", err)
+		}
+		return n > 0
+	}
+
+	boom := errors.New("boom")
+	err := repo.WithTx(ctx, func(tx pgx.Tx) error {
+		if err := insert(700001)(tx); err != nil {
+			return err
+		}
+		return boom
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("WithTx = %v, want the callback's error", err)
+	}
+	if exists(700001) {
+		t.Error("the insert survived a failed transaction")
+	}
+
+	if err := repo.WithTx(ctx, insert(700002)); err != nil {
+		t.Fatalf("WithTx: %v", err)
+	}
+	if !exists(700002) {
+		t.Error("a successful transaction was not committed")
+	}
+}
+
+func TestGetLastAnalysesForUsers(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	save := func(userID int32, period string, score float64) {
+		t.Helper()
+		resp := dto.AnalyzeResponse{ProductivityModel: dto.ProductivityModel{Score: score}}
+		if err := repo.UpsertLastAnalysis(ctx, userID, period, resp); err != nil {
+			t.Fatalf("UpsertLastAnalysis: %v", err)
+		}
+	}
+	save(700001, "week", 61)
+	save(700001, "month", 11)
+	save(700002, "week", 62)
+	save(700003, "month", 13)
+	save(700004, "week", 64) // not asked for
+
+	got, meta, err := repo.GetLastAnalysesForUsers(ctx, []int32{700001, 700002, 700003, 700005}, "week")
+	if err != nil {
+		t.Fatalf("GetLastAnalysesForUsers: %v", err)
+	}
+	want := map[int32]float64{700001: 61, 700002: 62}
+	if len(got) != len(want) || len(meta) != len(want) {
+		t.Fatalf("got %d analyses and %d times, want %d", len(got), len(meta), len(want))
+	}
+	for id, score := range want {
+		if got[id].ProductivityModel.Score != score || meta[id].IsZero() {
+			t.Errorf("user %d: score %v at %s, want %v", id, got[id].ProductivityModel.Score, meta[id], score)
+		}
+	}
+
+	if got, _, err := repo.GetLastAnalysesForUsers(ctx, nil, "week"); err != nil || len(got) != 0 {
+		t.Errorf("no users: %v, %v; want an empty map", got, err)
+	}
+	if _, _, err := repo.GetLastAnalysesForUsers(ctx, []int32{700001}, ""); err == nil {
+		t.Error("an empty period was accepted")
+	}
+}
+
+func TestFailedAnalysisQueue(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	day := now.Truncate(24 * time.Hour)
+
+	if err := repo.EnqueueFailedAnalysis(ctx, 700001, "UTC", day, day.AddDate(0, 0, 1), now.Add(5*time.Minute), "llm down"); err != nil {
+		t.Fatalf("EnqueueFailedAnalysis: %v", err)
+	}
+	if due, err := repo.ListDueFailedAnalyses(ctx, now, 10); err != nil || len(due) != 0 {
+		t.Errorf("due before the backoff = %+v, %v; want none", due, err)
+	}
+	due, err := repo.ListDueFailedAnalyses(ctx, now.Add(5*time.Minute), 10)
+	if err != nil || len(due) != 1 || due[0].UserID != 700001 || due[0].Attempts != 0 || due[0].LastError != "llm down" {
+		t.Fatalf("due after the backoff = %+v, %v", due, err)
+	}
+
+	// A failed retry pushes the next attempt out; a dead entry leaves the due list for the dead one.
+	if err := repo.RecordFailedAnalysisRetry(ctx, 700001, day, 1, now.Add(time.Hour), "still down", false); err != nil {
+		t.Fatalf("RecordFailedAnalysisRetry: %v", err)
+	}
+	if due, _ := repo.ListDueFailedAnalyses(ctx, now.Add(30*time.Minute), 10); len(due) != 0 {
+		t.Errorf("an entry is due before its new attempt time: %+v", due)
+	}
+	if err := repo.RecordFailedAnalysisRetry(ctx, 700001, day, 5, now.Add(time.Hour), "retries exhausted", true); err != nil {
+		t.Fatalf("RecordFailedAnalysisRetry: %v", err)
+	}
+	if due, _ := repo.ListDueFailedAnalyses(ctx, now.Add(48*time.Hour), 10); len(due) != 0 {
+		t.Errorf("a dead entry is due: %+v", due)
+	}
+	dead, err := repo.ListDeadFailedAnalyses(ctx, 10)
+	if err != nil || len(dead) != 1 || dead[0].Attempts != 5 || !dead[0].Dead {
+		t.Errorf("dead entries = %+v, %v", dead, err)
+	}
+
+	// A new failure of the same day restarts the budget; success removes it.
+	if err := repo.EnqueueFailedAnalysis(ctx, 700001, "UTC", day, day.AddDate(0, 0, 1), now, "again"); err != nil {
+		t.Fatalf("EnqueueFailedAnalysis: %v", err)
+	}
+	if due, _ := repo.ListDueFailedAnalyses(ctx, now, 10); len(due) != 1 || due[0].Attempts != 0 || due[0].Dead {
+		t.Errorf("re-enqueued entry = %+v, want a fresh live entry", due)
+	}
+	if err := repo.DeleteFailedAnalysis(ctx, 700001, day); err != nil {
+		t.Fatalf("DeleteFailedAnalysis: %v", err)
+	}
+	if due, _ := repo.ListDueFailedAnalyses(ctx, now.Add(48*time.Hour), 10); len(due) != 0 {
+		t.Errorf("entries after delete = %+v", due)
+	}
+}
+
+func TestListFriendsEnrichedFallsBackPerRow(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	seedUsers(t, repo, 700001, 700002, 700003, 700004)
+	for _, id := range []int32{700002, 700003, 700004} {
+		if _, err := repo.pg.Exec(ctx, `insert into friends (user_id, friend_id) values (700001, $1)`, id); err != nil {
+			t.Fatalf("insert friend: %v", err)
+		}
+	}
+	// A zone written before names were validated must not fail the list.
+	if _, err := repo.pg.Exec(ctx, `insert into user_settings (user_id, user_tz) values (700002, 'Mars/Olympus')`); err != nil {
+		t.Fatalf("insert settings: %v", err)
+	}
+	if err := repo.UpsertUserSettings(ctx, 700004, "Europe/Berlin"); err != nil {
+		t.Fatalf("UpsertUserSettings: %v", err)
+	}
+	if err := repo.UpsertUserSettings(ctx, 700004, "Mars/Olympus"); err == nil {
+		t.Error("an unknown zone was saved")
+	}
+	now := time.Now().UTC().Add(-time.Second).Truncate(time.Second)
+	for _, id := range []int32{700002, 700003} {
+		if _, err := repo.SaveTrackPoints(ctx, id, []dto.TrackPoint{{TS: now, Mood: 6}}); err != nil {
+			t.Fatalf("SaveTrackPoints: %v", err)
+		}
+	}
+
+	got, err := repo.ListFriendsEnriched(ctx, 700001, "UTC")
+	if err != nil {
+		t.Fatalf("ListFriendsEnriched: %v", err)
+	}
+	logged := map[int32]bool{}
+	for _, f := range got {
+		logged[f.Profile.UserID] = f.LoggedToday
+	}
+	want := map[int32]bool{700002: true, 700003: true, 700004: false}
+	if len(logged) != len(want) {
+		t.Fatalf("friends = %v, want %v", logged, want)
+	}
+	for id, w := range want {
+		if logged[id] != w {
+			t.Errorf("friend %d logged today = %v, want %v", id, logged[id], w)
+		}
+	}
+}
+
+func TestUnsetTimezoneIsEmpty(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	if tz, err := repo.GetUserSettings(ctx, 700001); err != nil || tz != "" {
+		t.Errorf("no settings: %q, %v; want \"\" so DEFAULT_TZ applies", tz, err)
+	}
+	if err := repo.UpsertUserSettings(ctx, 700001, ""); err != nil {
+		t.Fatalf("UpsertUserSettings: %v", err)
+	}
+	if tz, err := repo.GetUserSettings(ctx, 700001); err != nil || tz != "" {
+		t.Errorf("after an empty save: %q, %v; want \"\"", tz, err)
+	}
+	if err := repo.UpsertUserSettings(ctx, 700001, "Asia/Tokyo"); err != nil {
+		t.Fatalf("UpsertUserSettings: %v", err)
+	}
+	if err := repo.UpsertUserSettings(ctx, 700001, ""); err != nil {
+		t.Fatalf("UpsertUserSettings: %v", err)
+	}
+	if tz, err := repo.GetUserSettings(ctx, 700001); err != nil || tz != "Asia/Tokyo" {
+		t.Errorf("an empty save overwrote the zone: %q, %v", tz, err)
+	}
+}
+
+func TestMutualFriendRequestsAutoAccept(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	seedUsers(t, repo, 700001, 700002, 700003, 700004)
+	check := func(a, b int32) {
+		t.Helper()
+		var friends, pending int
+		if err := repo.pg.QueryRow(ctx, `select count(*) from friends
+			where (user_id, friend_id) in (($1, $2), ($2, $1))`, a, b).Scan(&friends); err != nil {
+			t.Fatalf("count friends: %v", err)
+		}
+		if err := repo.pg.QueryRow(ctx, `select count(*) from friend_requests
+			where status = 'pending' and (from_user_id, to_user_id) in (($1, $2), ($2, $1))`, a, b).Scan(&pending); err != nil {
+			t.Fatalf("count pending: %v", err)
+		}
+		if friends != 2 || pending != 0 {
+			t.Errorf("%d and %d: %d friend rows and %d pending requests, want 2 and 0", a, b, friends, pending)
+		}
+	}
+
+	if fr, err := repo.CreateFriendRequest(ctx, 700001, 700002); err != nil || fr.Status != "pending" {
+		t.Fatalf("first request: %+v, %v", fr, err)
+	}
+	if fr, err := repo.CreateFriendRequest(ctx, 700002, 700001); err != nil || fr.Status != "accepted" {
+		t.Fatalf("reciprocal request: %+v, %v; want accepted", fr, err)
+	}
+	check(700001, 700002)
+	if _, err := repo.CreateFriendRequest(ctx, 700001, 700002); err == nil {
+		t.Error("a request between friends was accepted")
+	}
+
+	// Two opposite requests at the same time still end in one friendship.
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, pair := range [][2]int32{{700003, 700004}, {700004, 700003}} {
+		wg.Add(1)
+		go func(i int, from, to int32) {
+			defer wg.Done()
+			_, errs[i] = repo.CreateFriendRequest(ctx, from, to)
+		}(i, pair[0], pair[1])
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent request: %v", err)
+		}
+	}
+	check(700003, 700004)
+}
+
+func TestTrendArrowsFromSuccessiveAnalyses(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	save := func(avgs map[string]float64) {
+		t.Helper()
+		if err := repo.UpsertLastAnalysis(ctx, 700001, "week", dto.AnalyzeResponse{Averages: avgs}); err != nil {
+			t.Fatalf("UpsertLastAnalysis: %v", err)
+		}
+	}
+
+	first := map[string]float64{"sleep_hours": 6.5, "mood": 6, "stress": 5}
+	save(first)
+	prev, err := repo.GetPreviousAverages(ctx, 700001)
+	if err != nil {
+		t.Fatalf("GetPreviousAverages: %v", err)
+	}
+	if _, ok := prev["week"]; ok {
+		t.Fatalf("the first analysis has previous averages: %v", prev)
+	}
+
+	save(map[string]float64{"sleep_hours": 7.5, "mood": 6.1, "stress": 4})
+	prev, err = repo.GetPreviousAverages(ctx, 700001)
+	if err != nil {
+		t.Fatalf("GetPreviousAverages: %v", err)
+	}
+	if !reflect.DeepEqual(prev["week"], first) {
+		t.Fatalf("previous week averages = %v, want %v", prev["week"], first)
+	}
+	last, _, err := repo.GetLastAnalyses(ctx, 700001)
+	if err != nil {
+		t.Fatalf("GetLastAnalyses: %v", err)
+	}
+	want := map[string]string{"sleep_hours": "up", "mood": "flat", "stress": "down"}
+	if got := analytics.CompareAverages(prev["week"], last["week"].Averages); !reflect.DeepEqual(got, want) {
+		t.Errorf("arrows = %v, want %v", got, want)
+	}
+}
+
+func TestAnalysisHistoryCapPerPeriod(t *testing.T) {
+	repo := testRepository(t)
+	repo.historyLimit = 2
+	ctx := context.Background()
+	save := func(key string, userID int32, period dto.Period) {
+		t.Helper()
+		req := dto.AnalyzeRequest{UserID: userID, Period: period}
+		if err := repo.SaveAnalysis(ctx, key, req, dto.AnalyzeResponse{}); err != nil {
+			t.Fatalf("SaveAnalysis %s: %v", key, err)
+		}
+	}
+	ids := func(userID int32, period dto.Period) []string {
+		t.Helper()
+		rows, err := repo.pg.Query(ctx, `select id from analyses where user_id = $1 and period = $2 order by id`, userID, string(period))
+		if err != nil {
+			t.Fatalf("query: %v", err)
+		}
+		out, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			t.Fatalf("collect: %v", err)
+		}
+		return out
+	}
+
+	save("week-1", 700001, dto.PeriodWeek)
+	save("week-2", 700001, dto.PeriodWeek)
+	save("month-1", 700001, dto.PeriodMonth)
+	save("other-week-1", 700002, dto.PeriodWeek)
+	save("week-3", 700001, dto.PeriodWeek)
+
+	if got, want := ids(700001, dto.PeriodWeek), []string{"week-2", "week-3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("week history = %v, want the oldest evicted: %v", got, want)
+	}
+	if got := ids(700001, dto.PeriodMonth); len(got) != 1 {
+		t.Errorf("month history = %v, want it untouched", got)
+	}
+	if got := ids(700002, dto.PeriodWeek); len(got) != 1 {
+		t.Errorf("another user's history = %v, want it untouched", got)
+	}
+}
+
+func TestListFriendsEnrichedHidesLevelWithoutConsent(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	seedUsers(t, repo, 700001, 700002, 700003)
+	for _, id := range []int32{700002, 700003} {
+		if _, err := repo.pg.Exec(ctx, `insert into friends (user_id, friend_id) values (700001, $1)`, id); err != nil {
+			t.Fatalf("insert friend: %v", err)
+		}
+		resp := dto.AnalyzeResponse{BurnoutRisk: dto.BurnoutRisk{Level: "high"}}
+		if err := repo.UpsertLastAnalysis(ctx, id, "week", resp); err != nil {
+			t.Fatalf("UpsertLastAnalysis: %v", err)
+		}
+	}
+	if err := repo.UpdateShareAnalyses(ctx, 700002, true); err != nil {
+		t.Fatalf("UpdateShareAnalyses: %v", err)
+	}
+
+	got, err := repo.ListFriendsEnriched(ctx, 700001, "UTC")
+	if err != nil {
+		t.Fatalf("ListFriendsEnriched: %v", err)
+	}
+	levels := map[int32]string{}
+	for _, f := range got {
+		levels[f.Profile.UserID] = f.BurnoutLevel
+	}
+	if want := map[int32]string{700002: "high", 700003: ""}; !reflect.DeepEqual(levels, want) {
+		t.Errorf("burnout levels = %v, want %v", levels, want)
+	}
+}
+
+func TestPresenceMigrationUpDown(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	seedPoints(t, repo, 1, 1, func(_ int, p *dto.TrackPoint) {
+		p.Missing = map[string]bool{"mood": true, "stress": true}
+	})
+	// Every bit but mood (2) and stress (16).
+	const want = 255 - 2 - 16
+	presence := func() (int, bool) {
+		var exists bool
+		if err := repo.pg.QueryRow(ctx, `select exists (select 1 from information_schema.columns
+			where table_name = 'track_points' and column_name = 'presence')`).Scan(&exists); err != nil {
+			t.Fatalf("column lookup: %v", err)
+		}
+		if !exists {
+			return 0, false
+		}
+		var v int
+		if err := repo.pg.QueryRow(ctx, `select presence from track_points where user_id = 1`).Scan(&v); err != nil {
+			t.Fatalf("presence: %v", err)
+		}
+		return v, true
+	}
+	if v, ok := presence(); !ok || v != want {
+		t.Fatalf("presence after up = %d (column %v), want %d", v, ok, want)
+	}
+
+	db, err := sql.Open("pgx", os.Getenv(testDatabaseEnv))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+	// Whatever happens below, leave the schema fully migrated for the other tests.
+	t.Cleanup(func() { _ = goose.Up(db, "../../migrations") })
+
+	if err := goose.DownTo(db, "../../migrations", 202610150015); err != nil {
+		t.Fatalf("down: %v", err)
+	}
+	if _, ok := presence(); ok {
+		t.Fatal("presence column survived the down migration")
+	}
+	if err := goose.DownTo(db, "../../migrations", 202610150015); err != nil {
+		t.Fatalf("second down: %v", err)
+	}
+
+	// Up again backfills the existing row from its columns.
+	if err := goose.Up(db, "../../migrations"); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	if v, ok := presence(); !ok || v != want {
+		t.Errorf("presence after down and up = %d (column %v), want %d", v, ok, want)
+	}
+}
+
+func TestTrackPointContextRoundTrip(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	pts := seedPoints(t, repo, 1, 2, func(i int, p *dto.TrackPoint) {
+		if i == 0 {
+			p.Context = map[string]string{"weather": "rain", "место": "дом"}
+		}
+	})
+
+	got, err := repo.GetTrackPoints(ctx, 1, pts[0].TS.Add(-time.Minute), time.Now(), nil)
+	if err != nil {
+		t.Fatalf("GetTrackPoints: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("points = %d, want 2", len(got))
+	}
+	if want := map[string]string{"weather": "rain", "место": "дом"}; !reflect.DeepEqual(got[0].Context, want) {
+		t.Errorf("context = %v, want %v", got[0].Context, want)
+	}
+	if len(got[1].Context) != 0 {
+		t.Errorf("a point stored without context came back with %v", got[1].Context)
+	}
+}
+
+func TestFriendActivityFeedPrivacy(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	// 700002 and 700003 are friends of 700001, only 700002 shares; 700004 shares but is no friend.
+	seedUsers(t, repo, 700001, 700002, 700003, 700004)
+	for _, id := range []int32{700002, 700003} {
+		if _, err := repo.pg.Exec(ctx, `insert into friends (user_id, friend_id) values (700001, $1)`, id); err != nil {
+			t.Fatalf("insert friend: %v", err)
+		}
+	}
+	for _, id := range []int32{700002, 700004} {
+		if err := repo.UpdateShareAnalyses(ctx, id, true); err != nil {
+			t.Fatalf("UpdateShareAnalyses: %v", err)
+		}
+	}
+	for _, id := range []int32{700002, 700003, 700004} {
+		for i := 0; i < 2; i++ {
+			// The second call repeats the dedupe key and is dropped.
+			if err := repo.RecordFriendActivity(ctx, id, dto.ActivityKindStreak, "7", "streak:7:2026-10-01"); err != nil {
+				t.Fatalf("RecordFriendActivity: %v", err)
+			}
+		}
+	}
+
+	got, err := repo.ListFriendActivity(ctx, 700001, time.Now().Add(-time.Hour), 10)
+	if err != nil {
+		t.Fatalf("ListFriendActivity: %v", err)
+	}
+	if len(got) != 1 || got[0].Friend.UserID != 700002 || got[0].Value != "7" || !got[0].Friend.IsFriend {
+		t.Fatalf("feed = %+v, want one streak event of 700002", got)
+	}
+
+	// Turning sharing off hides the events already recorded.
+	if err := repo.UpdateShareAnalyses(ctx, 700002, false); err != nil {
+		t.Fatalf("UpdateShareAnalyses: %v", err)
+	}
+	if got, err := repo.ListFriendActivity(ctx, 700001, time.Now().Add(-time.Hour), 10); err != nil || len(got) != 0 {
+		t.Errorf("feed after sharing was turned off = %+v, %v", got, err)
+	}
+}
+
+func TestReplicaRoutingAfterWrites(t *testing.T) {
+	// The pools are only compared, never used, so no database is needed.
+	primary, replica := &pgxpool.Pool{}, &pgxpool.Pool{}
+	r := &Repository{pg: primary, readPG: replica}
+
+	r.markWrite(1)
+	if r.readPoolFor(1) != primary {
+		t.Error("a user who just wrote reads from the replica")
+	}
+	if r.readPoolFor(2) != replica || r.readPool() != replica {
+		t.Error("other reads do not use the replica")
+	}
+	r.recentWrites.Store(int32(1), time.Now().Add(-replicaStickiness))
+	if r.readPoolFor(1) != replica {
+		t.Error("reads stay on the primary after replicaStickiness")
+	}
+
+	// Writers who never read again are swept by a later write once the window has passed.
+	for id := int32(100); id < 200; id++ {
+		r.recentWrites.Store(id, time.Now().Add(-2*replicaStickiness))
+	}
+	r.lastPrune.Store(time.Now().Add(-2 * replicaStickiness).UnixNano())
+	r.markWrite(3)
+	n := 0
+	r.recentWrites.Range(func(any, any) bool { n++; return true })
+	if n != 1 {
+		t.Errorf("%d entries after the sweep, want only the fresh write", n)
+	}
+
+	// Without a replica everything reads from the primary and nothing is tracked.
+	solo := &Repository{pg: primary}
+	solo.markWrite(1)
+	if solo.readPoolFor(1) != primary || solo.readPool() != primary {
+		t.Error("no replica configured, but reads leave the primary")
+	}
+	if _, ok := solo.recentWrites.Load(int32(1)); ok {
+		t.Error("a write was tracked without a replica")
+	}
+}
+
+func TestGetTrackPointsCapsLargeRanges(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	// Ten days with four points each, at 09:00, 11:00, 13:00 and 15:00 UTC.
+	first := time.Now().UTC().AddDate(0, 0, -12).Truncate(24 * time.Hour)
+	var pts []dto.TrackPoint
+	for d := 0; d < 10; d++ {
+		for h := 9; h <= 15; h += 2 {
+			pts = append(pts, dto.TrackPoint{TS: first.AddDate(0, 0, d).Add(time.Duration(h) * time.Hour), Mood: float64(h - 8), Energy: 5})
+		}
+	}
+	if _, err := repo.SaveTrackPoints(ctx, 700001, pts); err != nil {
+		t.Fatalf("SaveTrackPoints: %v", err)
+	}
+	from, to := first, time.Now().UTC()
+
+	repo.maxPoints = 15
+	got, err := repo.GetTrackPoints(ctx, 700001, from, to, nil)
+	if err != nil {
+		t.Fatalf("GetTrackPoints: %v", err)
+	}
+	if len(got) != 15 || !got[14].TS.Equal(pts[39].TS) || !got[0].TS.Equal(pts[25].TS) {
+		t.Errorf("capped: %d points from %s to %s, want the newest 15", len(got), got[0].TS, got[len(got)-1].TS)
+	}
+
+	repo.downsample = true
+	got, err = repo.GetTrackPoints(ctx, 700001, from, to, nil)
+	if err != nil {
+		t.Fatalf("GetTrackPoints: %v", err)
+	}
+	if len(got) != 10 {
+		t.Fatalf("downsampled: %d points, want one per day", len(got))
+	}
+	for i, p := range got {
+		if !p.TS.Equal(pts[i*4+3].TS) {
+			t.Errorf("day %d: point at %s, want the day's last one at %s", i, p.TS, pts[i*4+3].TS)
+		}
+	}
+
+	// The cap still holds when there are more days than it allows.
+	repo.maxPoints = 5
+	if got, err = repo.GetTrackPoints(ctx, 700001, from, to, nil); err != nil || len(got) != 5 || !got[0].TS.Equal(pts[23].TS) {
+		t.Errorf("downsampled under a cap of 5: %d points, %v", len(got), err)
+	}
+
+	// A range within the cap comes back whole.
+	repo.maxPoints, repo.downsample = 100, false
+	if got, err = repo.GetTrackPoints(ctx, 700001, from, to, nil); err != nil || len(got) != 40 {
+		t.Errorf("uncapped: %d points, %v", len(got), err)
+	}
+}
+
+func TestGetTrackPointsDownsampleFallsBackToTheDefaultZone(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	// Five days with points at 09:00, 11:00, 13:00 and 15:00 UTC; in Tokyo the 15:00 one is past midnight.
+	first := time.Now().UTC().AddDate(0, 0, -7).Truncate(24 * time.Hour)
+	var pts []dto.TrackPoint
+	for d := 0; d < 5; d++ {
+		for h := 9; h <= 15; h += 2 {
+			pts = append(pts, dto.TrackPoint{TS: first.AddDate(0, 0, d).Add(time.Duration(h) * time.Hour), Mood: 5, Energy: 5})
+		}
+	}
+	from, to := first, time.Now().UTC()
+	repo.maxPoints, repo.downsample, repo.defaultTZ = 10, true, "Asia/Tokyo"
+
+	// An empty zone and one saved before names were validated both mean the default zone.
+	for i, tz := range []string{"", "Mars/Olympus"} {
+		userID := int32(700001 + i)
+		if _, err := repo.SaveTrackPoints(ctx, userID, pts); err != nil {
+			t.Fatalf("SaveTrackPoints: %v", err)
+		}
+		if _, err := repo.pg.Exec(ctx, `insert into user_settings (user_id, user_tz) values ($1, $2)`, userID, tz); err != nil {
+			t.Fatalf("insert settings: %v", err)
+		}
+		got, err := repo.GetTrackPoints(ctx, userID, from, to, nil)
+		if err != nil {
+			t.Fatalf("zone %q: GetTrackPoints: %v", tz, err)
+		}
+		// Tokyo days end at 15:00 UTC: the 13:00 point closes each of them, the last 15:00 one opens a sixth.
+		if len(got) != 6 || !got[0].TS.Equal(pts[2].TS) || !got[5].TS.Equal(pts[19].TS) {
+			t.Errorf("zone %q: %d points, want the last of each of 6 Tokyo days", tz, len(got))
+		}
+	}
+}
+
+func TestGetAnalysisAtPicksTheSnapshotBefore(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	t1 := time.Date(2026, 9, 7, 9, 0, 0, 0, time.UTC)
+	t2 := t1.AddDate(0, 0, 14)
+	rows := []struct {
+		at    time.Time
+		req   dto.AnalyzeRequest
+		score float64
+	}{
+		{t1, dto.AnalyzeRequest{UserID: 700001, Period: dto.PeriodWeek}, 60},
+		{t2, dto.AnalyzeRequest{UserID: 700001, Period: dto.PeriodWeek}, 70},
+		// A tag-filtered view does not describe the week and is never picked.
+		{t2.Add(time.Hour), dto.AnalyzeRequest{UserID: 700001, Period: dto.PeriodWeek, Tags: []string{"work"}}, 10},
+		// Nor is a field-masked one: its other blocks were never computed.
+		{t2.Add(90 * time.Minute), dto.AnalyzeRequest{UserID: 700001, Period: dto.PeriodWeek, Fields: []string{"energy_by_weekday"}}, 0},
+	}
+	for i, row := range rows {
+		resp := dto.AnalyzeResponse{ProductivityModel: dto.ProductivityModel{Score: row.score}}
+		key := "diff-" + strconv.Itoa(i)
+		if err := repo.SaveAnalysis(ctx, key, row.req, resp); err != nil {
+			t.Fatalf("SaveAnalysis: %v", err)
+		}
+		if _, err := repo.pg.Exec(ctx, `update analyses set created_at = $2 where id = $1`, key, row.at); err != nil {
+			t.Fatalf("backdate: %v", err)
+		}
+	}
+
+	cases := []struct {
+		at        time.Time
+		ok        bool
+		score     float64
+		createdAt time.Time
+	}{
+		{t1.Add(-time.Minute), false, 0, time.Time{}},
+		{t1, true, 60, t1},
+		{t2.Add(-time.Minute), true, 60, t1},
+		{t2.Add(2 * time.Hour), true, 70, t2},
+	}
+	for _, c := range cases {
+		resp, createdAt, ok, err := repo.GetAnalysisAt(ctx, 700001, "week", c.at)
+		if err != nil {
+			t.Fatalf("GetAnalysisAt(%s): %v", c.at, err)
+		}
+		if ok != c.ok || resp.ProductivityModel.Score != c.score || !createdAt.Equal(c.createdAt) {
+			t.Errorf("GetAnalysisAt(%s) = score %v at %s, ok %v; want %v at %s, ok %v",
+				c.at, resp.ProductivityModel.Score, createdAt, ok, c.score, c.createdAt, c.ok)
+		}
+	}
+}
+
+func TestConcurrentFriendResponsesResolveOnce(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	seedUsers(t, repo, 700001, 700002, 700003)
+
+	respond := func(requestID int64, actions ...string) []string {
+		t.Helper()
+		prev := make([]string, len(actions))
+		errs := make([]error, len(actions))
+		var wg sync.WaitGroup
+		for i, action := range actions {
+			wg.Add(1)
+			go func(i int, action string) {
+				defer wg.Done()
+				prev[i], errs[i] = repo.RespondFriendRequest(ctx, 700002, requestID, action)
+			}(i, action)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				t.Fatalf("RespondFriendRequest: %v", err)
+			}
+		}
+		return prev
+	}
+	resolved := func(prev []string) int {
+		n := 0
+		for _, p := range prev {
+			if p == "pending" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// A double click on two instances: one accept resolves it, the others see it already accepted.
+	fr, err := repo.CreateFriendRequest(ctx, 700001, 700002)
+	if err != nil {
+		t.Fatalf("CreateFriendRequest: %v", err)
+	}
+	prev := respond(fr.ID, "accept", "accept", "accept", "accept", "accept", "accept")
+	if n := resolved(prev); n != 1 {
+		t.Errorf("%d accepts resolved the request (%v), want 1", n, prev)
+	}
+	var friends int
+	if err := repo.pg.QueryRow(ctx, `select count(*) from friends where (user_id, friend_id) in ((700001, 700002), (700002, 700001))`).Scan(&friends); err != nil {
+		t.Fatalf("count friends: %v", err)
+	}
+	if friends != 2 {
+		t.Errorf("%d friend rows, want 2", friends)
+	}
+
+	// An accept racing a decline: whichever wins, the other leaves the request as it found it.
+	fr, err = repo.CreateFriendRequest(ctx, 700003, 700002)
+	if err != nil {
+		t.Fatalf("CreateFriendRequest: %v", err)
+	}
+	prev = respond(fr.ID, "accept", "decline")
+	if n := resolved(prev); n != 1 {
+		t.Fatalf("%d responses resolved the request (%v), want 1", n, prev)
+	}
+	var status string
+	if err := repo.pg.QueryRow(ctx, `select status from friend_requests where id = $1`, fr.ID).Scan(&status); err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if err := repo.pg.QueryRow(ctx, `select count(*) from friends where user_id = 700003 and friend_id = 700002`).Scan(&friends); err != nil {
+		t.Fatalf("count friends: %v", err)
+	}
+	if (status == "accepted") != (friends == 1) || (status != "accepted" && status != "declined") {
+		t.Errorf("status %q with %d friend rows", status, friends)
+	}
+}
+
+func TestCountTrackPointsStopsAtLimit(t *testing.T) {
+	repo := testRepository(t)
+	ctx := context.Background()
+	start := time.Now().UTC().AddDate(0, 0, -10).Truncate(24 * time.Hour)
+	var pts []dto.TrackPoint
+	for d := 0; d < 7; d++ {
+		pts = append(pts, dto.TrackPoint{TS: start.AddDate(0, 0, d).Add(9 * time.Hour), Mood: 6})
+	}
+	if _, err := repo.SaveTrackPoints(ctx, 700001, pts); err != nil {
+		t.Fatalf("SaveTrackPoints: %v", err)
+	}
+
+	for _, c := range []struct{ user, limit, want int }{{700001, 5, 5}, {700001, 100, 7}, {700002, 5, 0}} {
+		n, err := repo.CountTrackPoints(ctx, int32(c.user), c.limit)
+		if err != nil {
+			t.Fatalf("CountTrackPoints(%d, %d): %v", c.user, c.limit, err)
+		}
+		if n != c.want {
+			t.Errorf("CountTrackPoints(%d, %d) = %d, want %d", c.user, c.limit, n, c.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+const (
+	// activityFeedWindow — насколько старые события друзей ещё попадают в ленту.
+	activityFeedWindow = 30 * 24 * time.Hour
+	// defaultActivityFeedLimit/maxActivityFeedLimit — размер ленты по умолчанию и предел.
+	defaultActivityFeedLimit = 20
+	maxActivityFeedLimit     = 100
+)
+
+// GetActivityFeed отдаёт последние события друзей, которые включили share_analyses: серии дней с отметками
+// и снижение риска выгорания. Сырых метрик и заметок в ленте нет. limit <= 0 — defaultActivityFeedLimit.
+// Пример: GetActivityFeed(ctx, 42, 0)[0] -> {Friend: {Name: "Аня"}, Kind: "streak", Value: "30"}.
+func (a *Analyzer) GetActivityFeed(ctx context.Context, userID int32, limit int) ([]dto.FriendActivity, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return nil, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("user id is required")
+	}
+	if limit <= 0 {
+		limit = defaultActivityFeedLimit
+	}
+	if limit > maxActivityFeedLimit {
+		limit = maxActivityFeedLimit
+	}
+	events, err := a.repo.ListFriendActivity(ctx, userID, time.Now().Add(-activityFeedWindow), limit)
+	if err != nil {
+		return nil, err
+	}
+	for i := range events {
+		events[i].Message = activityMessage(events[i])
+	}
+	return events, nil
+}
+
+// sharesActivity reports whether the user agreed to show friends their analyses; the feed uses the
+// same consent. A failed read counts as no consent.
+func (a *Analyzer) sharesActivity(ctx context.Context, userID int32) bool {
+	p, err := a.repo.GetUserProfile(ctx, userID)
+	return err == nil && p.ShareAnalyses
+}
+
+// recordMilestones records the feed events a background run produced: the highest streak milestone the
+// current logging streak reached (once per streak) and a drop of the weekly burnout level from prevLevel
+// to curLevel (once per day). Failures are ignored: the feed is best effort.
+func (a *Analyzer) recordMilestones(ctx context.Context, userID int32, userTZ, prevLevel, curLevel string) {
+	loc := a.ResolveLocation(userTZ)
+	dayStart := a.dayStartHour(ctx, userID)
+	now := time.Now().In(loc)
+	today, _ := dayBounds(now, dayStart)
+	longest := analytics.StreakMilestones[len(analytics.StreakMilestones)-1]
+	from, _ := dayBounds(now.AddDate(0, 0, -longest), dayStart)
+	if days, err := a.repo.GetTrackDays(ctx, userID, from.UTC(), now.UTC(), zoneName(loc), dayStart); err == nil {
+		streak, first := analytics.StreakRun(days, today.Format("2006-01-02"))
+		if m := analytics.StreakMilestone(streak); m > 0 {
+			_ = a.repo.RecordFriendActivity(ctx, userID, dto.ActivityKindStreak, strconv.Itoa(m),
+				fmt.Sprintf("streak:%d:%s", m, first))
+		}
+	}
+	if analytics.BurnoutImproved(prevLevel, curLevel) {
+		_ = a.repo.RecordFriendActivity(ctx, userID, dto.ActivityKindBurnoutImproved, curLevel,
+			fmt.Sprintf("burnout:%s:%s", curLevel, today.Format("2006-01-02")))
+	}
+}
+
+// activityMessage — готовая строка события для ленты.
+func activityMessage(ev dto.FriendActivity) string {
+	switch ev.Kind {
+	case dto.ActivityKindStreak:
+		return fmt.Sprintf("%s отмечается %s дней подряд", ev.Friend.Name, ev.Value)
+	case dto.ActivityKindBurnoutImproved:
+		level := map[string]string{"low": "низкого", "medium": "среднего"}[ev.Value]
+		if level == "" {
+			level = ev.Value
+		}
+		return fmt.Sprintf("%s: риск выгорания снизился до %s", ev.Friend.Name, level)
+	}
+	return ""
+}
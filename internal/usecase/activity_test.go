@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// activityRepo records the feed events a run produces.
+type activityRepo struct {
+	*fakeRepo
+	events []dto.FriendActivity
+	keys   []string
+}
+
+func (r *activityRepo) RecordFriendActivity(_ context.Context, _ int32, kind, value, dedupeKey string) error {
+	r.events = append(r.events, dto.FriendActivity{Kind: kind, Value: value})
+	r.keys = append(r.keys, dedupeKey)
+	return nil
+}
+
+func TestRecordMilestonesOnStreakThreshold(t *testing.T) {
+	cases := []struct {
+		name string
+		days int
+		prev string
+		cur  string
+		want []dto.FriendActivity
+	}{
+		{"below the first threshold", 6, "", "", nil},
+		{"week streak", 7, "", "", []dto.FriendActivity{{Kind: dto.ActivityKindStreak, Value: "7"}}},
+		{"month streak and lower burnout", 31, "high", "medium", []dto.FriendActivity{
+			{Kind: dto.ActivityKindStreak, Value: "30"},
+			{Kind: dto.ActivityKindBurnoutImproved, Value: "medium"},
+		}},
+		{"burnout got worse", 3, "low", "high", nil},
+	}
+	for _, c := range cases {
+		repo := &activityRepo{fakeRepo: &fakeRepo{}}
+		seedDays(repo.fakeRepo, c.days, nil)
+		// time.Local is the server zone of a user without one; its SQL name must not be "Local".
+		a := NewAnalyzer(nil, repo, Config{DefaultLocation: time.Local})
+		a.recordMilestones(context.Background(), testUserID, "", c.prev, c.cur)
+
+		if len(repo.events) != len(c.want) {
+			t.Errorf("%s: events = %+v, want %+v", c.name, repo.events, c.want)
+			continue
+		}
+		for i := range c.want {
+			if repo.events[i] != c.want[i] {
+				t.Errorf("%s: event %d = %+v, want %+v", c.name, i, repo.events[i], c.want[i])
+			}
+		}
+	}
+
+	// The same streak keeps its dedupe key on the next run, so it is announced once.
+	repo := &activityRepo{fakeRepo: &fakeRepo{}}
+	seedDays(repo.fakeRepo, 8, nil)
+	a := NewAnalyzer(nil, repo, Config{DefaultLocation: time.Local})
+	a.recordMilestones(context.Background(), testUserID, "", "", "")
+	a.recordMilestones(context.Background(), testUserID, "", "", "")
+	if len(repo.keys) != 2 || repo.keys[0] != repo.keys[1] {
+		t.Errorf("dedupe keys = %q, want the same key twice", repo.keys)
+	}
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"nexus/internal/dto"
+	"nexus/internal/notify"
+)
+
+// burnoutAlertTimeout bounds one webhook delivery started after an analysis.
+const burnoutAlertTimeout = 15 * time.Second
+
+// ErrInvalidWebhookURL — адрес вебхука не https или указывает на локальный или внутренний хост.
+var ErrInvalidWebhookURL = errors.New("invalid webhook url")
+
+// GetBurnoutWebhook отдаёт адрес, на который уходят оповещения о высоком риске выгорания; пустой — оповещения выключены.
+// Пример: GetBurnoutWebhook(ctx, 42) -> "https://example.com/hooks/burnout".
+func (a *Analyzer) GetBurnoutWebhook(ctx context.Context, userID int32) (string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return "", errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return "", errors.New("user id is required")
+	}
+	return a.repo.GetBurnoutWebhook(ctx, userID)
+}
+
+// SetBurnoutWebhook включает оповещения о переходе недельного риска выгорания в high на адрес rawURL;
+// пустой адрес их выключает. Принимается только https на публичный хост. Оповещение уходит один раз
+// за период высокого риска и снова взводится, когда риск опускается ниже.
+// Пример: SetBurnoutWebhook(ctx, 42, "https://example.com/hooks/burnout") -> "https://example.com/hooks/burnout".
+func (a *Analyzer) SetBurnoutWebhook(ctx context.Context, userID int32, rawURL string) (string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return "", errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return "", errors.New("user id is required")
+	}
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL != "" {
+		if err := validateWebhookURL(rawURL); err != nil {
+			return "", err
+		}
+	}
+	if err := a.repo.UpdateBurnoutWebhook(ctx, userID, rawURL); err != nil {
+		return "", err
+	}
+	return rawURL, nil
+}
+
+// validateWebhookURL keeps user-supplied endpoints off the service's own network: https only, and no
+// localhost or non-public address literals. Hostnames are checked again when the notifier dials them.
+func validateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return ErrInvalidWebhookURL
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrInvalidWebhookURL
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if !notify.PublicIP(ip) {
+			return ErrInvalidWebhookURL
+		}
+	}
+	return nil
+}
+
+// checkBurnoutAlert fires the burnout webhook when a fresh weekly analysis reports high risk and no
+// alert was sent for the current high streak; a low or medium level re-arms it. Insufficient data
+// leaves the state alone so a gap in logging does not cause a repeat alert.
+func (a *Analyzer) checkBurnoutAlert(ctx context.Context, userID int32, risk dto.BurnoutRisk) {
+	if a.burnoutNotifier == nil {
+		return
+	}
+	switch risk.Level {
+	case "high":
+	case "low", "medium":
+		_ = a.repo.ResetBurnoutAlert(ctx, userID)
+		return
+	default:
+		return
+	}
+	url, ok, err := a.repo.ClaimBurnoutAlert(ctx, userID)
+	if err != nil || !ok {
+		return
+	}
+	alert := dto.BurnoutAlert{
+		UserID: userID,
+		Level:  risk.Level,
+		Score:  risk.Score,
+		At:     time.Now().UTC(),
+	}
+	for _, r := range risk.StructuredReasons {
+		alert.Reasons = append(alert.Reasons, r.Code)
+	}
+	a.goAsync(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), burnoutAlertTimeout)
+		defer cancel()
+		if err := a.burnoutNotifier.NotifyBurnout(ctx, url, alert); err != nil {
+			// Re-arm so the next analysis that still sees high risk tries again.
+			log.Printf("burnout alert: user %d: %v", userID, err)
+			_ = a.repo.ResetBurnoutAlert(ctx, userID)
+		}
+	})
+}
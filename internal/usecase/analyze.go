@@ -7,13 +7,31 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
 	"math"
 	"nexus/internal/domain/analytics"
 	"nexus/internal/dto"
+	"nexus/internal/hepler"
+	"os"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
+
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	ErrUnknownMetric = errors.New("unknown metric")
+	ErrInvalidDate   = errors.New("date must be YYYY-MM-DD and not in the future")
 )
 
+// insightUnavailablePrefix начинает текст инсайта, если вызов LLM не удался.
+const insightUnavailablePrefix = "LLM insight unavailable: "
+
+// minBurnoutPoints — столько отметок нужно для прогноза выгорания; до этого инсайт строится шаблоном (onboardingInsight).
+const minBurnoutPoints = 5
+
 func (a *Analyzer) Analyze(ctx context.Context, req dto.AnalyzeRequest) (*dto.AnalyzeResponse, error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -21,119 +39,289 @@ func (a *Analyzer) Analyze(ctx context.Context, req dto.AnalyzeRequest) (*dto.An
 	if req.UserID <= 0 {
 		return nil, errors.New("user id is required")
 	}
-
-	loc := time.UTC
-	if req.UserTZ != "" {
-		l, err := time.LoadLocation(req.UserTZ)
-		if err == nil {
-			loc = l
-		}
+	if req.Date != "" {
+		req.Period = dto.PeriodDay
+	}
+	if !req.SkipInsight && !a.analysesEnabled(ctx, req.UserID) {
+		req.SkipInsight = true
 	}
 
+	loc := a.ResolveLocation(req.UserTZ)
+
 	cacheKey, err := buildCacheKey(req)
-	if err == nil && a.repo != nil && a.llm == nil {
+	if err == nil && a.repo != nil && (a.llm == nil || req.SkipInsight) {
 		resp, ok, err := a.repo.GetCachedResponse(ctx, cacheKey)
-		if err == nil && ok && resp != nil {
+		// An entry cached without its LLM text cannot answer a request that wants an insight.
+		if err == nil && ok && resp != nil && (req.SkipInsight || resp.LLMInsight != "") {
+			a.applyBurnoutDismissal(ctx, req.UserID, req.Period, &resp.BurnoutRisk)
 			return resp, nil
 		}
 	}
 
-	start, end := periodRange(req.Period, time.Now().In(loc))
 	if a.repo == nil {
 		return nil, errors.New("repository not configured")
 	}
-	pts, err := a.repo.GetTrackPoints(ctx, req.UserID, start.UTC(), end.UTC())
+
+	resp, prompt, err := a.computeAnalysis(ctx, req, loc)
 	if err != nil {
 		return nil, err
 	}
-	if len(pts) < 1 {
-		return nil, errors.New("need at least 1 point for analytics")
+	if prompt.NumPoints == 0 {
+		return resp, nil
+	}
+
+	// Onboarding is for new users: a quiet window of a long-time user still gets a real insight.
+	total := prompt.NumPoints
+	if !req.SkipInsight {
+		total = a.lifetimePoints(ctx, req.UserID, prompt.NumPoints)
+	}
+	switch {
+	case req.SkipInsight:
+	case total < minBurnoutPoints:
+		resp.InsightSource = insightSourceOnboarding
+		resp.LLMInsight = onboardingInsight(resp.DataSufficiency, prompt, total)
+	case a.llm != nil:
+		resp.InsightSource = insightSourceLLM
+		resp.LLMInsight, err = a.callInsight(ctx, req.UserID, prompt)
+		if err != nil {
+			resp.LLMInsight = insightUnavailablePrefix + err.Error()
+		} else {
+			a.dedupeInsight(ctx, req, resp)
+		}
+	default:
+		resp.InsightSource = insightSourceSummary
+		resp.LLMInsight = numericSummary(prompt)
+	}
+
+	a.storeResult(ctx, cacheKey, req, *resp)
+
+	return resp, nil
+}
+
+// GetPromptAggregates пересчитывает агрегаты, которые ушли бы в промпт инсайта за период, не вызывая LLM
+// и ничего не сохраняя: по ним поддержка разбирает, почему инсайт сказал то, что сказал.
+// Пример: GetPromptAggregates(ctx, dto.AnalyzeRequest{UserID: 42, Period: dto.PeriodWeek}).AvgSleepHours -> 6.8.
+func (a *Analyzer) GetPromptAggregates(ctx context.Context, req dto.AnalyzeRequest) (dto.AIPrompt, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.AIPrompt{}, errors.New("repository not configured")
+	}
+	if req.UserID <= 0 {
+		return dto.AIPrompt{}, errors.New("user id is required")
+	}
+	if req.UserTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, req.UserID); err == nil {
+			req.UserTZ = tz
+		}
+	}
+	_, prompt, err := a.computeAnalysis(ctx, req, a.ResolveLocation(req.UserTZ))
+	return prompt, err
+}
+
+// computeAnalysis считает разбор без обращения к LLM: ответ без LLMInsight и промпт, который ушёл бы в модель.
+// Если в окне нет отметок, возвращает пустой ответ и промпт только с окном (NumPoints == 0).
+func (a *Analyzer) computeAnalysis(ctx context.Context, req dto.AnalyzeRequest, loc *time.Location) (*dto.AnalyzeResponse, dto.AIPrompt, error) {
+	var err error
+	dayStart := a.dayStartHour(ctx, req.UserID)
+	start, end := periodRange(req.Period, time.Now().In(loc), dayStart)
+	if req.RollingDays > 0 {
+		end = time.Now().In(loc)
+		start = end.AddDate(0, 0, -req.RollingDays)
+	}
+	if req.Date != "" {
+		start, end, err = a.dateRange(ctx, req.UserID, req.Date, loc)
+		if err != nil {
+			return nil, dto.AIPrompt{}, err
+		}
+	}
+	pts, err := a.repo.GetTrackPoints(ctx, req.UserID, start.UTC(), end.UTC(), req.Tags)
+	if err != nil {
+		return nil, dto.AIPrompt{}, err
 	}
 	for i := range pts {
 		pts[i].TS = pts[i].TS.In(loc)
 	}
+	pts, excludedDays := analytics.ExcludeDays(pts, dayStart, req.ExcludeTags, req.ExcludeOutliers, a.energyParams)
+	horizon := req.BurnoutHorizonDays
+	if horizon <= 0 {
+		horizon = analytics.DefaultBurnoutHorizonDays
+	}
+	meta := dto.PeriodMeta{End: end.In(loc), Label: analytics.PeriodLabel(start.In(loc), end.In(loc), dayStart)}
+	if !start.IsZero() {
+		meta.Start = start.In(loc)
+	}
+	if len(pts) < 1 {
+		resp := emptyAnalyzeResponse(horizon)
+		resp.PeriodMeta = meta
+		resp.ExcludedDays = excludedDays
+		return resp, dto.AIPrompt{
+			UserTZ:      req.UserTZ,
+			Period:      req.Period,
+			PeriodStart: start.In(loc),
+			PeriodEnd:   end.In(loc),
+		}, nil
+	}
 
-	energyByWeekday := analytics.ComputeEnergyByWeekday(pts)
-
-	model := analytics.ComputeProductivityModel(pts)
+	hidden := a.hiddenMetrics(ctx, req.UserID)
 
-	var risk dto.BurnoutRisk
-	if len(pts) >= 5 {
-		risk = analytics.ComputeBurnoutRisk(pts, model)
-	} else {
-		risk = dto.BurnoutRisk{
-			Score:                 0,
-			Level:                 "недостаточно данных",
-			Reasons:               []string{"Недостаточно данных для прогноза выгорания (нужно хотя бы 5 точек)."},
-			PredictionHorizonDays: 14,
+	// The analytics blocks only read pts, so they run concurrently with the averages below
+	// and are joined before the prompt and the response are built.
+	var (
+		g               errgroup.Group
+		energyByWeekday map[string]float64
+		energyCI        map[string]dto.EnergyCI
+		model           dto.ProductivityModel
+		risk            dto.BurnoutRisk
+		schedule        dto.OptimalSchedule
+		quality         []dto.DataQualityWarning
+		sufficiency     dto.DataSufficiency
+		userNotes       string
+		uniqueDays      int
+		maxGapDays      int
+		coverage        float64
+		trendsReliable  bool
+		focus           *dto.FocusStats
+		recentPrompt    dto.AIPrompt
+	)
+	g.Go(func() error {
+		energyByWeekday = analytics.ComputeEnergyByWeekday(pts, a.energyParams)
+		if wantsField(req, "energy_by_weekday_ci") {
+			energyCI = analytics.ComputeEnergyByWeekdayCI(pts, a.energyParams)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		model = analytics.ComputeProductivityModel(pts, a.energyParams)
+		if len(pts) >= minBurnoutPoints {
+			risk = analytics.ComputeBurnoutRisk(pts, model, horizon, hidden, a.energyParams)
+			// The period's points may start well after the account did; coverage counts from the first entry.
+			if first, ok, err := a.repo.GetFirstTrackTS(ctx, req.UserID); err == nil && ok {
+				risk.CoverageRatio = analytics.LoggingCoverage(pts, horizon, first)
+			}
+			if a.coverageSignal {
+				risk = analytics.ApplyCoverageSignal(risk, pts)
+			}
+		} else {
+			risk = dto.BurnoutRisk{
+				Score:                 0,
+				Level:                 "недостаточно данных",
+				Reasons:               []string{"Недостаточно данных для прогноза выгорания (нужно хотя бы 5 точек)."},
+				PredictionHorizonDays: horizon,
+			}
+		}
+		return nil
+	})
+	// Blocks the prompt does not use are skipped when the field mask leaves them out.
+	if wantsField(req, "optimal_schedule") {
+		g.Go(func() error {
+			schedule = analytics.ComputeOptimalSchedule(pts, req.Constraints, a.energyParams)
+			return nil
+		})
+	}
+	if wantsField(req, "data_quality_warnings") {
+		g.Go(func() error {
+			quality = analytics.DetectContradictions(pts)
+			return nil
+		})
+	}
+	g.Go(func() error {
+		uniqueDays = countUniqueDays(pts)
+		sufficiency = dataSufficiency(pts, uniqueDays, windowDays(start, end), horizon)
+		maxGapDays, coverage = analytics.TrendCoverage(pts, horizon)
+		trendsReliable = analytics.TrendReliable(pts, horizon)
+		recent, older := splitLongHistory(pts, end)
+		userNotes = buildUserNotes(recent, maxUserNotesRunes)
+		if len(older) > 0 {
+			recentPrompt = a.recentAggregates(recent, older)
 		}
+		return nil
+	})
+	if len(req.Tags) == 0 && wantsField(req, "focus_stats") {
+		// Sessions carry no tags, so a tag-filtered view has no focus block. A read error only drops the block.
+		g.Go(func() error {
+			sessions, err := a.repo.ListFocusSessions(ctx, req.UserID, start.UTC(), end.UTC())
+			if err == nil && len(sessions) > 0 {
+				fs := analytics.ComputeFocusStats(sessions, loc)
+				focus = &fs
+			}
+			return nil
+		})
 	}
 
-	obsDays := analytics.ObservedWeekdaysList(energyByWeekday)
-	userNotes := buildUserNotes(pts, 1200)
-
-	uniqueDays := countUniqueDays(pts)
-	avgSleepHours := round2(avgField(pts, func(p dto.TrackPoint) float64 { return p.SleepHours }))
-	avgSleepQuality := round2(avgField(pts, func(p dto.TrackPoint) float64 { return p.SleepQuality }))
-	avgMood := round2(avgField(pts, func(p dto.TrackPoint) float64 { return p.Mood }))
-	avgActivity := round2(avgField(pts, func(p dto.TrackPoint) float64 { return p.Activity }))
-	avgProductive := round2(avgField(pts, func(p dto.TrackPoint) float64 { return p.Productive }))
-	avgStress := round2(avgField(pts, func(p dto.TrackPoint) float64 { return p.Stress }))
-	avgEnergy := round2(avgField(pts, func(p dto.TrackPoint) float64 { return p.Energy }))
-	avgConcentration := round2(avgField(pts, func(p dto.TrackPoint) float64 { return p.Concentration }))
+	avgSleepHours := avgField(pts, func(p dto.TrackPoint) float64 { return p.SleepHours })
+	avgSleepQuality := avgField(pts, func(p dto.TrackPoint) float64 { return p.SleepQuality })
+	avgMood := avgField(pts, func(p dto.TrackPoint) float64 { return p.Mood })
+	avgActivity := avgField(pts, func(p dto.TrackPoint) float64 { return p.Activity })
+	avgProductive := avgField(pts, func(p dto.TrackPoint) float64 { return p.Productive })
+	avgStress := avgField(pts, func(p dto.TrackPoint) float64 { return p.Stress })
+	avgEnergy := avgField(pts, func(p dto.TrackPoint) float64 { return p.Energy })
+	avgConcentration := avgField(pts, func(p dto.TrackPoint) float64 { return p.Concentration })
 	minEnergy, maxEnergy := minMaxField(pts, func(p dto.TrackPoint) float64 { return p.Energy })
 	minStress, maxStress := minMaxField(pts, func(p dto.TrackPoint) float64 { return p.Stress })
 	minSleep, maxSleep := minMaxField(pts, func(p dto.TrackPoint) float64 { return p.SleepHours })
-	minEnergy = round2(minEnergy)
-	maxEnergy = round2(maxEnergy)
-	minStress = round2(minStress)
-	maxStress = round2(maxStress)
-	minSleep = round2(minSleep)
-	maxSleep = round2(maxSleep)
 	avgSleepStart := avgSleepTime(pts, func(p dto.TrackPoint) string { return p.SleepStart })
 	avgSleepEnd := avgSleepTime(pts, func(p dto.TrackPoint) string { return p.SleepEnd })
+	jetlag := analytics.SocialJetlag(pts)
 
-	llmText := "LLM disabled"
-	if a.llm != nil {
-		llmText, err = a.llm.CallInsight(ctx, dto.AIPrompt{
-			UserTZ:               req.UserTZ,
-			Period:               req.Period,
-			PeriodStart:          start.In(loc),
-			PeriodEnd:            end.In(loc),
-			EnergyByWeekday:      energyByWeekday,
-			ProductivityScore:    model.Score,
-			BurnoutScore:         risk.Score,
-			BurnoutLevel:         risk.Level,
-			BurnoutReasons:       risk.Reasons,
-			NumPoints:            len(pts),
-			NumObservedWeekdays:  len(energyByWeekday),
-			NumObservedDays:      uniqueDays,
-			ObservedWeekdaysList: obsDays,
-			UserNotes:            userNotes,
-			AvgSleepHours:        avgSleepHours,
-			AvgSleepQuality:      avgSleepQuality,
-			AvgMood:              avgMood,
-			AvgActivity:          avgActivity,
-			AvgProductive:        avgProductive,
-			AvgStress:            avgStress,
-			AvgEnergy:            avgEnergy,
-			AvgConcentration:     avgConcentration,
-			AvgSleepStart:        avgSleepStart,
-			AvgSleepEnd:          avgSleepEnd,
-			MinEnergy:            minEnergy,
-			MaxEnergy:            maxEnergy,
-			MinStress:            minStress,
-			MaxStress:            maxStress,
-			MinSleepHours:        minSleep,
-			MaxSleepHours:        maxSleep,
-		})
-		if err != nil {
-			llmText = "LLM insight unavailable: " + err.Error()
-		}
+	if err := g.Wait(); err != nil {
+		return nil, dto.AIPrompt{}, err
+	}
+	a.applyBurnoutDismissal(ctx, req.UserID, req.Period, &risk)
+	obsDays := analytics.ObservedWeekdaysList(energyByWeekday)
+
+	// The response keeps full precision and is rounded by the handler; the prompt gets two decimals, as
+	// it prints them, so the insight cache keys do not change with float noise.
+	prompt := dto.AIPrompt{
+		UserTZ:               req.UserTZ,
+		Period:               req.Period,
+		PeriodStart:          start.In(loc),
+		PeriodEnd:            end.In(loc),
+		EnergyByWeekday:      round2Values(energyByWeekday),
+		ProductivityScore:    round2(model.Score),
+		BurnoutScore:         round2(risk.Score),
+		BurnoutLevel:         risk.Level,
+		BurnoutReasons:       risk.Reasons,
+		NumPoints:            len(pts),
+		NumObservedWeekdays:  len(energyByWeekday),
+		NumObservedDays:      uniqueDays,
+		ObservedWeekdaysList: obsDays,
+		TrendsReliable:       trendsReliable,
+		HiddenMetrics:        sortedKeys(hidden),
+		Model:                req.Model,
+		Tone:                 a.insightTone(ctx, req),
+		UserNotes:            userNotes,
+		Feedback:             req.Feedback,
+		AvgSleepHours:        round2(avgSleepHours),
+		AvgSleepQuality:      round2(avgSleepQuality),
+		AvgMood:              round2(avgMood),
+		AvgActivity:          round2(avgActivity),
+		AvgProductive:        round2(avgProductive),
+		AvgStress:            round2(avgStress),
+		AvgEnergy:            round2(avgEnergy),
+		AvgConcentration:     round2(avgConcentration),
+		AvgSleepStart:        avgSleepStart,
+		AvgSleepEnd:          avgSleepEnd,
+		MinEnergy:            round2(minEnergy),
+		MaxEnergy:            round2(maxEnergy),
+		MinStress:            round2(minStress),
+		MaxStress:            round2(maxStress),
+		MinSleepHours:        round2(minSleep),
+		MaxSleepHours:        round2(maxSleep),
+		SocialJetlagHours:    round2(jetlag),
+		RecentWindowDays:     recentPrompt.RecentWindowDays,
+		RecentAvgSleepHours:  recentPrompt.RecentAvgSleepHours,
+		RecentAvgMood:        recentPrompt.RecentAvgMood,
+		RecentAvgStress:      recentPrompt.RecentAvgStress,
+		RecentAvgEnergy:      recentPrompt.RecentAvgEnergy,
+		OlderNotes:           recentPrompt.OlderNotes,
+		OlderKeywords:        recentPrompt.OlderKeywords,
+		Goals:                a.goalProgress(ctx, req.UserID, pts, hidden, dayStart),
 	}
 
 	debug := map[string]any{}
-	avgSleep := analytics.AvgSleepDays(pts, 14)
+	avgSleep := analytics.AvgSleepDays(pts, horizon)
 	if avgSleep > 0 {
 		debug["avg_sleep_hours"] = avgSleep
 	}
@@ -143,6 +331,8 @@ func (a *Analyzer) Analyze(ctx context.Context, req dto.AnalyzeRequest) (*dto.An
 	if avgSleepEnd != "" {
 		debug["avg_sleep_end"] = avgSleepEnd
 	}
+	debug["max_gap_days"] = maxGapDays
+	debug["coverage_ratio"] = round2(coverage)
 	sleepDelta := analytics.SleepDeltaDays(pts, 7)
 	if sleepDelta != 0 {
 		debug["avg_sleep_delta"] = sleepDelta
@@ -150,16 +340,66 @@ func (a *Analyzer) Analyze(ctx context.Context, req dto.AnalyzeRequest) (*dto.An
 
 	resp := &dto.AnalyzeResponse{
 		EnergyByWeekday:   energyByWeekday,
+		EnergyByWeekdayCI: energyCI,
 		ProductivityModel: model,
 		BurnoutRisk:       risk,
-		OptimalSchedule:   dto.OptimalSchedule{},
-		LLMInsight:        llmText,
+		OptimalSchedule:   schedule,
+		DataSufficiency:   sufficiency,
+		DataQuality:       quality,
+		FocusStats:        focus,
+		SocialJetlagHours: jetlag,
+		PeriodMeta:        meta,
+		ExcludedDays:      excludedDays,
 		Debug:             debug,
+		FilterTags:        req.Tags,
+		Averages: map[string]float64{
+			"sleep_hours":   avgSleepHours,
+			"sleep_quality": avgSleepQuality,
+			"mood":          avgMood,
+			"activity":      avgActivity,
+			"productive":    avgProductive,
+			"stress":        avgStress,
+			"energy":        avgEnergy,
+			"concentration": avgConcentration,
+		},
 	}
+	return resp, prompt, nil
+}
 
-	a.storeResult(ctx, cacheKey, req, *resp)
-
-	return resp, nil
+func (a *Analyzer) RegenerateInsight(ctx context.Context, req dto.AnalyzeRequest) (*dto.AnalyzeResponse, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return nil, errors.New("repository not configured")
+	}
+	if a.llm == nil {
+		return nil, errors.New("llm not configured")
+	}
+	if req.UserID <= 0 {
+		return nil, errors.New("user id is required")
+	}
+	req.Feedback = hepler.SanitizeUserText(req.Feedback)
+	if r := []rune(req.Feedback); len(r) > hepler.MaxFeedbackRunes {
+		req.Feedback = string(r[:hepler.MaxFeedbackRunes])
+	}
+	if req.Feedback == "" {
+		return nil, errors.New("feedback is required")
+	}
+	if req.UserTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, req.UserID); err == nil {
+			req.UserTZ = tz
+		}
+	}
+	period := string(req.Period)
+	if period == "" {
+		period = "all"
+	}
+	// The feedback is kept for prompt tuning only; failing to store it must not cost the user the new insight.
+	if err := a.repo.SaveInsightFeedback(ctx, req.UserID, period, req.Feedback); err != nil {
+		log.Printf("insight feedback: user %d: %v", req.UserID, err)
+	}
+	return a.Analyze(ctx, req)
 }
 
 func (a *Analyzer) Track(ctx context.Context, req dto.TrackRequest) (int, error) {
@@ -175,24 +415,21 @@ func (a *Analyzer) Track(ctx context.Context, req dto.TrackRequest) (int, error)
 	if len(req.Points) == 0 {
 		return 0, nil
 	}
-	loc := time.UTC
-	if req.UserTZ != "" {
-		if l, err := time.LoadLocation(req.UserTZ); err == nil {
-			loc = l
-		}
-	}
+	loc := a.ResolveLocation(req.UserTZ)
 	p := req.Points[0]
-	ts := p.TS.In(loc)
-	start := time.Date(ts.Year(), ts.Month(), ts.Day(), 0, 0, 0, 0, loc)
-	end := start.AddDate(0, 0, 1)
+	start, end := dayBounds(p.TS.In(loc), a.dayStartHour(ctx, req.UserID))
 	updated, err := a.repo.UpsertTrackPointForDay(ctx, req.UserID, p, start.UTC(), end.UTC())
 	if err != nil {
 		return 0, err
 	}
 	_ = a.repo.UpsertUserSettings(ctx, req.UserID, req.UserTZ)
 
-	_ = a.repo.SetAnalysisStatusForDay(ctx, req.UserID, start.UTC(), end.UTC(), "pending", "")
-	go a.runAnalysesForUserAsync(req.UserID, req.UserTZ, start.UTC(), end.UTC())
+	// With analyses switched off the point is only stored: no status to track and nothing to run.
+	if a.analysesEnabled(ctx, req.UserID) {
+		_ = a.repo.SetAnalysisStatusForDay(ctx, req.UserID, start.UTC(), end.UTC(), "pending", "")
+		// A newly logged day shifts the hourly stats behind tomorrow's schedule; re-editing the same day rarely does.
+		a.goAsync(func() { a.runAnalysesForUserAsync(req.UserID, req.UserTZ, start.UTC(), end.UTC(), !updated) })
+	}
 
 	if updated {
 		return 0, nil
@@ -200,43 +437,208 @@ func (a *Analyzer) Track(ctx context.Context, req dto.TrackRequest) (int, error)
 	return 1, nil
 }
 
-func (a *Analyzer) runAnalysesForUser(ctx context.Context, userID int32, userTZ string) error {
-	if a.repo == nil || userID <= 0 {
+// runAnalysesForUser re-runs the stored analyses of every period. Unless force is set, the month and
+// all-time periods are skipped while their last run is younger than longRefresh: they barely move
+// between two entries of the same day, and each run costs an LLM call.
+func (a *Analyzer) runAnalysesForUser(ctx context.Context, userID int32, userTZ string, force bool) error {
+	if a.repo == nil || userID <= 0 || !a.analysesEnabled(ctx, userID) {
 		return nil
 	}
-	if userTZ == "" {
-		userTZ = "UTC"
+	var periods []dto.Period
+	for _, p := range append([]dto.Period{dto.PeriodDay, dto.PeriodWeek}, a.dueLongPeriods(ctx, userID, force)...) {
+		if a.autoAnalyzed(p) {
+			periods = append(periods, p)
+		}
+	}
+	// Feed events are only produced for users who share their analyses with friends. The burnout event
+	// compares weekly levels, so it needs week among the auto periods.
+	share := a.sharesActivity(ctx, userID)
+	var prevLevel, curLevel string
+	if share && a.autoAnalyzed(dto.PeriodWeek) {
+		prevLevel, _ = a.repo.GetLastBurnoutLevel(ctx, userID, string(dto.PeriodWeek))
 	}
-	periods := []dto.Period{dto.PeriodDay, dto.PeriodWeek, dto.PeriodMonth, dto.PeriodAll}
-	c := dto.Constraints{WorkStartHour: 9, WorkEndHour: 18}
 	var firstErr error
 	for _, p := range periods {
-		_, err := a.Analyze(ctx, dto.AnalyzeRequest{
-			UserID:      userID,
-			UserTZ:      userTZ,
-			WeekStarts:  "monday",
-			Constraints: c,
-			Period:      p,
-		})
+		resp, err := a.analyzeSingleFlight(ctx, backgroundAnalyzeRequest(userID, userTZ, p))
+		// A failed LLM call still yields a response; report it so the day is retried.
+		if err == nil && resp != nil && strings.HasPrefix(resp.LLMInsight, insightUnavailablePrefix) {
+			err = fmt.Errorf("%s insight: %s", p, strings.TrimPrefix(resp.LLMInsight, insightUnavailablePrefix))
+		}
 		if err != nil && firstErr == nil {
 			firstErr = err
 		}
+		if p == dto.PeriodWeek && resp != nil {
+			curLevel = resp.BurnoutRisk.Level
+		}
+	}
+	if share {
+		a.recordMilestones(ctx, userID, userTZ, prevLevel, curLevel)
 	}
 	return firstErr
 }
 
+// ParseAutoPeriods разбирает список периодов для автоматических разборов через запятую: day, week, month,
+// all, без учёта регистра и повторов. Пустой список и неизвестный период — ошибка.
+// Пример: ParseAutoPeriods("week, month") -> [week month].
+func ParseAutoPeriods(s string) ([]dto.Period, error) {
+	var out []dto.Period
+	seen := map[dto.Period]bool{}
+	for _, part := range strings.Split(s, ",") {
+		p := dto.Period(strings.ToLower(strings.TrimSpace(part)))
+		switch p {
+		case dto.PeriodDay, dto.PeriodWeek, dto.PeriodMonth, dto.PeriodAll:
+		default:
+			return nil, fmt.Errorf("unknown period %q, want day, week, month or all", part)
+		}
+		if !seen[p] {
+			seen[p] = true
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+// autoAnalyzed reports whether p is one of the periods analyzed automatically and shown as the latest.
+func (a *Analyzer) autoAnalyzed(p dto.Period) bool {
+	return a.autoPeriods == nil || a.autoPeriods[p]
+}
+
+// backgroundAnalyzeRequest is the request the stored analyses are produced with.
+func backgroundAnalyzeRequest(userID int32, userTZ string, p dto.Period) dto.AnalyzeRequest {
+	return dto.AnalyzeRequest{
+		UserID:      userID,
+		UserTZ:      userTZ,
+		WeekStarts:  "monday",
+		Constraints: dto.Constraints{WorkStartHour: 9, WorkEndHour: 18},
+		Period:      p,
+	}
+}
+
+// analysisLockTTL bounds how long a crashed instance can block re-analysis of a period (Redis only).
+// A locked run is cut off at analysisRunTimeout, so the lock cannot expire under a slow LLM call and
+// let a second run in.
+const (
+	analysisLockTTL    = 10 * time.Minute
+	analysisRunTimeout = analysisLockTTL - time.Minute
+)
+
+// analyzeSingleFlight runs the stored analysis of req.Period under the single-flight lock of the user's
+// period. When another run holds the lock, the period is marked dirty and skipped (resp is nil): the
+// holder may have read the data before the newest point, so it runs the period again after unlocking.
+// The mark lives in this instance; a run skipped on another instance shows up as a stale period in
+// GetLastAnalyses until the next entry.
+func (a *Analyzer) analyzeSingleFlight(ctx context.Context, req dto.AnalyzeRequest) (*dto.AnalyzeResponse, error) {
+	if a.locker == nil {
+		return a.Analyze(ctx, req)
+	}
+	key := fmt.Sprintf("analysis:%d:%s", req.UserID, req.Period)
+	for {
+		// Mark before trying, so a holder that unlocks right after our failed attempt still sees it.
+		a.setDirty(key, true)
+		unlock, ok := a.lockAnalysis(ctx, key)
+		if !ok {
+			return nil, nil
+		}
+		a.setDirty(key, false)
+		runCtx, cancel := context.WithTimeout(ctx, analysisRunTimeout)
+		resp, err := a.Analyze(runCtx, req)
+		cancel()
+		unlock()
+		if !a.setDirty(key, false) || ctx.Err() != nil {
+			return resp, err
+		}
+	}
+}
+
+// setDirty sets the dirty mark of key and reports whether it was set before.
+func (a *Analyzer) setDirty(key string, dirty bool) bool {
+	a.dirtyMu.Lock()
+	defer a.dirtyMu.Unlock()
+	was := a.dirty[key]
+	if dirty {
+		a.dirty[key] = true
+	} else {
+		delete(a.dirty, key)
+	}
+	return was
+}
+
+// lockAnalysis takes the single-flight lock key. ok is false when another run holds it. When the lock
+// backend fails, the analysis runs unlocked rather than not at all.
+func (a *Analyzer) lockAnalysis(ctx context.Context, key string) (unlock func(), ok bool) {
+	noop := func() {}
+	unlock, ok, err := a.locker.TryLock(ctx, key, analysisLockTTL)
+	if err != nil {
+		return noop, true
+	}
+	if !ok {
+		return nil, false
+	}
+	return unlock, true
+}
+
+// dueLongPeriods returns the month and all-time periods whose stored analysis is missing or older
+// than longRefresh. A failed read counts as due, so the analyses are refreshed rather than left stale.
+func (a *Analyzer) dueLongPeriods(ctx context.Context, userID int32, force bool) []dto.Period {
+	long := []dto.Period{dto.PeriodMonth, dto.PeriodAll}
+	if force || a.longRefresh <= 0 {
+		return long
+	}
+	times, err := a.repo.GetLastAnalysisTimes(ctx, userID)
+	if err != nil {
+		return long
+	}
+	var due []dto.Period
+	for _, p := range long {
+		if last, ok := times[string(p)]; !ok || time.Since(last) >= a.longRefresh {
+			due = append(due, p)
+		}
+	}
+	return due
+}
+
 func (a *Analyzer) AnalyzeAllPeriods(ctx context.Context, userID int32, userTZ string) error {
-	return a.runAnalysesForUser(ctx, userID, userTZ)
+	return a.runAnalysesForUser(ctx, userID, userTZ, true)
+}
+
+// goAsync runs fn in the background and tracks it so Drain can wait for it.
+func (a *Analyzer) goAsync(fn func()) {
+	a.async.Add(1)
+	go func() {
+		defer a.async.Done()
+		fn()
+	}()
 }
 
-func (a *Analyzer) runAnalysesForUserAsync(userID int32, userTZ string, from, to time.Time) {
+// Drain waits for the background re-analyses started by Track and PatchTodayTrack, or until ctx is done.
+// Call it after the servers stopped accepting requests, so no new work is started meanwhile.
+func (a *Analyzer) Drain(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		a.async.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (a *Analyzer) runAnalysesForUserAsync(userID int32, userTZ string, from, to time.Time, refreshSchedule bool) {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 	defer cancel()
-	if err := a.runAnalysesForUser(ctx, userID, userTZ); err != nil {
+	if refreshSchedule {
+		_, _ = a.PrecomputeTomorrowSchedule(ctx, userID, userTZ)
+	}
+	if err := a.runAnalysesForUser(ctx, userID, userTZ, false); err != nil {
 		_ = a.repo.SetAnalysisStatusForDay(ctx, userID, from, to, "failed", err.Error())
+		_ = a.repo.EnqueueFailedAnalysis(ctx, userID, userTZ, from, to, time.Now().Add(analysisRetryDelay(0)), err.Error())
 		return
 	}
 	_ = a.repo.SetAnalysisStatusForDay(ctx, userID, from, to, "ready", "")
+	_ = a.repo.DeleteFailedAnalysis(ctx, userID, from)
 }
 
 func (a *Analyzer) GetTodayTrack(ctx context.Context, userID int32, userTZ string) (dto.TrackPoint, bool, error) {
@@ -249,23 +651,197 @@ func (a *Analyzer) GetTodayTrack(ctx context.Context, userID int32, userTZ strin
 	if userID <= 0 {
 		return dto.TrackPoint{}, false, errors.New("user id is required")
 	}
+	_, start, end := a.todayRange(ctx, userID, userTZ)
+	return a.repo.GetTrackPointForDay(ctx, userID, start.UTC(), end.UTC())
+}
+
+func (a *Analyzer) PatchTodayTrack(ctx context.Context, userID int32, userTZ string, fields map[string]any) (dto.TrackPoint, bool, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.TrackPoint{}, false, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.TrackPoint{}, false, errors.New("user id is required")
+	}
+	userTZ, start, end := a.todayRange(ctx, userID, userTZ)
+	updated, err := a.repo.PatchTrackPointForDay(ctx, userID, start.UTC(), end.UTC(), fields)
+	if err != nil {
+		return dto.TrackPoint{}, false, err
+	}
+	if !updated {
+		return dto.TrackPoint{}, false, nil
+	}
+	if a.analysesEnabled(ctx, userID) {
+		a.goAsync(func() { a.runAnalysesForUserAsync(userID, userTZ, start.UTC(), end.UTC(), false) })
+	}
+	return a.repo.GetTrackPointForDay(ctx, userID, start.UTC(), end.UTC())
+}
+
+// ResolveLocation returns the location for tz, falling back to the configured default zone
+// when tz is empty or not a known IANA name.
+func (a *Analyzer) ResolveLocation(tz string) *time.Location {
+	if tz != "" && tz != "Local" {
+		if l, err := time.LoadLocation(tz); err == nil {
+			return l
+		}
+	}
+	if a.defaultLoc == nil {
+		return time.UTC
+	}
+	return a.defaultLoc
+}
+
+// zoneName returns the IANA name of loc for SQL "at time zone". time.Local calls itself "Local",
+// which Postgres rejects, so its name is taken from TZ or the /etc/localtime link; UTC when neither
+// names a zone.
+func zoneName(loc *time.Location) string {
+	if loc != time.Local {
+		return loc.String()
+	}
+	if tz := strings.TrimPrefix(os.Getenv("TZ"), ":"); tz != "" && tz != "Local" {
+		if _, err := time.LoadLocation(tz); err == nil {
+			return tz
+		}
+	}
+	if target, err := os.Readlink("/etc/localtime"); err == nil {
+		if _, name, ok := strings.Cut(target, "zoneinfo/"); ok {
+			if _, err := time.LoadLocation(name); err == nil {
+				return name
+			}
+		}
+	}
+	return "UTC"
+}
+
+func (a *Analyzer) todayRange(ctx context.Context, userID int32, userTZ string) (string, time.Time, time.Time) {
 	if userTZ == "" {
 		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
 			userTZ = tz
 		}
 	}
-	loc := time.UTC
-	if userTZ != "" {
-		if l, err := time.LoadLocation(userTZ); err == nil {
-			loc = l
+	loc := a.ResolveLocation(userTZ)
+	start, end := dayBounds(time.Now().In(loc), a.dayStartHour(ctx, userID))
+	return userTZ, start, end
+}
+
+// hiddenMetrics returns the metrics the user excluded from analysis; errors mean nothing is hidden.
+func (a *Analyzer) hiddenMetrics(ctx context.Context, userID int32) map[string]struct{} {
+	out := map[string]struct{}{}
+	if a.repo == nil || userID <= 0 {
+		return out
+	}
+	names, err := a.repo.GetHiddenMetrics(ctx, userID)
+	if err != nil {
+		return out
+	}
+	for _, n := range names {
+		out[n] = struct{}{}
+	}
+	return out
+}
+
+// UpdateHiddenMetrics replaces the user's hidden metrics; names must come from analytics.HideableMetrics.
+func (a *Analyzer) UpdateHiddenMetrics(ctx context.Context, userID int32, metrics []string) ([]string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return nil, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("user id is required")
+	}
+	set := map[string]struct{}{}
+	for _, m := range metrics {
+		m = strings.ToLower(strings.TrimSpace(m))
+		if _, ok := analytics.HideableMetrics[m]; !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownMetric, m)
 		}
+		set[m] = struct{}{}
 	}
-	now := time.Now().In(loc)
-	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
-	end := start.AddDate(0, 0, 1)
-	return a.repo.GetTrackPointForDay(ctx, userID, start.UTC(), end.UTC())
+	names := sortedKeys(set)
+	if err := a.repo.UpdateHiddenMetrics(ctx, userID, names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// insightTone returns the tone requested for this call, falling back to the user's saved default.
+func (a *Analyzer) insightTone(ctx context.Context, req dto.AnalyzeRequest) dto.Tone {
+	if req.Tone != dto.ToneDefault || a.repo == nil || req.UserID <= 0 {
+		return req.Tone
+	}
+	t, err := a.repo.GetInsightTone(ctx, req.UserID)
+	if err != nil {
+		return dto.ToneDefault
+	}
+	return t
+}
+
+// analysesEnabled reports the user's analyses switch. A failed read counts as on, the column's default,
+// and is logged: a passing database error must not silently stop a user's analyses.
+func (a *Analyzer) analysesEnabled(ctx context.Context, userID int32) bool {
+	if a.repo == nil || userID <= 0 {
+		return true
+	}
+	enabled, err := a.repo.GetAnalysesEnabled(ctx, userID)
+	if err != nil {
+		log.Printf("analyses switch: user %d: %v", userID, err)
+		return true
+	}
+	return enabled
+}
+
+// dayStartHour returns the user's day-boundary offset; errors fall back to midnight.
+func (a *Analyzer) dayStartHour(ctx context.Context, userID int32) int {
+	if a.repo == nil || userID <= 0 {
+		return 0
+	}
+	h, err := a.repo.GetDayStartHour(ctx, userID)
+	if err != nil {
+		return 0
+	}
+	return h
 }
 
+// dateRange returns the logical day named by date (YYYY-MM-DD in loc); future days are rejected.
+func (a *Analyzer) dateRange(ctx context.Context, userID int32, date string, loc *time.Location) (time.Time, time.Time, error) {
+	d, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidDate
+	}
+	dayStart := a.dayStartHour(ctx, userID)
+	// Noon is inside the logical day for any allowed dayStartHour (0..12).
+	start, end := dayBounds(d.Add(12*time.Hour), dayStart)
+	if start.After(time.Now()) {
+		return time.Time{}, time.Time{}, ErrInvalidDate
+	}
+	return start, end, nil
+}
+
+// dayBounds returns the logical day containing t. The day starts at dayStartHour local time,
+// so with dayStartHour=4 an entry at 02:00 still belongs to the previous day.
+func dayBounds(t time.Time, dayStartHour int) (time.Time, time.Time) {
+	shifted := t.Add(-time.Duration(dayStartHour) * time.Hour)
+	start := time.Date(shifted.Year(), shifted.Month(), shifted.Day(), dayStartHour, 0, 0, 0, t.Location())
+	return start, start.AddDate(0, 0, 1)
+}
+
+// GetLastAnalyses отдаёт сохранённые разборы по периодам, которые разбираются автоматически (Config.AutoPeriods):
+// разборы других периодов, оставшиеся от прежней настройки, не отдаются. Если разборов нет, а отметки есть,
+// они считаются на лету без LLM (recoverLastAnalyses), а полный прогон запускается в фоне.
+// Пример: GetLastAnalyses(ctx, 42) -> {"week": {...InsightSource: "summary"}}, {"week": now}.
 func (a *Analyzer) GetLastAnalyses(ctx context.Context, userID int32) (map[string]dto.AnalyzeResponse, map[string]time.Time, error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -276,7 +852,217 @@ func (a *Analyzer) GetLastAnalyses(ctx context.Context, userID int32) (map[strin
 	if userID <= 0 {
 		return nil, nil, errors.New("user id is required")
 	}
-	return a.repo.GetLastAnalyses(ctx, userID)
+	m, meta, err := a.repo.GetLastAnalyses(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for period, resp := range m {
+		if !a.autoAnalyzed(dto.Period(period)) {
+			delete(m, period)
+			delete(meta, period)
+			continue
+		}
+		// The stored flag is from when the analysis ran; a dismissal made since must hide it now.
+		a.applyBurnoutDismissal(ctx, userID, dto.Period(period), &resp.BurnoutRisk)
+		m[period] = resp
+	}
+	if len(m) > 0 {
+		return m, meta, nil
+	}
+	return a.recoverLastAnalyses(ctx, userID)
+}
+
+// recoverLastAnalyses covers a user with track points but no stored analyses (the LLM was off, or the
+// background run failed before saving): every period with data is computed on the spot without the LLM
+// and returned unsaved, while a full run is started in the background to store the real analyses. At
+// most one such run per user is in flight on this instance; calls made meanwhile only compute.
+func (a *Analyzer) recoverLastAnalyses(ctx context.Context, userID int32) (map[string]dto.AnalyzeResponse, map[string]time.Time, error) {
+	m, meta := map[string]dto.AnalyzeResponse{}, map[string]time.Time{}
+	if _, ok, err := a.repo.GetLatestTrackTS(ctx, userID); err != nil || !ok {
+		return m, meta, err
+	}
+	userTZ, _ := a.repo.GetUserSettings(ctx, userID)
+	loc := a.ResolveLocation(userTZ)
+	now := time.Now()
+	total := a.lifetimePoints(ctx, userID, 0)
+	for _, p := range []dto.Period{dto.PeriodDay, dto.PeriodWeek, dto.PeriodMonth, dto.PeriodAll} {
+		if !a.autoAnalyzed(p) {
+			continue
+		}
+		resp, prompt, err := a.computeAnalysis(ctx, backgroundAnalyzeRequest(userID, userTZ, p), loc)
+		if err != nil {
+			return nil, nil, err
+		}
+		if prompt.NumPoints == 0 {
+			continue
+		}
+		if max(total, prompt.NumPoints) < minBurnoutPoints {
+			resp.InsightSource = insightSourceOnboarding
+			resp.LLMInsight = onboardingInsight(resp.DataSufficiency, prompt, max(total, prompt.NumPoints))
+		} else {
+			resp.InsightSource = insightSourceSummary
+			resp.LLMInsight = numericSummary(prompt)
+		}
+		a.applyBurnoutDismissal(ctx, userID, p, &resp.BurnoutRisk)
+		m[string(p)] = *resp
+		meta[string(p)] = now
+	}
+	if len(m) > 0 && !a.setRecovering(userID, true) {
+		a.goAsync(func() {
+			defer a.setRecovering(userID, false)
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+			// On failure the next GetLastAnalyses recovers again; the nightly job also covers the user.
+			_ = a.runAnalysesForUser(ctx, userID, userTZ, true)
+		})
+	}
+	return m, meta, nil
+}
+
+// lifetimePoints returns how many track points the user has in total, counted up to minBurnoutPoints:
+// enough to tell a new user from a long-time one whose window happens to be quiet. windowPoints is a
+// lower bound that saves the read once it reaches the threshold; a failed read falls back to it.
+func (a *Analyzer) lifetimePoints(ctx context.Context, userID int32, windowPoints int) int {
+	if windowPoints >= minBurnoutPoints {
+		return windowPoints
+	}
+	n, err := a.repo.CountTrackPoints(ctx, userID, minBurnoutPoints)
+	if err != nil {
+		return windowPoints
+	}
+	return max(n, windowPoints)
+}
+
+// setRecovering sets the in-flight recovery mark of userID and reports whether it was set before.
+func (a *Analyzer) setRecovering(userID int32, on bool) bool {
+	a.recoverMu.Lock()
+	defer a.recoverMu.Unlock()
+	was := a.recovering[userID]
+	if on {
+		a.recovering[userID] = true
+	} else {
+		delete(a.recovering, userID)
+	}
+	return was
+}
+
+// PeriodTrends сравнивает средние каждого сохранённого разбора с разбором, который он заменил:
+// period -> metric -> "up" | "down" | "flat". Периоды без предыдущего разбора не попадают в результат;
+// ошибка чтения оставляет карточки без стрелок.
+// Пример: PeriodTrends(ctx, 42, last)["week"]["sleep_hours"] -> "up".
+func (a *Analyzer) PeriodTrends(ctx context.Context, userID int32, last map[string]dto.AnalyzeResponse) map[string]map[string]string {
+	out := make(map[string]map[string]string, len(last))
+	if a.repo == nil || userID <= 0 {
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	prev, err := a.repo.GetPreviousAverages(ctx, userID)
+	if err != nil {
+		return out
+	}
+	for period, resp := range last {
+		p, ok := prev[period]
+		if !ok {
+			continue
+		}
+		if arrows := analytics.CompareAverages(p, resp.Averages); len(arrows) > 0 {
+			out[period] = arrows
+		}
+	}
+	return out
+}
+
+// StalePeriods отмечает периоды, чей сохранённый разбор старше самой свежей отметки пользователя:
+// по ним клиент может предложить обновление. Без отметок или при ошибке чтения ничего не помечается.
+func (a *Analyzer) StalePeriods(ctx context.Context, userID int32, updatedAt map[string]time.Time) map[string]bool {
+	out := make(map[string]bool, len(updatedAt))
+	if a.repo == nil || userID <= 0 {
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	latest, ok, err := a.repo.GetLatestTrackTS(ctx, userID)
+	if err != nil || !ok {
+		return out
+	}
+	for period, ts := range updatedAt {
+		out[period] = ts.Before(latest)
+	}
+	return out
+}
+
+// pendingAnalysisTimeout — дольше фоновый прогон не живёт (runAnalysesForUserAsync ограничен двумя минутами):
+// более старый статус pending остался от упавшего экземпляра и «обновлением» не считается.
+const pendingAnalysisTimeout = 5 * time.Minute
+
+// AnalysesInProgress отмечает периоды из updatedAt, которые сейчас пересчитываются в фоне после Track:
+// у пользователя есть день со статусом pending. День и неделя пересчитываются всегда, месяц и всё время —
+// только если подошёл их срок (dueLongPeriods). При ошибке чтения ничего не помечается.
+// Пример: AnalysesInProgress(ctx, 42, meta) -> {"day": true, "week": true, "month": false}.
+func (a *Analyzer) AnalysesInProgress(ctx context.Context, userID int32, updatedAt map[string]time.Time) map[string]bool {
+	out := make(map[string]bool, len(updatedAt))
+	if a.repo == nil || userID <= 0 {
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	pending, err := a.repo.HasPendingAnalysis(ctx, userID, time.Now().Add(-pendingAnalysisTimeout))
+	if err != nil || !pending {
+		return out
+	}
+	running := map[dto.Period]bool{dto.PeriodDay: true, dto.PeriodWeek: true}
+	for _, p := range a.dueLongPeriods(ctx, userID, false) {
+		running[p] = true
+	}
+	for period := range updatedAt {
+		out[period] = running[dto.Period(period)] && a.autoAnalyzed(dto.Period(period))
+	}
+	return out
+}
+
+func emptyAnalyzeResponse(horizon int) *dto.AnalyzeResponse {
+	return &dto.AnalyzeResponse{
+		EnergyByWeekday:   map[string]float64{},
+		ProductivityModel: dto.ProductivityModel{Weights: map[string]float64{}, Insufficient: true},
+		BurnoutRisk: dto.BurnoutRisk{
+			Level:                 "недостаточно данных",
+			Reasons:               []string{"Пока нет ни одной отметки за выбранный период."},
+			PredictionHorizonDays: horizon,
+		},
+		LLMInsight:      "Начни отмечать сон, настроение и энергию — после первых записей здесь появится разбор.",
+		DataSufficiency: dataSufficiency(nil, 0, 0, horizon),
+	}
+}
+
+// dataSufficiency grades how much data the window has. periodDays (0 for an unbounded window) and
+// horizon scale the per-analysis day requirements.
+func dataSufficiency(pts []dto.TrackPoint, numDays, periodDays, horizon int) dto.DataSufficiency {
+	numPoints := len(pts)
+	level := dto.DataSufficiencySufficient
+	switch {
+	case numPoints == 0:
+		level = dto.DataSufficiencyNone
+	case numPoints < 5 || numDays < 5:
+		level = dto.DataSufficiencyInsufficient
+	}
+	return dto.DataSufficiency{
+		Level:           level,
+		NumPoints:       numPoints,
+		NumObservedDays: numDays,
+		Coverage:        analytics.ComputeAnalysisCoverage(pts, periodDays, horizon),
+	}
+}
+
+// windowDays is the length of the analysis window in whole days, at least 1; 0 for an unbounded window.
+func windowDays(start, end time.Time) int {
+	if start.IsZero() {
+		return 0
+	}
+	return max(1, int(math.Round(end.Sub(start).Hours()/24)))
 }
 
 func buildCacheKey(req dto.AnalyzeRequest) (string, error) {
@@ -293,27 +1079,66 @@ func (a *Analyzer) storeResult(ctx context.Context, key string, req dto.AnalyzeR
 	if a.repo == nil || key == "" {
 		return
 	}
-	cacheResp := resp
-	cacheResp.LLMInsight = ""
+	// resp shares its maps with the response returned to the caller; the cache gets its own copy. The
+	// LLM's text stays out of it, while a summary or onboarding text is rebuilt from nothing but these
+	// numbers and is kept.
+	cacheResp := resp.Clone()
+	if cacheResp.InsightSource == insightSourceLLM {
+		cacheResp.LLMInsight = ""
+	}
 	_ = a.repo.CacheResponse(ctx, key, cacheResp, a.cacheTTL)
+	// A reused insight still gets its history row: the numbers are new, and InsightUnchanged marks the text.
 	_ = a.repo.SaveAnalysis(ctx, key, req, resp)
-	if req.UserID > 0 {
-		period := string(req.Period)
-		if period == "" {
-			period = "all"
+	if storesLatest(req) {
+		_ = a.repo.UpsertLastAnalysis(ctx, req.UserID, latestPeriodKey(req.Period), resp)
+		if req.Period == dto.PeriodWeek {
+			a.checkBurnoutAlert(ctx, req.UserID, resp.BurnoutRisk)
 		}
-		_ = a.repo.UpsertLastAnalysis(ctx, req.UserID, period, resp)
 	}
 }
 
-func periodRange(period dto.Period, now time.Time) (time.Time, time.Time) {
+// storesLatest reports whether req's result becomes the latest analysis shown for its period: a
+// historical day, a tag-filtered or day-excluding view, a rolling window or a field-masked response
+// must not replace it.
+func storesLatest(req dto.AnalyzeRequest) bool {
+	return req.UserID > 0 && req.Date == "" && len(req.Tags) == 0 && req.RollingDays == 0 &&
+		len(req.ExcludeTags) == 0 && !req.ExcludeOutliers && len(req.Fields) == 0
+}
+
+// wantsField reports whether the response block name (a top-level AnalyzeResponse field) is requested.
+func wantsField(req dto.AnalyzeRequest, name string) bool {
+	if len(req.Fields) == 0 {
+		return true
+	}
+	for _, f := range req.Fields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// latestPeriodKey is the last_analyses key of period; an unspecified period is stored as "all".
+func latestPeriodKey(period dto.Period) string {
+	if period == dto.PeriodUnspecified {
+		return string(dto.PeriodAll)
+	}
+	return string(period)
+}
+
+// periodRange returns the analysis window ending at now. Bounded periods start on a logical-day
+// boundary (see dayBounds) so a late-night entry is never split from the day it belongs to.
+func periodRange(period dto.Period, now time.Time, dayStartHour int) (time.Time, time.Time) {
 	switch period {
 	case dto.PeriodDay:
-		return now.AddDate(0, 0, -1), now
+		start, _ := dayBounds(now.AddDate(0, 0, -1), dayStartHour)
+		return start, now
 	case dto.PeriodWeek:
-		return now.AddDate(0, 0, -7), now
+		start, _ := dayBounds(now.AddDate(0, 0, -7), dayStartHour)
+		return start, now
 	case dto.PeriodMonth:
-		return now.AddDate(0, -1, 0), now
+		start, _ := dayBounds(now.AddDate(0, -1, 0), dayStartHour)
+		return start, now
 	case dto.PeriodAll, dto.PeriodUnspecified:
 		return time.Time{}, now
 	default:
@@ -321,32 +1146,108 @@ func periodRange(period dto.Period, now time.Time) (time.Time, time.Time) {
 	}
 }
 
+// maxUserNotesRunes caps the notes block of a single prompt, however many points the period has.
+const maxUserNotesRunes = 1200
+
+const (
+	// longHistoryDays is the span of points beyond which a prompt focuses on the recent window: the
+	// aggregates still cover the whole period, but notes come only from the last recentWindowDays.
+	longHistoryDays  = 90
+	recentWindowDays = 30
+)
+
+// olderKeywordsLimit is how many of the most frequent words of the notes left out of a long-history
+// prompt stand in for them.
+const olderKeywordsLimit = 8
+
+// splitLongHistory splits pts at recentWindowDays before windowEnd, the end of the analysed period, so a
+// user who stopped logging still gets the calendar window rather than their last active month. Histories
+// starting no more than longHistoryDays before windowEnd are returned whole. pts are sorted by time.
+func splitLongHistory(pts []dto.TrackPoint, windowEnd time.Time) (recent, older []dto.TrackPoint) {
+	if len(pts) == 0 || windowEnd.Sub(pts[0].TS) <= longHistoryDays*24*time.Hour {
+		return pts, nil
+	}
+	cutoff := windowEnd.AddDate(0, 0, -recentWindowDays)
+	i := sort.Search(len(pts), func(i int) bool { return !pts[i].TS.Before(cutoff) })
+	return pts[i:], pts[:i]
+}
+
+// recentAggregates fills the Recent* prompt fields from the points of the recent window and summarizes
+// the older points by their note count and most frequent note words.
+func (a *Analyzer) recentAggregates(recent, older []dto.TrackPoint) dto.AIPrompt {
+	out := dto.AIPrompt{
+		RecentWindowDays:    recentWindowDays,
+		RecentAvgSleepHours: round2(avgField(recent, func(p dto.TrackPoint) float64 { return p.SleepHours })),
+		RecentAvgMood:       round2(avgField(recent, func(p dto.TrackPoint) float64 { return p.Mood })),
+		RecentAvgStress:     round2(avgField(recent, func(p dto.TrackPoint) float64 { return p.Stress })),
+		RecentAvgEnergy:     round2(avgField(recent, func(p dto.TrackPoint) float64 { return p.Energy })),
+	}
+	for _, p := range older {
+		if strings.TrimSpace(p.LLMText) != "" {
+			out.OlderNotes++
+		}
+	}
+	for _, k := range analytics.NoteKeywords(older, a.stopWords, olderKeywordsLimit) {
+		out.OlderKeywords = append(out.OlderKeywords, k.Word)
+	}
+	return out
+}
+
+// buildUserNotes keeps the most recent notes that fit in maxLen runes and joins them oldest first, so a
+// long history never pushes out the latest context. Older notes that do not fit whole are dropped; only
+// a single newest note longer than maxLen is cut, on a rune boundary.
 func buildUserNotes(pts []dto.TrackPoint, maxLen int) string {
 	if len(pts) == 0 || maxLen <= 0 {
 		return ""
 	}
-	var b strings.Builder
-	for _, p := range pts {
-		txt := strings.TrimSpace(p.LLMText)
+	var lines []string
+	used, size := 0, 0
+	for i := len(pts) - 1; i >= 0 && used < maxLen; i-- {
+		txt := strings.TrimSpace(pts[i].LLMText)
 		if txt == "" {
 			continue
 		}
-		line := p.TS.Format("2006-01-02 15:04") + " — " + txt
-		if b.Len() > 0 {
-			line = "\n" + line
+		line := pts[i].TS.Format("2006-01-02 15:04") + " — " + txt
+		sep := 0
+		if len(lines) > 0 {
+			sep = 1 // newline joining it to the newer note
 		}
-		if b.Len()+len(line) > maxLen {
-			remain := maxLen - b.Len()
-			if remain > 0 {
-				b.WriteString(line[:remain])
-			}
+		remain := maxLen - used - sep
+		if remain <= 0 {
 			break
 		}
-		b.WriteString(line)
+		n := utf8.RuneCountInString(line)
+		if n > remain {
+			if len(lines) > 0 {
+				break
+			}
+			line, n = cutRunes(line, remain), remain
+		}
+		lines = append(lines, line)
+		used += n + sep
+		size += len(line) + sep
+	}
+	var b strings.Builder
+	b.Grow(size)
+	for i := len(lines) - 1; i >= 0; i-- {
+		b.WriteString(lines[i])
+		if i > 0 {
+			b.WriteByte('\n')
+		}
 	}
 	return b.String()
 }
 
+// cutRunes returns the first n runes of s without converting it to a rune slice.
+func cutRunes(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
 
 func countUniqueDays(pts []dto.TrackPoint) int {
 	if len(pts) == 0 {
@@ -389,37 +1290,13 @@ func minMaxField(pts []dto.TrackPoint, f func(dto.TrackPoint) float64) (float64,
 	return min, max
 }
 
+// avgSleepTime is the circular mean of the picked "HH:MM" times as "HH:MM", or "" without any.
 func avgSleepTime(pts []dto.TrackPoint, pick func(dto.TrackPoint) string) string {
-	sumSin := 0.0
-	sumCos := 0.0
-	n := 0
-	for _, p := range pts {
-		raw := strings.TrimSpace(pick(p))
-		if raw == "" {
-			continue
-		}
-		tm, err := time.Parse("15:04", raw)
-		if err != nil {
-			continue
-		}
-		minutes := float64(tm.Hour()*60 + tm.Minute())
-		angle := 2 * math.Pi * minutes / 1440.0
-		sumSin += math.Sin(angle)
-		sumCos += math.Cos(angle)
-		n++
-	}
-	if n == 0 {
+	total, ok := analytics.ClockMean(pts, pick)
+	if !ok {
 		return ""
 	}
-	avgAngle := math.Atan2(sumSin/float64(n), sumCos/float64(n))
-	if avgAngle < 0 {
-		avgAngle += 2 * math.Pi
-	}
-	minutes := avgAngle * 1440.0 / (2 * math.Pi)
-	total := int(math.Round(minutes)) % 1440
-	h := total / 60
-	m := total % 60
-	return fmt.Sprintf("%02d:%02d", h, m)
+	return fmt.Sprintf("%02d:%02d", total/60, total%60)
 }
 
 func round2(v float64) float64 {
@@ -428,3 +1305,15 @@ func round2(v float64) float64 {
 	}
 	return math.Round(v*100) / 100
 }
+
+// round2Values returns a copy of m with every value rounded to two decimals.
+func round2Values(m map[string]float64) map[string]float64 {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]float64, len(m))
+	for k, v := range m {
+		out[k] = round2(v)
+	}
+	return out
+}
@@ -0,0 +1,717 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+	"nexus/internal/hepler"
+)
+
+const testUserID = 42
+
+func TestAnalyzeWithoutPoints(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	a := NewAnalyzer(nil, repo, Config{})
+
+	resp, err := a.Analyze(context.Background(), dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodWeek})
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if resp.DataSufficiency.Level != dto.DataSufficiencyNone || resp.DataSufficiency.NumPoints != 0 {
+		t.Errorf("data sufficiency = %+v, want none", resp.DataSufficiency)
+	}
+	if resp.LLMInsight == "" {
+		t.Error("empty response has no onboarding text")
+	}
+	if resp.EnergyByWeekday == nil || !resp.ProductivityModel.Insufficient {
+		t.Errorf("empty response is not well-formed: %+v", resp)
+	}
+	if len(repo.saved) != 0 || len(repo.last) != 0 {
+		t.Errorf("an empty analysis was stored: saved=%d last=%d", len(repo.saved), len(repo.last))
+	}
+}
+
+func TestDayBoundsLateNight(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+	at := func(day, hour, min int) time.Time { return time.Date(2026, 3, day, hour, min, 0, 0, msk) }
+
+	cases := []struct {
+		name     string
+		t        time.Time
+		dayStart int
+		want     time.Time
+	}{
+		{"midnight default", at(10, 2, 0), 0, at(10, 0, 0)},
+		{"02:00 belongs to the previous day", at(10, 2, 0), 4, at(9, 4, 0)},
+		{"03:59 still the previous day", at(10, 3, 59), 4, at(9, 4, 0)},
+		{"04:00 starts the new day", at(10, 4, 0), 4, at(10, 4, 0)},
+		{"late evening", at(10, 23, 30), 4, at(10, 4, 0)},
+	}
+	for _, c := range cases {
+		start, end := dayBounds(c.t, c.dayStart)
+		if !start.Equal(c.want) || !end.Equal(c.want.AddDate(0, 0, 1)) {
+			t.Errorf("%s: dayBounds = %s..%s, want %s..%s", c.name, start, end, c.want, c.want.AddDate(0, 0, 1))
+		}
+	}
+
+	// A 02:00 entry and a 10:00 entry of the same logical day share bounds.
+	night, _ := dayBounds(at(11, 2, 0), 4)
+	morning, _ := dayBounds(at(10, 10, 0), 4)
+	if !night.Equal(morning) {
+		t.Errorf("02:00 and the previous 10:00 are on different days: %s vs %s", night, morning)
+	}
+}
+
+func TestPeriodRangeStartsOnDayBoundary(t *testing.T) {
+	now := time.Date(2026, 3, 10, 2, 30, 0, 0, time.UTC)
+	start, end := periodRange(dto.PeriodWeek, now, 4)
+	if want := time.Date(2026, 3, 2, 4, 0, 0, 0, time.UTC); !start.Equal(want) {
+		t.Errorf("week start = %s, want %s", start, want)
+	}
+	if !end.Equal(now) {
+		t.Errorf("week end = %s, want now", end)
+	}
+}
+
+// seedDays fills the fake with one steady point per day for the last days days, ending an hour ago.
+func seedDays(repo *fakeRepo, days int, fill func(i int, p *dto.TrackPoint)) {
+	end := time.Now().UTC().Add(-time.Hour)
+	for i := 0; i < days; i++ {
+		p := dto.TrackPoint{
+			TS: end.AddDate(0, 0, -(days - 1 - i)), SleepHours: 8, SleepQuality: 8, Mood: 7, Activity: 7,
+			Productive: 7, Stress: 3, Energy: 7, Concentration: 7, Workout: true,
+		}
+		if fill != nil {
+			fill(i, &p)
+		}
+		repo.points = append(repo.points, p)
+	}
+}
+
+func TestHiddenMetricsLeaveThePrompt(t *testing.T) {
+	heavy := func(i int, p *dto.TrackPoint) {
+		p.Stress = 8
+		p.Alcohol = i%2 == 0
+	}
+	shown := &fakeRepo{tz: "UTC"}
+	seedDays(shown, 14, heavy)
+	hidden := &fakeRepo{tz: "UTC", hidden: []string{"alcohol", "stress"}}
+	seedDays(hidden, 14, heavy)
+
+	req := dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodAll}
+	base, err := NewAnalyzer(nil, shown, Config{}).GetPromptAggregates(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetPromptAggregates: %v", err)
+	}
+	p, err := NewAnalyzer(nil, hidden, Config{}).GetPromptAggregates(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetPromptAggregates: %v", err)
+	}
+
+	// The same data without hidden metrics does trigger both signals.
+	if len(base.BurnoutReasons) != 2 {
+		t.Fatalf("baseline burnout reasons = %v, want stress and alcohol", base.BurnoutReasons)
+	}
+	reasons := strings.ToLower(strings.Join(p.BurnoutReasons, "; "))
+	if p.BurnoutScore != 0 || strings.Contains(reasons, "стресс") || strings.Contains(reasons, "алкогол") {
+		t.Errorf("hidden metrics still drive burnout: score=%v reasons=%v", p.BurnoutScore, p.BurnoutReasons)
+	}
+	if strings.Join(p.HiddenMetrics, ",") != "alcohol,stress" {
+		t.Errorf("prompt hidden_metrics = %v", p.HiddenMetrics)
+	}
+	text := hepler.BuildRussianPrompt(p)
+	for _, key := range []string{"avg_stress", "min_stress", "max_stress", "алкогол"} {
+		if strings.Contains(text, key) {
+			t.Errorf("prompt mentions hidden %q:\n%s", key, text)
+		}
+	}
+	if !strings.Contains(hepler.BuildRussianPrompt(base), "avg_stress") {
+		t.Error("baseline prompt has no avg_stress, the check above proves nothing")
+	}
+}
+
+func TestInsightToneDefault(t *testing.T) {
+	ctx := context.Background()
+	saved := NewAnalyzer(nil, &fakeRepo{tone: dto.ToneDataOnly}, Config{})
+	unset := NewAnalyzer(nil, &fakeRepo{}, Config{})
+
+	cases := []struct {
+		name string
+		a    *Analyzer
+		req  dto.Tone
+		want dto.Tone
+	}{
+		{"saved default", saved, dto.ToneDefault, dto.ToneDataOnly},
+		{"request overrides the default", saved, dto.ToneGentle, dto.ToneGentle},
+		{"nothing saved", unset, dto.ToneDefault, dto.ToneDefault},
+		{"request without a saved default", unset, dto.ToneDirect, dto.ToneDirect},
+	}
+	for _, c := range cases {
+		if got := c.a.insightTone(ctx, dto.AnalyzeRequest{UserID: testUserID, Tone: c.req}); got != c.want {
+			t.Errorf("%s: tone = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestAnalyzeSinglePastDay(t *testing.T) {
+	at := func(day, hour int) time.Time { return time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC) }
+	repo := &fakeRepo{tz: "UTC", dayStart: 4}
+	for _, ts := range []time.Time{at(9, 20), at(10, 9), at(10, 22), at(11, 2), at(11, 10)} {
+		repo.points = append(repo.points, dto.TrackPoint{TS: ts, SleepHours: 7, Mood: 6, Energy: 6})
+	}
+	a := NewAnalyzer(nil, repo, Config{})
+	ctx := context.Background()
+
+	resp, err := a.Analyze(ctx, dto.AnalyzeRequest{UserID: testUserID, Date: "2026-03-10", SkipInsight: true})
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	// 09:00 and 22:00 on the 10th, and 02:00 on the 11th, which is before the 04:00 day start.
+	if resp.DataSufficiency.NumPoints != 3 {
+		t.Errorf("points = %d, want the 3 of the logical day", resp.DataSufficiency.NumPoints)
+	}
+	if want := at(10, 4); !resp.PeriodMeta.Start.Equal(want) || !resp.PeriodMeta.End.Equal(want.AddDate(0, 0, 1)) {
+		t.Errorf("window = %s..%s, want the day from %s", resp.PeriodMeta.Start, resp.PeriodMeta.End, want)
+	}
+	if len(repo.last) != 0 {
+		t.Errorf("a historical day replaced the latest analysis: %v", repo.last)
+	}
+
+	tomorrow := time.Now().UTC().AddDate(0, 0, 2).Format("2006-01-02")
+	for _, date := range []string{tomorrow, "10.03.2026", "2026-02-30"} {
+		if _, err := a.Analyze(ctx, dto.AnalyzeRequest{UserID: testUserID, Date: date, SkipInsight: true}); !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("date %q: err = %v, want ErrInvalidDate", date, err)
+		}
+	}
+}
+
+func TestBuildUserNotesCapIsRuneSafe(t *testing.T) {
+	ts := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	pts := []dto.TrackPoint{
+		{TS: ts, LLMText: strings.Repeat("старая заметка ", 10)},
+		{TS: ts.Add(time.Hour), LLMText: strings.Repeat("😴", 30)},
+	}
+	for _, limit := range []int{5, 19, 20, 21, 40, 500} {
+		notes := buildUserNotes(pts, limit)
+		if !utf8.ValidString(notes) {
+			t.Errorf("limit %d: notes are not valid UTF-8: %q", limit, notes)
+		}
+		if n := utf8.RuneCountInString(notes); n > limit {
+			t.Errorf("limit %d: notes are %d runes", limit, n)
+		}
+	}
+	// The newest note comes first and is kept whole when it fits.
+	if notes := buildUserNotes(pts, 60); !strings.HasPrefix(notes, "2026-03-10 10:00 — 😴") || strings.Contains(notes, "старая") {
+		t.Errorf("notes = %q, want only the newest note", notes)
+	}
+}
+
+func TestAnalyzeTagFilter(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 10, func(i int, p *dto.TrackPoint) {
+		switch {
+		case i < 3:
+			p.Tags = []string{"coffee", "отпуск"}
+		case i < 6:
+			p.Tags = []string{"coffee"}
+		}
+	})
+	a := NewAnalyzer(nil, repo, Config{})
+
+	resp, err := a.Analyze(context.Background(), dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodAll, Tags: []string{"coffee", "отпуск"}, SkipInsight: true})
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if resp.DataSufficiency.NumPoints != 3 {
+		t.Errorf("points = %d, want only the 3 carrying both tags", resp.DataSufficiency.NumPoints)
+	}
+	if len(repo.last) != 0 {
+		t.Errorf("a tag-filtered view replaced the latest analysis: %v", repo.last)
+	}
+}
+
+func TestAnalyzeRollingDaysOverridesPeriod(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	now := time.Now().UTC()
+	for _, back := range []time.Duration{1, 30, 60, 70, 100, 400} {
+		repo.points = append(repo.points, dto.TrackPoint{TS: now.Add(-back * time.Hour), SleepHours: 7, Mood: 6, Energy: 6})
+	}
+	a := NewAnalyzer(nil, repo, Config{})
+
+	for _, period := range []dto.Period{dto.PeriodDay, dto.PeriodMonth, dto.PeriodAll} {
+		resp, err := a.Analyze(context.Background(), dto.AnalyzeRequest{UserID: testUserID, Period: period, RollingDays: 3, SkipInsight: true})
+		if err != nil {
+			t.Fatalf("%s: Analyze: %v", period, err)
+		}
+		// 1h, 30h, 60h and 70h ago fall within the last 72 hours.
+		if resp.DataSufficiency.NumPoints != 4 {
+			t.Errorf("%s: %d points, want the 4 of the last 3 days", period, resp.DataSufficiency.NumPoints)
+		}
+		if span := resp.PeriodMeta.End.Sub(resp.PeriodMeta.Start); span != 72*time.Hour {
+			t.Errorf("%s: window spans %s, want exactly 72h", period, span)
+		}
+		if end := resp.PeriodMeta.End; end.Before(now) || end.Sub(now) > time.Minute {
+			t.Errorf("%s: window ends at %s, want now", period, end)
+		}
+	}
+	if len(repo.last) != 0 {
+		t.Errorf("a rolling window replaced the latest analysis: %v", repo.last)
+	}
+}
+
+func TestConcurrentBlocksMatchSequential(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 60, func(i int, p *dto.TrackPoint) {
+		p.SleepHours = 5 + float64(i%5)
+		p.Mood = float64(2 + i%8)
+		p.Stress = float64(1 + i%9)
+		p.Energy = float64(3 + i%7)
+		p.Alcohol = i%4 == 0
+		p.SleepStart, p.SleepEnd = "23:30", "07:00"
+		if i%6 == 0 {
+			p.SleepEnd = "10:30"
+		}
+	})
+	a := NewAnalyzer(nil, repo, Config{})
+	req := dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodAll, SkipInsight: true}
+
+	pts, _ := repo.GetTrackPoints(context.Background(), testUserID, time.Time{}, time.Now(), nil)
+	ep := analytics.DefaultEnergyScoreParams
+	model := analytics.ComputeProductivityModel(pts, ep)
+	want := dto.AnalyzeResponse{
+		EnergyByWeekday:   analytics.ComputeEnergyByWeekday(pts, ep),
+		ProductivityModel: model,
+		BurnoutRisk:       analytics.ComputeBurnoutRisk(pts, model, analytics.DefaultBurnoutHorizonDays, map[string]struct{}{}, ep),
+		OptimalSchedule:   analytics.ComputeOptimalSchedule(pts, dto.Constraints{}, ep),
+		DataQuality:       analytics.DetectContradictions(pts),
+	}
+
+	// Several analyses at once also give the race detector shared state to trip over.
+	const runs = 8
+	got := make([]*dto.AnalyzeResponse, runs)
+	var wg sync.WaitGroup
+	for i := range got {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := a.Analyze(context.Background(), req)
+			if err != nil {
+				t.Errorf("Analyze: %v", err)
+				return
+			}
+			got[i] = resp
+		}()
+	}
+	wg.Wait()
+	for i, resp := range got {
+		if resp == nil {
+			continue
+		}
+		for name, pair := range map[string][2]any{
+			"energy_by_weekday":     {resp.EnergyByWeekday, want.EnergyByWeekday},
+			"productivity_model":    {resp.ProductivityModel, want.ProductivityModel},
+			"burnout_risk":          {resp.BurnoutRisk, want.BurnoutRisk},
+			"optimal_schedule":      {resp.OptimalSchedule, want.OptimalSchedule},
+			"data_quality_warnings": {resp.DataQuality, want.DataQuality},
+		} {
+			if !reflect.DeepEqual(pair[0], pair[1]) {
+				t.Errorf("run %d: %s differs from the sequential result:\n got %+v\nwant %+v", i, name, pair[0], pair[1])
+			}
+		}
+	}
+}
+
+func TestStalePeriodsFlipOnNewPoint(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepo{tz: "UTC"}
+	a := NewAnalyzer(nil, repo, Config{})
+	now := time.Now()
+
+	if got := a.StalePeriods(ctx, testUserID, map[string]time.Time{"week": now}); got["week"] {
+		t.Errorf("stale without any points: %v", got)
+	}
+
+	repo.points = []dto.TrackPoint{{TS: now.Add(-2 * time.Hour)}}
+	updated := map[string]time.Time{"day": now.Add(-time.Hour), "week": now.Add(-time.Hour), "month": now.Add(-3 * time.Hour)}
+	if got := a.StalePeriods(ctx, testUserID, updated); got["day"] || got["week"] || !got["month"] {
+		t.Errorf("stale = %v, want only month, which predates the point", got)
+	}
+
+	repo.points = append(repo.points, dto.TrackPoint{TS: now.Add(-30 * time.Minute)})
+	got := a.StalePeriods(ctx, testUserID, updated)
+	for period := range updated {
+		if !got[period] {
+			t.Errorf("%s is not stale after a newer point was logged", period)
+		}
+	}
+}
+
+func TestEmptyUserTZUsesDefaultLocation(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Skipf("no tzdata: %v", err)
+	}
+	repo := &fakeRepo{}
+	seedDays(repo, 7, nil)
+	a := NewAnalyzer(nil, repo, Config{DefaultLocation: moscow})
+
+	for _, tz := range []string{"", "Mars/Olympus"} {
+		if got := a.ResolveLocation(tz); got != moscow {
+			t.Errorf("ResolveLocation(%q) = %s, want Europe/Moscow", tz, got)
+		}
+	}
+	if got := a.ResolveLocation("Europe/Berlin"); got.String() != "Europe/Berlin" {
+		t.Errorf("a saved zone was overridden: %s", got)
+	}
+	if got := NewAnalyzer(nil, repo, Config{}).ResolveLocation(""); got != time.UTC {
+		t.Errorf("without DEFAULT_TZ: %s, want UTC", got)
+	}
+
+	resp, err := a.Analyze(context.Background(), dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodDay})
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	start := resp.PeriodMeta.Start
+	if start.Location().String() != "Europe/Moscow" || start.Hour() != 0 || start.Minute() != 0 {
+		t.Errorf("day starts at %s, want local midnight in Europe/Moscow", start)
+	}
+}
+
+// BenchmarkBuildUserNotes measures note assembly over a year of daily notes with the production cap:
+// only the newest notes that fit are read, so the cost does not grow with the history.
+func BenchmarkBuildUserNotes(b *testing.B) {
+	end := time.Date(2026, 3, 10, 21, 0, 0, 0, time.UTC)
+	pts := make([]dto.TrackPoint, 365)
+	for i := range pts {
+		pts[i] = dto.TrackPoint{
+			TS:      end.AddDate(0, 0, i-len(pts)+1),
+			LLMText: strings.Repeat("день прошёл спокойно, вечером прогулка; ", 4),
+		}
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = buildUserNotes(pts, maxUserNotesRunes)
+	}
+}
+
+func TestLongPeriodsRefreshAtMostOncePerInterval(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 14, nil)
+	a := NewAnalyzer(nil, repo, Config{LongPeriodRefresh: time.Hour})
+	runs := func() map[dto.Period]int {
+		out := map[dto.Period]int{}
+		for _, s := range repo.saved {
+			out[s.req.Period]++
+		}
+		repo.saved = nil
+		return out
+	}
+
+	if err := a.runAnalysesForUser(context.Background(), testUserID, "", false); err != nil {
+		t.Fatalf("first run: %v", err)
+	}
+	if got := runs(); got[dto.PeriodDay] != 1 || got[dto.PeriodWeek] != 1 || got[dto.PeriodMonth] != 1 || got[dto.PeriodAll] != 1 {
+		t.Fatalf("first run = %v, want every period once", got)
+	}
+
+	// A second entry within the interval re-runs only the short periods.
+	if err := a.runAnalysesForUser(context.Background(), testUserID, "", false); err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	if got := runs(); got[dto.PeriodDay] != 1 || got[dto.PeriodWeek] != 1 || got[dto.PeriodMonth] != 0 || got[dto.PeriodAll] != 0 {
+		t.Errorf("run within the interval = %v, want day and week only", got)
+	}
+
+	// Once the interval has passed, or when forced, the long periods run again.
+	repo.lastAt[string(dto.PeriodMonth)] = time.Now().Add(-2 * time.Hour)
+	if err := a.runAnalysesForUser(context.Background(), testUserID, "", false); err != nil {
+		t.Fatalf("third run: %v", err)
+	}
+	if got := runs(); got[dto.PeriodMonth] != 1 || got[dto.PeriodAll] != 0 {
+		t.Errorf("run after the month interval = %v, want month but not all", got)
+	}
+	if err := a.runAnalysesForUser(context.Background(), testUserID, "", true); err != nil {
+		t.Fatalf("forced run: %v", err)
+	}
+	if got := runs(); got[dto.PeriodMonth] != 1 || got[dto.PeriodAll] != 1 {
+		t.Errorf("forced run = %v, want every long period", got)
+	}
+}
+
+func TestLongHistoryPromptStaysBounded(t *testing.T) {
+	prompt := func(days int) (dto.AIPrompt, string) {
+		repo := &fakeRepo{tz: "UTC"}
+		seedDays(repo, days, func(i int, p *dto.TrackPoint) {
+			p.LLMText = "дедлайн проекта, созвоны до ночи"
+			if i >= days-recentWindowDays {
+				p.LLMText = "отпуск, море и прогулки"
+			}
+		})
+		p, err := NewAnalyzer(nil, repo, Config{}).GetPromptAggregates(context.Background(),
+			dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodAll})
+		if err != nil {
+			t.Fatalf("%d days: GetPromptAggregates: %v", days, err)
+		}
+		return p, hepler.BuildRussianPrompt(p)
+	}
+
+	year, yearText := prompt(365)
+	long, longText := prompt(3 * 365)
+	if long.NumPoints != 3*365 {
+		t.Fatalf("aggregates cover %d points, want the whole history", long.NumPoints)
+	}
+	// Three times the history costs no more prompt than one year; only the counters grow.
+	if n, limit := utf8.RuneCountInString(longText), utf8.RuneCountInString(yearText)+20; n > limit {
+		t.Errorf("3-year prompt is %d runes, 1-year prompt %d", n, limit-20)
+	}
+	if long.RecentWindowDays != recentWindowDays || year.RecentWindowDays != recentWindowDays {
+		t.Errorf("recent window = %d/%d days, want %d", year.RecentWindowDays, long.RecentWindowDays, recentWindowDays)
+	}
+	if strings.Contains(long.UserNotes, "дедлайн") || !strings.Contains(long.UserNotes, "отпуск") {
+		t.Errorf("user_notes are not limited to the recent window: %q", long.UserNotes)
+	}
+	if long.OlderNotes != 3*365-recentWindowDays {
+		t.Errorf("older notes = %d, want %d", long.OlderNotes, 3*365-recentWindowDays)
+	}
+	if !strings.Contains(longText, "older_notes_keywords=дедлайн") || strings.Contains(strings.Join(long.OlderKeywords, ","), "отпуск") {
+		t.Errorf("older history summary = %v:\n%s", long.OlderKeywords, longText)
+	}
+}
+
+func TestSplitLongHistoryCutsAtWindowEnd(t *testing.T) {
+	end := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	var pts []dto.TrackPoint
+	// Logging stopped two months before the end of the window.
+	for d := 200; d >= 60; d-- {
+		pts = append(pts, dto.TrackPoint{TS: end.AddDate(0, 0, -d)})
+	}
+	recent, older := splitLongHistory(pts, end)
+	if len(recent) != 0 || len(older) != len(pts) {
+		t.Errorf("split = %d recent, %d older; want the whole history older than the window", len(recent), len(older))
+	}
+
+	short := pts[len(pts)-20:]
+	if recent, older := splitLongHistory(short, end); len(recent) != len(short) || older != nil {
+		t.Errorf("short history was split: %d recent, %d older", len(recent), len(older))
+	}
+}
+
+func TestAnalysesInProgressFollowsTrack(t *testing.T) {
+	repo := &switchRepo{fakeRepo: &fakeRepo{tz: "UTC"}}
+	seedDays(repo.fakeRepo, 6, nil)
+	llm := &gateLLM{started: make(chan struct{}), release: make(chan struct{})}
+	a := NewAnalyzer(llm, repo, Config{})
+	ctx := context.Background()
+	shown := map[string]time.Time{"day": time.Now().Add(-time.Hour), "week": time.Now().Add(-time.Hour)}
+
+	if got := a.AnalysesInProgress(ctx, testUserID, shown); got["day"] || got["week"] {
+		t.Fatalf("in progress before any track: %v", got)
+	}
+	point := dto.TrackPoint{TS: time.Now().UTC().Add(-time.Minute), SleepHours: 7, Mood: 6, Energy: 6}
+	if _, err := a.Track(ctx, dto.TrackRequest{UserID: testUserID, UserTZ: "UTC", Points: []dto.TrackPoint{point}}); err != nil {
+		t.Fatalf("Track: %v", err)
+	}
+	<-llm.started
+	if got := a.AnalysesInProgress(ctx, testUserID, shown); !got["day"] || !got["week"] {
+		t.Errorf("in progress while the run is blocked: %v, want day and week", got)
+	}
+
+	close(llm.release)
+	if err := a.Drain(ctx); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	if got := a.AnalysesInProgress(ctx, testUserID, shown); got["day"] || got["week"] {
+		t.Errorf("in progress after the run finished: %v (statuses %v)", got, repo.statuses)
+	}
+}
+
+func TestCoverageCountsFromTheAccountsFirstEntry(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 7, nil)
+	newcomer := NewAnalyzer(nil, repo, Config{})
+	req := dto.AnalyzeRequest{UserID: testUserID, RollingDays: 7, SkipInsight: true}
+	resp, err := newcomer.Analyze(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if resp.BurnoutRisk.CoverageRatio != 1 {
+		t.Errorf("newcomer coverage = %v, want 1", resp.BurnoutRisk.CoverageRatio)
+	}
+
+	// The same week for a user who started months ago, outside the analysed window: the empty first
+	// half of the 14-day burnout window counts.
+	repo.points = append(repo.points, dto.TrackPoint{TS: time.Now().UTC().AddDate(0, -3, 0), Mood: 6})
+	resp, err = NewAnalyzer(nil, repo, Config{}).Analyze(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if got := resp.BurnoutRisk.CoverageRatio; got != 0.5 {
+		t.Errorf("long-time user coverage = %v, want 0.5", got)
+	}
+}
+
+func TestGoalsReachThePromptOnlyWhenSet(t *testing.T) {
+	req := dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodAll}
+	prompt := func(repo *fakeRepo) (dto.AIPrompt, string) {
+		seedDays(repo, 10, nil)
+		p, err := NewAnalyzer(nil, repo, Config{}).GetPromptAggregates(context.Background(), req)
+		if err != nil {
+			t.Fatalf("GetPromptAggregates: %v", err)
+		}
+		return p, hepler.BuildRussianPrompt(p)
+	}
+
+	p, text := prompt(&fakeRepo{tz: "UTC"})
+	if len(p.Goals) != 0 || strings.Contains(text, "goal") {
+		t.Errorf("goal context without goals: %+v\n%s", p.Goals, text)
+	}
+
+	// A goal on a hidden metric is left out like the metric itself.
+	p, text = prompt(&fakeRepo{tz: "UTC", goals: map[string]float64{"sleep_hours": 8, "stress": 4}, hidden: []string{"stress"}})
+	want := []dto.GoalProgress{{Metric: "sleep_hours", Target: 8, Met: 7, Days: 7}}
+	if !reflect.DeepEqual(p.Goals, want) {
+		t.Errorf("goals = %+v, want %+v", p.Goals, want)
+	}
+	if !strings.Contains(text, "goal sleep_hours >= 8: выполнено 7 из 7 дней") || strings.Contains(text, "goal stress") {
+		t.Errorf("prompt goal context:\n%s", text)
+	}
+}
+
+func TestPeriodMetaMatchesTheResolvedWindow(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*3600)
+	for _, seeded := range []bool{false, true} {
+		repo := &fakeRepo{dayStart: 4}
+		if seeded {
+			seedDays(repo, 40, nil)
+		}
+		a := NewAnalyzer(nil, repo, Config{DefaultLocation: msk})
+		for _, period := range []dto.Period{dto.PeriodDay, dto.PeriodWeek, dto.PeriodMonth, dto.PeriodAll} {
+			resp, err := a.Analyze(context.Background(), dto.AnalyzeRequest{UserID: testUserID, Period: period, SkipInsight: true})
+			if err != nil {
+				t.Fatalf("%s: Analyze: %v", period, err)
+			}
+			meta := resp.PeriodMeta
+			start, end := periodRange(period, meta.End, 4)
+			if !meta.Start.Equal(start) || meta.End.Location() != msk || time.Since(end) > time.Minute {
+				t.Errorf("%s (points: %v): meta %s – %s, want %s – now in MSK", period, seeded, meta.Start, meta.End, start)
+			}
+			if period != dto.PeriodAll && meta.Start.Location() != msk {
+				t.Errorf("%s: start is in %s, want the user's zone", period, meta.Start.Location())
+			}
+			if want := analytics.PeriodLabel(start, end, 4); meta.Label != want {
+				t.Errorf("%s: label = %q, want %q", period, meta.Label, want)
+			}
+		}
+	}
+}
+
+func TestOnlyAutoPeriodsGetLastAnalyses(t *testing.T) {
+	periods, err := ParseAutoPeriods(" Week,month,week")
+	if err != nil || len(periods) != 2 || periods[0] != dto.PeriodWeek || periods[1] != dto.PeriodMonth {
+		t.Fatalf("ParseAutoPeriods = %v, %v; want [week month]", periods, err)
+	}
+	for _, bad := range []string{"", "week,", "year", "week;month"} {
+		if _, err := ParseAutoPeriods(bad); err == nil {
+			t.Errorf("ParseAutoPeriods(%q) accepted", bad)
+		}
+	}
+
+	repo := &switchRepo{fakeRepo: &fakeRepo{tz: "UTC"}}
+	seedDays(repo.fakeRepo, 10, nil)
+	// A row left from before the list was narrowed.
+	_ = repo.UpsertLastAnalysis(context.Background(), testUserID, "day", dto.AnalyzeResponse{})
+	a := NewAnalyzer(&stubLLM{text: "Энергия\nВсё ровно."}, repo, Config{AutoPeriods: periods})
+	ctx := context.Background()
+
+	point := dto.TrackPoint{TS: time.Now().UTC().Add(-time.Minute), SleepHours: 7, Mood: 6, Energy: 6}
+	if _, err := a.Track(ctx, dto.TrackRequest{UserID: testUserID, UserTZ: "UTC", Points: []dto.TrackPoint{point}}); err != nil {
+		t.Fatalf("Track: %v", err)
+	}
+	if err := a.Drain(ctx); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+
+	repo.mu.Lock()
+	stored := make([]string, 0, len(repo.last))
+	for p := range repo.last {
+		if p != "day" {
+			stored = append(stored, p)
+		}
+	}
+	repo.mu.Unlock()
+	sort.Strings(stored)
+	if strings.Join(stored, ",") != "month,week" {
+		t.Errorf("last_analyses rows written = %v, want month and week", stored)
+	}
+
+	last, meta, err := a.GetLastAnalyses(ctx, testUserID)
+	if err != nil {
+		t.Fatalf("GetLastAnalyses: %v", err)
+	}
+	if _, ok := last["day"]; ok || len(last) != 2 || len(meta) != 2 {
+		t.Errorf("GetLastAnalyses periods = %v, want only week and month", last)
+	}
+}
+
+// focusCountRepo counts ListFocusSessions reads.
+type focusCountRepo struct {
+	*fakeRepo
+	focusReads atomic.Int32
+}
+
+func (r *focusCountRepo) ListFocusSessions(ctx context.Context, userID int32, from, to time.Time) ([]dto.FocusSession, error) {
+	r.focusReads.Add(1)
+	return r.fakeRepo.ListFocusSessions(ctx, userID, from, to)
+}
+
+func TestFieldMaskSkipsUnrequestedBlocks(t *testing.T) {
+	repo := &focusCountRepo{fakeRepo: &fakeRepo{tz: "UTC"}}
+	// Sleep shorter than the 8 hours logged hints at a contradiction: the quality block has work to do.
+	seedDays(repo.fakeRepo, 14, func(i int, p *dto.TrackPoint) {
+		p.TS = p.TS.Truncate(24 * time.Hour).Add(time.Duration(8+i%8) * time.Hour)
+		p.SleepStart, p.SleepEnd = "23:00", "03:00"
+	})
+	a := NewAnalyzer(nil, repo, Config{})
+	ctx := context.Background()
+
+	full, err := a.Analyze(ctx, dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodAll, SkipInsight: true})
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if len(full.OptimalSchedule.BestFocusHours) == 0 || len(full.EnergyByWeekdayCI) == 0 || len(full.DataQuality) == 0 ||
+		repo.focusReads.Load() != 1 {
+		t.Fatalf("unmasked analysis is missing blocks: schedule %v, ci %d, quality %d, focus reads %d",
+			full.OptimalSchedule, len(full.EnergyByWeekdayCI), len(full.DataQuality), repo.focusReads.Load())
+	}
+
+	// The handler skips the insight for a mask without llm_insight.
+	req := dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodAll, Fields: []string{"energy_by_weekday"}, SkipInsight: true}
+	resp, err := a.Analyze(ctx, req)
+	if err != nil {
+		t.Fatalf("Analyze masked: %v", err)
+	}
+	if !reflect.DeepEqual(resp.EnergyByWeekday, full.EnergyByWeekday) {
+		t.Errorf("energy_by_weekday = %v, want %v", resp.EnergyByWeekday, full.EnergyByWeekday)
+	}
+	if !reflect.DeepEqual(resp.OptimalSchedule, dto.OptimalSchedule{}) || resp.EnergyByWeekdayCI != nil ||
+		resp.DataQuality != nil || resp.FocusStats != nil || resp.LLMInsight != "" {
+		t.Errorf("masked response computed other blocks: schedule %v, ci %v, quality %v, focus %v, insight %q",
+			resp.OptimalSchedule, resp.EnergyByWeekdayCI, resp.DataQuality, resp.FocusStats, resp.LLMInsight)
+	}
+	if n := repo.focusReads.Load(); n != 1 {
+		t.Errorf("focus sessions read %d times, want only for the unmasked analysis", n)
+	}
+	if last := repo.last["all"]; len(last.OptimalSchedule.BestFocusHours) == 0 {
+		t.Error("masked response replaced the latest analysis")
+	}
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+// GetBedtimeTonight рекомендует время отбоя на сегодня по отметкам за последний месяц: привычный отбой
+// берётся по всему месяцу, недосып — за последнюю неделю (см. analytics.BedtimeTonight).
+// Пример: GetBedtimeTonight(ctx, 42, "").Bedtime -> "22:45".
+func (a *Analyzer) GetBedtimeTonight(ctx context.Context, userID int32, userTZ string) (dto.BedtimeRecommendation, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.BedtimeRecommendation{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.BedtimeRecommendation{}, errors.New("user id is required")
+	}
+
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	start, end := periodRange(dto.PeriodMonth, time.Now().In(loc), a.dayStartHour(ctx, userID))
+	pts, err := a.repo.GetTrackPoints(ctx, userID, start.UTC(), end.UTC(), nil)
+	if err != nil {
+		return dto.BedtimeRecommendation{}, err
+	}
+	return analytics.BedtimeTonight(pts), nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+const (
+	// bestTimeMinSamples — сколько отметок должно быть в часе, чтобы он участвовал в выборе.
+	bestTimeMinSamples = 2
+	// bestTimeFullConfidence — при таком числе отметок в часе уверенность считается полной.
+	bestTimeFullConfidence = 8
+)
+
+// BestTimeTomorrow выбирает по отметкам за последний месяц один час с лучшей концентрацией для сложной задачи
+// и час с самой низкой энергией как слот для восстановления.
+func (a *Analyzer) BestTimeTomorrow(ctx context.Context, userID int32, userTZ string) (dto.BestTime, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.BestTime{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.BestTime{}, errors.New("user id is required")
+	}
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	start, end := periodRange(dto.PeriodMonth, time.Now().In(loc), a.dayStartHour(ctx, userID))
+	pts, err := a.repo.GetTrackPoints(ctx, userID, start.UTC(), end.UTC(), nil)
+	if err != nil {
+		return dto.BestTime{}, err
+	}
+	for i := range pts {
+		pts[i].TS = pts[i].TS.In(loc)
+	}
+
+	var focus, recovery *dto.HourStat
+	eligible := 0
+	for _, st := range analytics.ComputeFocusByHour(pts, a.energyParams) {
+		if st.Count < bestTimeMinSamples {
+			continue
+		}
+		st := st
+		eligible++
+		if focus == nil || st.AvgFocus > focus.AvgFocus || (st.AvgFocus == focus.AvgFocus && st.Hour < focus.Hour) {
+			focus = &st
+		}
+		if recovery == nil || st.AvgEnergy < recovery.AvgEnergy || (st.AvgEnergy == recovery.AvgEnergy && st.Hour < recovery.Hour) {
+			recovery = &st
+		}
+	}
+	if eligible < 2 {
+		return dto.BestTime{
+			Message: "Недостаточно данных: нужно хотя бы по две отметки в двух разных часах за последний месяц.",
+		}, nil
+	}
+
+	return dto.BestTime{
+		EnoughData:         true,
+		FocusHour:          fmt.Sprintf("%02d:00", focus.Hour),
+		FocusConfidence:    hourConfidence(focus.Count),
+		RecoveryHour:       fmt.Sprintf("%02d:00", recovery.Hour),
+		RecoveryConfidence: hourConfidence(recovery.Count),
+		Message:            fmt.Sprintf("Сложную задачу завтра лучше начать в %02d:00, а паузу запланировать на %02d:00.", focus.Hour, recovery.Hour),
+	}, nil
+}
+
+func hourConfidence(n int) float64 {
+	return round2(math.Min(1, float64(n)/bestTimeFullConfidence))
+}
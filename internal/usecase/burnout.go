@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"nexus/internal/dto"
+)
+
+const (
+	burnoutLevelHigh = "high"
+	// burnoutRetriggerDelta — на сколько баллов риск должен вырасти над уровнем при скрытии,
+	// чтобы предупреждение показалось снова.
+	burnoutRetriggerDelta = 10.0
+)
+
+var ErrNoBurnoutWarning = errors.New("no active burnout warning")
+
+// DismissBurnoutWarning скрывает текущее предупреждение о высоком риске выгорания для последнего разбора
+// за period и запоминает его балл: пока риск не вырастет на burnoutRetriggerDelta, баннер не показывается.
+func (a *Analyzer) DismissBurnoutWarning(ctx context.Context, userID int32, period dto.Period) (dto.BurnoutRisk, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.BurnoutRisk{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.BurnoutRisk{}, errors.New("user id is required")
+	}
+	key := latestPeriodKey(period)
+	last, _, err := a.repo.GetLastAnalyses(ctx, userID)
+	if err != nil {
+		return dto.BurnoutRisk{}, err
+	}
+	resp, ok := last[key]
+	if !ok || resp.BurnoutRisk.Level != burnoutLevelHigh {
+		return dto.BurnoutRisk{}, ErrNoBurnoutWarning
+	}
+	risk := resp.BurnoutRisk
+	if err := a.repo.SaveBurnoutDismissal(ctx, userID, key, risk.Score, risk.Level); err != nil {
+		return dto.BurnoutRisk{}, err
+	}
+	risk.Dismissed = true
+	return risk, nil
+}
+
+// applyBurnoutDismissal отмечает высокий риск как уже скрытый, если с момента скрытия за тот же period
+// он вырос меньше, чем на burnoutRetriggerDelta. Ошибки чтения не скрывают предупреждение.
+func (a *Analyzer) applyBurnoutDismissal(ctx context.Context, userID int32, period dto.Period, risk *dto.BurnoutRisk) {
+	risk.Dismissed = false
+	if a.repo == nil || userID <= 0 || risk.Level != burnoutLevelHigh {
+		return
+	}
+	score, ok, err := a.repo.GetBurnoutDismissal(ctx, userID, latestPeriodKey(period))
+	if err != nil || !ok {
+		return
+	}
+	risk.Dismissed = risk.Score < score+burnoutRetriggerDelta
+}
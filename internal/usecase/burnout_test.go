@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"nexus/internal/dto"
+)
+
+func highRisk(score float64) dto.AnalyzeResponse {
+	return dto.AnalyzeResponse{BurnoutRisk: dto.BurnoutRisk{Score: score, Level: burnoutLevelHigh}}
+}
+
+func TestBurnoutDismissalRetrigger(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepo{tz: "UTC"}
+	_ = repo.UpsertLastAnalysis(ctx, testUserID, "week", highRisk(60))
+	_ = repo.UpsertLastAnalysis(ctx, testUserID, "month", highRisk(60))
+	a := NewAnalyzer(nil, repo, Config{})
+
+	risk, err := a.DismissBurnoutWarning(ctx, testUserID, dto.PeriodWeek)
+	if err != nil || !risk.Dismissed {
+		t.Fatalf("DismissBurnoutWarning = %+v, %v", risk, err)
+	}
+	if got := repo.dismissals; len(got) != 1 || got["week"] != 60 {
+		t.Fatalf("stored dismissals = %v, want week at 60", got)
+	}
+
+	// The stored analyses predate the dismissal; reading them must reflect it, for that period only.
+	last, _, err := a.GetLastAnalyses(ctx, testUserID)
+	if err != nil {
+		t.Fatalf("GetLastAnalyses: %v", err)
+	}
+	if !last["week"].BurnoutRisk.Dismissed || last["month"].BurnoutRisk.Dismissed {
+		t.Errorf("dismissed: week=%v month=%v, want only week", last["week"].BurnoutRisk.Dismissed, last["month"].BurnoutRisk.Dismissed)
+	}
+
+	cases := []struct {
+		score float64
+		level string
+		want  bool
+	}{
+		{55, burnoutLevelHigh, true},
+		{60 + burnoutRetriggerDelta - 0.5, burnoutLevelHigh, true},
+		{60 + burnoutRetriggerDelta, burnoutLevelHigh, false},
+		{85, burnoutLevelHigh, false},
+		{40, "medium", false},
+	}
+	for _, c := range cases {
+		r := dto.BurnoutRisk{Score: c.score, Level: c.level, Dismissed: !c.want}
+		a.applyBurnoutDismissal(ctx, testUserID, dto.PeriodWeek, &r)
+		if r.Dismissed != c.want {
+			t.Errorf("score %v (%s): dismissed = %v, want %v", c.score, c.level, r.Dismissed, c.want)
+		}
+	}
+}
+
+func TestDismissBurnoutWarningNeedsHighRisk(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepo{tz: "UTC"}
+	_ = repo.UpsertLastAnalysis(ctx, testUserID, "week", dto.AnalyzeResponse{BurnoutRisk: dto.BurnoutRisk{Score: 30, Level: "medium"}})
+	a := NewAnalyzer(nil, repo, Config{})
+
+	for _, p := range []dto.Period{dto.PeriodWeek, dto.PeriodMonth} {
+		if _, err := a.DismissBurnoutWarning(ctx, testUserID, p); !errors.Is(err, ErrNoBurnoutWarning) {
+			t.Errorf("%s: err = %v, want ErrNoBurnoutWarning", p, err)
+		}
+	}
+	if len(repo.dismissals) != 0 {
+		t.Errorf("a dismissal was stored without a warning: %v", repo.dismissals)
+	}
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+// maxCalendarDays ограничивает календарь тремя месяцами — этого хватает на экран с месяцем и соседями.
+const maxCalendarDays = 93
+
+var ErrInvalidCalendarRange = errors.New("calendar range must be YYYY-MM-DD dates with from <= to and at most 93 days")
+
+// GetStreakCalendar отмечает, в какие дни диапазона from..to (YYYY-MM-DD, включительно) были отметки.
+// Дни считаются по часовому поясу и началу дня пользователя; без диапазона берётся текущий месяц до сегодня.
+// withEnergy добавляет energy score залогированных дней. LLM не вызывается.
+// Пример: GetStreakCalendar(ctx, 42, "", "2026-10-01", "2026-10-31", false).Days[0] -> {Date: "2026-10-01", Logged: true}.
+func (a *Analyzer) GetStreakCalendar(ctx context.Context, userID int32, userTZ, from, to string, withEnergy bool) (dto.StreakCalendar, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.StreakCalendar{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.StreakCalendar{}, errors.New("user id is required")
+	}
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	dayStart := a.dayStartHour(ctx, userID)
+
+	first, last, err := calendarRange(from, to, time.Now().In(loc), dayStart, loc)
+	if err != nil {
+		return dto.StreakCalendar{}, err
+	}
+	// Noon is inside the logical day for any allowed dayStartHour (0..12).
+	start, _ := dayBounds(first.Add(12*time.Hour), dayStart)
+	_, end := dayBounds(last.Add(12*time.Hour), dayStart)
+
+	logged := map[string]bool{}
+	var energy map[string]float64
+	if withEnergy {
+		pts, err := a.repo.GetTrackPoints(ctx, userID, start.UTC(), end.UTC(), nil)
+		if err != nil {
+			return dto.StreakCalendar{}, err
+		}
+		for i := range pts {
+			pts[i].TS = pts[i].TS.In(loc)
+		}
+		energy = analytics.DailyEnergyScores(pts, dayStart, a.energyParams)
+		for day := range energy {
+			logged[day] = true
+		}
+	} else {
+		days, err := a.repo.GetTrackDays(ctx, userID, start.UTC(), end.UTC(), zoneName(loc), dayStart)
+		if err != nil {
+			return dto.StreakCalendar{}, err
+		}
+		for _, day := range days {
+			logged[day] = true
+		}
+	}
+
+	out := dto.StreakCalendar{
+		From: first.Format("2006-01-02"),
+		To:   last.Format("2006-01-02"),
+	}
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		key := d.Format("2006-01-02")
+		day := dto.CalendarDay{Date: key, Logged: logged[key]}
+		if v, ok := energy[key]; ok {
+			day.EnergyScore = &v
+		}
+		out.Days = append(out.Days, day)
+	}
+	return out, nil
+}
+
+// calendarRange разбирает границы календаря как даты в loc. Пустой диапазон — с первого числа
+// текущего месяца по сегодняшний логический день; одна пустая граница — ошибка.
+func calendarRange(from, to string, now time.Time, dayStartHour int, loc *time.Location) (time.Time, time.Time, error) {
+	if from == "" && to == "" {
+		today, _ := dayBounds(now, dayStartHour)
+		today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, loc)
+		return today.AddDate(0, 0, 1-today.Day()), today, nil
+	}
+	first, err := time.ParseInLocation("2006-01-02", from, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidCalendarRange
+	}
+	last, err := time.ParseInLocation("2006-01-02", to, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidCalendarRange
+	}
+	if last.Before(first) || last.Sub(first) >= maxCalendarDays*24*time.Hour {
+		return time.Time{}, time.Time{}, ErrInvalidCalendarRange
+	}
+	return first, last, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestStreakCalendarPassesZoneNameToSQL(t *testing.T) {
+	t.Setenv("TZ", "Europe/Berlin")
+	repo := &fakeRepo{}
+	seedDays(repo, 5, nil)
+	a := NewAnalyzer(nil, repo, Config{DefaultLocation: time.Local})
+
+	today := time.Now().In(time.Local)
+	from := today.AddDate(0, 0, -6).Format("2006-01-02")
+	cal, err := a.GetStreakCalendar(context.Background(), testUserID, "", from, today.Format("2006-01-02"), false)
+	if err != nil {
+		t.Fatalf("GetStreakCalendar: %v", err)
+	}
+	if len(repo.zones) != 1 || repo.zones[0] != "Europe/Berlin" {
+		t.Errorf("zones passed to SQL = %q, want [Europe/Berlin]", repo.zones)
+	}
+	logged := 0
+	for _, d := range cal.Days {
+		if d.Logged {
+			logged++
+		}
+	}
+	if len(cal.Days) != 7 || logged < 4 {
+		t.Errorf("calendar = %d days with %d logged, want 7 days with the seeded ones logged", len(cal.Days), logged)
+	}
+
+	// A saved zone is passed as is.
+	repo.zones, repo.tz = nil, "Asia/Tokyo"
+	if _, err := a.GetStreakCalendar(context.Background(), testUserID, "", "", "", false); err != nil {
+		t.Fatalf("GetStreakCalendar: %v", err)
+	}
+	if len(repo.zones) != 1 || repo.zones[0] != "Asia/Tokyo" {
+		t.Errorf("zones passed to SQL = %q, want [Asia/Tokyo]", repo.zones)
+	}
+}
+
+func TestZoneName(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("no tzdata: %v", err)
+	}
+	if got := zoneName(tokyo); got != "Asia/Tokyo" {
+		t.Errorf("zoneName(Asia/Tokyo) = %q", got)
+	}
+	t.Setenv("TZ", ":Europe/Berlin")
+	if got := zoneName(time.Local); got != "Europe/Berlin" {
+		t.Errorf("zoneName(Local) with TZ set = %q, want Europe/Berlin", got)
+	}
+	t.Setenv("TZ", "Not/AZone")
+	if got := zoneName(time.Local); got == "Local" || got == "Not/AZone" {
+		t.Errorf("zoneName(Local) with a bad TZ = %q, want a real zone name", got)
+	}
+}
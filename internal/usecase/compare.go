@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"nexus/internal/dto"
+)
+
+// compareTieEps — разница метрик, ниже которой считаем результат ничьей.
+const compareTieEps = 0.5
+
+type comparedMetric struct {
+	name           string
+	label          string
+	higherIsBetter bool
+	value          func(dto.AnalyzeResponse) float64
+}
+
+var comparedMetrics = []comparedMetric{
+	{name: "productivity_score", label: "Продуктивность", higherIsBetter: true, value: func(r dto.AnalyzeResponse) float64 { return r.ProductivityModel.Score }},
+	{name: "burnout_score", label: "Риск выгорания", higherIsBetter: false, value: func(r dto.AnalyzeResponse) float64 { return r.BurnoutRisk.Score }},
+	{name: "avg_energy", label: "Энергия", higherIsBetter: true, value: func(r dto.AnalyzeResponse) float64 { return meanOfMap(r.EnergyByWeekday) }},
+}
+
+// CompareWithFriend сравнивает последние разборы двух друзей за период. Берутся только агрегаты из
+// last_analyses: текст разбора, заметки и сырые точки друга наружу не отдаются. Если друг не включил
+// share_analyses, возвращается только его профиль с пояснением — без метрик и времени разбора.
+func (a *Analyzer) CompareWithFriend(ctx context.Context, viewerID, friendID int32, period dto.Period) (dto.FriendComparison, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.FriendComparison{}, errors.New("repository not configured")
+	}
+	if viewerID <= 0 || friendID <= 0 || viewerID == friendID {
+		return dto.FriendComparison{}, errors.New("invalid user id")
+	}
+	friend, err := a.repo.GetUserProfileForViewer(ctx, viewerID, friendID)
+	if err != nil {
+		return dto.FriendComparison{}, err
+	}
+	key := string(period)
+	if key == "" {
+		key = "all"
+	}
+	out := dto.FriendComparison{Friend: friend, Period: key}
+	if !friend.ShareAnalyses {
+		out.Summary = "Друг не делится своими разборами, поэтому сравнить не получится."
+		return out, nil
+	}
+
+	last, meta, err := a.repo.GetLastAnalysesForUsers(ctx, []int32{viewerID, friendID}, key)
+	if err != nil {
+		return dto.FriendComparison{}, err
+	}
+	myResp, okMine := last[viewerID]
+	friendResp, okFriend := last[friendID]
+	out.MyUpdatedAt = meta[viewerID]
+	out.FriendUpdatedAt = meta[friendID]
+	if !okMine || !okFriend {
+		out.Summary = "Пока не с чем сравнивать: за этот период нет разбора у тебя или у друга."
+		return out, nil
+	}
+
+	lines := make([]string, 0, len(comparedMetrics))
+	for _, m := range comparedMetrics {
+		my := round2(m.value(myResp))
+		their := round2(m.value(friendResp))
+		delta := round2(my - their)
+		leader := "tie"
+		if math.Abs(delta) >= compareTieEps {
+			if (delta > 0) == m.higherIsBetter {
+				leader = "me"
+			} else {
+				leader = "friend"
+			}
+		}
+		out.Metrics = append(out.Metrics, dto.MetricComparison{
+			Metric: m.name,
+			Mine:   my,
+			Friend: their,
+			Delta:  delta,
+			Leader: leader,
+		})
+		lines = append(lines, compareLine(m.label, leader, my, their))
+	}
+	out.Summary = strings.Join(lines, "\n")
+	return out, nil
+}
+
+func compareLine(label, leader string, mine, friend float64) string {
+	switch leader {
+	case "me":
+		return fmt.Sprintf("%s: у тебя лучше (%.1f против %.1f).", label, mine, friend)
+	case "friend":
+		return fmt.Sprintf("%s: у друга лучше (%.1f против %.1f).", label, friend, mine)
+	default:
+		return fmt.Sprintf("%s: примерно одинаково (%.1f и %.1f).", label, mine, friend)
+	}
+}
+
+func meanOfMap(m map[string]float64) float64 {
+	if len(m) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range m {
+		sum += v
+	}
+	return sum / float64(len(m))
+}
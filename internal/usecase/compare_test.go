@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// friendsRepo serves profiles and last analyses of several users for the social use cases.
+type friendsRepo struct {
+	*fakeRepo
+	profiles map[int32]dto.UserProfile
+	last     map[int32]dto.AnalyzeResponse
+	// lastReads counts GetLastAnalysesForUsers calls.
+	lastReads int
+}
+
+func (r *friendsRepo) GetUserProfileForViewer(_ context.Context, _, targetID int32) (dto.UserProfile, error) {
+	p := r.profiles[targetID]
+	p.IsFriend = true
+	return p, nil
+}
+
+func (r *friendsRepo) GetLastAnalysesForUsers(_ context.Context, userIDs []int32, _ string) (map[int32]dto.AnalyzeResponse, map[int32]time.Time, error) {
+	r.lastReads++
+	out, meta := map[int32]dto.AnalyzeResponse{}, map[int32]time.Time{}
+	for _, id := range userIDs {
+		if resp, ok := r.last[id]; ok {
+			out[id], meta[id] = resp, time.Now()
+		}
+	}
+	return out, meta, nil
+}
+
+func TestCompareWithFriendNeedsConsent(t *testing.T) {
+	resp := func(score float64) dto.AnalyzeResponse {
+		return dto.AnalyzeResponse{ProductivityModel: dto.ProductivityModel{Score: score}, BurnoutRisk: dto.BurnoutRisk{Score: 40}}
+	}
+	repo := &friendsRepo{
+		fakeRepo: &fakeRepo{},
+		profiles: map[int32]dto.UserProfile{7: {UserID: 7, Name: "Аня"}},
+		last:     map[int32]dto.AnalyzeResponse{testUserID: resp(60), 7: resp(80)},
+	}
+	a := NewAnalyzer(nil, repo, Config{})
+
+	got, err := a.CompareWithFriend(context.Background(), testUserID, 7, dto.PeriodWeek)
+	if err != nil {
+		t.Fatalf("CompareWithFriend: %v", err)
+	}
+	if len(got.Metrics) != 0 || !got.FriendUpdatedAt.IsZero() || repo.lastReads != 0 {
+		t.Errorf("a non-sharing friend's analysis was used: %+v (%d reads)", got, repo.lastReads)
+	}
+	if got.Friend.UserID != 7 || got.Summary == "" {
+		t.Errorf("comparison = %+v, want the profile and an explanation", got)
+	}
+
+	repo.profiles[7] = dto.UserProfile{UserID: 7, Name: "Аня", ShareAnalyses: true}
+	got, err = a.CompareWithFriend(context.Background(), testUserID, 7, dto.PeriodWeek)
+	if err != nil {
+		t.Fatalf("CompareWithFriend: %v", err)
+	}
+	if len(got.Metrics) != len(comparedMetrics) || got.Metrics[0].Friend != 80 || got.Metrics[0].Leader != "friend" {
+		t.Errorf("sharing friend: metrics = %+v", got.Metrics)
+	}
+}
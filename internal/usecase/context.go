@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+// ErrContextNotCategorical — у ключа слишком много разных значений для разбивки по группам.
+var ErrContextNotCategorical = analytics.ErrContextNotCategorical
+
+// GetContextBreakdown сравнивает настроение и энергию за period по значениям ключа контекста key
+// (например, "weather"), который пользователь сам добавляет к отметкам.
+// Пример: GetContextBreakdown(ctx, 42, "", dto.PeriodMonth, "weather") -> {Groups: [{rain 5 5.8 58.2} {sun 4 7.1 70.4}]}.
+func (a *Analyzer) GetContextBreakdown(ctx context.Context, userID int32, userTZ string, period dto.Period, key string) (dto.ContextBreakdown, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.ContextBreakdown{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.ContextBreakdown{}, errors.New("user id is required")
+	}
+	key = strings.ToLower(strings.TrimSpace(key))
+
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	start, end := periodRange(period, time.Now().In(loc), a.dayStartHour(ctx, userID))
+	pts, err := a.repo.GetTrackPoints(ctx, userID, start.UTC(), end.UTC(), nil)
+	if err != nil {
+		return dto.ContextBreakdown{}, err
+	}
+	return analytics.ContextBreakdown(pts, key, a.energyParams)
+}
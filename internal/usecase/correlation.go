@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+// GetCorrelationMatrix считает попарные корреляции сна, настроения, энергии, стресса, концентрации
+// и продуктивности за period. Это отдельный экран для любопытных, в промпт LLM он не идёт.
+// Пример: GetCorrelationMatrix(ctx, 42, "", dto.PeriodMonth).Cells[0] -> {A: "sleep_hours", B: "mood", R: 0.42, N: 28}.
+func (a *Analyzer) GetCorrelationMatrix(ctx context.Context, userID int32, userTZ string, period dto.Period) (dto.CorrelationMatrix, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.CorrelationMatrix{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.CorrelationMatrix{}, errors.New("user id is required")
+	}
+
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	start, end := periodRange(period, time.Now().In(loc), a.dayStartHour(ctx, userID))
+	pts, err := a.repo.GetTrackPoints(ctx, userID, start.UTC(), end.UTC(), nil)
+	if err != nil {
+		return dto.CorrelationMatrix{}, err
+	}
+	return analytics.ComputeCorrelationMatrix(pts), nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// insightDiffEps — изменение метрики между разборами, меньше которого она считается неизменной
+// (тот же порог, что у стрелок PeriodTrends).
+const insightDiffEps = 0.25
+
+var (
+	// ErrAnalysisNotFound — на запрошенный момент у пользователя нет сохранённого разбора за период.
+	ErrAnalysisNotFound = errors.New("analysis not found")
+	// ErrInvalidDiffRange — моменты сравнения не заданы или идут в обратном порядке.
+	ErrInvalidDiffRange = errors.New("invalid diff range")
+)
+
+// GetInsightDiff сравнивает два сохранённых разбора периода: для каждого момента берётся последний разбор
+// не позже него. Сравниваются итоговые оценки (как в CompareWithFriend) и средние метрик, скрытые метрики
+// пропускаются; для стресса и риска выгорания улучшение — это снижение. Ничего не пересчитывается и LLM
+// не вызывается.
+// Пример: GetInsightDiff(ctx, 42, week, t1, t2).Metrics -> [{Metric: "stress", From: 6, To: 4.5, Delta: -1.5, Change: "improved"}, ...].
+func (a *Analyzer) GetInsightDiff(ctx context.Context, userID int32, period dto.Period, from, to time.Time) (dto.InsightDiff, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.InsightDiff{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.InsightDiff{}, errors.New("user id is required")
+	}
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return dto.InsightDiff{}, ErrInvalidDiffRange
+	}
+	key := string(period)
+	if key == "" {
+		key = "all"
+	}
+	fromResp, fromAt, ok, err := a.repo.GetAnalysisAt(ctx, userID, key, from)
+	if err != nil {
+		return dto.InsightDiff{}, err
+	}
+	if !ok {
+		return dto.InsightDiff{}, ErrAnalysisNotFound
+	}
+	toResp, toAt, ok, err := a.repo.GetAnalysisAt(ctx, userID, key, to)
+	if err != nil {
+		return dto.InsightDiff{}, err
+	}
+	if !ok {
+		return dto.InsightDiff{}, ErrAnalysisNotFound
+	}
+
+	out := dto.InsightDiff{Period: key, FromAt: fromAt, ToAt: toAt}
+	for _, m := range comparedMetrics {
+		out.Metrics = append(out.Metrics, metricDiff(m.name, m.value(fromResp), m.value(toResp), m.higherIsBetter))
+	}
+	hidden := a.hiddenMetrics(ctx, userID)
+	metrics := make([]string, 0, len(toResp.Averages))
+	for metric := range toResp.Averages {
+		if _, ok := fromResp.Averages[metric]; !ok {
+			continue
+		}
+		if _, ok := hidden[metric]; ok {
+			continue
+		}
+		metrics = append(metrics, metric)
+	}
+	sort.Strings(metrics)
+	for _, metric := range metrics {
+		out.Metrics = append(out.Metrics, metricDiff(metric, fromResp.Averages[metric], toResp.Averages[metric], metric != "stress"))
+	}
+	return out, nil
+}
+
+func metricDiff(metric string, from, to float64, higherIsBetter bool) dto.MetricDiff {
+	d := dto.MetricDiff{Metric: metric, From: round2(from), To: round2(to), Delta: round2(to - from), Change: "unchanged"}
+	if math.Abs(d.Delta) >= insightDiffEps {
+		if (d.Delta > 0) == higherIsBetter {
+			d.Change = "improved"
+		} else {
+			d.Change = "regressed"
+		}
+	}
+	return d
+}
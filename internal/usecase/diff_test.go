@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// historyRepo serves saved analyses of one period by creation time.
+type historyRepo struct {
+	*fakeRepo
+	at    []time.Time
+	resps []dto.AnalyzeResponse
+}
+
+func (r *historyRepo) GetAnalysisAt(_ context.Context, _ int32, _ string, at time.Time) (dto.AnalyzeResponse, time.Time, bool, error) {
+	for i := len(r.at) - 1; i >= 0; i-- {
+		if !r.at[i].After(at) {
+			return r.resps[i], r.at[i], true, nil
+		}
+	}
+	return dto.AnalyzeResponse{}, time.Time{}, false, nil
+}
+
+func TestGetInsightDiff(t *testing.T) {
+	t1 := time.Date(2026, 9, 7, 9, 0, 0, 0, time.UTC)
+	t2 := t1.AddDate(0, 0, 14)
+	repo := &historyRepo{
+		fakeRepo: &fakeRepo{hidden: []string{"alcohol"}},
+		at:       []time.Time{t1, t2},
+		resps: []dto.AnalyzeResponse{
+			{
+				ProductivityModel: dto.ProductivityModel{Score: 60},
+				BurnoutRisk:       dto.BurnoutRisk{Score: 50},
+				EnergyByWeekday:   map[string]float64{"Mon": 60, "Tue": 70},
+				Averages:          map[string]float64{"stress": 6, "mood": 7, "alcohol": 0.5},
+			},
+			{
+				ProductivityModel: dto.ProductivityModel{Score: 70},
+				BurnoutRisk:       dto.BurnoutRisk{Score: 50.1},
+				EnergyByWeekday:   map[string]float64{"Mon": 60, "Tue": 60},
+				Averages:          map[string]float64{"stress": 4.5, "mood": 6, "alcohol": 0.1, "sleep_hours": 7},
+			},
+		},
+	}
+	a := NewAnalyzer(nil, repo, Config{})
+	ctx := context.Background()
+
+	got, err := a.GetInsightDiff(ctx, testUserID, dto.PeriodWeek, t1.Add(time.Hour), t2.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("GetInsightDiff: %v", err)
+	}
+	want := dto.InsightDiff{Period: "week", FromAt: t1, ToAt: t2, Metrics: []dto.MetricDiff{
+		{Metric: "productivity_score", From: 60, To: 70, Delta: 10, Change: "improved"},
+		{Metric: "burnout_score", From: 50, To: 50.1, Delta: 0.1, Change: "unchanged"},
+		{Metric: "avg_energy", From: 65, To: 60, Delta: -5, Change: "regressed"},
+		// Hidden metrics and metrics missing from one side are left out.
+		{Metric: "mood", From: 7, To: 6, Delta: -1, Change: "regressed"},
+		{Metric: "stress", From: 6, To: 4.5, Delta: -1.5, Change: "improved"},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diff = %+v\nwant   %+v", got, want)
+	}
+
+	if _, err := a.GetInsightDiff(ctx, testUserID, dto.PeriodWeek, t1.Add(-time.Hour), t2); !errors.Is(err, ErrAnalysisNotFound) {
+		t.Errorf("before the first analysis: err = %v, want ErrAnalysisNotFound", err)
+	}
+	if _, err := a.GetInsightDiff(ctx, testUserID, dto.PeriodWeek, t2, t1); !errors.Is(err, ErrInvalidDiffRange) {
+		t.Errorf("reversed range: err = %v, want ErrInvalidDiffRange", err)
+	}
+}
@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// fakeRepo is an in-memory AnalysisRepository for a single user's data. Methods no test reaches are
+// left to the embedded nil interface and panic when called.
+type fakeRepo struct {
+	AnalysisRepository
+
+	mu       sync.Mutex
+	points   []dto.TrackPoint
+	tz       string
+	dayStart int
+	disabled bool
+	hidden   []string
+	goals    map[string]float64
+	tone     dto.Tone
+	saved    []savedAnalysis
+	last     map[string]dto.AnalyzeResponse
+	lastAt   map[string]time.Time
+	// dismissals holds the dismissed burnout score per period.
+	dismissals map[string]float64
+	// zones records the tz names passed to GetTrackDays.
+	zones []string
+}
+
+type savedAnalysis struct {
+	req  dto.AnalyzeRequest
+	resp dto.AnalyzeResponse
+}
+
+func (r *fakeRepo) GetUserSettings(context.Context, int32) (string, error) { return r.tz, nil }
+func (r *fakeRepo) GetDayStartHour(context.Context, int32) (int, error)    { return r.dayStart, nil }
+func (r *fakeRepo) GetAnalysesEnabled(context.Context, int32) (bool, error) {
+	return !r.disabled, nil
+}
+func (r *fakeRepo) GetUserProfile(_ context.Context, userID int32) (dto.UserProfile, error) {
+	return dto.UserProfile{UserID: userID, DayStartHour: int32(r.dayStart), AnalysesEnabled: !r.disabled}, nil
+}
+func (r *fakeRepo) GetHiddenMetrics(context.Context, int32) ([]string, error) { return r.hidden, nil }
+func (r *fakeRepo) GetGoals(context.Context, int32) (map[string]float64, error) {
+	out := make(map[string]float64, len(r.goals))
+	for k, v := range r.goals {
+		out[k] = v
+	}
+	return out, nil
+}
+func (r *fakeRepo) GetInsightTone(context.Context, int32) (dto.Tone, error) { return r.tone, nil }
+func (r *fakeRepo) GetBurnoutDismissal(_ context.Context, _ int32, period string) (float64, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	score, ok := r.dismissals[period]
+	return score, ok, nil
+}
+func (r *fakeRepo) SaveBurnoutDismissal(_ context.Context, _ int32, period string, score float64, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.dismissals == nil {
+		r.dismissals = map[string]float64{}
+	}
+	r.dismissals[period] = score
+	return nil
+}
+
+func (r *fakeRepo) GetCachedResponse(context.Context, string) (*dto.AnalyzeResponse, bool, error) {
+	return nil, false, nil
+}
+func (r *fakeRepo) CacheResponse(context.Context, string, dto.AnalyzeResponse, time.Duration) error {
+	return nil
+}
+func (r *fakeRepo) ListFocusSessions(context.Context, int32, time.Time, time.Time) ([]dto.FocusSession, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) GetTrackPoints(_ context.Context, _ int32, from, to time.Time, tags []string) ([]dto.TrackPoint, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []dto.TrackPoint
+	for _, p := range r.points {
+		if (!from.IsZero() && p.TS.Before(from)) || !p.TS.Before(to) || !hasAllTags(p, tags) {
+			continue
+		}
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].TS.Before(out[j].TS) })
+	return out, nil
+}
+
+func hasAllTags(p dto.TrackPoint, tags []string) bool {
+	for _, t := range tags {
+		found := false
+		for _, pt := range p.Tags {
+			found = found || pt == t
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *fakeRepo) GetLatestTrackTS(context.Context, int32) (time.Time, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var latest time.Time
+	for _, p := range r.points {
+		if p.TS.After(latest) {
+			latest = p.TS
+		}
+	}
+	return latest, !latest.IsZero(), nil
+}
+
+func (r *fakeRepo) GetFirstTrackTS(context.Context, int32) (time.Time, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var first time.Time
+	for _, p := range r.points {
+		if first.IsZero() || p.TS.Before(first) {
+			first = p.TS
+		}
+	}
+	return first, !first.IsZero(), nil
+}
+
+func (r *fakeRepo) CountTrackPoints(_ context.Context, _ int32, limit int) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return min(len(r.points), limit), nil
+}
+
+func (r *fakeRepo) SaveAnalysis(_ context.Context, _ string, req dto.AnalyzeRequest, resp dto.AnalyzeResponse) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.saved = append(r.saved, savedAnalysis{req: req, resp: resp})
+	return nil
+}
+
+func (r *fakeRepo) UpsertLastAnalysis(_ context.Context, _ int32, period string, resp dto.AnalyzeResponse) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.last == nil {
+		r.last, r.lastAt = map[string]dto.AnalyzeResponse{}, map[string]time.Time{}
+	}
+	r.last[period] = resp
+	r.lastAt[period] = time.Now()
+	return nil
+}
+
+func (r *fakeRepo) GetLastAnalysis(_ context.Context, _ int32, period string) (dto.AnalyzeResponse, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	resp, ok := r.last[period]
+	return resp, ok, nil
+}
+
+func (r *fakeRepo) GetLastAnalyses(context.Context, int32) (map[string]dto.AnalyzeResponse, map[string]time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, meta := map[string]dto.AnalyzeResponse{}, map[string]time.Time{}
+	for k, v := range r.last {
+		m[k], meta[k] = v, r.lastAt[k]
+	}
+	return m, meta, nil
+}
+
+func (r *fakeRepo) GetLastAnalysisTimes(context.Context, int32) (map[string]time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := map[string]time.Time{}
+	for k, v := range r.lastAt {
+		out[k] = v
+	}
+	return out, nil
+}
+
+// GetTrackDays mirrors the SQL version, including Postgres rejecting zone names it does not know.
+func (r *fakeRepo) GetTrackDays(_ context.Context, _ int32, from, to time.Time, tz string, dayStartHour int) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.zones = append(r.zones, tz)
+	loc, err := time.LoadLocation(tz)
+	if err != nil || tz == "Local" {
+		return nil, fmt.Errorf("time zone %q not recognized", tz)
+	}
+	seen := map[string]bool{}
+	var out []string
+	for _, p := range r.points {
+		if p.TS.Before(from) || !p.TS.Before(to) {
+			continue
+		}
+		day := p.TS.In(loc).Add(-time.Duration(dayStartHour) * time.Hour).Format("2006-01-02")
+		if !seen[day] {
+			seen[day] = true
+			out = append(out, day)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+const (
+	// maxFocusSession — самая длинная сессия, которую можно залогировать одной записью.
+	maxFocusSession = 12 * time.Hour
+	// focusClockSkew — насколько конец сессии может опережать часы сервера.
+	focusClockSkew = 5 * time.Minute
+)
+
+var (
+	ErrInvalidFocusSession  = errors.New("invalid focus session")
+	ErrFocusSessionNotFound = errors.New("focus session not found")
+)
+
+// LogFocusSession сохраняет сессию фокуса. Конец должен быть позже начала, сессия — не длиннее
+// maxFocusSession и не в будущем, оценка — от 0 до 10.
+// Пример: LogFocusSession(ctx, 42, dto.FocusSession{StartedAt: t, EndedAt: t.Add(50 * time.Minute), Quality: 8}).
+func (a *Analyzer) LogFocusSession(ctx context.Context, userID int32, fs dto.FocusSession) (dto.FocusSession, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.FocusSession{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.FocusSession{}, errors.New("user id is required")
+	}
+	if err := validateFocusSession(fs, time.Now()); err != nil {
+		return dto.FocusSession{}, err
+	}
+	return a.repo.CreateFocusSession(ctx, userID, fs)
+}
+
+// DeleteFocusSession удаляет ошибочно залогированную сессию пользователя.
+func (a *Analyzer) DeleteFocusSession(ctx context.Context, userID int32, id int64) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return errors.New("user id is required")
+	}
+	ok, err := a.repo.DeleteFocusSession(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrFocusSessionNotFound
+	}
+	return nil
+}
+
+func validateFocusSession(fs dto.FocusSession, now time.Time) error {
+	switch {
+	case fs.StartedAt.IsZero() || fs.EndedAt.IsZero():
+		return fmt.Errorf("%w: start and end are required", ErrInvalidFocusSession)
+	case !fs.EndedAt.After(fs.StartedAt):
+		return fmt.Errorf("%w: end must be after start", ErrInvalidFocusSession)
+	case fs.EndedAt.Sub(fs.StartedAt) > maxFocusSession:
+		return fmt.Errorf("%w: a session may last at most %d hours", ErrInvalidFocusSession, int(maxFocusSession.Hours()))
+	case fs.EndedAt.After(now.Add(focusClockSkew)):
+		return fmt.Errorf("%w: end must not be in the future", ErrInvalidFocusSession)
+	case fs.Quality < 0 || fs.Quality > 10:
+		return fmt.Errorf("%w: quality must be between 0 and 10", ErrInvalidFocusSession)
+	}
+	return nil
+}
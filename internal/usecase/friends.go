@@ -19,13 +19,33 @@ func (a *Analyzer) GetMyProfile(ctx context.Context, userID int32) (dto.UserProf
 	return a.repo.GetUserProfile(ctx, userID)
 }
 
-func (a *Analyzer) UpdateMyProfile(ctx context.Context, userID int32, emoji string, bgIndex int32) (dto.UserProfile, error) {
+func (a *Analyzer) UpdateMyProfile(ctx context.Context, userID int32, emoji string, bgIndex int32, dayStartHour *int, tone *dto.Tone, shareAnalyses, analysesEnabled *bool) (dto.UserProfile, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	if a.repo == nil {
 		return dto.UserProfile{}, errors.New("repository not configured")
 	}
+	if dayStartHour != nil {
+		if err := a.repo.UpdateDayStartHour(ctx, userID, *dayStartHour); err != nil {
+			return dto.UserProfile{}, err
+		}
+	}
+	if tone != nil {
+		if err := a.repo.UpdateInsightTone(ctx, userID, *tone); err != nil {
+			return dto.UserProfile{}, err
+		}
+	}
+	if shareAnalyses != nil {
+		if err := a.repo.UpdateShareAnalyses(ctx, userID, *shareAnalyses); err != nil {
+			return dto.UserProfile{}, err
+		}
+	}
+	if analysesEnabled != nil {
+		if err := a.repo.UpdateAnalysesEnabled(ctx, userID, *analysesEnabled); err != nil {
+			return dto.UserProfile{}, err
+		}
+	}
 	return a.repo.UpdateUserProfile(ctx, userID, emoji, bgIndex)
 }
 
@@ -50,13 +70,48 @@ func (a *Analyzer) GetUserLastAnalysesForViewer(ctx context.Context, viewerID, t
 		return nil, nil, errors.New("invalid user id")
 	}
 	if viewerID != targetID {
-		if _, err := a.repo.GetUserProfileForViewer(ctx, viewerID, targetID); err != nil {
+		p, err := a.repo.GetUserProfileForViewer(ctx, viewerID, targetID)
+		if err != nil {
 			return nil, nil, err
 		}
+		if !p.ShareAnalyses {
+			return map[string]dto.AnalyzeResponse{}, map[string]time.Time{}, nil
+		}
 	}
 	return a.repo.GetLastAnalyses(ctx, targetID)
 }
 
+// GetFriendAnalysis отдаёт последние сохранённые разборы друга, только если он включил share_analyses;
+// иначе — один профиль без метрик. Новый разбор за друга никогда не запускается.
+// Пример: GetFriendAnalysis(ctx, 42, 7) -> {Profile: {Name: "Аня"}, Shared: true, Analyses: {"week": ...}}.
+func (a *Analyzer) GetFriendAnalysis(ctx context.Context, viewerID, friendID int32) (dto.FriendAnalysis, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.FriendAnalysis{}, errors.New("repository not configured")
+	}
+	if viewerID <= 0 || friendID <= 0 {
+		return dto.FriendAnalysis{}, errors.New("invalid user id")
+	}
+	p, err := a.repo.GetUserProfileForViewer(ctx, viewerID, friendID)
+	if err != nil {
+		return dto.FriendAnalysis{}, err
+	}
+	out := dto.FriendAnalysis{Profile: p, Shared: viewerID == friendID || p.ShareAnalyses}
+	if viewerID != friendID {
+		out.Profile.Email = ""
+	}
+	if !out.Shared {
+		return out, nil
+	}
+	out.Analyses, out.UpdatedAt, err = a.repo.GetLastAnalyses(ctx, friendID)
+	if err != nil {
+		return dto.FriendAnalysis{}, err
+	}
+	return out, nil
+}
+
 func (a *Analyzer) SearchUsers(ctx context.Context, userID int32, query string) ([]dto.UserProfile, error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -71,6 +126,32 @@ func (a *Analyzer) SearchUsers(ctx context.Context, userID int32, query string)
 	return a.repo.SearchUsers(ctx, query, userID, 20)
 }
 
+const (
+	emailLookupLimit  = 20
+	emailLookupWindow = time.Hour
+)
+
+var ErrRateLimited = errors.New("too many requests")
+
+// FindUserByEmail ищет пользователя по точному email (без учёта регистра) для приглашения в друзья.
+// Отдаёт только id, имя и эмодзи; сам email не возвращается. Лимитируется, чтобы нельзя было перебирать адреса.
+func (a *Analyzer) FindUserByEmail(ctx context.Context, userID int32, email string) (dto.UserProfile, bool, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.UserProfile{}, false, errors.New("repository not configured")
+	}
+	if !a.emailLookups.allow(userID) {
+		return dto.UserProfile{}, false, ErrRateLimited
+	}
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return dto.UserProfile{}, false, nil
+	}
+	return a.repo.GetUserByEmail(ctx, email, userID)
+}
+
 func (a *Analyzer) ListFriends(ctx context.Context, userID int32) ([]dto.UserProfile, error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -81,6 +162,21 @@ func (a *Analyzer) ListFriends(ctx context.Context, userID int32) ([]dto.UserPro
 	return a.repo.ListFriends(ctx, userID)
 }
 
+// ListFriendsEnriched возвращает друзей вместе с отметкой за сегодня и уровнем выгорания одним запросом.
+// Пример: ListFriendsEnriched(ctx, 42) -> [{Profile: {Name: "Аня"}, LoggedToday: true, BurnoutLevel: "low"}].
+func (a *Analyzer) ListFriendsEnriched(ctx context.Context, userID int32) ([]dto.FriendSummary, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return nil, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("user id is required")
+	}
+	return a.repo.ListFriendsEnriched(ctx, userID, a.ResolveLocation("").String())
+}
+
 func (a *Analyzer) ListFriendRequests(ctx context.Context, userID int32, status string) ([]dto.FriendRequest, error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -101,6 +197,16 @@ func (a *Analyzer) SendFriendRequest(ctx context.Context, fromUserID, toUserID i
 	return a.repo.CreateFriendRequest(ctx, fromUserID, toUserID)
 }
 
+var (
+	// ErrFriendRequestNotFound — заявки с таким id нет.
+	ErrFriendRequestNotFound = errors.New("friend request not found")
+	// ErrFriendRequestResolved — заявка уже закрыта с другим ответом (например, отклонена в другой вкладке).
+	ErrFriendRequestResolved = errors.New("friend request already resolved")
+)
+
+// RespondFriendRequest принимает или отклоняет входящую заявку. Повторный такой же ответ (двойной клик)
+// ничего не меняет и ошибкой не считается; другой ответ на уже закрытую заявку — ErrFriendRequestResolved.
+// Пример: RespondFriendRequest(ctx, 42, 7, "accept") дважды -> nil оба раза, дружба создаётся один раз.
 func (a *Analyzer) RespondFriendRequest(ctx context.Context, userID int32, requestID int64, action string) error {
 	if ctx == nil {
 		ctx = context.Background()
@@ -108,5 +214,19 @@ func (a *Analyzer) RespondFriendRequest(ctx context.Context, userID int32, reque
 	if a.repo == nil {
 		return errors.New("repository not configured")
 	}
-	return a.repo.RespondFriendRequest(ctx, userID, requestID, action)
+	prev, err := a.repo.RespondFriendRequest(ctx, userID, requestID, action)
+	if err != nil {
+		return err
+	}
+	want := "declined"
+	if strings.EqualFold(strings.TrimSpace(action), "accept") {
+		want = "accepted"
+	}
+	switch prev {
+	case "":
+		return ErrFriendRequestNotFound
+	case "pending", want:
+		return nil
+	}
+	return ErrFriendRequestResolved
 }
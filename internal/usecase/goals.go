@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+// ErrInvalidGoal — цель по неизвестной метрике или с недопустимым значением (см. analytics.ValidGoal).
+var ErrInvalidGoal = errors.New("invalid goal")
+
+// GetGoals отдаёт цели пользователя: метрика -> целевое значение.
+// Пример: GetGoals(ctx, 42) -> {"sleep_hours": 8, "stress": 4}.
+func (a *Analyzer) GetGoals(ctx context.Context, userID int32) (map[string]float64, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return nil, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("user id is required")
+	}
+	return a.repo.GetGoals(ctx, userID)
+}
+
+// UpdateGoals заменяет цели пользователя; пустой набор снимает все цели. Имена метрик приводятся
+// к нижнему регистру. Цели попадают в промпт разбора вместе с выполнением за неделю.
+// Пример: UpdateGoals(ctx, 42, {"sleep_hours": 8}) -> {"sleep_hours": 8}.
+func (a *Analyzer) UpdateGoals(ctx context.Context, userID int32, goals map[string]float64) (map[string]float64, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return nil, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return nil, errors.New("user id is required")
+	}
+	out := make(map[string]float64, len(goals))
+	for metric, target := range goals {
+		metric = strings.ToLower(strings.TrimSpace(metric))
+		if !analytics.ValidGoal(metric, target) {
+			return nil, fmt.Errorf("%w: %s = %g", ErrInvalidGoal, metric, target)
+		}
+		out[metric] = target
+	}
+	if err := a.repo.UpdateGoals(ctx, userID, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// goalProgress computes the attainment of the user's goals over pts for the prompt, by the user's
+// logical days, leaving out goals on hidden metrics. A failed read means no goals, so the prompt never
+// mentions unverified ones.
+func (a *Analyzer) goalProgress(ctx context.Context, userID int32, pts []dto.TrackPoint, hidden map[string]struct{}, dayStartHour int) []dto.GoalProgress {
+	goals, err := a.repo.GetGoals(ctx, userID)
+	if err != nil || len(goals) == 0 {
+		return nil
+	}
+	for metric := range goals {
+		if _, ok := hidden[metric]; ok {
+			delete(goals, metric)
+		}
+	}
+	return analytics.GoalAttainment(pts, goals, dayStartHour)
+}
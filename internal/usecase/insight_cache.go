@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"expvar"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+var (
+	sharedInsightHits   = expvar.NewInt("insight_shared_cache_hits_total")
+	sharedInsightMisses = expvar.NewInt("insight_shared_cache_misses_total")
+	userInsightHits     = expvar.NewInt("insight_user_cache_hits_total")
+	userInsightMisses   = expvar.NewInt("insight_user_cache_misses_total")
+)
+
+// callInsight вызывает LLM, но для промптов без пользовательского текста (заметок и отзыва) сначала ищет
+// готовый разбор по хэшу числовой части промпта: одинаковые агрегаты у разных людей дают один вызов LLM.
+// Промпты с заметками никогда не попадают в общий кэш, чтобы текст одного пользователя не ушёл другому;
+// для них (и при выключенном общем кэше) работает личный кэш, ключ которого включает хэш заметок и отзыва,
+// так что правка заметки за любой день даёт новый ключ и новый вызов LLM.
+func (a *Analyzer) callInsight(ctx context.Context, userID int32, p dto.AIPrompt) (string, error) {
+	if a.repo == nil {
+		return a.llm.CallInsight(ctx, p)
+	}
+	if a.sharedInsightTTL > 0 && p.UserNotes == "" && p.Feedback == "" {
+		hash := numericPromptHash(p)
+		if text, ok, err := a.repo.GetSharedInsight(ctx, hash); err == nil && ok {
+			sharedInsightHits.Add(1)
+			return text, nil
+		}
+		sharedInsightMisses.Add(1)
+		text, err := a.llm.CallInsight(ctx, p)
+		if err != nil {
+			return "", err
+		}
+		_ = a.repo.CacheSharedInsight(ctx, hash, text, a.sharedInsightTTL)
+		return text, nil
+	}
+	if a.insightCacheTTL <= 0 || userID <= 0 {
+		return a.llm.CallInsight(ctx, p)
+	}
+	hash := userInsightHash(p)
+	if text, ok, err := a.repo.GetUserInsight(ctx, userID, hash); err == nil && ok {
+		userInsightHits.Add(1)
+		return text, nil
+	}
+	userInsightMisses.Add(1)
+	text, err := a.llm.CallInsight(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	_ = a.repo.CacheUserInsight(ctx, userID, hash, text, a.insightCacheTTL)
+	return text, nil
+}
+
+// userInsightHash дополняет numericPromptHash хэшами собранного блока заметок, отзыва и часового пояса.
+// Пример: та же неделя, но исправленная заметка за вторник -> другой хэш и новый разбор.
+func userInsightHash(p dto.AIPrompt) string {
+	numeric := numericPromptHash(p)
+	if numeric == "" {
+		return ""
+	}
+	notes := sha256.Sum256([]byte(p.UserNotes))
+	feedback := sha256.Sum256([]byte(p.Feedback))
+	sum := sha256.Sum256([]byte(numeric + ":" + hex.EncodeToString(notes[:]) + ":" + hex.EncodeToString(feedback[:]) + ":" + p.UserTZ))
+	return hex.EncodeToString(sum[:])
+}
+
+// numericPromptHash хэширует всё, что попадает в промпт, кроме пользовательского текста и часового пояса.
+// Границы периода берутся с точностью до дня, как они и выводятся в промпт.
+func numericPromptHash(p dto.AIPrompt) string {
+	key := p
+	key.UserTZ = ""
+	key.UserNotes = ""
+	key.Feedback = ""
+	start, end := key.PeriodStart.Format("2006-01-02"), key.PeriodEnd.Format("2006-01-02")
+	key.PeriodStart, key.PeriodEnd = time.Time{}, time.Time{}
+	payload, err := json.Marshal(struct {
+		Prompt dto.AIPrompt
+		Start  string
+		End    string
+	}{key, start, end})
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// insightCacheRepo keeps the shared and per-user insight caches in maps.
+type insightCacheRepo struct {
+	*fakeRepo
+	shared map[string]string
+	user   map[string]string
+}
+
+func (r *insightCacheRepo) GetSharedInsight(_ context.Context, hash string) (string, bool, error) {
+	text, ok := r.shared[hash]
+	return text, ok, nil
+}
+
+func (r *insightCacheRepo) CacheSharedInsight(_ context.Context, hash, text string, _ time.Duration) error {
+	r.shared[hash] = text
+	return nil
+}
+
+func (r *insightCacheRepo) GetUserInsight(_ context.Context, userID int32, hash string) (string, bool, error) {
+	text, ok := r.user[fmt.Sprint(userID, hash)]
+	return text, ok, nil
+}
+
+func (r *insightCacheRepo) CacheUserInsight(_ context.Context, userID int32, hash, text string, _ time.Duration) error {
+	r.user[fmt.Sprint(userID, hash)] = text
+	return nil
+}
+
+// countingLLM answers with a numbered text so cached and fresh answers can be told apart.
+type countingLLM struct{ calls int }
+
+func (l *countingLLM) CallInsight(context.Context, dto.AIPrompt) (string, error) {
+	l.calls++
+	return fmt.Sprintf("insight %d", l.calls), nil
+}
+
+func TestUserInsightCacheMissesOnChangedNotes(t *testing.T) {
+	repo := &insightCacheRepo{fakeRepo: &fakeRepo{}, shared: map[string]string{}, user: map[string]string{}}
+	llm := &countingLLM{}
+	a := NewAnalyzer(llm, repo, Config{InsightCacheTTL: time.Hour, SharedInsightTTL: time.Hour})
+	ctx := context.Background()
+	p := dto.AIPrompt{Period: dto.PeriodWeek, NumPoints: 7, AvgSleepHours: 7.2, UserNotes: "2026-03-10 10:00 — плохо спал"}
+
+	first, _ := a.callInsight(ctx, testUserID, p)
+	again, _ := a.callInsight(ctx, testUserID, p)
+	if llm.calls != 1 || again != first {
+		t.Fatalf("same notes: %d LLM calls, %q then %q; want one call and a cache hit", llm.calls, first, again)
+	}
+	if len(repo.shared) != 0 {
+		t.Errorf("a prompt with notes reached the shared cache: %v", repo.shared)
+	}
+
+	edited := p
+	edited.UserNotes = "2026-03-10 10:00 — плохо спал, болела голова"
+	if got, _ := a.callInsight(ctx, testUserID, edited); llm.calls != 2 || got == first {
+		t.Errorf("edited note: %d LLM calls, got %q; want a miss and a new insight", llm.calls, got)
+	}
+	feedback := p
+	feedback.Feedback = "короче"
+	a.callInsight(ctx, testUserID, feedback)
+	if llm.calls != 3 {
+		t.Errorf("new feedback: %d LLM calls, want a miss", llm.calls)
+	}
+	a.callInsight(ctx, testUserID+1, p)
+	if llm.calls != 4 {
+		t.Errorf("another user: %d LLM calls, want a miss", llm.calls)
+	}
+
+	// Without notes the shared cache answers for every user.
+	bare := p
+	bare.UserNotes = ""
+	a.callInsight(ctx, testUserID, bare)
+	a.callInsight(ctx, testUserID+1, bare)
+	if llm.calls != 5 || len(repo.shared) != 1 {
+		t.Errorf("shared prompt: %d LLM calls, %d shared entries; want 5 and 1", llm.calls, len(repo.shared))
+	}
+}
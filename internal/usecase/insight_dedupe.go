@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"expvar"
+	"strings"
+	"unicode"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+var insightDedupeHits = expvar.NewInt("insight_dedupe_hits_total")
+
+// dedupeInsight заменяет свежий разбор сохранённым для того же периода, если тексты почти совпадают
+// (insightSimilarity не ниже порога Config.InsightDedupeThreshold), а средние и уровень выгорания с тех пор
+// не сдвинулись: прежний текст не противоречит новым числам. Ответ помечается InsightUnchanged, строка
+// истории с новыми числами всё равно сохраняется. Порог 0 выключает проверку.
+// Пример: вчера и сегодня «Сон стабилен, стресс снизился…» при тех же средних -> resp.LLMInsight = вчерашний текст, InsightUnchanged = true.
+func (a *Analyzer) dedupeInsight(ctx context.Context, req dto.AnalyzeRequest, resp *dto.AnalyzeResponse) {
+	if a.dedupeThreshold <= 0 || !storesLatest(req) || resp.LLMInsight == "" {
+		return
+	}
+	prev, ok, err := a.repo.GetLastAnalysis(ctx, req.UserID, latestPeriodKey(req.Period))
+	if err != nil || !ok || prev.LLMInsight == "" || strings.HasPrefix(prev.LLMInsight, insightUnavailablePrefix) {
+		return
+	}
+	if !sameNumbers(prev, *resp) {
+		return
+	}
+	if insightSimilarity(prev.LLMInsight, resp.LLMInsight) >= a.dedupeThreshold {
+		insightDedupeHits.Add(1)
+		resp.LLMInsight = prev.LLMInsight
+		resp.InsightUnchanged = true
+	}
+}
+
+// sameNumbers reports whether cur keeps prev's burnout level and every average within the "flat" band of
+// analytics.CompareAverages, so an insight written for prev still describes cur.
+func sameNumbers(prev, cur dto.AnalyzeResponse) bool {
+	if prev.BurnoutRisk.Level != cur.BurnoutRisk.Level || len(prev.Averages) != len(cur.Averages) {
+		return false
+	}
+	arrows := analytics.CompareAverages(prev.Averages, cur.Averages)
+	if len(arrows) != len(cur.Averages) {
+		return false
+	}
+	for _, dir := range arrows {
+		if dir != "flat" {
+			return false
+		}
+	}
+	return true
+}
+
+// insightSimilarity is 1 minus the word-level edit distance of the two texts over the longer one's word
+// count, case and punctuation ignored: 1 for the same words in the same order, 0 for nothing in common.
+// Reordered or inserted words ("не") cost an edit each, unlike a word-set comparison.
+func insightSimilarity(a, b string) float64 {
+	wa, wb := words(a), words(b)
+	longest := max(len(wa), len(wb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(wordEditDistance(wa, wb))/float64(longest)
+}
+
+func words(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// wordEditDistance is the Levenshtein distance between two word sequences.
+func wordEditDistance(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"nexus/internal/dto"
+)
+
+const weekInsight = "Энергия\nСон стабилен, около восьми часов. Стресс снизился до трёх из десяти, настроение ровное. " +
+	"Тренировки идут почти каждый день, продуктивность держится на семи."
+
+func TestDedupeInsight(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 10, nil)
+	llm := &stubLLM{text: weekInsight}
+	a := NewAnalyzer(llm, repo, Config{InsightDedupeThreshold: 0.9})
+	ctx := context.Background()
+	req := dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodWeek}
+	run := func() *dto.AnalyzeResponse {
+		t.Helper()
+		resp, err := a.Analyze(ctx, req)
+		if err != nil {
+			t.Fatalf("Analyze: %v", err)
+		}
+		return resp
+	}
+
+	first := run()
+	if first.InsightUnchanged {
+		t.Fatal("first insight flagged unchanged")
+	}
+	stored := first.LLMInsight
+
+	// Identical numbers, identical text: the stored insight is reused, the history row is still saved.
+	if resp := run(); !resp.InsightUnchanged || resp.LLMInsight != stored {
+		t.Errorf("identical insight: unchanged=%v, text %q", resp.InsightUnchanged, resp.LLMInsight)
+	}
+	if len(repo.saved) != 2 || !repo.saved[1].resp.InsightUnchanged {
+		t.Fatalf("history rows = %d, want 2 with the second flagged unchanged", len(repo.saved))
+	}
+
+	// Same numbers, a materially different text.
+	llm.text = "Энергия\nПосле тренировок вечерами заметно больше сил, а утренние часы уходят на раскачку."
+	if resp := run(); resp.InsightUnchanged || resp.LLMInsight == stored {
+		t.Errorf("different insight was replaced by the stored one: %q", resp.LLMInsight)
+	}
+
+	// The same text again, but the stress average moved: the stored insight no longer fits the numbers,
+	// however similar the words.
+	llm.text = weekInsight
+	run()
+	for i := range repo.points {
+		repo.points[i].Stress = 7
+	}
+	if resp := run(); resp.InsightUnchanged {
+		t.Errorf("insight reused after the averages moved: %q", resp.LLMInsight)
+	}
+	if n := len(repo.saved); n != 5 {
+		t.Errorf("history rows = %d, want one per analysis (5)", n)
+	}
+}
+
+func TestInsightSimilarityFollowsWordOrder(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		min, max float64
+	}{
+		{"Стресс снизился.", "стресс  снизился", 1, 1},
+		{"стресс снизился", "снизился стресс", 0, 0},
+		{"стресс снизился, сон ровный", "стресс не снизился, сон ровный", 0.8, 0.8},
+		{"сон ровный", "тренировки помогают вечером", 0, 0},
+		{"", "", 1, 1},
+	}
+	for _, c := range cases {
+		if got := insightSimilarity(c.a, c.b); got < c.min || got > c.max {
+			t.Errorf("insightSimilarity(%q, %q) = %v, want in [%v, %v]", c.a, c.b, got, c.min, c.max)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+const (
+	defaultNoteKeywords = 10
+	maxNoteKeywords     = 50
+)
+
+// GetNoteKeywords возвращает limit самых частых слов из заметок за period (0 — defaultNoteKeywords,
+// не больше maxNoteKeywords) без стоп-слов и число непустых заметок. LLM не вызывает.
+// Пример: GetNoteKeywords(ctx, 42, "", dto.PeriodMonth, 3) -> [{дедлайн 6 5} {зал 4 4} {голова 3 3}], 18.
+func (a *Analyzer) GetNoteKeywords(ctx context.Context, userID int32, userTZ string, period dto.Period, limit int) ([]dto.NoteKeyword, int, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return nil, 0, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return nil, 0, errors.New("user id is required")
+	}
+	if limit <= 0 {
+		limit = defaultNoteKeywords
+	}
+	if limit > maxNoteKeywords {
+		limit = maxNoteKeywords
+	}
+
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	start, end := periodRange(period, time.Now().In(loc), a.dayStartHour(ctx, userID))
+	pts, err := a.repo.GetTrackPoints(ctx, userID, start.UTC(), end.UTC(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	notes := 0
+	for _, p := range pts {
+		if strings.TrimSpace(p.LLMText) != "" {
+			notes++
+		}
+	}
+	return analytics.NoteKeywords(pts, a.stopWords, limit), notes, nil
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// memLocker is an in-process Locker.
+type memLocker struct {
+	mu   sync.Mutex
+	held map[string]bool
+	ttls []time.Duration
+}
+
+func (l *memLocker) TryLock(_ context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.ttls = append(l.ttls, ttl)
+	if l.held[key] {
+		return nil, false, nil
+	}
+	l.held[key] = true
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.held, key)
+	}, true, nil
+}
+
+// gateLLM blocks its first call until release is closed and counts calls running at once.
+type gateLLM struct {
+	started  chan struct{}
+	release  chan struct{}
+	calls    atomic.Int32
+	running  atomic.Int32
+	overlap  atomic.Bool
+	deadline time.Duration
+}
+
+func (l *gateLLM) CallInsight(ctx context.Context, _ dto.AIPrompt) (string, error) {
+	if l.running.Add(1) > 1 {
+		l.overlap.Store(true)
+	}
+	defer l.running.Add(-1)
+	if dl, ok := ctx.Deadline(); ok {
+		l.deadline = time.Until(dl)
+	}
+	if l.calls.Add(1) == 1 {
+		close(l.started)
+		<-l.release
+	}
+	return "Энергия\nРовная.", nil
+}
+
+func TestAnalysisSingleFlightRerunsSkippedPeriod(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 14, nil)
+	llm := &gateLLM{started: make(chan struct{}), release: make(chan struct{})}
+	locker := &memLocker{held: map[string]bool{}}
+	a := NewAnalyzer(llm, repo, Config{Locker: locker, AutoPeriods: []dto.Period{dto.PeriodWeek}})
+	ctx := context.Background()
+
+	done := make(chan error, 1)
+	go func() { done <- a.runAnalysesForUser(ctx, testUserID, "", false) }()
+	<-llm.started
+
+	// A point tracked while the first run waits for the LLM: its run is skipped, not lost.
+	if err := a.runAnalysesForUser(ctx, testUserID, "", false); err != nil {
+		t.Fatalf("skipped run: %v", err)
+	}
+	if n := llm.calls.Load(); n != 1 {
+		t.Fatalf("%d LLM calls while the lock was held, want 1", n)
+	}
+	close(llm.release)
+	if err := <-done; err != nil {
+		t.Fatalf("first run: %v", err)
+	}
+
+	if n := llm.calls.Load(); n != 2 {
+		t.Errorf("%d LLM calls, want the skipped period run once more after unlock", n)
+	}
+	if llm.overlap.Load() {
+		t.Error("two runs of the period overlapped")
+	}
+	if len(locker.held) != 0 || len(a.dirty) != 0 {
+		t.Errorf("left behind: locks %v, dirty %v", locker.held, a.dirty)
+	}
+	for _, ttl := range locker.ttls {
+		if ttl <= analysisRunTimeout {
+			t.Errorf("lock TTL %s does not outlast the run timeout %s", ttl, analysisRunTimeout)
+		}
+	}
+	if llm.deadline <= 0 || llm.deadline > analysisRunTimeout {
+		t.Errorf("LLM call deadline = %s, want at most %s", llm.deadline, analysisRunTimeout)
+	}
+
+	// Without a concurrent run nothing is repeated.
+	if err := a.runAnalysesForUser(ctx, testUserID, "", false); err != nil || llm.calls.Load() != 3 {
+		t.Errorf("plain run: %d LLM calls (%v), want 3", llm.calls.Load(), err)
+	}
+}
+
+func TestRecoverLastAnalysesStartsOneRunPerUser(t *testing.T) {
+	// One unblocked recovery shows how many LLM calls a full run makes.
+	base := &fakeRepo{tz: "UTC"}
+	seedDays(base, 10, nil)
+	ref := &gateLLM{started: make(chan struct{}), release: make(chan struct{})}
+	close(ref.release)
+	a := NewAnalyzer(ref, base, Config{})
+	if _, _, err := a.GetLastAnalyses(context.Background(), testUserID); err != nil {
+		t.Fatalf("GetLastAnalyses: %v", err)
+	}
+	if err := a.Drain(context.Background()); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	perRun := ref.calls.Load()
+	if perRun == 0 {
+		t.Fatal("the recovery started no background run")
+	}
+
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 10, nil)
+	llm := &gateLLM{started: make(chan struct{}), release: make(chan struct{})}
+	a = NewAnalyzer(llm, repo, Config{})
+	ctx := context.Background()
+
+	// A client polls while the first background run is stuck in the LLM.
+	m, _, err := a.GetLastAnalyses(ctx, testUserID)
+	if err != nil || len(m) == 0 {
+		t.Fatalf("GetLastAnalyses = %d periods, %v; want the numeric fallback", len(m), err)
+	}
+	<-llm.started
+	for i := 0; i < 5; i++ {
+		if m, _, err := a.GetLastAnalyses(ctx, testUserID); err != nil || len(m) == 0 {
+			t.Fatalf("poll %d: %d periods, %v", i, len(m), err)
+		}
+	}
+	close(llm.release)
+	if err := a.Drain(ctx); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+
+	if got := llm.calls.Load(); got != perRun {
+		t.Errorf("LLM calls = %d after 6 polls, want %d (one run)", got, perRun)
+	}
+	if a.setRecovering(testUserID, false) {
+		t.Error("the in-flight mark outlived the run")
+	}
+}
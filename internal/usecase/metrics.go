@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+// GetMetricDefinitions отдаёт справочник метрик и сигналов выгорания на языке lang для подсказок в клиенте;
+// второй результат — язык, на котором он собран. Данные пользователя не нужны.
+// Пример: GetMetricDefinitions("de") -> [...], "ru".
+func (a *Analyzer) GetMetricDefinitions(lang string) ([]dto.MetricDefinition, string) {
+	return analytics.MetricDefinitions(lang)
+}
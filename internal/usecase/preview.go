@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+var ErrInvalidPreviewSettings = errors.New("invalid preview settings")
+
+// PreviewWithSettings пересчитывает энергию, продуктивность и риск выгорания за period дважды: с текущими
+// параметрами сервиса и с предложенными (energyOverrides — ключи ParseEnergyScoreParams, productivityWeights —
+// ключи ProductivityModel.Weights). Ничего не сохраняет и LLM не вызывает.
+// Пример: PreviewWithSettings(ctx, 42, "", dto.PeriodWeek, {"mood": 0.4}, nil) -> {Current: {ProductivityScore: 72}, Proposed: {ProductivityScore: 68}}.
+func (a *Analyzer) PreviewWithSettings(ctx context.Context, userID int32, userTZ string, period dto.Period, energyOverrides, productivityWeights map[string]float64) (dto.SettingsPreview, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.SettingsPreview{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.SettingsPreview{}, errors.New("user id is required")
+	}
+	ep, err := a.energyParams.With(energyOverrides)
+	if err != nil {
+		return dto.SettingsPreview{}, fmt.Errorf("%w: %v", ErrInvalidPreviewSettings, err)
+	}
+	weights, err := analytics.ProductivityWeights(productivityWeights)
+	if err != nil {
+		return dto.SettingsPreview{}, fmt.Errorf("%w: %v", ErrInvalidPreviewSettings, err)
+	}
+
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	start, end := periodRange(period, time.Now().In(loc), a.dayStartHour(ctx, userID))
+	pts, err := a.repo.GetTrackPoints(ctx, userID, start.UTC(), end.UTC(), nil)
+	if err != nil {
+		return dto.SettingsPreview{}, err
+	}
+	for i := range pts {
+		pts[i].TS = pts[i].TS.In(loc)
+	}
+	hidden := a.hiddenMetrics(ctx, userID)
+	return dto.SettingsPreview{
+		Period:    period,
+		NumPoints: len(pts),
+		Current:   a.previewScores(pts, a.energyParams, nil, hidden),
+		Proposed:  a.previewScores(pts, ep, weights, hidden),
+	}, nil
+}
+
+func (a *Analyzer) previewScores(pts []dto.TrackPoint, ep analytics.EnergyScoreParams, weights map[string]float64, hidden map[string]struct{}) dto.ScorePreview {
+	model := analytics.ComputeProductivityModelWeights(pts, ep, weights)
+	out := dto.ScorePreview{
+		AvgEnergyScore:    analytics.MeanEnergyScore(pts, ep),
+		ProductivityScore: model.Score,
+		BurnoutLevel:      "недостаточно данных",
+	}
+	if len(pts) >= minBurnoutPoints {
+		risk := analytics.ComputeBurnoutRisk(pts, model, analytics.DefaultBurnoutHorizonDays, hidden, ep)
+		if a.coverageSignal {
+			risk = analytics.ApplyCoverageSignal(risk, pts)
+		}
+		out.BurnoutScore, out.BurnoutLevel = risk.Score, risk.Level
+	}
+	return out
+}
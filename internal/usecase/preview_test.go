@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+func TestPreviewWithSettingsComparesProposedWithSaved(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 7, func(i int, p *dto.TrackPoint) {
+		p.Mood = float64(2 + i)
+		p.SleepHours = 5 + float64(i%3)
+	})
+	saved, err := analytics.DefaultEnergyScoreParams.With(map[string]float64{"sleep": 0.5})
+	if err != nil {
+		t.Fatalf("saved params: %v", err)
+	}
+	a := NewAnalyzer(nil, repo, Config{EnergyScore: saved})
+	ctx := context.Background()
+
+	// No overrides: the proposal is the saved settings, so both sides match.
+	same, err := a.PreviewWithSettings(ctx, testUserID, "", dto.PeriodWeek, nil, nil)
+	if err != nil {
+		t.Fatalf("PreviewWithSettings: %v", err)
+	}
+	if same.NumPoints != 7 || same.Current != same.Proposed {
+		t.Fatalf("preview without overrides = %+v, want 7 points and equal sides", same)
+	}
+
+	// Current reflects the service's saved params, not the package defaults.
+	def, err := NewAnalyzer(nil, repo, Config{}).PreviewWithSettings(ctx, testUserID, "", dto.PeriodWeek, nil, nil)
+	if err != nil {
+		t.Fatalf("PreviewWithSettings: %v", err)
+	}
+	if def.Current.AvgEnergyScore == same.Current.AvgEnergyScore {
+		t.Fatalf("saved sleep weight did not change the current energy score (%v)", same.Current.AvgEnergyScore)
+	}
+
+	changed, err := a.PreviewWithSettings(ctx, testUserID, "", dto.PeriodWeek,
+		map[string]float64{"mood": 0.6}, map[string]float64{"energy_mean": 0})
+	if err != nil {
+		t.Fatalf("PreviewWithSettings: %v", err)
+	}
+	if changed.Current != same.Current {
+		t.Errorf("overrides moved the current side: %+v, want %+v", changed.Current, same.Current)
+	}
+	if changed.Proposed.AvgEnergyScore == changed.Current.AvgEnergyScore {
+		t.Errorf("mood override left the energy score at %v", changed.Proposed.AvgEnergyScore)
+	}
+	if changed.Proposed.ProductivityScore == changed.Current.ProductivityScore {
+		t.Errorf("weight override left the productivity score at %v", changed.Proposed.ProductivityScore)
+	}
+
+	for _, bad := range []map[string]float64{{"unknown": 1}, {"energy_mean": -1}} {
+		if _, err := a.PreviewWithSettings(ctx, testUserID, "", dto.PeriodWeek, nil, bad); !errors.Is(err, ErrInvalidPreviewSettings) {
+			t.Errorf("weights %v: err = %v, want ErrInvalidPreviewSettings", bad, err)
+		}
+	}
+	if _, err := a.PreviewWithSettings(ctx, testUserID, "", dto.PeriodWeek, map[string]float64{"bogus": 1}, nil); !errors.Is(err, ErrInvalidPreviewSettings) {
+		t.Errorf("energy override: err = %v, want ErrInvalidPreviewSettings", err)
+	}
+
+	if len(repo.saved) != 0 || len(repo.last) != 0 {
+		t.Errorf("preview persisted %d analyses and %d last results", len(repo.saved), len(repo.last))
+	}
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import "nexus/internal/dto"
+
+// ProviderStatusReporter is implemented by LLM clients that track their providers' health.
+type ProviderStatusReporter interface {
+	ProviderStatus() []dto.ProviderStatus
+}
+
+// GetProviderStatus отдаёт состояние LLM-провайдеров в порядке обращения к ним: недавние успехи и ошибки,
+// последнюю ошибку и признак деградации. Без LLM или у клиента без учёта состояния — пустой список.
+// Пример: GetProviderStatus() -> [{Name: "api.deepseek.com", Successes: 40, Errors: 2}].
+func (a *Analyzer) GetProviderStatus() []dto.ProviderStatus {
+	r, ok := a.llm.(ProviderStatusReporter)
+	if !ok {
+		return nil
+	}
+	return r.ProviderStatus()
+}
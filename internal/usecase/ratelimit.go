@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"sync"
+	"time"
+)
+
+// userRateLimiter — простой скользящий лимит запросов на пользователя в пределах одного процесса.
+type userRateLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	hits   map[int32][]time.Time
+}
+
+func newUserRateLimiter(limit int, window time.Duration) *userRateLimiter {
+	return &userRateLimiter{limit: limit, window: window, hits: map[int32][]time.Time{}}
+}
+
+func (l *userRateLimiter) allow(userID int32) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	cut := now.Add(-l.window)
+	recent := l.hits[userID][:0]
+	for _, t := range l.hits[userID] {
+		if t.After(cut) {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= l.limit {
+		l.hits[userID] = recent
+		return false
+	}
+	l.hits[userID] = append(recent, now)
+	return true
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+const (
+	// defaultReminderMinute — 21:00, когда привычного времени отметок нет.
+	defaultReminderMinute = 21 * 60
+	// reminderMinLogs — меньше отметок за месяц не дают устойчивого времени.
+	reminderMinLogs = 5
+	// reminderMinConcentration — ниже этого отметки слишком разбросаны по суткам, чтобы говорить о привычке.
+	reminderMinConcentration = 0.5
+	// reminderRoundMinutes — к какому шагу округляется предложенное время.
+	reminderRoundMinutes = 15
+)
+
+// SuggestedReminderTime предлагает время ежедневного напоминания по тому, когда человек обычно
+// делает отметки за последний месяц (в его часовом поясе); без устойчивой привычки — 21:00.
+func (a *Analyzer) SuggestedReminderTime(ctx context.Context, userID int32, userTZ string) (dto.ReminderSuggestion, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.ReminderSuggestion{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.ReminderSuggestion{}, errors.New("user id is required")
+	}
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	start, end := periodRange(dto.PeriodMonth, time.Now().In(loc), a.dayStartHour(ctx, userID))
+	pts, err := a.repo.GetTrackPoints(ctx, userID, start.UTC(), end.UTC(), nil)
+	if err != nil {
+		return dto.ReminderSuggestion{}, err
+	}
+	for i := range pts {
+		pts[i].TS = pts[i].TS.In(loc)
+	}
+
+	return suggestReminder(pts), nil
+}
+
+// suggestReminder выбирает время напоминания по отметкам, уже переведённым в часовой пояс пользователя.
+func suggestReminder(pts []dto.TrackPoint) dto.ReminderSuggestion {
+	out := dto.ReminderSuggestion{Time: formatMinuteOfDay(defaultReminderMinute), NumLogs: len(pts)}
+	if len(pts) < reminderMinLogs {
+		return out
+	}
+	minute, concentration := analytics.TypicalLogTime(pts)
+	out.Confidence = concentration
+	if concentration < reminderMinConcentration {
+		return out
+	}
+	rounded := (minute + reminderRoundMinutes/2) / reminderRoundMinutes * reminderRoundMinutes
+	out.Time = formatMinuteOfDay(rounded % 1440)
+	out.Habitual = true
+	return out
+}
+
+func formatMinuteOfDay(m int) string {
+	return fmt.Sprintf("%02d:%02d", m/60, m%60)
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+const (
+	// maxAnalysisRetries — после стольких неудачных повторов день помечается как окончательно упавший.
+	maxAnalysisRetries = 5
+	// analysisRetryBaseDelay удваивается с каждой попыткой, но не больше analysisRetryMaxDelay.
+	analysisRetryBaseDelay = 5 * time.Minute
+	analysisRetryMaxDelay  = 6 * time.Hour
+	// analysisRetryBatch — сколько дней разбирается за один проход очереди.
+	analysisRetryBatch = 50
+)
+
+var (
+	analysisRetriesSucceeded = expvar.NewInt("analysis_retries_succeeded_total")
+	analysisRetriesDead      = expvar.NewInt("analysis_retries_dead_total")
+)
+
+// RetryFailedAnalyses повторяет разборы дней из очереди, у которых подошло время попытки. Удачный повтор
+// убирает день из очереди и ставит статус "ready"; неудачный откладывает следующую попытку с экспоненциальной
+// задержкой, а после maxAnalysisRetries помечает день как окончательно упавший. Возвращает число удачных повторов.
+func (a *Analyzer) RetryFailedAnalyses(ctx context.Context) (int, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return 0, errors.New("repository not configured")
+	}
+	due, err := a.repo.ListDueFailedAnalyses(ctx, time.Now(), analysisRetryBatch)
+	if err != nil {
+		return 0, err
+	}
+	retried := 0
+	for _, f := range due {
+		if err := a.runAnalysesForUser(ctx, f.UserID, f.UserTZ, true); err != nil {
+			attempts := f.Attempts + 1
+			dead := attempts >= maxAnalysisRetries
+			errText := err.Error()
+			if dead {
+				analysisRetriesDead.Add(1)
+				errText = "retries exhausted: " + errText
+			}
+			_ = a.repo.RecordFailedAnalysisRetry(ctx, f.UserID, f.DayStart, attempts, time.Now().Add(analysisRetryDelay(attempts)), errText, dead)
+			_ = a.repo.SetAnalysisStatusForDay(ctx, f.UserID, f.DayStart, f.DayEnd, "failed", errText)
+			continue
+		}
+		_ = a.repo.SetAnalysisStatusForDay(ctx, f.UserID, f.DayStart, f.DayEnd, "ready", "")
+		_ = a.repo.DeleteFailedAnalysis(ctx, f.UserID, f.DayStart)
+		analysisRetriesSucceeded.Add(1)
+		retried++
+	}
+	return retried, nil
+}
+
+// ListDeadAnalyses возвращает дни, для которых повторы исчерпаны, — их нужно разбирать вручную.
+func (a *Analyzer) ListDeadAnalyses(ctx context.Context, limit int) ([]dto.FailedAnalysis, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return nil, errors.New("repository not configured")
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return a.repo.ListDeadFailedAnalyses(ctx, limit)
+}
+
+// analysisRetryDelay — задержка перед попыткой номер attempts+1.
+func analysisRetryDelay(attempts int) time.Duration {
+	d := analysisRetryBaseDelay
+	for i := 0; i < attempts && d < analysisRetryMaxDelay; i++ {
+		d *= 2
+	}
+	if d > analysisRetryMaxDelay {
+		d = analysisRetryMaxDelay
+	}
+	return d
+}
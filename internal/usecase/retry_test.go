@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// stubLLM answers every insight call with text, or fails with err when it is set.
+type stubLLM struct {
+	text string
+	err  error
+}
+
+func (l *stubLLM) CallInsight(context.Context, dto.AIPrompt) (string, error) {
+	if l.err != nil {
+		return "", l.err
+	}
+	return l.text, nil
+}
+
+// retryRepo adds the failed-analysis queue and day statuses to fakeRepo.
+type retryRepo struct {
+	*fakeRepo
+	queue    map[time.Time]dto.FailedAnalysis
+	statuses map[time.Time]string
+}
+
+func (r *retryRepo) ListDueFailedAnalyses(_ context.Context, now time.Time, limit int) ([]dto.FailedAnalysis, error) {
+	var out []dto.FailedAnalysis
+	for _, f := range r.queue {
+		if !f.Dead && !f.NextAttemptAt.After(now) && len(out) < limit {
+			out = append(out, f)
+		}
+	}
+	return out, nil
+}
+
+func (r *retryRepo) RecordFailedAnalysisRetry(_ context.Context, _ int32, dayStart time.Time, attempts int, next time.Time, errText string, dead bool) error {
+	f := r.queue[dayStart]
+	f.Attempts, f.NextAttemptAt, f.LastError, f.Dead = attempts, next, errText, dead
+	r.queue[dayStart] = f
+	return nil
+}
+
+func (r *retryRepo) DeleteFailedAnalysis(_ context.Context, _ int32, dayStart time.Time) error {
+	delete(r.queue, dayStart)
+	return nil
+}
+
+func (r *retryRepo) SetAnalysisStatusForDay(_ context.Context, _ int32, from, _ time.Time, status, _ string) error {
+	r.statuses[from] = status
+	return nil
+}
+
+func newRetryRepo(attempts int) (*retryRepo, time.Time) {
+	repo := &retryRepo{fakeRepo: &fakeRepo{tz: "UTC"}, queue: map[time.Time]dto.FailedAnalysis{}, statuses: map[time.Time]string{}}
+	seedDays(repo.fakeRepo, 10, nil)
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+	repo.queue[day] = dto.FailedAnalysis{
+		UserID: testUserID, UserTZ: "UTC", DayStart: day, DayEnd: day.AddDate(0, 0, 1),
+		Attempts: attempts, NextAttemptAt: time.Now().Add(-time.Minute),
+	}
+	return repo, day
+}
+
+func TestRetryFailedAnalysesDrainsOnSuccess(t *testing.T) {
+	repo, day := newRetryRepo(2)
+	a := NewAnalyzer(&stubLLM{text: "Энергия\nВсё ровно."}, repo, Config{AutoPeriods: []dto.Period{dto.PeriodWeek}})
+
+	n, err := a.RetryFailedAnalyses(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("RetryFailedAnalyses = %d, %v; want 1 retried", n, err)
+	}
+	if len(repo.queue) != 0 || repo.statuses[day] != "ready" {
+		t.Errorf("queue=%v status=%q, want an empty queue and a ready day", repo.queue, repo.statuses[day])
+	}
+}
+
+func TestRetryFailedAnalysesBacksOff(t *testing.T) {
+	repo, day := newRetryRepo(1)
+	a := NewAnalyzer(&stubLLM{err: errors.New("provider down")}, repo, Config{AutoPeriods: []dto.Period{dto.PeriodWeek}})
+
+	before := time.Now()
+	if n, err := a.RetryFailedAnalyses(context.Background()); err != nil || n != 0 {
+		t.Fatalf("RetryFailedAnalyses = %d, %v; want 0 retried", n, err)
+	}
+	f := repo.queue[day]
+	if f.Attempts != 2 || f.Dead || repo.statuses[day] != "failed" {
+		t.Fatalf("entry = %+v, status %q; want a live entry with 2 attempts", f, repo.statuses[day])
+	}
+	if wait := f.NextAttemptAt.Sub(before); wait < analysisRetryDelay(2)-time.Second || wait > analysisRetryDelay(2)+time.Second {
+		t.Errorf("next attempt in %s, want %s", wait, analysisRetryDelay(2))
+	}
+
+	// The last allowed attempt fails for good and leaves the queue's due list.
+	f.Attempts, f.NextAttemptAt = maxAnalysisRetries-1, time.Now().Add(-time.Minute)
+	repo.queue[day] = f
+	_, _ = a.RetryFailedAnalyses(context.Background())
+	if f := repo.queue[day]; !f.Dead || f.Attempts != maxAnalysisRetries {
+		t.Errorf("entry after the last retry = %+v, want dead", f)
+	}
+	if due, _ := repo.ListDueFailedAnalyses(context.Background(), time.Now().Add(24*time.Hour), 10); len(due) != 0 {
+		t.Errorf("a dead entry is still due: %+v", due)
+	}
+}
+
+func TestAnalysisRetryDelay(t *testing.T) {
+	want := []time.Duration{5 * time.Minute, 10 * time.Minute, 20 * time.Minute, 40 * time.Minute}
+	for attempts, w := range want {
+		if got := analysisRetryDelay(attempts); got != w {
+			t.Errorf("delay after %d attempts = %s, want %s", attempts, got, w)
+		}
+	}
+	if got := analysisRetryDelay(20); got != analysisRetryMaxDelay {
+		t.Errorf("delay after 20 attempts = %s, want the %s cap", got, analysisRetryMaxDelay)
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+// tomorrowScheduleTTL держит расписание до следующего ночного пересчёта с запасом на сдвиг часовых поясов.
+const tomorrowScheduleTTL = 36 * time.Hour
+
+// GetTomorrowSchedule отдаёт расписание на следующий логический день из кэша. Если в кэше нет записи
+// или она посчитана для другого дня, расписание считается сразу и кладётся в кэш.
+func (a *Analyzer) GetTomorrowSchedule(ctx context.Context, userID int32, userTZ string) (dto.TomorrowSchedule, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.TomorrowSchedule{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.TomorrowSchedule{}, errors.New("user id is required")
+	}
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	date := a.tomorrowDate(ctx, userID, userTZ)
+	if cached, ok, err := a.repo.GetTomorrowSchedule(ctx, userID); err == nil && ok && cached.Date == date {
+		return *cached, nil
+	}
+	return a.PrecomputeTomorrowSchedule(ctx, userID, userTZ)
+}
+
+// PrecomputeTomorrowSchedule считает по отметкам за последний месяц расписание и время напоминания
+// на следующий логический день и сохраняет их в кэш. Рабочие часы — 9–18, как в фоновых разборах.
+func (a *Analyzer) PrecomputeTomorrowSchedule(ctx context.Context, userID int32, userTZ string) (dto.TomorrowSchedule, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.TomorrowSchedule{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.TomorrowSchedule{}, errors.New("user id is required")
+	}
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	start, end := periodRange(dto.PeriodMonth, time.Now().In(loc), a.dayStartHour(ctx, userID))
+	pts, err := a.repo.GetTrackPoints(ctx, userID, start.UTC(), end.UTC(), nil)
+	if err != nil {
+		return dto.TomorrowSchedule{}, err
+	}
+	for i := range pts {
+		pts[i].TS = pts[i].TS.In(loc)
+	}
+
+	out := dto.TomorrowSchedule{
+		Date:       a.tomorrowDate(ctx, userID, userTZ),
+		Schedule:   analytics.ComputeOptimalSchedule(pts, dto.Constraints{WorkStartHour: 9, WorkEndHour: 18}, a.energyParams),
+		Reminder:   suggestReminder(pts),
+		NumPoints:  len(pts),
+		ComputedAt: time.Now().UTC(),
+	}
+	_ = a.repo.CacheTomorrowSchedule(ctx, userID, out, tomorrowScheduleTTL)
+	return out, nil
+}
+
+// tomorrowDate — дата (YYYY-MM-DD) логического дня, следующего за текущим, с учётом начала суток пользователя.
+func (a *Analyzer) tomorrowDate(ctx context.Context, userID int32, userTZ string) string {
+	loc := a.ResolveLocation(userTZ)
+	start, _ := dayBounds(time.Now().In(loc), a.dayStartHour(ctx, userID))
+	return start.AddDate(0, 0, 1).Format("2006-01-02")
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+const (
+	defaultAggregateRange = 30 * 24 * time.Hour
+	maxAggregateRange     = 366 * 24 * time.Hour
+	// minAggregateUsers — меньше этого числа разных пользователей в бакете считаем небезопасным для выдачи.
+	minAggregateUsers = 5
+)
+
+var ErrInvalidTimeRange = errors.New("time range must be non-empty and at most one year")
+
+// GetAggregateStats возвращает обезличенную статистику по всем пользователям за ограниченный период.
+// Нулевой to — сейчас, нулевой from — 30 дней до to; окно длиннее года отклоняется.
+func (a *Analyzer) GetAggregateStats(ctx context.Context, from, to time.Time) (dto.AggregateStats, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.AggregateStats{}, errors.New("repository not configured")
+	}
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultAggregateRange)
+	}
+	if !to.After(from) || to.Sub(from) > maxAggregateRange {
+		return dto.AggregateStats{}, ErrInvalidTimeRange
+	}
+	return a.repo.GetAggregateStats(ctx, from.UTC(), to.UTC(), minAggregateUsers)
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"nexus/internal/dto"
+)
+
+// Источник текста инсайта в ответе: модель или детерминированная сводка без ИИ.
+const (
+	insightSourceLLM        = "llm"
+	insightSourceSummary    = "summary"
+	insightSourceOnboarding = "onboarding"
+)
+
+var onboardingFieldsRU = map[string]string{
+	"sleep_hours": "часы сна", "mood": "настроение", "energy": "энергия", "stress": "стресс",
+	"productive": "продуктивность", "concentration": "концентрация", "sleep_quality": "качество сна",
+	"activity": "активность",
+}
+
+var summaryWeekdaysRU = map[string]string{
+	"Mon": "понедельник", "Tue": "вторник", "Wed": "среда", "Thu": "четверг",
+	"Fri": "пятница", "Sat": "суббота", "Sun": "воскресенье",
+}
+
+// numericSummary собирает текст инсайта из уже посчитанных блоков, когда LLM отключён: средние
+// с диапазонами, продуктивность, риск выгорания с главной причиной и лучший день по энергии.
+// Скрытые пользователем метрики не упоминаются.
+// Пример: numericSummary(prompt) -> "Сводка за неделю без ИИ, дней с отметками: 6.\nСон: в среднем 7.1 ч (5.5–8.0). ...".
+func numericSummary(p dto.AIPrompt) string {
+	hidden := make(map[string]bool, len(p.HiddenMetrics))
+	for _, m := range p.HiddenMetrics {
+		hidden[m] = true
+	}
+	lines := []string{fmt.Sprintf("Сводка %s без ИИ, дней с отметками: %d.", summaryPeriodRU(p.Period), p.NumObservedDays)}
+
+	sleep := fmt.Sprintf("Сон: в среднем %.1f ч (%.1f–%.1f)", p.AvgSleepHours, p.MinSleepHours, p.MaxSleepHours)
+	if p.AvgSleepStart != "" && p.AvgSleepEnd != "" {
+		sleep += fmt.Sprintf(", обычно %s–%s", p.AvgSleepStart, p.AvgSleepEnd)
+	}
+	lines = append(lines, sleep+".")
+
+	ratings := []string{
+		fmt.Sprintf("настроение %.1f", p.AvgMood),
+		fmt.Sprintf("энергия %.1f (%.0f–%.0f)", p.AvgEnergy, p.MinEnergy, p.MaxEnergy),
+	}
+	if !hidden["stress"] {
+		ratings = append(ratings, fmt.Sprintf("стресс %.1f", p.AvgStress))
+	}
+	if !hidden["concentration"] && p.AvgConcentration > 0 {
+		ratings = append(ratings, fmt.Sprintf("концентрация %.1f", p.AvgConcentration))
+	}
+	lines = append(lines, "Средние оценки из 10: "+strings.Join(ratings, ", ")+".")
+	lines = append(lines, fmt.Sprintf("Продуктивность: %.0f из 100.", p.ProductivityScore))
+
+	burnout := fmt.Sprintf("Риск выгорания: %s (%.0f из 100)", p.BurnoutLevel, p.BurnoutScore)
+	if len(p.BurnoutReasons) > 0 {
+		burnout += " — " + strings.TrimSuffix(p.BurnoutReasons[0], ".")
+	}
+	lines = append(lines, burnout+".")
+
+	if day, ok := bestWeekday(p.EnergyByWeekday); ok {
+		lines = append(lines, fmt.Sprintf("Больше всего энергии: %s.", day))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// bestWeekday называет день недели с самым высоким energy score; нужно хотя бы два дня для сравнения.
+func bestWeekday(byDay map[string]float64) (string, bool) {
+	if len(byDay) < 2 {
+		return "", false
+	}
+	days := make([]string, 0, len(byDay))
+	for d := range byDay {
+		days = append(days, d)
+	}
+	sort.Strings(days)
+	best := days[0]
+	for _, d := range days[1:] {
+		if byDay[d] > byDay[best] {
+			best = d
+		}
+	}
+	if name, ok := summaryWeekdaysRU[best]; ok {
+		return name, true
+	}
+	return best, true
+}
+
+func summaryPeriodRU(p dto.Period) string {
+	switch p {
+	case dto.PeriodDay:
+		return "за день"
+	case dto.PeriodWeek:
+		return "за неделю"
+	case dto.PeriodMonth:
+		return "за месяц"
+	default:
+		return "за всё время"
+	}
+}
+
+// onboardingInsight — шаблонный разбор для первых отметок (меньше minBurnoutPoints за всё время, total): что
+// уже видно, каких полей не хватает по DataSufficiency.Coverage и сколько отметок осталось до прогноза
+// выгорания. LLM не вызывается. Пример: 1 отметка без стресса -> "...заполняй ещё: стресс, продуктивность. ...осталось 4".
+func onboardingInsight(ds dto.DataSufficiency, p dto.AIPrompt, total int) string {
+	first := fmt.Sprintf("Хорошее начало: отметок уже %d.", total)
+	if total == ds.NumPoints {
+		// The window holds the whole history, so its day count is the user's too.
+		first = fmt.Sprintf("Хорошее начало: отметок уже %d, дней с отметками: %d.", total, ds.NumObservedDays)
+	}
+	lines := []string{first}
+
+	var seen []string
+	if p.AvgSleepHours > 0 {
+		seen = append(seen, fmt.Sprintf("сон около %.1f ч", p.AvgSleepHours))
+	}
+	if p.AvgMood > 0 {
+		seen = append(seen, fmt.Sprintf("настроение %.1f из 10", p.AvgMood))
+	}
+	if p.AvgEnergy > 0 {
+		seen = append(seen, fmt.Sprintf("энергия %.1f из 10", p.AvgEnergy))
+	}
+	if len(seen) > 0 {
+		lines = append(lines, "Пока видно: "+strings.Join(seen, ", ")+".")
+	}
+
+	var missing []string
+	listed := map[string]bool{}
+	for _, c := range ds.Coverage {
+		for _, f := range c.MissingFields {
+			if listed[f] {
+				continue
+			}
+			listed[f] = true
+			if name, ok := onboardingFieldsRU[f]; ok {
+				missing = append(missing, name)
+			} else {
+				missing = append(missing, f)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		lines = append(lines, "Чтобы разбор был полнее, заполняй ещё: "+strings.Join(missing, ", ")+".")
+	}
+
+	if left := minBurnoutPoints - total; left > 0 {
+		lines = append(lines, fmt.Sprintf("Прогноз выгорания появится после %d отметок — осталось %d. Отмечай день каждый вечер, так картина сложится быстрее.", minBurnoutPoints, left))
+	}
+	return strings.Join(lines, "\n")
+}
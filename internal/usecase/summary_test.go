@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+func TestDisabledLLMGivesNumericSummary(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC", hidden: []string{"stress"}}
+	seedDays(repo, 10, func(i int, p *dto.TrackPoint) {
+		p.SleepHours = 6 + float64(i%3)
+		p.Energy = float64(4 + i%5)
+	})
+	a := NewAnalyzer(nil, repo, Config{})
+
+	resp, err := a.Analyze(context.Background(), dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodWeek})
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if resp.InsightSource != insightSourceSummary {
+		t.Errorf("insight source = %q, want %q", resp.InsightSource, insightSourceSummary)
+	}
+	text := resp.LLMInsight
+	lines := strings.Split(text, "\n")
+	if !strings.HasPrefix(text, "Сводка за неделю без ИИ") || len(lines) < 5 {
+		t.Fatalf("summary = %q, want a multi-line summary for the week", text)
+	}
+	for _, want := range []string{"Сон: в среднем", "Средние оценки из 10:", "Продуктивность:", "Риск выгорания:"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("summary lacks %q:\n%s", want, text)
+		}
+	}
+	for _, ln := range lines {
+		if !strings.HasSuffix(ln, ".") {
+			t.Errorf("line %q does not end a sentence", ln)
+		}
+	}
+	if strings.Contains(text, "LLM") || strings.Contains(text, "стресс") {
+		t.Errorf("summary mentions the LLM or a hidden metric:\n%s", text)
+	}
+	if got := firstSentences(text, 1); !strings.HasPrefix(got, "Сводка") {
+		t.Errorf("weekly card summary = %q, want the first summary sentence", got)
+	}
+}
+
+func TestOnboardingFollowsLifetimePoints(t *testing.T) {
+	ctx := context.Background()
+	week := dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodWeek}
+	// lastDay keeps only the newest point inside the week and moves the rest back by a month.
+	lastDay := func(days int) func(int, *dto.TrackPoint) {
+		return func(i int, p *dto.TrackPoint) {
+			if i < days-1 {
+				p.TS = p.TS.AddDate(0, -1, 0)
+			}
+		}
+	}
+
+	cases := []struct {
+		name       string
+		days       int
+		fill       func(int, *dto.TrackPoint)
+		weekPoints int
+		wantSource string
+		wantText   []string
+	}{
+		{"first entry", 1, nil, 1, insightSourceOnboarding, []string{"отметок уже 1, дней с отметками: 1", "осталось 4"}},
+		{"four entries", 4, nil, 4, insightSourceOnboarding, []string{"отметок уже 4, дней с отметками: 4", "осталось 1"}},
+		// Three entries in all, one of them this week: still a newcomer, counted by the whole history.
+		{"newcomer with a quiet week", 3, lastDay(3), 1, insightSourceOnboarding, []string{"отметок уже 3.", "осталось 2"}},
+		// A long-time user with a single entry this week gets a real insight.
+		{"long-time user with a quiet week", 30, lastDay(30), 1, insightSourceLLM, nil},
+	}
+	for _, c := range cases {
+		repo := &fakeRepo{tz: "UTC"}
+		seedDays(repo, c.days, c.fill)
+		llm := &countingLLM{}
+		resp, err := NewAnalyzer(llm, repo, Config{}).Analyze(ctx, week)
+		if err != nil {
+			t.Fatalf("%s: Analyze: %v", c.name, err)
+		}
+		if resp.DataSufficiency.NumPoints != c.weekPoints {
+			t.Fatalf("%s: %d points in the week, want %d", c.name, resp.DataSufficiency.NumPoints, c.weekPoints)
+		}
+		if resp.InsightSource != c.wantSource {
+			t.Errorf("%s: insight source = %q, want %q", c.name, resp.InsightSource, c.wantSource)
+		}
+		if wantLLM := c.wantSource == insightSourceLLM; (llm.calls > 0) != wantLLM {
+			t.Errorf("%s: LLM calls = %d", c.name, llm.calls)
+		}
+		for _, want := range c.wantText {
+			if !strings.Contains(resp.LLMInsight, want) {
+				t.Errorf("%s: insight %q does not mention %q", c.name, resp.LLMInsight, want)
+			}
+		}
+	}
+}
+
+func TestRecoveredAnalysesSkipOnboardingForLongTimeUsers(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 30, nil)
+	// Nothing this week but a single entry an hour ago.
+	for i := range repo.points[:len(repo.points)-1] {
+		repo.points[i].TS = repo.points[i].TS.AddDate(0, -1, 0)
+	}
+	a := NewAnalyzer(nil, repo, Config{AutoPeriods: []dto.Period{dto.PeriodWeek}})
+	ctx := context.Background()
+
+	last, _, err := a.GetLastAnalyses(ctx, testUserID)
+	if err != nil {
+		t.Fatalf("GetLastAnalyses: %v", err)
+	}
+	if got := last["week"].InsightSource; got != insightSourceSummary {
+		t.Errorf("recovered week insight source = %q, want %q", got, insightSourceSummary)
+	}
+	drainCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := a.Drain(drainCtx); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+}
+
+// responseCacheRepo keeps the response cache in a map and counts the hits.
+type responseCacheRepo struct {
+	*fakeRepo
+	cache map[string]dto.AnalyzeResponse
+	hits  int
+}
+
+func (r *responseCacheRepo) GetCachedResponse(_ context.Context, key string) (*dto.AnalyzeResponse, bool, error) {
+	resp, ok := r.cache[key]
+	if !ok {
+		return nil, false, nil
+	}
+	r.hits++
+	resp = resp.Clone()
+	return &resp, true, nil
+}
+
+func (r *responseCacheRepo) CacheResponse(_ context.Context, key string, resp dto.AnalyzeResponse, _ time.Duration) error {
+	r.cache[key] = resp.Clone()
+	return nil
+}
+
+func TestCachedResponseKeepsTheLocalInsight(t *testing.T) {
+	ctx := context.Background()
+	req := dto.AnalyzeRequest{UserID: testUserID, Period: dto.PeriodWeek}
+	for _, c := range []struct {
+		days   int
+		source string
+	}{{10, insightSourceSummary}, {2, insightSourceOnboarding}} {
+		repo := &responseCacheRepo{fakeRepo: &fakeRepo{tz: "UTC"}, cache: map[string]dto.AnalyzeResponse{}}
+		seedDays(repo.fakeRepo, c.days, nil)
+		a := NewAnalyzer(nil, repo, Config{})
+
+		first, err := a.Analyze(ctx, req)
+		if err != nil {
+			t.Fatalf("%s: Analyze: %v", c.source, err)
+		}
+		second, err := a.Analyze(ctx, req)
+		if err != nil {
+			t.Fatalf("%s: Analyze again: %v", c.source, err)
+		}
+		if repo.hits != 1 {
+			t.Fatalf("%s: cache hits = %d, want the second call served from the cache", c.source, repo.hits)
+		}
+		if first.LLMInsight == "" || second.LLMInsight != first.LLMInsight || second.InsightSource != c.source {
+			t.Errorf("%s: cached insight = %q (%s), want %q", c.source, second.LLMInsight, second.InsightSource, first.LLMInsight)
+		}
+	}
+
+	// An entry cached while the LLM was on has no text: with the LLM off it is recomputed, not served empty.
+	repo := &responseCacheRepo{fakeRepo: &fakeRepo{tz: "UTC"}, cache: map[string]dto.AnalyzeResponse{}}
+	seedDays(repo.fakeRepo, 10, nil)
+	if _, err := NewAnalyzer(&countingLLM{}, repo, Config{}).Analyze(ctx, req); err != nil {
+		t.Fatalf("Analyze with the LLM: %v", err)
+	}
+	resp, err := NewAnalyzer(nil, repo, Config{}).Analyze(ctx, req)
+	if err != nil {
+		t.Fatalf("Analyze without the LLM: %v", err)
+	}
+	if resp.LLMInsight == "" || resp.InsightSource != insightSourceSummary {
+		t.Errorf("after an LLM-era cache entry: insight %q (%s), want a summary", resp.LLMInsight, resp.InsightSource)
+	}
+}
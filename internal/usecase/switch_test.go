@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"nexus/internal/dto"
+)
+
+// switchRepo stores Track's points in memory and can fail the analyses switch read.
+type switchRepo struct {
+	*fakeRepo
+	readErr  error
+	statusMu sync.Mutex
+	statuses []string
+}
+
+func (r *switchRepo) GetAnalysesEnabled(ctx context.Context, userID int32) (bool, error) {
+	if r.readErr != nil {
+		return false, r.readErr
+	}
+	return r.fakeRepo.GetAnalysesEnabled(ctx, userID)
+}
+func (r *switchRepo) UpsertTrackPointForDay(_ context.Context, _ int32, p dto.TrackPoint, _, _ time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.points = append(r.points, p)
+	return false, nil
+}
+func (r *switchRepo) UpsertUserSettings(context.Context, int32, string) error { return nil }
+func (r *switchRepo) SetAnalysisStatusForDay(_ context.Context, _ int32, _, _ time.Time, status, _ string) error {
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	r.statuses = append(r.statuses, status)
+	return nil
+}
+
+// HasPendingAnalysis reports whether the last status set is still pending.
+func (r *switchRepo) HasPendingAnalysis(context.Context, int32, time.Time) (bool, error) {
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	return len(r.statuses) > 0 && r.statuses[len(r.statuses)-1] == "pending", nil
+}
+func (r *switchRepo) DeleteFailedAnalysis(context.Context, int32, time.Time) error { return nil }
+func (r *switchRepo) CacheTomorrowSchedule(context.Context, int32, dto.TomorrowSchedule, time.Duration) error {
+	return nil
+}
+
+func TestAnalysesSwitchGatesTrack(t *testing.T) {
+	cases := []struct {
+		name     string
+		disabled bool
+		readErr  error
+		wantRun  bool
+	}{
+		{"enabled", false, nil, true},
+		{"disabled", true, nil, false},
+		// A failed read keeps the default instead of silently switching the user off.
+		{"read error", true, errors.New("connection reset"), true},
+	}
+	for _, c := range cases {
+		repo := &switchRepo{fakeRepo: &fakeRepo{tz: "UTC", disabled: c.disabled}, readErr: c.readErr}
+		seedDays(repo.fakeRepo, 6, nil)
+		llm := &gateLLM{started: make(chan struct{}), release: make(chan struct{})}
+		close(llm.release)
+		a := NewAnalyzer(llm, repo, Config{})
+
+		point := dto.TrackPoint{TS: time.Now().UTC().Add(-time.Minute), SleepHours: 7, Mood: 6, Energy: 6, LLMText: "личная заметка"}
+		if _, err := a.Track(context.Background(), dto.TrackRequest{UserID: testUserID, UserTZ: "UTC", Points: []dto.TrackPoint{point}}); err != nil {
+			t.Fatalf("%s: Track: %v", c.name, err)
+		}
+		if err := a.Drain(context.Background()); err != nil {
+			t.Fatalf("%s: Drain: %v", c.name, err)
+		}
+
+		if ran := llm.calls.Load() > 0; ran != c.wantRun {
+			t.Errorf("%s: LLM called = %v, want %v", c.name, ran, c.wantRun)
+		}
+		if ran := len(repo.statuses) > 0; ran != c.wantRun {
+			t.Errorf("%s: analysis statuses = %v, want a run: %v", c.name, repo.statuses, c.wantRun)
+		}
+		if len(repo.saved) > 0 != c.wantRun {
+			t.Errorf("%s: %d analyses saved, want a run: %v", c.name, len(repo.saved), c.wantRun)
+		}
+	}
+}
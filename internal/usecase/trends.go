@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+const (
+	// maxTrendsRange ограничивает окно трендов годом — это же ограничивает число дневных точек в ряду.
+	maxTrendsRange = 366 * 24 * time.Hour
+	// maxTrendsWindow — самое широкое окно сглаживания в днях.
+	maxTrendsWindow = 30
+)
+
+var ErrInvalidSmoothingWindow = errors.New("smoothing window must be between 0 and 30 days")
+
+// GetTrends возвращает дневные ряды сна, настроения, энергии, стресса, продуктивности и energy score.
+// Явный диапазон from/to важнее period; без диапазона окно берётся по period, а "all" обрезается до года.
+// window — ширина скользящего среднего в днях (0 или 1 — без сглаживания).
+func (a *Analyzer) GetTrends(ctx context.Context, userID int32, userTZ string, period dto.Period, from, to time.Time, window int) (dto.Trends, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.Trends{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.Trends{}, errors.New("user id is required")
+	}
+	if window < 0 || window > maxTrendsWindow {
+		return dto.Trends{}, ErrInvalidSmoothingWindow
+	}
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	dayStart := a.dayStartHour(ctx, userID)
+
+	if from.IsZero() && to.IsZero() {
+		from, to = periodRange(period, time.Now().In(loc), dayStart)
+		if from.IsZero() || to.Sub(from) > maxTrendsRange {
+			from = to.Add(-maxTrendsRange)
+		}
+	} else {
+		if to.IsZero() {
+			to = time.Now()
+		}
+		if from.IsZero() {
+			from = to.Add(-maxTrendsRange)
+		}
+		if !to.After(from) || to.Sub(from) > maxTrendsRange {
+			return dto.Trends{}, ErrInvalidTimeRange
+		}
+	}
+
+	pts, err := a.repo.GetTrackPoints(ctx, userID, from.UTC(), to.UTC(), nil)
+	if err != nil {
+		return dto.Trends{}, err
+	}
+	for i := range pts {
+		pts[i].TS = pts[i].TS.In(loc)
+	}
+	daily := analytics.CollapseToDaily(pts, dayStart)
+
+	out := dto.Trends{
+		From:   from.UTC(),
+		To:     to.UTC(),
+		Window: window,
+		Days:   make([]string, 0, len(daily)),
+		Series: analytics.ComputeTrendSeries(daily, window, a.energyParams),
+	}
+	for _, p := range daily {
+		out.Days = append(out.Days, p.TS.Format("2006-01-02"))
+	}
+	return out, nil
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"nexus/internal/domain/analytics"
 	"nexus/internal/dto"
+	"sync"
 	"time"
 )
 
@@ -10,36 +12,186 @@ type LLMClient interface {
 	CallInsight(ctx context.Context, p dto.AIPrompt) (string, error)
 }
 
+// Locker hands out non-blocking exclusive locks shared by every instance of the service.
+// TryLock returns ok = false when key is already held; unlock must be called once ok is true.
+type Locker interface {
+	TryLock(ctx context.Context, key string, ttl time.Duration) (unlock func(), ok bool, err error)
+}
+
+// BurnoutNotifier delivers a burnout alert to the user's endpoint.
+type BurnoutNotifier interface {
+	NotifyBurnout(ctx context.Context, url string, alert dto.BurnoutAlert) error
+}
+
 type AnalysisRepository interface {
 	GetCachedResponse(ctx context.Context, key string) (*dto.AnalyzeResponse, bool, error)
 	CacheResponse(ctx context.Context, key string, resp dto.AnalyzeResponse, ttl time.Duration) error
+	GetSharedInsight(ctx context.Context, hash string) (string, bool, error)
+	CacheSharedInsight(ctx context.Context, hash, text string, ttl time.Duration) error
+	GetUserInsight(ctx context.Context, userID int32, hash string) (string, bool, error)
+	CacheUserInsight(ctx context.Context, userID int32, hash, text string, ttl time.Duration) error
+	GetTomorrowSchedule(ctx context.Context, userID int32) (*dto.TomorrowSchedule, bool, error)
+	CacheTomorrowSchedule(ctx context.Context, userID int32, s dto.TomorrowSchedule, ttl time.Duration) error
 	SaveAnalysis(ctx context.Context, key string, req dto.AnalyzeRequest, resp dto.AnalyzeResponse) error
 	SaveTrackPoints(ctx context.Context, userID int32, pts []dto.TrackPoint) (int, error)
-	GetTrackPoints(ctx context.Context, userID int32, from, to time.Time) ([]dto.TrackPoint, error)
+	GetTrackPoints(ctx context.Context, userID int32, from, to time.Time, tags []string) ([]dto.TrackPoint, error)
+	GetTrackDays(ctx context.Context, userID int32, from, to time.Time, tz string, dayStartHour int) ([]string, error)
+	RecordFriendActivity(ctx context.Context, userID int32, kind, value, dedupeKey string) error
+	ListFriendActivity(ctx context.Context, userID int32, since time.Time, limit int) ([]dto.FriendActivity, error)
+	GetLastBurnoutLevel(ctx context.Context, userID int32, period string) (string, error)
+	GetLastAnalysis(ctx context.Context, userID int32, period string) (dto.AnalyzeResponse, bool, error)
+	GetGoals(ctx context.Context, userID int32) (map[string]float64, error)
+	UpdateGoals(ctx context.Context, userID int32, goals map[string]float64) error
+	GetBurnoutWebhook(ctx context.Context, userID int32) (string, error)
+	UpdateBurnoutWebhook(ctx context.Context, userID int32, url string) error
+	ClaimBurnoutAlert(ctx context.Context, userID int32) (string, bool, error)
+	ResetBurnoutAlert(ctx context.Context, userID int32) error
 	GetTrackPointForDay(ctx context.Context, userID int32, from, to time.Time) (dto.TrackPoint, bool, error)
 	UpsertTrackPointForDay(ctx context.Context, userID int32, p dto.TrackPoint, from, to time.Time) (bool, error)
+	PatchTrackPointForDay(ctx context.Context, userID int32, from, to time.Time, fields map[string]any) (bool, error)
 	ListUsersWithTrackPoints(ctx context.Context) ([]int32, error)
+	EnqueueFailedAnalysis(ctx context.Context, userID int32, userTZ string, from, to, nextAttempt time.Time, errText string) error
+	ListDueFailedAnalyses(ctx context.Context, now time.Time, limit int) ([]dto.FailedAnalysis, error)
+	ListDeadFailedAnalyses(ctx context.Context, limit int) ([]dto.FailedAnalysis, error)
+	RecordFailedAnalysisRetry(ctx context.Context, userID int32, dayStart time.Time, attempts int, nextAttempt time.Time, errText string, dead bool) error
+	DeleteFailedAnalysis(ctx context.Context, userID int32, dayStart time.Time) error
 	SetAnalysisStatusForDay(ctx context.Context, userID int32, from, to time.Time, status, errText string) error
+	HasPendingAnalysis(ctx context.Context, userID int32, since time.Time) (bool, error)
 	UpsertLastAnalysis(ctx context.Context, userID int32, period string, resp dto.AnalyzeResponse) error
 	GetLastAnalyses(ctx context.Context, userID int32) (map[string]dto.AnalyzeResponse, map[string]time.Time, error)
+	GetAnalysisAt(ctx context.Context, userID int32, period string, at time.Time) (dto.AnalyzeResponse, time.Time, bool, error)
+	GetPreviousAverages(ctx context.Context, userID int32) (map[string]map[string]float64, error)
+	GetLastAnalysisTimes(ctx context.Context, userID int32) (map[string]time.Time, error)
+	GetLatestTrackTS(ctx context.Context, userID int32) (time.Time, bool, error)
+	GetFirstTrackTS(ctx context.Context, userID int32) (time.Time, bool, error)
+	CountTrackPoints(ctx context.Context, userID int32, limit int) (int, error)
+	CreateFocusSession(ctx context.Context, userID int32, fs dto.FocusSession) (dto.FocusSession, error)
+	ListFocusSessions(ctx context.Context, userID int32, from, to time.Time) ([]dto.FocusSession, error)
+	DeleteFocusSession(ctx context.Context, userID int32, id int64) (bool, error)
+	SaveInsightFeedback(ctx context.Context, userID int32, period, feedback string) error
 	UpsertUserSettings(ctx context.Context, userID int32, userTZ string) error
 	GetUserSettings(ctx context.Context, userID int32) (string, error)
+	GetDayStartHour(ctx context.Context, userID int32) (int, error)
+	UpdateDayStartHour(ctx context.Context, userID int32, hour int) error
+	GetInsightTone(ctx context.Context, userID int32) (dto.Tone, error)
+	UpdateInsightTone(ctx context.Context, userID int32, tone dto.Tone) error
+	UpdateShareAnalyses(ctx context.Context, userID int32, share bool) error
+	GetAnalysesEnabled(ctx context.Context, userID int32) (bool, error)
+	UpdateAnalysesEnabled(ctx context.Context, userID int32, enabled bool) error
+	GetHiddenMetrics(ctx context.Context, userID int32) ([]string, error)
+	UpdateHiddenMetrics(ctx context.Context, userID int32, metrics []string) error
+	SaveBurnoutDismissal(ctx context.Context, userID int32, period string, score float64, level string) error
+	GetBurnoutDismissal(ctx context.Context, userID int32, period string) (float64, bool, error)
 	GetUserProfile(ctx context.Context, userID int32) (dto.UserProfile, error)
 	UpdateUserProfile(ctx context.Context, userID int32, emoji string, bgIndex int32) (dto.UserProfile, error)
 	GetUserProfileForViewer(ctx context.Context, viewerID, targetID int32) (dto.UserProfile, error)
 	SearchUsers(ctx context.Context, query string, excludeUserID int32, limit int) ([]dto.UserProfile, error)
+	GetLastAnalysesForUsers(ctx context.Context, userIDs []int32, period string) (map[int32]dto.AnalyzeResponse, map[int32]time.Time, error)
+	GetUserByEmail(ctx context.Context, email string, excludeUserID int32) (dto.UserProfile, bool, error)
 	ListFriends(ctx context.Context, userID int32) ([]dto.UserProfile, error)
+	ListFriendsEnriched(ctx context.Context, userID int32, defaultTZ string) ([]dto.FriendSummary, error)
 	CreateFriendRequest(ctx context.Context, fromUserID, toUserID int32) (dto.FriendRequest, error)
 	ListFriendRequests(ctx context.Context, userID int32, status string) ([]dto.FriendRequest, error)
-	RespondFriendRequest(ctx context.Context, userID int32, requestID int64, action string) error
+	RespondFriendRequest(ctx context.Context, userID int32, requestID int64, action string) (string, error)
+	GetAggregateStats(ctx context.Context, from, to time.Time, minUsers int) (dto.AggregateStats, error)
+}
+
+type Config struct {
+	CacheTTL time.Duration
+	// SharedInsightTTL enables the cross-user insight cache for note-free prompts; 0 disables it.
+	SharedInsightTTL time.Duration
+	// InsightCacheTTL enables the per-user insight cache keyed by the prompt and a hash of its notes;
+	// 0 disables it.
+	InsightCacheTTL time.Duration
+	// EnergyScore overrides the energy score blend; the zero value means analytics.DefaultEnergyScoreParams.
+	EnergyScore analytics.EnergyScoreParams
+	// DefaultLocation is used when a user's timezone is unset or unknown; nil means UTC.
+	DefaultLocation *time.Location
+	// LongPeriodRefresh limits how often a Track re-runs the month and all-time analyses; 0 re-runs them
+	// on every Track. The nightly job and retries always run every period.
+	LongPeriodRefresh time.Duration
+	// Locker makes re-analysis single-flight per user and period across instances; nil disables locking.
+	Locker Locker
+	// StopWords extends the built-in Russian and English stop-word list used by GetNoteKeywords.
+	StopWords []string
+	// BurnoutCoverageSignal counts sparse logging (less than half of the forecast window) as a burnout
+	// signal; off by default, the coverage ratio is reported either way.
+	BurnoutCoverageSignal bool
+	// InsightDedupeThreshold reuses the stored insight of the period when a new one is at least this
+	// similar to it (word-sequence edit ratio, 0..1) and the averages and burnout level have not moved;
+	// the history row is still saved, flagged InsightUnchanged. 0 disables the check.
+	InsightDedupeThreshold float64
+	// AutoPeriods limits the periods analyzed automatically (after Track, by the nightly job and by
+	// retries) and returned by GetLastAnalyses; empty means all four. See ParseAutoPeriods. Burnout
+	// alerts and the feed's burnout events come from the weekly run: without week they never fire.
+	AutoPeriods []dto.Period
+	// BurnoutNotifier sends a webhook when a user's weekly burnout risk turns high; nil disables alerts.
+	BurnoutNotifier BurnoutNotifier
 }
 
 type Analyzer struct {
-	llm      LLMClient
-	repo     AnalysisRepository
-	cacheTTL time.Duration
+	llm              LLMClient
+	repo             AnalysisRepository
+	cacheTTL         time.Duration
+	sharedInsightTTL time.Duration
+	insightCacheTTL  time.Duration
+	energyParams     analytics.EnergyScoreParams
+	defaultLoc       *time.Location
+	longRefresh      time.Duration
+	locker           Locker
+	stopWords        map[string]struct{}
+	coverageSignal   bool
+	burnoutNotifier  BurnoutNotifier
+	autoPeriods      map[dto.Period]bool // nil means every period
+	dedupeThreshold  float64
+	emailLookups     *userRateLimiter
+	// async tracks the background re-analyses started by Track so shutdown can drain them.
+	async sync.WaitGroup
+	// dirty holds the lock keys of periods whose run was skipped because another run held the lock;
+	// the holder runs them again after unlocking.
+	dirtyMu sync.Mutex
+	dirty   map[string]bool
+	// recovering holds the users whose background run started by recoverLastAnalyses has not finished,
+	// so polling GetLastAnalyses does not start another one per call.
+	recoverMu  sync.Mutex
+	recovering map[int32]bool
 }
 
-func NewAnalyzer(llm LLMClient, repo AnalysisRepository, cacheTTL time.Duration) *Analyzer {
-	return &Analyzer{llm: llm, repo: repo, cacheTTL: cacheTTL}
+func NewAnalyzer(llm LLMClient, repo AnalysisRepository, cfg Config) *Analyzer {
+	energyParams := analytics.DefaultEnergyScoreParams
+	if cfg.EnergyScore != (analytics.EnergyScoreParams{}) {
+		if ep, err := cfg.EnergyScore.Normalize(); err == nil {
+			energyParams = ep
+		}
+	}
+	defaultLoc := cfg.DefaultLocation
+	if defaultLoc == nil {
+		defaultLoc = time.UTC
+	}
+	var autoPeriods map[dto.Period]bool
+	if len(cfg.AutoPeriods) > 0 {
+		autoPeriods = make(map[dto.Period]bool, len(cfg.AutoPeriods))
+		for _, p := range cfg.AutoPeriods {
+			autoPeriods[p] = true
+		}
+	}
+	return &Analyzer{
+		llm:              llm,
+		repo:             repo,
+		cacheTTL:         cfg.CacheTTL,
+		sharedInsightTTL: cfg.SharedInsightTTL,
+		insightCacheTTL:  cfg.InsightCacheTTL,
+		energyParams:     energyParams,
+		defaultLoc:       defaultLoc,
+		longRefresh:      cfg.LongPeriodRefresh,
+		locker:           cfg.Locker,
+		stopWords:        analytics.StopWords(cfg.StopWords),
+		coverageSignal:   cfg.BurnoutCoverageSignal,
+		burnoutNotifier:  cfg.BurnoutNotifier,
+		autoPeriods:      autoPeriods,
+		dedupeThreshold:  cfg.InsightDedupeThreshold,
+		emailLookups:     newUserRateLimiter(emailLookupLimit, emailLookupWindow),
+		dirty:            map[string]bool{},
+		recovering:       map[int32]bool{},
+	}
 }
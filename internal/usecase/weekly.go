@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
+)
+
+const (
+	// weeklyStreakLookbackDays — насколько далеко назад считается серия дней с отметками.
+	weeklyStreakLookbackDays = 90
+	// weeklySummarySentences — сколько первых предложений недельного инсайта попадает в карточку.
+	weeklySummarySentences = 2
+)
+
+// weeklyHabits — привычки-флаги в порядке приоритета при равном числе дней.
+var weeklyHabits = []struct {
+	name string
+	has  func(dto.TrackPoint) bool
+}{
+	{"workout", func(p dto.TrackPoint) bool { return p.Workout }},
+	{"caffeine", func(p dto.TrackPoint) bool { return p.Caffeine }},
+	{"alcohol", func(p dto.TrackPoint) bool { return p.Alcohol }},
+}
+
+// GetWeeklyReport собирает итоги последних 7 дней для карточки «поделиться»: средние, лучший и худший
+// день недели по энергии, уровень выгорания и начало инсайта из сохранённого недельного разбора, серию
+// дней с отметками и самую частую привычку. LLM не вызывается, поэтому карточка быстрая и одинаковая
+// при повторных запросах.
+func (a *Analyzer) GetWeeklyReport(ctx context.Context, userID int32, userTZ string) (dto.WeeklyReport, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.repo == nil {
+		return dto.WeeklyReport{}, errors.New("repository not configured")
+	}
+	if userID <= 0 {
+		return dto.WeeklyReport{}, errors.New("user id is required")
+	}
+	if userTZ == "" {
+		if tz, err := a.repo.GetUserSettings(ctx, userID); err == nil {
+			userTZ = tz
+		}
+	}
+	loc := a.ResolveLocation(userTZ)
+	dayStart := a.dayStartHour(ctx, userID)
+	now := time.Now().In(loc)
+	weekStart, weekEnd := periodRange(dto.PeriodWeek, now, dayStart)
+	lookback, _ := dayBounds(now.AddDate(0, 0, -weeklyStreakLookbackDays), dayStart)
+
+	pts, err := a.repo.GetTrackPoints(ctx, userID, lookback.UTC(), weekEnd.UTC(), nil)
+	if err != nil {
+		return dto.WeeklyReport{}, err
+	}
+	week := make([]dto.TrackPoint, 0, len(pts))
+	for i := range pts {
+		pts[i].TS = pts[i].TS.In(loc)
+		if !pts[i].TS.Before(weekStart) {
+			week = append(week, pts[i])
+		}
+	}
+
+	out := dto.WeeklyReport{
+		WeekStart:  weekStart.Format("2006-01-02"),
+		WeekEnd:    weekEnd.Format("2006-01-02"),
+		NumDays:    countUniqueDays(week),
+		Averages:   map[string]float64{},
+		StreakDays: analytics.LoggingStreak(pts, now, dayStart),
+	}
+	if len(week) > 0 {
+		out.Averages = map[string]float64{
+			"sleep_hours": round2(avgField(week, func(p dto.TrackPoint) float64 { return p.SleepHours })),
+			"mood":        round2(avgField(week, func(p dto.TrackPoint) float64 { return p.Mood })),
+			"energy":      round2(avgField(week, func(p dto.TrackPoint) float64 { return p.Energy })),
+			"stress":      round2(avgField(week, func(p dto.TrackPoint) float64 { return p.Stress })),
+			"productive":  round2(avgField(week, func(p dto.TrackPoint) float64 { return p.Productive })),
+		}
+	}
+
+	// With a single observed weekday there is no best or worst day to speak of.
+	if byWeekday := analytics.ComputeEnergyByWeekday(week, a.energyParams); len(byWeekday) >= 2 {
+		days := make([]string, 0, len(byWeekday))
+		for d := range byWeekday {
+			days = append(days, d)
+		}
+		sort.Strings(days)
+		for _, d := range days {
+			if out.BestWeekday == "" || byWeekday[d] > byWeekday[out.BestWeekday] {
+				out.BestWeekday = d
+			}
+			if out.WorstWeekday == "" || byWeekday[d] < byWeekday[out.WorstWeekday] {
+				out.WorstWeekday = d
+			}
+		}
+	}
+
+	hidden := a.hiddenMetrics(ctx, userID)
+	for _, h := range weeklyHabits {
+		if _, ok := hidden[h.name]; ok {
+			continue
+		}
+		days := map[string]struct{}{}
+		for _, p := range week {
+			if h.has(p) {
+				days[p.TS.Format("2006-01-02")] = struct{}{}
+			}
+		}
+		if len(days) > out.TopHabitDays {
+			out.TopHabit, out.TopHabitDays = h.name, len(days)
+		}
+	}
+
+	if last, _, err := a.repo.GetLastAnalyses(ctx, userID); err == nil {
+		if resp, ok := last[string(dto.PeriodWeek)]; ok {
+			out.BurnoutLevel = resp.BurnoutRisk.Level
+			out.Summary = firstSentences(resp.LLMInsight, weeklySummarySentences)
+		}
+	}
+	return out, nil
+}
+
+// firstSentences возвращает первые n предложений инсайта. Строки без знаков конца предложения
+// (заголовки блоков) пропускаются, точка внутри числа ("7.5 ч") предложение не завершает;
+// текст-заглушка при недоступном LLM даёт "".
+func firstSentences(text string, n int) string {
+	if strings.HasPrefix(text, insightUnavailablePrefix) {
+		return ""
+	}
+	var sentences []string
+	for _, ln := range strings.Split(text, "\n") {
+		ln = strings.TrimSpace(ln)
+		if !strings.ContainsAny(ln, ".!?") {
+			continue
+		}
+		start := 0
+		for i, r := range ln {
+			if r != '.' && r != '!' && r != '?' {
+				continue
+			}
+			if r == '.' && i+1 < len(ln) && ln[i+1] >= '0' && ln[i+1] <= '9' {
+				continue
+			}
+			if s := strings.TrimSpace(ln[start : i+1]); s != "" {
+				sentences = append(sentences, s)
+				if len(sentences) == n {
+					return strings.Join(sentences, " ")
+				}
+			}
+			start = i + 1
+		}
+	}
+	return strings.Join(sentences, " ")
+}
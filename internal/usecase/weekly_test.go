@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"nexus/internal/dto"
+)
+
+func TestWeeklyReportFixedWeek(t *testing.T) {
+	repo := &fakeRepo{tz: "UTC"}
+	seedDays(repo, 20, func(i int, p *dto.TrackPoint) {
+		p.Energy = float64(int(p.TS.Weekday()) + 2)
+		p.Caffeine = true
+		p.Workout = i%2 == 0
+	})
+	// A gap 15 days back ends the streak there.
+	repo.points = append(repo.points[:5], repo.points[6:]...)
+	repo.last = map[string]dto.AnalyzeResponse{string(dto.PeriodWeek): {
+		BurnoutRisk: dto.BurnoutRisk{Level: "low"},
+		LLMInsight:  "Энергия\nСон 7.5 ч стабилен. Энергия растёт к выходным!\nСтресс низкий.",
+	}}
+	a := NewAnalyzer(nil, repo, Config{})
+
+	got, err := a.GetWeeklyReport(context.Background(), testUserID, "")
+	if err != nil {
+		t.Fatalf("GetWeeklyReport: %v", err)
+	}
+	if got.NumDays < 7 || got.NumDays > 8 {
+		t.Errorf("num days = %d, want a week", got.NumDays)
+	}
+	if got.Averages["sleep_hours"] != 8 || got.Averages["mood"] != 7 || got.Averages["stress"] != 3 {
+		t.Errorf("averages = %v", got.Averages)
+	}
+	if got.BestWeekday == "" || got.WorstWeekday == "" || got.BestWeekday == got.WorstWeekday {
+		t.Errorf("best/worst weekday = %q/%q", got.BestWeekday, got.WorstWeekday)
+	}
+	if got.StreakDays != 14 {
+		t.Errorf("streak = %d, want 14", got.StreakDays)
+	}
+	if got.TopHabit != "caffeine" || got.TopHabitDays != got.NumDays {
+		t.Errorf("top habit = %s x%d, want caffeine every day", got.TopHabit, got.TopHabitDays)
+	}
+	if got.BurnoutLevel != "low" {
+		t.Errorf("burnout level = %q, want low", got.BurnoutLevel)
+	}
+	if want := "Сон 7.5 ч стабилен. Энергия растёт к выходным!"; got.Summary != want {
+		t.Errorf("summary = %q, want %q", got.Summary, want)
+	}
+
+	again, err := a.GetWeeklyReport(context.Background(), testUserID, "")
+	if err != nil || !reflect.DeepEqual(again, got) {
+		t.Errorf("second report differs: %+v vs %+v (%v)", again, got, err)
+	}
+
+	repo.hidden = []string{"caffeine"}
+	hidden, err := a.GetWeeklyReport(context.Background(), testUserID, "")
+	if err != nil || hidden.TopHabit != "workout" {
+		t.Errorf("hidden caffeine: top habit = %q (%v), want workout", hidden.TopHabit, err)
+	}
+}
@@ -3,18 +3,26 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"expvar"
 	"log"
 	"net"
 	"net/http"
+	"nexus/internal/domain/analytics"
+	"nexus/internal/dto"
 	"nexus/internal/handler"
 	"nexus/internal/llm"
 	"nexus/internal/middleware"
+	"nexus/internal/notify"
 	"nexus/internal/repository"
 	"nexus/internal/usecase"
 	"nexus/proto/nexusai/v1"
 	"os"
 	"os/signal"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -42,13 +50,29 @@ func main() {
 	}
 
 	disableLLM := os.Getenv("DISABLE_LLM") == "1" || os.Getenv("DISABLE_LLM") == "true"
-	fastLLM := true
+	// LLM_FAST_PERIODS: comma-separated periods answered in one call (default day,week); "none" makes every period thorough.
+	var fastPeriods []dto.Period
+	if v := os.Getenv("LLM_FAST_PERIODS"); v != "" {
+		fastPeriods = []dto.Period{}
+		for _, name := range strings.Split(v, ",") {
+			switch p := dto.Period(strings.TrimSpace(name)); p {
+			case dto.PeriodDay, dto.PeriodWeek, dto.PeriodMonth, dto.PeriodAll:
+				fastPeriods = append(fastPeriods, p)
+			}
+		}
+	}
 	maxTokens := 1200
 	if v := os.Getenv("DEEPSEEK_MAX_TOKENS"); v != "" {
 		if n, err := strconv.Atoi(v); err == nil && n > 0 {
 			maxTokens = n
 		}
 	}
+	dsRPM := 0
+	if v := os.Getenv("DEEPSEEK_RPM"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			dsRPM = n
+		}
+	}
 	dsTimeout := 60 * time.Second
 	if v := os.Getenv("DEEPSEEK_TIMEOUT"); v != "" {
 		if d, err := time.ParseDuration(v); err == nil {
@@ -56,14 +80,51 @@ func main() {
 		}
 	}
 
-	var llmClient llm.AIClient
+	// LLM_LANGUAGE picks the topic blocklist; an unknown language would leave the answers unfiltered.
+	llmLanguage := os.Getenv("LLM_LANGUAGE")
+	if _, ok := llm.DefaultBlocklists[llmLanguage]; llmLanguage != "" && !ok {
+		log.Fatalf("LLM_LANGUAGE=%q is not supported", llmLanguage)
+	}
+
+	var llmClient *llm.AIClient
 	if !disableLLM && dsToken != "" {
-		llmClient = *llm.NewAIClient(llm.AIConfig{
-			Token:      dsToken,
-			Fast:       fastLLM,
-			MaxTokens:  maxTokens,
-			HTTPClient: &http.Client{Timeout: dsTimeout},
-		})
+		aiCfg := llm.AIConfig{
+			Token:       dsToken,
+			FastPeriods: fastPeriods,
+			MaxTokens:   maxTokens,
+			HTTPClient:  &http.Client{Timeout: dsTimeout},
+			Language:    llmLanguage,
+			RPM:         dsRPM,
+		}
+		// INSIGHT_MIN_ACTIONS / INSIGHT_MAX_ACTIONS set the action count the prompt asks for and the validator accepts.
+		if v := os.Getenv("INSIGHT_MIN_ACTIONS"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				aiCfg.MinActions = n
+			}
+		}
+		if v := os.Getenv("INSIGHT_MAX_ACTIONS"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				aiCfg.MaxActions = n
+			}
+		}
+		// DEEPSEEK_TIMEOUT_INITIAL / _CONTINUE / _REPAIR: per-call deadlines of the insight stages, e.g. "30s";
+		// DEEPSEEK_TIMEOUT stays the ceiling of every single request.
+		for env, dst := range map[string]*time.Duration{
+			"DEEPSEEK_TIMEOUT_INITIAL":  &aiCfg.InitialTimeout,
+			"DEEPSEEK_TIMEOUT_CONTINUE": &aiCfg.ContinueTimeout,
+			"DEEPSEEK_TIMEOUT_REPAIR":   &aiCfg.RepairTimeout,
+		} {
+			if v := os.Getenv(env); v != "" {
+				if d, err := time.ParseDuration(v); err == nil && d > 0 {
+					*dst = d
+				}
+			}
+		}
+		// SANITIZE_BLOCKLIST: comma-separated substrings that drop a line of the insight; replaces the language default.
+		if v := os.Getenv("SANITIZE_BLOCKLIST"); v != "" {
+			aiCfg.Blocklist = strings.Split(v, ",")
+		}
+		llmClient = llm.NewAIClient(aiCfg)
 	} else {
 		log.Printf("llm disabled: disable=%v token=%v", disableLLM, dsToken != "")
 	}
@@ -75,7 +136,42 @@ func main() {
 		}
 	}
 
+	sharedInsightTTL := time.Duration(0)
+	if v := os.Getenv("SHARED_INSIGHT_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			sharedInsightTTL = d
+		}
+	}
+
+	// INSIGHT_CACHE_TTL caches insights per user, including prompts with notes; editing a note changes the key.
+	insightCacheTTL := time.Duration(0)
+	if v := os.Getenv("INSIGHT_CACHE_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			insightCacheTTL = d
+		}
+	}
+
+	// INSIGHT_DEDUPE_THRESHOLD: 0..1 word-sequence similarity above which a new insight reuses the stored one; 0 or unset disables.
+	insightDedupe := 0.0
+	if v := os.Getenv("INSIGHT_DEDUPE_THRESHOLD"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 || f > 1 {
+			log.Fatalf("INSIGHT_DEDUPE_THRESHOLD: want a number between 0 and 1, got %q", v)
+		}
+		insightDedupe = f
+	}
+
 	var repo *repository.Repository
+	// DEFAULT_TZ: IANA zone for users without a saved timezone, e.g. "Europe/Moscow"; defaults to UTC.
+	defaultLoc := time.UTC
+	if v := os.Getenv("DEFAULT_TZ"); v != "" {
+		l, err := time.LoadLocation(v)
+		if err != nil {
+			log.Fatalf("DEFAULT_TZ: %v", err)
+		}
+		defaultLoc = l
+	}
+
 	pgURL := os.Getenv("DATABASE_URL")
 	redisAddr := os.Getenv("REDIS_ADDR")
 	if pgURL != "" || redisAddr != "" {
@@ -90,12 +186,50 @@ func main() {
 				redisDB = n
 			}
 		}
-		r, err := repository.NewRepository(context.Background(), repository.Config{
+		repoCfg := repository.Config{
 			PostgresURL:   pgURL,
 			RedisAddr:     redisAddr,
 			RedisPassword: os.Getenv("REDIS_PASSWORD"),
 			RedisDB:       redisDB,
-		})
+			// DATABASE_READ_URL: optional read replica for history, calendar, friends and stats queries.
+			ReadReplicaURL: os.Getenv("DATABASE_READ_URL"),
+			DefaultTZ:      defaultLoc.String(),
+		}
+		if v := os.Getenv("DB_MAX_CONNS"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				repoCfg.MaxConns = int32(n)
+			}
+		}
+		if v := os.Getenv("DB_MIN_CONNS"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				repoCfg.MinConns = int32(n)
+			}
+		}
+		if v := os.Getenv("DB_MAX_CONN_LIFETIME"); v != "" {
+			if d, err := time.ParseDuration(v); err == nil {
+				repoCfg.MaxConnLifetime = d
+			}
+		}
+		if v := os.Getenv("DB_MAX_CONN_IDLE_TIME"); v != "" {
+			if d, err := time.ParseDuration(v); err == nil {
+				repoCfg.MaxConnIdleTime = d
+			}
+		}
+		// ANALYSIS_HISTORY_LIMIT: saved analyses kept per user and period; 0 or unset keeps all.
+		if v := os.Getenv("ANALYSIS_HISTORY_LIMIT"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+				repoCfg.AnalysisHistoryLimit = n
+			}
+		}
+		// MAX_TRACK_POINTS: cap on the points loaded for one analysis range; unset uses the built-in 20000.
+		if v := os.Getenv("MAX_TRACK_POINTS"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				repoCfg.MaxTrackPoints = n
+			}
+		}
+		// TRACK_POINTS_DOWNSAMPLE: past the cap, load one point per day instead of only the newest points.
+		repoCfg.DownsampleTrackPoints = os.Getenv("TRACK_POINTS_DOWNSAMPLE") == "1" || os.Getenv("TRACK_POINTS_DOWNSAMPLE") == "true"
+		r, err := repository.NewRepository(context.Background(), repoCfg)
 		if err != nil {
 			log.Fatalf("repository init: %v", err)
 		}
@@ -104,12 +238,95 @@ func main() {
 
 	var llmPtr usecase.LLMClient
 	if !disableLLM && dsToken != "" {
-		llmPtr = &llmClient
+		llmPtr = llmClient
+	}
+
+	// LONG_PERIOD_REFRESH: minimum age of the month/all analyses before a Track re-runs them; 0 = every Track.
+	longPeriodRefresh := 6 * time.Hour
+	if v := os.Getenv("LONG_PERIOD_REFRESH"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			longPeriodRefresh = d
+		}
+	}
+
+	// AUTO_ANALYSIS_PERIODS: comma-separated periods analyzed after each Track and nightly, e.g. "week,month";
+	// unset analyzes day, week, month and all. Burnout alerts and the feed's burnout events follow the
+	// weekly run, so a list without week turns them off (with BURNOUT_WEBHOOK_SECRET set it is refused).
+	var autoPeriods []dto.Period
+	if v := os.Getenv("AUTO_ANALYSIS_PERIODS"); v != "" {
+		ps, err := usecase.ParseAutoPeriods(v)
+		if err != nil {
+			log.Fatalf("AUTO_ANALYSIS_PERIODS: %v", err)
+		}
+		autoPeriods = ps
+	}
+
+	retryInterval := 5 * time.Minute
+	if v := os.Getenv("ANALYSIS_RETRY_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			retryInterval = d
+		}
+	}
+
+	// ENERGY_SCORE_PARAMS: overrides of the energy score blend, e.g. "mood=0.3,alcohol=-5"; weights are normalized to sum to 1.
+	energyParams := analytics.DefaultEnergyScoreParams
+	if v := os.Getenv("ENERGY_SCORE_PARAMS"); v != "" {
+		ep, err := analytics.ParseEnergyScoreParams(v)
+		if err != nil {
+			log.Fatalf("ENERGY_SCORE_PARAMS: %v", err)
+		}
+		energyParams = ep
+	}
+
+	// BURNOUT_COVERAGE_SIGNAL: count sparse logging as a burnout signal (off by default).
+	coverageSignal := os.Getenv("BURNOUT_COVERAGE_SIGNAL") == "1" || os.Getenv("BURNOUT_COVERAGE_SIGNAL") == "true"
+
+	// BURNOUT_WEBHOOK_SECRET: HMAC key for the signed burnout alert webhooks; unset disables the alerts.
+	var burnoutNotifier usecase.BurnoutNotifier
+	if v := os.Getenv("BURNOUT_WEBHOOK_SECRET"); v != "" {
+		burnoutNotifier = notify.NewWebhookNotifier(v, 0)
+		if len(autoPeriods) > 0 && !slices.Contains(autoPeriods, dto.PeriodWeek) {
+			log.Fatalf("AUTO_ANALYSIS_PERIODS: burnout alerts need the week period; add it or unset BURNOUT_WEBHOOK_SECRET")
+		}
 	}
 
-	analyzer := usecase.NewAnalyzer(llmPtr, repo, cacheTTL)
+	// NOTE_STOP_WORDS: comma-separated words added to the built-in stop-word list of GetNoteKeywords.
+	var stopWords []string
+	if v := os.Getenv("NOTE_STOP_WORDS"); v != "" {
+		stopWords = strings.Split(v, ",")
+	}
+
+	// Re-analysis is single-flight per user and period: Redis locks when Redis is configured,
+	// Postgres advisory locks otherwise.
+	var locker usecase.Locker
 	if repo != nil {
-		startDailyAnalysisScheduler(analyzer, repo)
+		if l := repo.RedisLocker(); l != nil {
+			locker = l
+		} else if l := repo.PostgresLocker(); l != nil {
+			locker = l
+		}
+	}
+
+	analyzer := usecase.NewAnalyzer(llmPtr, repo, usecase.Config{
+		CacheTTL:          cacheTTL,
+		SharedInsightTTL:  sharedInsightTTL,
+		InsightCacheTTL:   insightCacheTTL,
+		EnergyScore:       energyParams,
+		DefaultLocation:   defaultLoc,
+		LongPeriodRefresh: longPeriodRefresh,
+		Locker:            locker,
+		StopWords:         stopWords,
+
+		BurnoutCoverageSignal: coverageSignal,
+		BurnoutNotifier:       burnoutNotifier,
+		AutoPeriods:           autoPeriods,
+
+		InsightDedupeThreshold: insightDedupe,
+	})
+	loops := newBackgroundLoops()
+	if repo != nil {
+		startDailyAnalysisScheduler(loops, analyzer, repo)
+		startAnalysisRetryLoop(loops, analyzer, retryInterval)
 	}
 	authConn, err := grpc.Dial(authGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -118,19 +335,82 @@ func main() {
 	defer authConn.Close()
 
 	authClient := authpb.NewAuthServiceClient(authConn)
-	analyzeHandler := handler.NewGRPCAnalyzeHandler(analyzer, authClient)
-	authMW := middleware.NewAuthGRPCMiddleware(authURL, nil)
+	handlerCfg := handler.GRPCHandlerConfig{
+		AdminToken:    os.Getenv("ADMIN_TOKEN"),
+		TruncateNotes: os.Getenv("TRUNCATE_NOTES") == "1" || os.Getenv("TRUNCATE_NOTES") == "true",
+	}
+	if v := os.Getenv("AUTH_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			handlerCfg.AuthTimeout = d
+		}
+	}
+	// TRACK_FUTURE_TOLERANCE: accepted client clock skew for point timestamps, e.g. "2m"; default 5m,
+	// "0" rejects any point ahead of the server clock.
+	if v := os.Getenv("TRACK_FUTURE_TOLERANCE"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			handlerCfg.FutureTolerance = d
+			if d == 0 {
+				handlerCfg.FutureTolerance = handler.NoFutureTolerance
+			}
+		}
+	}
+	if v := os.Getenv("MAX_NOTE_RUNES"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			handlerCfg.MaxNoteRunes = n
+		}
+	}
+	analyzeHandler := handler.NewGRPCAnalyzeHandler(analyzer, authClient, handlerCfg)
+	// GRPC_AUTH_MODE=hmac switches to shared-secret signatures for trusted backend callers.
+	var authInterceptor grpc.UnaryServerInterceptor
+	switch os.Getenv("GRPC_AUTH_MODE") {
+	case "hmac":
+		secret := os.Getenv("HMAC_SECRET")
+		if secret == "" {
+			log.Fatal("GRPC_AUTH_MODE=hmac requires HMAC_SECRET")
+		}
+		maxSkew := time.Duration(0)
+		if v := os.Getenv("HMAC_MAX_SKEW"); v != "" {
+			if d, err := time.ParseDuration(v); err == nil {
+				maxSkew = d
+			}
+		}
+		authInterceptor = middleware.NewHMACGRPCMiddleware(secret, maxSkew).Unary()
+	default:
+		// AUTH_CACHE_TTL reuses a successful token validation for a few seconds; 0 or unset checks every call.
+		authCacheTTL := time.Duration(0)
+		if v := os.Getenv("AUTH_CACHE_TTL"); v != "" {
+			if d, err := time.ParseDuration(v); err == nil {
+				authCacheTTL = d
+			}
+		}
+		authCacheSize := 0
+		if v := os.Getenv("AUTH_CACHE_SIZE"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				authCacheSize = n
+			}
+		}
+		authInterceptor = middleware.NewAuthGRPCMiddleware(authURL, nil, authCacheTTL, authCacheSize).Unary()
+	}
 
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authMW.Unary()),
+		grpc.UnaryInterceptor(authInterceptor),
 	)
 	nexusai.RegisterAnalyzerServiceServer(grpcServer, analyzeHandler)
 
+	// Readiness is the dependency check: Postgres (and its replica) and Redis must answer a ping.
+	// The gRPC health status follows it, and /readyz runs it on every probe.
+	checkReady := func(ctx context.Context) error {
+		if repo == nil {
+			return nil
+		}
+		return repo.Ping(ctx)
+	}
 	healthServer := health.NewServer()
 	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	startHealthWatcher(loops, healthServer, checkReady, healthCheckInterval)
 
-	errCh := make(chan error, 1)
+	errCh := make(chan error, 2)
 	go func() {
 		lis, err := net.Listen("tcp", grpcAddr)
 		if err != nil {
@@ -141,6 +421,45 @@ func main() {
 		errCh <- grpcServer.Serve(lis)
 	}()
 
+	// METRICS_ADDR serves the expvar counters at /debug/vars; HEALTH_ADDR serves the HTTP probes
+	// /healthz (liveness) and /readyz (readiness). Both may name the same address to share a listener;
+	// an unset address disables its endpoints.
+	var httpServers []*http.Server
+	muxes := map[string]*http.ServeMux{}
+	var addrs []string
+	muxFor := func(addr string) *http.ServeMux {
+		if mux, ok := muxes[addr]; ok {
+			return mux
+		}
+		muxes[addr] = http.NewServeMux()
+		addrs = append(addrs, addr)
+		return muxes[addr]
+	}
+	if addr := os.Getenv("METRICS_ADDR"); addr != "" {
+		muxFor(addr).Handle("/debug/vars", expvar.Handler())
+	}
+	if addr := os.Getenv("HEALTH_ADDR"); addr != "" {
+		registerHealthHandlers(muxFor(addr), checkReady)
+	}
+	for _, addr := range addrs {
+		srv := &http.Server{Addr: addr, Handler: muxes[addr], ReadHeaderTimeout: 5 * time.Second}
+		httpServers = append(httpServers, srv)
+		go func() {
+			log.Printf("http listening on %s", srv.Addr)
+			if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- err
+			}
+		}()
+	}
+
+	// SHUTDOWN_TIMEOUT bounds each shutdown step: draining gRPC and HTTP, then background analyses.
+	shutdownTimeout := 30 * time.Second
+	if v := os.Getenv("SHUTDOWN_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			shutdownTimeout = d
+		}
+	}
+
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 
@@ -149,10 +468,91 @@ func main() {
 		log.Fatal(err)
 	case sig := <-sigCh:
 		log.Printf("shutdown signal: %s", sig.String())
-		if repo != nil {
-			repo.Close()
+		closeRepo := func() {
+			if repo != nil {
+				repo.Close()
+			}
 		}
+		shutdown(grpcServer, httpServers, loops, analyzer, closeRepo, shutdownTimeout)
+	}
+}
+
+// shutdown drains the service in dependency order: stop taking new calls (gRPC, then every HTTP
+// server), stop the background loops, let in-flight background analyses finish, and only then close
+// the repository they use. Each step gets its own timeout so one stuck component cannot block the
+// rest forever.
+func shutdown(grpcServer *grpc.Server, httpServers []*http.Server, loops *backgroundLoops, analyzer *usecase.Analyzer, closeRepo func(), timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
 		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.Printf("shutdown: grpc drain timed out, closing open streams")
+		grpcServer.Stop()
+	}
+
+	for _, srv := range httpServers {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Printf("shutdown: http %s: %v", srv.Addr, err)
+			_ = srv.Close()
+		}
+		cancel()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	if err := loops.Stop(ctx); err != nil {
+		log.Printf("shutdown: background loops still running: %v", err)
+	}
+	cancel()
+
+	ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	if err := analyzer.Drain(ctx); err != nil {
+		log.Printf("shutdown: background analyses still running: %v", err)
+	}
+	cancel()
+
+	closeRepo()
+}
+
+// backgroundLoops runs the periodic jobs (retry queue, daily scheduler, health watcher) under one
+// cancellable context, so shutdown can stop them and wait for them before closing the repository.
+type backgroundLoops struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newBackgroundLoops() *backgroundLoops {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &backgroundLoops{ctx: ctx, cancel: cancel}
+}
+
+// Go starts fn in its own goroutine; fn must return once ctx is cancelled.
+func (b *backgroundLoops) Go(fn func(ctx context.Context)) {
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		fn(b.ctx)
+	}()
+}
+
+// Stop cancels every loop and waits until they have returned or ctx expires.
+func (b *backgroundLoops) Stop(ctx context.Context) error {
+	b.cancel()
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
@@ -185,22 +585,106 @@ func runMigrations(dsn string) error {
 	return goose.Up(db, "migrations")
 }
 
-func startDailyAnalysisScheduler(analyzer *usecase.Analyzer, repo *repository.Repository) {
-	go func() {
+const (
+	healthCheckInterval = 10 * time.Second
+	healthCheckTimeout  = 2 * time.Second
+)
+
+// startHealthWatcher keeps the gRPC health status in step with the dependency check: NOT_SERVING
+// while it fails, SERVING again once it passes.
+func startHealthWatcher(loops *backgroundLoops, hs *health.Server, check func(context.Context) error, interval time.Duration) {
+	loops.Go(func(loopCtx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		serving := true
+		for {
+			select {
+			case <-loopCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			ctx, cancel := context.WithTimeout(loopCtx, healthCheckTimeout)
+			err := check(ctx)
+			cancel()
+			if (err == nil) == serving {
+				continue
+			}
+			serving = err == nil
+			if serving {
+				log.Printf("health: dependencies recovered")
+				hs.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+			} else {
+				log.Printf("health: degraded: %v", err)
+				hs.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			}
+		}
+	})
+}
+
+// registerHealthHandlers adds the HTTP probes: /healthz answers 200 while the process serves requests,
+// /readyz answers 200 only when the dependency check passes and 503 otherwise.
+func registerHealthHandlers(mux *http.ServeMux, check func(context.Context) error) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok\n"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+		defer cancel()
+		if err := check(ctx); err != nil {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok\n"))
+	})
+}
+
+// startAnalysisRetryLoop drains the failed-analysis queue every interval.
+func startAnalysisRetryLoop(loops *backgroundLoops, analyzer *usecase.Analyzer, interval time.Duration) {
+	loops.Go(func(loopCtx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-loopCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			ctx, cancel := context.WithTimeout(loopCtx, 5*time.Minute)
+			if n, err := analyzer.RetryFailedAnalyses(ctx); err != nil {
+				log.Printf("analysis retry: %v", err)
+			} else if n > 0 {
+				log.Printf("analysis retry: %d day(s) recovered", n)
+			}
+			cancel()
+		}
+	})
+}
+
+func startDailyAnalysisScheduler(loops *backgroundLoops, analyzer *usecase.Analyzer, repo *repository.Repository) {
+	loops.Go(func(loopCtx context.Context) {
 		for {
 			now := time.Now()
 			next := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
-			time.Sleep(time.Until(next))
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-loopCtx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+			ctx, cancel := context.WithTimeout(loopCtx, 5*time.Minute)
 			users, err := repo.ListUsersWithTrackPoints(ctx)
 			if err == nil {
 				for _, id := range users {
 					tz, _ := repo.GetUserSettings(ctx, id)
 					_ = analyzer.AnalyzeAllPeriods(ctx, id, tz)
+					_, _ = analyzer.PrecomputeTomorrowSchedule(ctx, id, tz)
 				}
 			}
 			cancel()
 		}
-	}()
+	})
 }
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"nexus/internal/usecase"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestShutdownStopsLoopsBeforeClosingRepo(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	record := func(step string) {
+		mu.Lock()
+		order = append(order, step)
+		mu.Unlock()
+	}
+
+	loops := newBackgroundLoops()
+	started := make(chan struct{})
+	loops.Go(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		// A loop still finishing its iteration must not find the repository closed.
+		time.Sleep(20 * time.Millisecond)
+		record("loop")
+	})
+	<-started
+
+	analyzer := usecase.NewAnalyzer(nil, nil, usecase.Config{})
+	shutdown(grpc.NewServer(), nil, loops, analyzer, func() { record("repo") }, time.Second)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(order) != 2 || order[0] != "loop" || order[1] != "repo" {
+		t.Fatalf("shutdown order = %v, want [loop repo]", order)
+	}
+}
+
+func TestBackgroundLoopsStopTimesOut(t *testing.T) {
+	loops := newBackgroundLoops()
+	release := make(chan struct{})
+	defer close(release)
+	loops.Go(func(context.Context) { <-release })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := loops.Stop(ctx); err == nil {
+		t.Fatal("Stop returned nil while a loop ignored cancellation")
+	}
+}
+
+func TestHealthEndpointsFollowTheDependencyCheck(t *testing.T) {
+	var down atomic.Bool
+	check := func(context.Context) error {
+		if down.Load() {
+			return errors.New("postgres: connection refused")
+		}
+		return nil
+	}
+	mux := http.NewServeMux()
+	registerHealthHandlers(mux, check)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	hs := health.NewServer()
+	hs.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	loops := newBackgroundLoops()
+	defer loops.Stop(context.Background())
+	startHealthWatcher(loops, hs, check, 5*time.Millisecond)
+
+	status := func(path string) int {
+		t.Helper()
+		resp, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	waitGRPC := func(want grpc_health_v1.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for {
+			resp, err := hs.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+			if err == nil && resp.Status == want {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("gRPC health status never became %v", want)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	steps := []struct {
+		name    string
+		down    bool
+		healthz int
+		readyz  int
+		grpc    grpc_health_v1.HealthCheckResponse_ServingStatus
+	}{
+		{"healthy", false, http.StatusOK, http.StatusOK, grpc_health_v1.HealthCheckResponse_SERVING},
+		{"degraded", true, http.StatusOK, http.StatusServiceUnavailable, grpc_health_v1.HealthCheckResponse_NOT_SERVING},
+		{"recovered", false, http.StatusOK, http.StatusOK, grpc_health_v1.HealthCheckResponse_SERVING},
+	}
+	for _, st := range steps {
+		down.Store(st.down)
+		if got := status("/healthz"); got != st.healthz {
+			t.Errorf("%s: /healthz = %d, want %d", st.name, got, st.healthz)
+		}
+		if got := status("/readyz"); got != st.readyz {
+			t.Errorf("%s: /readyz = %d, want %d", st.name, got, st.readyz)
+		}
+		waitGRPC(st.grpc)
+	}
+}
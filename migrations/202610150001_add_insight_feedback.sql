@@ -0,0 +1,14 @@
+-- +goose Up
+create table if not exists insight_feedback (
+	id bigserial primary key,
+	user_id int not null,
+	period text not null,
+	feedback text not null,
+	created_at timestamptz not null default now()
+);
+
+create index if not exists insight_feedback_user_idx on insight_feedback (user_id, created_at desc);
+
+-- +goose Down
+drop index if exists insight_feedback_user_idx;
+drop table if exists insight_feedback;
@@ -0,0 +1,7 @@
+-- +goose Up
+alter table user_settings
+	add column if not exists day_start_hour int not null default 0;
+
+-- +goose Down
+alter table user_settings
+	drop column if exists day_start_hour;
@@ -0,0 +1,5 @@
+-- +goose Up
+create index if not exists track_points_ts_idx on track_points (ts);
+
+-- +goose Down
+drop index if exists track_points_ts_idx;
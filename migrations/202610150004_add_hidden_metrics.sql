@@ -0,0 +1,7 @@
+-- +goose Up
+alter table user_settings
+	add column if not exists hidden_metrics text[] not null default '{}';
+
+-- +goose Down
+alter table user_settings
+	drop column if exists hidden_metrics;
@@ -0,0 +1,11 @@
+-- +goose Up
+alter table track_points
+	add column if not exists sleep_start_ts timestamptz;
+alter table track_points
+	add column if not exists sleep_end_ts timestamptz;
+
+-- +goose Down
+alter table track_points
+	drop column if exists sleep_start_ts;
+alter table track_points
+	drop column if exists sleep_end_ts;
@@ -0,0 +1,10 @@
+-- +goose Up
+create table if not exists burnout_dismissals (
+	user_id int primary key,
+	score double precision not null,
+	level text not null,
+	dismissed_at timestamptz not null default now()
+);
+
+-- +goose Down
+drop table if exists burnout_dismissals;
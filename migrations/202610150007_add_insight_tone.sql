@@ -0,0 +1,7 @@
+-- +goose Up
+alter table user_settings
+	add column if not exists insight_tone text not null default '';
+
+-- +goose Down
+alter table user_settings
+	drop column if exists insight_tone;
@@ -0,0 +1,39 @@
+-- +goose Up
+-- null means "not provided"; rows written before this migration keep their stored zeros.
+alter table track_points
+	alter column sleep_hours drop not null,
+	alter column mood drop not null,
+	alter column activity drop not null,
+	alter column productive drop not null,
+	alter column stress drop not null,
+	alter column stress drop default,
+	alter column energy drop not null,
+	alter column energy drop default,
+	alter column concentration drop not null,
+	alter column concentration drop default,
+	alter column sleep_quality drop not null,
+	alter column sleep_quality drop default;
+
+-- +goose Down
+update track_points set
+	sleep_hours = coalesce(sleep_hours, 0),
+	mood = coalesce(mood, 0),
+	activity = coalesce(activity, 0),
+	productive = coalesce(productive, 0),
+	stress = coalesce(stress, 0),
+	energy = coalesce(energy, 0),
+	concentration = coalesce(concentration, 0),
+	sleep_quality = coalesce(sleep_quality, 0);
+alter table track_points
+	alter column sleep_hours set not null,
+	alter column mood set not null,
+	alter column activity set not null,
+	alter column productive set not null,
+	alter column stress set not null,
+	alter column stress set default 0,
+	alter column energy set not null,
+	alter column energy set default 0,
+	alter column concentration set not null,
+	alter column concentration set default 0,
+	alter column sleep_quality set not null,
+	alter column sleep_quality set default 0;
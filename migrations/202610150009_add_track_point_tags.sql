@@ -0,0 +1,9 @@
+-- +goose Up
+alter table track_points
+	add column if not exists tags text[] not null default '{}';
+create index if not exists track_points_tags_idx on track_points using gin (tags);
+
+-- +goose Down
+drop index if exists track_points_tags_idx;
+alter table track_points
+	drop column if exists tags;
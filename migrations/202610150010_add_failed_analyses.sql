@@ -0,0 +1,17 @@
+-- +goose Up
+create table if not exists failed_analyses (
+	user_id int not null,
+	day_start timestamptz not null,
+	day_end timestamptz not null,
+	user_tz text not null default '',
+	attempts int not null default 0,
+	last_error text not null default '',
+	next_attempt_at timestamptz not null default now(),
+	dead boolean not null default false,
+	updated_at timestamptz not null default now(),
+	primary key (user_id, day_start)
+);
+create index if not exists failed_analyses_due_idx on failed_analyses (next_attempt_at) where not dead;
+
+-- +goose Down
+drop table if exists failed_analyses;
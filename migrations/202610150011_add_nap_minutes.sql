@@ -0,0 +1,7 @@
+-- +goose Up
+alter table track_points
+	add column if not exists nap_minutes int not null default 0;
+
+-- +goose Down
+alter table track_points
+	drop column if exists nap_minutes;
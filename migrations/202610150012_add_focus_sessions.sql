@@ -0,0 +1,14 @@
+-- +goose Up
+create table if not exists focus_sessions (
+	id bigserial primary key,
+	user_id int not null,
+	started_at timestamptz not null,
+	ended_at timestamptz not null,
+	quality double precision not null,
+	created_at timestamptz not null default now(),
+	check (ended_at > started_at)
+);
+create index if not exists focus_sessions_user_started_idx on focus_sessions (user_id, started_at desc);
+
+-- +goose Down
+drop table if exists focus_sessions;
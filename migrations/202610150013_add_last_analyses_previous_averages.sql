@@ -0,0 +1,7 @@
+-- +goose Up
+alter table last_analyses
+	add column if not exists previous_averages jsonb;
+
+-- +goose Down
+alter table last_analyses
+	drop column if exists previous_averages;
@@ -0,0 +1,11 @@
+-- +goose Up
+alter table analyses
+	add column if not exists user_id int,
+	add column if not exists period text;
+create index if not exists analyses_user_period_idx on analyses (user_id, period, created_at desc);
+
+-- +goose Down
+drop index if exists analyses_user_period_idx;
+alter table analyses
+	drop column if exists period,
+	drop column if exists user_id;
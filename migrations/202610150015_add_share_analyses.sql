@@ -0,0 +1,7 @@
+-- +goose Up
+alter table user_settings
+	add column if not exists share_analyses boolean not null default false;
+
+-- +goose Down
+alter table user_settings
+	drop column if exists share_analyses;
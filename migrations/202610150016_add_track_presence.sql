@@ -0,0 +1,20 @@
+-- +goose Up
+-- presence has bit i set when dto.OptionalTrackFields[i] was provided (non-null): 1 = sleep_hours,
+-- 2 = mood, 4 = activity, 8 = productive, 16 = stress, 32 = energy, 64 = concentration, 128 = sleep_quality.
+-- Being generated from the columns themselves, it is backfilled on add and never drifts from them;
+-- rows stored before nullable_track_ratings hold zeros instead of nulls and come out fully present (255).
+alter table track_points
+	add column if not exists presence smallint generated always as ((
+		(case when sleep_hours is not null then 1 else 0 end) |
+		(case when mood is not null then 2 else 0 end) |
+		(case when activity is not null then 4 else 0 end) |
+		(case when productive is not null then 8 else 0 end) |
+		(case when stress is not null then 16 else 0 end) |
+		(case when energy is not null then 32 else 0 end) |
+		(case when concentration is not null then 64 else 0 end) |
+		(case when sleep_quality is not null then 128 else 0 end)
+	)::smallint) stored;
+
+-- +goose Down
+alter table track_points
+	drop column if exists presence;
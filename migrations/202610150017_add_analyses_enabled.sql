@@ -0,0 +1,7 @@
+-- +goose Up
+alter table user_settings
+	add column if not exists analyses_enabled boolean not null default true;
+
+-- +goose Down
+alter table user_settings
+	drop column if exists analyses_enabled;
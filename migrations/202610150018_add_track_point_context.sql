@@ -0,0 +1,9 @@
+-- +goose Up
+-- Free-form categorical context of a point ({"weather": "rain", "location": "office"}); keys and sizes are
+-- validated by the service.
+alter table track_points
+	add column if not exists context jsonb not null default '{}';
+
+-- +goose Down
+alter table track_points
+	drop column if exists context;
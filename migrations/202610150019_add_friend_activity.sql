@@ -0,0 +1,14 @@
+-- +goose Up
+create table if not exists friend_activity (
+	id bigserial primary key,
+	user_id int not null,
+	kind text not null,
+	value text not null,
+	dedupe_key text not null,
+	created_at timestamptz not null default now(),
+	unique (user_id, dedupe_key)
+);
+create index if not exists friend_activity_user_created_idx on friend_activity (user_id, created_at desc);
+
+-- +goose Down
+drop table if exists friend_activity;
@@ -0,0 +1,9 @@
+-- +goose Up
+alter table user_settings
+	add column if not exists burnout_webhook_url text not null default '',
+	add column if not exists burnout_alert_active boolean not null default false;
+
+-- +goose Down
+alter table user_settings
+	drop column if exists burnout_alert_active,
+	drop column if exists burnout_webhook_url;
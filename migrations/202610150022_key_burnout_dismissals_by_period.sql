@@ -0,0 +1,18 @@
+-- +goose Up
+-- A dismissal used to hide the banner of every period; it now belongs to the period it was made on.
+-- Existing dismissals are copied to every period so no banner reappears on upgrade.
+alter table burnout_dismissals add column if not exists period text not null default 'all';
+alter table burnout_dismissals drop constraint if exists burnout_dismissals_pkey;
+insert into burnout_dismissals (user_id, period, score, level, dismissed_at)
+select d.user_id, p.period, d.score, d.level, d.dismissed_at
+from burnout_dismissals d
+cross join (values ('day'), ('week'), ('month')) as p(period)
+where d.period = 'all';
+alter table burnout_dismissals add primary key (user_id, period);
+alter table burnout_dismissals alter column period drop default;
+
+-- +goose Down
+delete from burnout_dismissals where period <> 'all';
+alter table burnout_dismissals drop constraint if exists burnout_dismissals_pkey;
+alter table burnout_dismissals drop column if exists period;
+alter table burnout_dismissals add primary key (user_id);
@@ -0,0 +1,8 @@
+-- +goose Up
+-- An empty user_tz means "not set": the service resolves it to DEFAULT_TZ. Rows created by other
+-- settings (avatar, day start) no longer pin the user to UTC.
+alter table user_settings alter column user_tz set default '';
+
+-- +goose Down
+update user_settings set user_tz = 'UTC' where user_tz = '';
+alter table user_settings alter column user_tz set default 'UTC';
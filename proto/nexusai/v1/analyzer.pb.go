@@ -22,6 +22,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Coaching style of the LLM insight; the answer format is the same for every tone.
+type Tone int32
+
+const (
+	Tone_TONE_UNSPECIFIED Tone = 0 // the user's saved default, or the neutral style
+	Tone_TONE_GENTLE      Tone = 1
+	Tone_TONE_DIRECT      Tone = 2
+	Tone_TONE_DATA_ONLY   Tone = 3 // facts only, no encouragement
+)
+
+// Enum value maps for Tone.
+var (
+	Tone_name = map[int32]string{
+		0: "TONE_UNSPECIFIED",
+		1: "TONE_GENTLE",
+		2: "TONE_DIRECT",
+		3: "TONE_DATA_ONLY",
+	}
+	Tone_value = map[string]int32{
+		"TONE_UNSPECIFIED": 0,
+		"TONE_GENTLE":      1,
+		"TONE_DIRECT":      2,
+		"TONE_DATA_ONLY":   3,
+	}
+)
+
+func (x Tone) Enum() *Tone {
+	p := new(Tone)
+	*p = x
+	return p
+}
+
+func (x Tone) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Tone) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_nexusai_v1_analyzer_proto_enumTypes[0].Descriptor()
+}
+
+func (Tone) Type() protoreflect.EnumType {
+	return &file_proto_nexusai_v1_analyzer_proto_enumTypes[0]
+}
+
+func (x Tone) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Tone.Descriptor instead.
+func (Tone) EnumDescriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{0}
+}
+
 type Period int32
 
 const (
@@ -61,11 +114,11 @@ func (x Period) String() string {
 }
 
 func (Period) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_nexusai_v1_analyzer_proto_enumTypes[0].Descriptor()
+	return file_proto_nexusai_v1_analyzer_proto_enumTypes[1].Descriptor()
 }
 
 func (Period) Type() protoreflect.EnumType {
-	return &file_proto_nexusai_v1_analyzer_proto_enumTypes[0]
+	return &file_proto_nexusai_v1_analyzer_proto_enumTypes[1]
 }
 
 func (x Period) Number() protoreflect.EnumNumber {
@@ -74,7 +127,7 @@ func (x Period) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Period.Descriptor instead.
 func (Period) EnumDescriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{0}
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{1}
 }
 
 type TrackRequest struct {
@@ -281,19 +334,32 @@ func (x *TodayTrackResponse) GetExists() bool {
 	return false
 }
 
-type AnalyzeRequest struct {
+// Only the fields that are set are written; everything else on today's point is left untouched.
+// Notes are not patchable: llm_text is only written by Track.
+type PatchTodayTrackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserTz      string       `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
-	WeekStarts  string       `protobuf:"bytes,2,opt,name=week_starts,json=weekStarts,proto3" json:"week_starts,omitempty"`
-	Constraints *Constraints `protobuf:"bytes,3,opt,name=constraints,proto3" json:"constraints,omitempty"`
-	Period      Period       `protobuf:"varint,4,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
-}
-
-func (x *AnalyzeRequest) Reset() {
-	*x = AnalyzeRequest{}
+	UserTz        string   `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+	SleepHours    *float64 `protobuf:"fixed64,2,opt,name=sleep_hours,json=sleepHours,proto3,oneof" json:"sleep_hours,omitempty"`
+	SleepStart    *string  `protobuf:"bytes,3,opt,name=sleep_start,json=sleepStart,proto3,oneof" json:"sleep_start,omitempty"`
+	SleepEnd      *string  `protobuf:"bytes,4,opt,name=sleep_end,json=sleepEnd,proto3,oneof" json:"sleep_end,omitempty"`
+	Mood          *float64 `protobuf:"fixed64,5,opt,name=mood,proto3,oneof" json:"mood,omitempty"`
+	Activity      *float64 `protobuf:"fixed64,6,opt,name=activity,proto3,oneof" json:"activity,omitempty"`
+	Productive    *float64 `protobuf:"fixed64,7,opt,name=productive,proto3,oneof" json:"productive,omitempty"`
+	Stress        *float64 `protobuf:"fixed64,8,opt,name=stress,proto3,oneof" json:"stress,omitempty"`
+	Energy        *float64 `protobuf:"fixed64,9,opt,name=energy,proto3,oneof" json:"energy,omitempty"`
+	Concentration *float64 `protobuf:"fixed64,10,opt,name=concentration,proto3,oneof" json:"concentration,omitempty"`
+	SleepQuality  *float64 `protobuf:"fixed64,11,opt,name=sleep_quality,json=sleepQuality,proto3,oneof" json:"sleep_quality,omitempty"`
+	Caffeine      *bool    `protobuf:"varint,12,opt,name=caffeine,proto3,oneof" json:"caffeine,omitempty"`
+	Alcohol       *bool    `protobuf:"varint,13,opt,name=alcohol,proto3,oneof" json:"alcohol,omitempty"`
+	Workout       *bool    `protobuf:"varint,14,opt,name=workout,proto3,oneof" json:"workout,omitempty"`
+	NapMinutes    *int32   `protobuf:"varint,16,opt,name=nap_minutes,json=napMinutes,proto3,oneof" json:"nap_minutes,omitempty"` // 0..300
+}
+
+func (x *PatchTodayTrackRequest) Reset() {
+	*x = PatchTodayTrackRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,13 +367,13 @@ func (x *AnalyzeRequest) Reset() {
 	}
 }
 
-func (x *AnalyzeRequest) String() string {
+func (x *PatchTodayTrackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeRequest) ProtoMessage() {}
+func (*PatchTodayTrackRequest) ProtoMessage() {}
 
-func (x *AnalyzeRequest) ProtoReflect() protoreflect.Message {
+func (x *PatchTodayTrackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -319,221 +385,184 @@ func (x *AnalyzeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeRequest.ProtoReflect.Descriptor instead.
-func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PatchTodayTrackRequest.ProtoReflect.Descriptor instead.
+func (*PatchTodayTrackRequest) Descriptor() ([]byte, []int) {
 	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AnalyzeRequest) GetUserTz() string {
+func (x *PatchTodayTrackRequest) GetUserTz() string {
 	if x != nil {
 		return x.UserTz
 	}
 	return ""
 }
 
-func (x *AnalyzeRequest) GetWeekStarts() string {
-	if x != nil {
-		return x.WeekStarts
+func (x *PatchTodayTrackRequest) GetSleepHours() float64 {
+	if x != nil && x.SleepHours != nil {
+		return *x.SleepHours
 	}
-	return ""
+	return 0
 }
 
-func (x *AnalyzeRequest) GetConstraints() *Constraints {
-	if x != nil {
-		return x.Constraints
+func (x *PatchTodayTrackRequest) GetSleepStart() string {
+	if x != nil && x.SleepStart != nil {
+		return *x.SleepStart
 	}
-	return nil
+	return ""
 }
 
-func (x *AnalyzeRequest) GetPeriod() Period {
-	if x != nil {
-		return x.Period
+func (x *PatchTodayTrackRequest) GetSleepEnd() string {
+	if x != nil && x.SleepEnd != nil {
+		return *x.SleepEnd
 	}
-	return Period_PERIOD_UNSPECIFIED
-}
-
-type TrackPoint struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ts             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=ts,proto3" json:"ts,omitempty"`
-	SleepHours     float64                `protobuf:"fixed64,2,opt,name=sleep_hours,json=sleepHours,proto3" json:"sleep_hours,omitempty"`
-	SleepStart     string                 `protobuf:"bytes,14,opt,name=sleep_start,json=sleepStart,proto3" json:"sleep_start,omitempty"`
-	SleepEnd       string                 `protobuf:"bytes,15,opt,name=sleep_end,json=sleepEnd,proto3" json:"sleep_end,omitempty"`
-	Mood           float64                `protobuf:"fixed64,3,opt,name=mood,proto3" json:"mood,omitempty"`
-	Activity       float64                `protobuf:"fixed64,4,opt,name=activity,proto3" json:"activity,omitempty"`
-	Productive     float64                `protobuf:"fixed64,5,opt,name=productive,proto3" json:"productive,omitempty"`
-	Stress         float64                `protobuf:"fixed64,6,opt,name=stress,proto3" json:"stress,omitempty"`
-	Energy         float64                `protobuf:"fixed64,7,opt,name=energy,proto3" json:"energy,omitempty"`
-	Concentration  float64                `protobuf:"fixed64,8,opt,name=concentration,proto3" json:"concentration,omitempty"`
-	SleepQuality   float64                `protobuf:"fixed64,9,opt,name=sleep_quality,json=sleepQuality,proto3" json:"sleep_quality,omitempty"`
-	Caffeine       bool                   `protobuf:"varint,10,opt,name=caffeine,proto3" json:"caffeine,omitempty"`
-	Alcohol        bool                   `protobuf:"varint,11,opt,name=alcohol,proto3" json:"alcohol,omitempty"`
-	Workout        bool                   `protobuf:"varint,12,opt,name=workout,proto3" json:"workout,omitempty"`
-	LlmText        string                 `protobuf:"bytes,13,opt,name=llm_text,json=llmText,proto3" json:"llm_text,omitempty"`
-	AnalysisStatus string                 `protobuf:"bytes,16,opt,name=analysis_status,json=analysisStatus,proto3" json:"analysis_status,omitempty"`
+	return ""
 }
 
-func (x *TrackPoint) Reset() {
-	*x = TrackPoint{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PatchTodayTrackRequest) GetMood() float64 {
+	if x != nil && x.Mood != nil {
+		return *x.Mood
 	}
+	return 0
 }
 
-func (x *TrackPoint) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TrackPoint) ProtoMessage() {}
-
-func (x *TrackPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PatchTodayTrackRequest) GetActivity() float64 {
+	if x != nil && x.Activity != nil {
+		return *x.Activity
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TrackPoint.ProtoReflect.Descriptor instead.
-func (*TrackPoint) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{5}
+	return 0
 }
 
-func (x *TrackPoint) GetTs() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Ts
+func (x *PatchTodayTrackRequest) GetProductive() float64 {
+	if x != nil && x.Productive != nil {
+		return *x.Productive
 	}
-	return nil
+	return 0
 }
 
-func (x *TrackPoint) GetSleepHours() float64 {
-	if x != nil {
-		return x.SleepHours
+func (x *PatchTodayTrackRequest) GetStress() float64 {
+	if x != nil && x.Stress != nil {
+		return *x.Stress
 	}
 	return 0
 }
 
-func (x *TrackPoint) GetSleepStart() string {
-	if x != nil {
-		return x.SleepStart
+func (x *PatchTodayTrackRequest) GetEnergy() float64 {
+	if x != nil && x.Energy != nil {
+		return *x.Energy
 	}
-	return ""
+	return 0
 }
 
-func (x *TrackPoint) GetSleepEnd() string {
-	if x != nil {
-		return x.SleepEnd
+func (x *PatchTodayTrackRequest) GetConcentration() float64 {
+	if x != nil && x.Concentration != nil {
+		return *x.Concentration
 	}
-	return ""
+	return 0
 }
 
-func (x *TrackPoint) GetMood() float64 {
-	if x != nil {
-		return x.Mood
+func (x *PatchTodayTrackRequest) GetSleepQuality() float64 {
+	if x != nil && x.SleepQuality != nil {
+		return *x.SleepQuality
 	}
 	return 0
 }
 
-func (x *TrackPoint) GetActivity() float64 {
-	if x != nil {
-		return x.Activity
+func (x *PatchTodayTrackRequest) GetCaffeine() bool {
+	if x != nil && x.Caffeine != nil {
+		return *x.Caffeine
 	}
-	return 0
+	return false
 }
 
-func (x *TrackPoint) GetProductive() float64 {
-	if x != nil {
-		return x.Productive
+func (x *PatchTodayTrackRequest) GetAlcohol() bool {
+	if x != nil && x.Alcohol != nil {
+		return *x.Alcohol
 	}
-	return 0
+	return false
 }
 
-func (x *TrackPoint) GetStress() float64 {
-	if x != nil {
-		return x.Stress
+func (x *PatchTodayTrackRequest) GetWorkout() bool {
+	if x != nil && x.Workout != nil {
+		return *x.Workout
 	}
-	return 0
+	return false
 }
 
-func (x *TrackPoint) GetEnergy() float64 {
-	if x != nil {
-		return x.Energy
+func (x *PatchTodayTrackRequest) GetNapMinutes() int32 {
+	if x != nil && x.NapMinutes != nil {
+		return *x.NapMinutes
 	}
 	return 0
 }
 
-func (x *TrackPoint) GetConcentration() float64 {
-	if x != nil {
-		return x.Concentration
-	}
-	return 0
+type PatchTodayTrackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated bool        `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	Point   *TrackPoint `protobuf:"bytes,2,opt,name=point,proto3" json:"point,omitempty"`
 }
 
-func (x *TrackPoint) GetSleepQuality() float64 {
-	if x != nil {
-		return x.SleepQuality
+func (x *PatchTodayTrackResponse) Reset() {
+	*x = PatchTodayTrackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *TrackPoint) GetCaffeine() bool {
-	if x != nil {
-		return x.Caffeine
-	}
-	return false
+func (x *PatchTodayTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TrackPoint) GetAlcohol() bool {
-	if x != nil {
-		return x.Alcohol
+func (*PatchTodayTrackResponse) ProtoMessage() {}
+
+func (x *PatchTodayTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *TrackPoint) GetWorkout() bool {
-	if x != nil {
-		return x.Workout
-	}
-	return false
+// Deprecated: Use PatchTodayTrackResponse.ProtoReflect.Descriptor instead.
+func (*PatchTodayTrackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *TrackPoint) GetLlmText() string {
+func (x *PatchTodayTrackResponse) GetUpdated() bool {
 	if x != nil {
-		return x.LlmText
+		return x.Updated
 	}
-	return ""
+	return false
 }
 
-func (x *TrackPoint) GetAnalysisStatus() string {
+func (x *PatchTodayTrackResponse) GetPoint() *TrackPoint {
 	if x != nil {
-		return x.AnalysisStatus
+		return x.Point
 	}
-	return ""
+	return nil
 }
 
-type UserProfile struct {
+// Day analysis for one past local day; it does not replace the latest "day" analysis.
+type AnalysisForDateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   int32  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email    string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Emoji    string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
-	BgIndex  int32  `protobuf:"varint,5,opt,name=bg_index,json=bgIndex,proto3" json:"bg_index,omitempty"`
-	IsFriend bool   `protobuf:"varint,6,opt,name=is_friend,json=isFriend,proto3" json:"is_friend,omitempty"`
+	UserTz         string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+	Date           string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                                            // YYYY-MM-DD in user_tz, not in the future
+	IncludeInsight bool   `protobuf:"varint,3,opt,name=include_insight,json=includeInsight,proto3" json:"include_insight,omitempty"` // false returns only the numeric blocks
 }
 
-func (x *UserProfile) Reset() {
-	*x = UserProfile{}
+func (x *AnalysisForDateRequest) Reset() {
+	*x = AnalysisForDateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -541,13 +570,13 @@ func (x *UserProfile) Reset() {
 	}
 }
 
-func (x *UserProfile) String() string {
+func (x *AnalysisForDateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfile) ProtoMessage() {}
+func (*AnalysisForDateRequest) ProtoMessage() {}
 
-func (x *UserProfile) ProtoReflect() protoreflect.Message {
+func (x *AnalysisForDateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,67 +588,59 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
-func (*UserProfile) Descriptor() ([]byte, []int) {
+// Deprecated: Use AnalysisForDateRequest.ProtoReflect.Descriptor instead.
+func (*AnalysisForDateRequest) Descriptor() ([]byte, []int) {
 	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UserProfile) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *UserProfile) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UserProfile) GetEmail() string {
+func (x *AnalysisForDateRequest) GetUserTz() string {
 	if x != nil {
-		return x.Email
+		return x.UserTz
 	}
 	return ""
 }
 
-func (x *UserProfile) GetEmoji() string {
+func (x *AnalysisForDateRequest) GetDate() string {
 	if x != nil {
-		return x.Emoji
+		return x.Date
 	}
 	return ""
 }
 
-func (x *UserProfile) GetBgIndex() int32 {
-	if x != nil {
-		return x.BgIndex
-	}
-	return 0
-}
-
-func (x *UserProfile) GetIsFriend() bool {
+func (x *AnalysisForDateRequest) GetIncludeInsight() bool {
 	if x != nil {
-		return x.IsFriend
+		return x.IncludeInsight
 	}
 	return false
 }
 
-type FriendRequest struct {
+type AnalyzeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	From      *UserProfile           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To        *UserProfile           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UserTz             string       `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+	WeekStarts         string       `protobuf:"bytes,2,opt,name=week_starts,json=weekStarts,proto3" json:"week_starts,omitempty"`
+	Constraints        *Constraints `protobuf:"bytes,3,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	Period             Period       `protobuf:"varint,4,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+	BurnoutHorizonDays int32        `protobuf:"varint,5,opt,name=burnout_horizon_days,json=burnoutHorizonDays,proto3" json:"burnout_horizon_days,omitempty"` // 0 = default (14); trend lookbacks use the same window
+	Model              string       `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`                                                        // optional LLM model override, e.g. deepseek-reasoner; empty = server default
+	Tone               Tone         `protobuf:"varint,7,opt,name=tone,proto3,enum=nexusai.v1.Tone" json:"tone,omitempty"`
+	Tags               []string     `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                   // analyze only points carrying all of these tags (normalized like TrackPoint.tags); the result is not stored as the latest analysis
+	RollingDays        int32        `protobuf:"varint,9,opt,name=rolling_days,json=rollingDays,proto3" json:"rolling_days,omitempty"` // > 0: analyze the last N days up to now (max 365) instead of period; not stored as the latest analysis
+	// Decimal places of scores and averages in the response, 0..6; unset = 2. Only the returned copy is rounded.
+	Precision *int32 `protobuf:"varint,10,opt,name=precision,proto3,oneof" json:"precision,omitempty"`
+	// Leave out whole days where any point carries one of these tags (e.g. vacation, sick); not stored as the latest analysis.
+	ExcludeTags []string `protobuf:"bytes,11,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags,omitempty"`
+	// Leave out days whose energy score is an outlier among the period's days; not stored as the latest analysis.
+	ExcludeOutliers bool `protobuf:"varint,12,opt,name=exclude_outliers,json=excludeOutliers,proto3" json:"exclude_outliers,omitempty"`
+	// Top-level AnalyzeResponse fields to return, e.g. ["energy_by_weekday", "burnout_risk"]; empty = all.
+	// Without llm_insight no insight is generated. A masked result is not stored as the latest analysis.
+	Fields []string `protobuf:"bytes,13,rep,name=fields,proto3" json:"fields,omitempty"`
 }
 
-func (x *FriendRequest) Reset() {
-	*x = FriendRequest{}
+func (x *AnalyzeRequest) Reset() {
+	*x = AnalyzeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -627,13 +648,13 @@ func (x *FriendRequest) Reset() {
 	}
 }
 
-func (x *FriendRequest) String() string {
+func (x *AnalyzeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FriendRequest) ProtoMessage() {}
+func (*AnalyzeRequest) ProtoMessage() {}
 
-func (x *FriendRequest) ProtoReflect() protoreflect.Message {
+func (x *AnalyzeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,54 +666,114 @@ func (x *FriendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
-func (*FriendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AnalyzeRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
 	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *FriendRequest) GetId() int64 {
+func (x *AnalyzeRequest) GetUserTz() string {
 	if x != nil {
-		return x.Id
+		return x.UserTz
 	}
-	return 0
+	return ""
 }
 
-func (x *FriendRequest) GetFrom() *UserProfile {
+func (x *AnalyzeRequest) GetWeekStarts() string {
 	if x != nil {
-		return x.From
+		return x.WeekStarts
 	}
-	return nil
+	return ""
 }
 
-func (x *FriendRequest) GetTo() *UserProfile {
+func (x *AnalyzeRequest) GetConstraints() *Constraints {
 	if x != nil {
-		return x.To
+		return x.Constraints
 	}
 	return nil
 }
 
-func (x *FriendRequest) GetStatus() string {
+func (x *AnalyzeRequest) GetPeriod() Period {
 	if x != nil {
-		return x.Status
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+func (x *AnalyzeRequest) GetBurnoutHorizonDays() int32 {
+	if x != nil {
+		return x.BurnoutHorizonDays
+	}
+	return 0
+}
+
+func (x *AnalyzeRequest) GetModel() string {
+	if x != nil {
+		return x.Model
 	}
 	return ""
 }
 
-func (x *FriendRequest) GetCreatedAt() *timestamppb.Timestamp {
+func (x *AnalyzeRequest) GetTone() Tone {
 	if x != nil {
-		return x.CreatedAt
+		return x.Tone
+	}
+	return Tone_TONE_UNSPECIFIED
+}
+
+func (x *AnalyzeRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-type GetMyProfileRequest struct {
+func (x *AnalyzeRequest) GetRollingDays() int32 {
+	if x != nil {
+		return x.RollingDays
+	}
+	return 0
+}
+
+func (x *AnalyzeRequest) GetPrecision() int32 {
+	if x != nil && x.Precision != nil {
+		return *x.Precision
+	}
+	return 0
+}
+
+func (x *AnalyzeRequest) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *AnalyzeRequest) GetExcludeOutliers() bool {
+	if x != nil {
+		return x.ExcludeOutliers
+	}
+	return false
+}
+
+func (x *AnalyzeRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type RegenerateInsightRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserTz   string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+	Period   Period `protobuf:"varint,2,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+	Feedback string `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"` // what was unhelpful about the previous insight, up to 500 chars
 }
 
-func (x *GetMyProfileRequest) Reset() {
-	*x = GetMyProfileRequest{}
+func (x *RegenerateInsightRequest) Reset() {
+	*x = RegenerateInsightRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,13 +781,13 @@ func (x *GetMyProfileRequest) Reset() {
 	}
 }
 
-func (x *GetMyProfileRequest) String() string {
+func (x *RegenerateInsightRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyProfileRequest) ProtoMessage() {}
+func (*RegenerateInsightRequest) ProtoMessage() {}
 
-func (x *GetMyProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *RegenerateInsightRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -718,21 +799,67 @@ func (x *GetMyProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetMyProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegenerateInsightRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateInsightRequest) Descriptor() ([]byte, []int) {
 	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{8}
 }
 
-type GetMyProfileResponse struct {
+func (x *RegenerateInsightRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+func (x *RegenerateInsightRequest) GetPeriod() Period {
+	if x != nil {
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+func (x *RegenerateInsightRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+// Unset numeric fields mean "not provided" and are stored as NULL, distinct from a real 0.
+type TrackPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profile *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Ts             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=ts,proto3" json:"ts,omitempty"`
+	SleepHours     *float64               `protobuf:"fixed64,2,opt,name=sleep_hours,json=sleepHours,proto3,oneof" json:"sleep_hours,omitempty"`
+	SleepStart     string                 `protobuf:"bytes,14,opt,name=sleep_start,json=sleepStart,proto3" json:"sleep_start,omitempty"`
+	SleepEnd       string                 `protobuf:"bytes,15,opt,name=sleep_end,json=sleepEnd,proto3" json:"sleep_end,omitempty"`
+	Mood           *float64               `protobuf:"fixed64,3,opt,name=mood,proto3,oneof" json:"mood,omitempty"`
+	Activity       *float64               `protobuf:"fixed64,4,opt,name=activity,proto3,oneof" json:"activity,omitempty"`
+	Productive     *float64               `protobuf:"fixed64,5,opt,name=productive,proto3,oneof" json:"productive,omitempty"`
+	Stress         *float64               `protobuf:"fixed64,6,opt,name=stress,proto3,oneof" json:"stress,omitempty"`
+	Energy         *float64               `protobuf:"fixed64,7,opt,name=energy,proto3,oneof" json:"energy,omitempty"`
+	Concentration  *float64               `protobuf:"fixed64,8,opt,name=concentration,proto3,oneof" json:"concentration,omitempty"`
+	SleepQuality   *float64               `protobuf:"fixed64,9,opt,name=sleep_quality,json=sleepQuality,proto3,oneof" json:"sleep_quality,omitempty"`
+	Caffeine       bool                   `protobuf:"varint,10,opt,name=caffeine,proto3" json:"caffeine,omitempty"`
+	Alcohol        bool                   `protobuf:"varint,11,opt,name=alcohol,proto3" json:"alcohol,omitempty"`
+	Workout        bool                   `protobuf:"varint,12,opt,name=workout,proto3" json:"workout,omitempty"`
+	LlmText        string                 `protobuf:"bytes,13,opt,name=llm_text,json=llmText,proto3" json:"llm_text,omitempty"`
+	AnalysisStatus string                 `protobuf:"bytes,16,opt,name=analysis_status,json=analysisStatus,proto3" json:"analysis_status,omitempty"`
+	// Exact sleep interval from wearables; when both are set they override sleep_hours and the HH:MM fields.
+	SleepStartTs *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=sleep_start_ts,json=sleepStartTs,proto3" json:"sleep_start_ts,omitempty"`
+	SleepEndTs   *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=sleep_end_ts,json=sleepEndTs,proto3" json:"sleep_end_ts,omitempty"`
+	Tags         []string               `protobuf:"bytes,19,rep,name=tags,proto3" json:"tags,omitempty"`                                // lowercased; letters of any script, digits, "-" and "_", up to 32 chars each, at most 10 per point; duplicates are dropped
+	NapMinutes   int32                  `protobuf:"varint,20,opt,name=nap_minutes,json=napMinutes,proto3" json:"nap_minutes,omitempty"` // daytime sleep, 0..300; 0 = no nap
+	// User-defined categorical factors, e.g. {"weather": "rain"}. Keys are trimmed and lowercased and may hold
+	// only letters of any script, digits, "-" and "_", up to 32 chars each; values are trimmed and pairs with an
+	// empty value are dropped. At most 10 keys, values up to 64 characters, 1 KB in total.
+	Context map[string]string `protobuf:"bytes,21,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *GetMyProfileResponse) Reset() {
-	*x = GetMyProfileResponse{}
+func (x *TrackPoint) Reset() {
+	*x = TrackPoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,13 +867,13 @@ func (x *GetMyProfileResponse) Reset() {
 	}
 }
 
-func (x *GetMyProfileResponse) String() string {
+func (x *TrackPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyProfileResponse) ProtoMessage() {}
+func (*TrackPoint) ProtoMessage() {}
 
-func (x *GetMyProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *TrackPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -758,137 +885,192 @@ func (x *GetMyProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetMyProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackPoint.ProtoReflect.Descriptor instead.
+func (*TrackPoint) Descriptor() ([]byte, []int) {
 	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetMyProfileResponse) GetProfile() *UserProfile {
+func (x *TrackPoint) GetTs() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Profile
+		return x.Ts
 	}
 	return nil
 }
 
-type GetUserProfileRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TrackPoint) GetSleepHours() float64 {
+	if x != nil && x.SleepHours != nil {
+		return *x.SleepHours
+	}
+	return 0
+}
 
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (x *TrackPoint) GetSleepStart() string {
+	if x != nil {
+		return x.SleepStart
+	}
+	return ""
 }
 
-func (x *GetUserProfileRequest) Reset() {
-	*x = GetUserProfileRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TrackPoint) GetSleepEnd() string {
+	if x != nil {
+		return x.SleepEnd
 	}
+	return ""
 }
 
-func (x *GetUserProfileRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TrackPoint) GetMood() float64 {
+	if x != nil && x.Mood != nil {
+		return *x.Mood
+	}
+	return 0
 }
 
-func (*GetUserProfileRequest) ProtoMessage() {}
+func (x *TrackPoint) GetActivity() float64 {
+	if x != nil && x.Activity != nil {
+		return *x.Activity
+	}
+	return 0
+}
 
-func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TrackPoint) GetProductive() float64 {
+	if x != nil && x.Productive != nil {
+		return *x.Productive
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{10}
+func (x *TrackPoint) GetStress() float64 {
+	if x != nil && x.Stress != nil {
+		return *x.Stress
+	}
+	return 0
 }
 
-func (x *GetUserProfileRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
+func (x *TrackPoint) GetEnergy() float64 {
+	if x != nil && x.Energy != nil {
+		return *x.Energy
 	}
 	return 0
 }
 
-type GetUserProfileResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TrackPoint) GetConcentration() float64 {
+	if x != nil && x.Concentration != nil {
+		return *x.Concentration
+	}
+	return 0
+}
 
-	Profile *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+func (x *TrackPoint) GetSleepQuality() float64 {
+	if x != nil && x.SleepQuality != nil {
+		return *x.SleepQuality
+	}
+	return 0
 }
 
-func (x *GetUserProfileResponse) Reset() {
-	*x = GetUserProfileResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TrackPoint) GetCaffeine() bool {
+	if x != nil {
+		return x.Caffeine
 	}
+	return false
 }
 
-func (x *GetUserProfileResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TrackPoint) GetAlcohol() bool {
+	if x != nil {
+		return x.Alcohol
+	}
+	return false
 }
 
-func (*GetUserProfileResponse) ProtoMessage() {}
+func (x *TrackPoint) GetWorkout() bool {
+	if x != nil {
+		return x.Workout
+	}
+	return false
+}
 
-func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TrackPoint) GetLlmText() string {
+	if x != nil {
+		return x.LlmText
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{11}
+func (x *TrackPoint) GetAnalysisStatus() string {
+	if x != nil {
+		return x.AnalysisStatus
+	}
+	return ""
 }
 
-func (x *GetUserProfileResponse) GetProfile() *UserProfile {
+func (x *TrackPoint) GetSleepStartTs() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Profile
+		return x.SleepStartTs
 	}
 	return nil
 }
 
-type GetUserLastAnalysesRequest struct {
+func (x *TrackPoint) GetSleepEndTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SleepEndTs
+	}
+	return nil
+}
+
+func (x *TrackPoint) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TrackPoint) GetNapMinutes() int32 {
+	if x != nil {
+		return x.NapMinutes
+	}
+	return 0
+}
+
+func (x *TrackPoint) GetContext() map[string]string {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type UserProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId          int32  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email           string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Emoji           string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	BgIndex         int32  `protobuf:"varint,5,opt,name=bg_index,json=bgIndex,proto3" json:"bg_index,omitempty"`
+	IsFriend        bool   `protobuf:"varint,6,opt,name=is_friend,json=isFriend,proto3" json:"is_friend,omitempty"`
+	DayStartHour    int32  `protobuf:"varint,7,opt,name=day_start_hour,json=dayStartHour,proto3" json:"day_start_hour,omitempty"`         // local hour the user's day starts at; 0 = midnight
+	Tone            Tone   `protobuf:"varint,8,opt,name=tone,proto3,enum=nexusai.v1.Tone" json:"tone,omitempty"`                          // saved default insight tone
+	ShareAnalyses   bool   `protobuf:"varint,9,opt,name=share_analyses,json=shareAnalyses,proto3" json:"share_analyses,omitempty"`        // friends may see the stored analyses; off by default
+	AnalysesEnabled bool   `protobuf:"varint,10,opt,name=analyses_enabled,json=analysesEnabled,proto3" json:"analyses_enabled,omitempty"` // false: no background analyses and no LLM insight, numbers only; on by default
 }
 
-func (x *GetUserLastAnalysesRequest) Reset() {
-	*x = GetUserLastAnalysesRequest{}
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[12]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserLastAnalysesRequest) String() string {
+func (x *UserProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLastAnalysesRequest) ProtoMessage() {}
+func (*UserProfile) ProtoMessage() {}
 
-func (x *GetUserLastAnalysesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[12]
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,98 +1081,110 @@ func (x *GetUserLastAnalysesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLastAnalysesRequest.ProtoReflect.Descriptor instead.
-func (*GetUserLastAnalysesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetUserLastAnalysesRequest) GetUserId() int32 {
+func (x *UserProfile) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type UpdateProfileRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UserProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-	Emoji   string `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
-	BgIndex int32  `protobuf:"varint,2,opt,name=bg_index,json=bgIndex,proto3" json:"bg_index,omitempty"`
+func (x *UserProfile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (x *UpdateProfileRequest) Reset() {
-	*x = UpdateProfileRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserProfile) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
 	}
+	return ""
 }
 
-func (x *UpdateProfileRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserProfile) GetBgIndex() int32 {
+	if x != nil {
+		return x.BgIndex
+	}
+	return 0
 }
 
-func (*UpdateProfileRequest) ProtoMessage() {}
+func (x *UserProfile) GetIsFriend() bool {
+	if x != nil {
+		return x.IsFriend
+	}
+	return false
+}
 
-func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserProfile) GetDayStartHour() int32 {
+	if x != nil {
+		return x.DayStartHour
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{13}
+func (x *UserProfile) GetTone() Tone {
+	if x != nil {
+		return x.Tone
+	}
+	return Tone_TONE_UNSPECIFIED
 }
 
-func (x *UpdateProfileRequest) GetEmoji() string {
+func (x *UserProfile) GetShareAnalyses() bool {
 	if x != nil {
-		return x.Emoji
+		return x.ShareAnalyses
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateProfileRequest) GetBgIndex() int32 {
+func (x *UserProfile) GetAnalysesEnabled() bool {
 	if x != nil {
-		return x.BgIndex
+		return x.AnalysesEnabled
 	}
-	return 0
+	return false
 }
 
-type UpdateProfileResponse struct {
+type FriendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profile *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	From      *UserProfile           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        *UserProfile           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *UpdateProfileResponse) Reset() {
-	*x = UpdateProfileResponse{}
+func (x *FriendRequest) Reset() {
+	*x = FriendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[14]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProfileResponse) String() string {
+func (x *FriendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileResponse) ProtoMessage() {}
+func (*FriendRequest) ProtoMessage() {}
 
-func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[14]
+func (x *FriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,43 +1195,69 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
+func (*FriendRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateProfileResponse) GetProfile() *UserProfile {
+func (x *FriendRequest) GetId() int64 {
 	if x != nil {
-		return x.Profile
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FriendRequest) GetFrom() *UserProfile {
+	if x != nil {
+		return x.From
 	}
 	return nil
 }
 
-type SearchUsersRequest struct {
+func (x *FriendRequest) GetTo() *UserProfile {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *FriendRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FriendRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetMyProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *SearchUsersRequest) Reset() {
-	*x = SearchUsersRequest{}
+func (x *GetMyProfileRequest) Reset() {
+	*x = GetMyProfileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[15]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUsersRequest) String() string {
+func (x *GetMyProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersRequest) ProtoMessage() {}
+func (*GetMyProfileRequest) ProtoMessage() {}
 
-func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[15]
+func (x *GetMyProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,43 +1268,36 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
-func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *SearchUsersRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
+// Deprecated: Use GetMyProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetMyProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{12}
 }
 
-type SearchUsersResponse struct {
+type GetMyProfileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*UserProfile `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Profile *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
-func (x *SearchUsersResponse) Reset() {
-	*x = SearchUsersResponse{}
+func (x *GetMyProfileResponse) Reset() {
+	*x = GetMyProfileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[16]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUsersResponse) String() string {
+func (x *GetMyProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersResponse) ProtoMessage() {}
+func (*GetMyProfileResponse) ProtoMessage() {}
 
-func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[16]
+func (x *GetMyProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,41 +1308,43 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
-func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetMyProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetMyProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SearchUsersResponse) GetUsers() []*UserProfile {
+func (x *GetMyProfileResponse) GetProfile() *UserProfile {
 	if x != nil {
-		return x.Users
+		return x.Profile
 	}
 	return nil
 }
 
-type ListFriendsRequest struct {
+type GetUserProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *ListFriendsRequest) Reset() {
-	*x = ListFriendsRequest{}
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[17]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListFriendsRequest) String() string {
+func (x *GetUserProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFriendsRequest) ProtoMessage() {}
+func (*GetUserProfileRequest) ProtoMessage() {}
 
-func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[17]
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,36 +1355,43 @@ func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFriendsRequest.ProtoReflect.Descriptor instead.
-func (*ListFriendsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{14}
 }
 
-type ListFriendsResponse struct {
+func (x *GetUserProfileRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserProfileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Friends []*UserProfile `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	Profile *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
-func (x *ListFriendsResponse) Reset() {
-	*x = ListFriendsResponse{}
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[18]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListFriendsResponse) String() string {
+func (x *GetUserProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFriendsResponse) ProtoMessage() {}
+func (*GetUserProfileResponse) ProtoMessage() {}
 
-func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[18]
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,43 +1402,43 @@ func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFriendsResponse.ProtoReflect.Descriptor instead.
-func (*ListFriendsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListFriendsResponse) GetFriends() []*UserProfile {
+func (x *GetUserProfileResponse) GetProfile() *UserProfile {
 	if x != nil {
-		return x.Friends
+		return x.Profile
 	}
 	return nil
 }
 
-type ListFriendRequestsRequest struct {
+type GetUserLastAnalysesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *ListFriendRequestsRequest) Reset() {
-	*x = ListFriendRequestsRequest{}
+func (x *GetUserLastAnalysesRequest) Reset() {
+	*x = GetUserLastAnalysesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[19]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListFriendRequestsRequest) String() string {
+func (x *GetUserLastAnalysesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFriendRequestsRequest) ProtoMessage() {}
+func (*GetUserLastAnalysesRequest) ProtoMessage() {}
 
-func (x *ListFriendRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[19]
+func (x *GetUserLastAnalysesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,43 +1449,50 @@ func (x *ListFriendRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFriendRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListFriendRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetUserLastAnalysesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLastAnalysesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListFriendRequestsRequest) GetStatus() string {
+func (x *GetUserLastAnalysesRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-type ListFriendRequestsResponse struct {
+// Recomputes the period with proposed, unsaved scoring settings next to the current ones. Nothing is persisted.
+type PreviewWithSettingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requests []*FriendRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	UserTz string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"` // empty = saved timezone
+	Period Period `protobuf:"varint,2,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+	// Energy score overrides on top of the service defaults: sleep, sleep_quality, mood, activity, self_energy,
+	// focus (weights, renormalized to 1) and caffeine, alcohol, workout, nap (points).
+	EnergyParams map[string]float64 `protobuf:"bytes,3,rep,name=energy_params,json=energyParams,proto3" json:"energy_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Productivity component weights (keys of ProductivityModel.weights), renormalized to 1.
+	ProductivityWeights map[string]float64 `protobuf:"bytes,4,rep,name=productivity_weights,json=productivityWeights,proto3" json:"productivity_weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 }
 
-func (x *ListFriendRequestsResponse) Reset() {
-	*x = ListFriendRequestsResponse{}
+func (x *PreviewWithSettingsRequest) Reset() {
+	*x = PreviewWithSettingsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[20]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListFriendRequestsResponse) String() string {
+func (x *PreviewWithSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFriendRequestsResponse) ProtoMessage() {}
+func (*PreviewWithSettingsRequest) ProtoMessage() {}
 
-func (x *ListFriendRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[20]
+func (x *PreviewWithSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,43 +1503,67 @@ func (x *ListFriendRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFriendRequestsResponse.ProtoReflect.Descriptor instead.
-func (*ListFriendRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PreviewWithSettingsRequest.ProtoReflect.Descriptor instead.
+func (*PreviewWithSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListFriendRequestsResponse) GetRequests() []*FriendRequest {
+func (x *PreviewWithSettingsRequest) GetUserTz() string {
 	if x != nil {
-		return x.Requests
+		return x.UserTz
+	}
+	return ""
+}
+
+func (x *PreviewWithSettingsRequest) GetPeriod() Period {
+	if x != nil {
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+func (x *PreviewWithSettingsRequest) GetEnergyParams() map[string]float64 {
+	if x != nil {
+		return x.EnergyParams
 	}
 	return nil
 }
 
-type SendFriendRequestRequest struct {
+func (x *PreviewWithSettingsRequest) GetProductivityWeights() map[string]float64 {
+	if x != nil {
+		return x.ProductivityWeights
+	}
+	return nil
+}
+
+type ScorePreview struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ToUserId int32 `protobuf:"varint,1,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	AvgEnergyScore    float64 `protobuf:"fixed64,1,opt,name=avg_energy_score,json=avgEnergyScore,proto3" json:"avg_energy_score,omitempty"`
+	ProductivityScore float64 `protobuf:"fixed64,2,opt,name=productivity_score,json=productivityScore,proto3" json:"productivity_score,omitempty"`
+	BurnoutScore      float64 `protobuf:"fixed64,3,opt,name=burnout_score,json=burnoutScore,proto3" json:"burnout_score,omitempty"`
+	BurnoutLevel      string  `protobuf:"bytes,4,opt,name=burnout_level,json=burnoutLevel,proto3" json:"burnout_level,omitempty"`
 }
 
-func (x *SendFriendRequestRequest) Reset() {
-	*x = SendFriendRequestRequest{}
+func (x *ScorePreview) Reset() {
+	*x = ScorePreview{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[21]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendFriendRequestRequest) String() string {
+func (x *ScorePreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendFriendRequestRequest) ProtoMessage() {}
+func (*ScorePreview) ProtoMessage() {}
 
-func (x *SendFriendRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[21]
+func (x *ScorePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,43 +1574,66 @@ func (x *SendFriendRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendFriendRequestRequest.ProtoReflect.Descriptor instead.
-func (*SendFriendRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ScorePreview.ProtoReflect.Descriptor instead.
+func (*ScorePreview) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SendFriendRequestRequest) GetToUserId() int32 {
+func (x *ScorePreview) GetAvgEnergyScore() float64 {
 	if x != nil {
-		return x.ToUserId
+		return x.AvgEnergyScore
 	}
 	return 0
 }
 
-type SendFriendRequestResponse struct {
+func (x *ScorePreview) GetProductivityScore() float64 {
+	if x != nil {
+		return x.ProductivityScore
+	}
+	return 0
+}
+
+func (x *ScorePreview) GetBurnoutScore() float64 {
+	if x != nil {
+		return x.BurnoutScore
+	}
+	return 0
+}
+
+func (x *ScorePreview) GetBurnoutLevel() string {
+	if x != nil {
+		return x.BurnoutLevel
+	}
+	return ""
+}
+
+type PreviewWithSettingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *FriendRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	NumPoints int32         `protobuf:"varint,1,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"`
+	Current   *ScorePreview `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	Proposed  *ScorePreview `protobuf:"bytes,3,opt,name=proposed,proto3" json:"proposed,omitempty"`
 }
 
-func (x *SendFriendRequestResponse) Reset() {
-	*x = SendFriendRequestResponse{}
+func (x *PreviewWithSettingsResponse) Reset() {
+	*x = PreviewWithSettingsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[22]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendFriendRequestResponse) String() string {
+func (x *PreviewWithSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendFriendRequestResponse) ProtoMessage() {}
+func (*PreviewWithSettingsResponse) ProtoMessage() {}
 
-func (x *SendFriendRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[22]
+func (x *PreviewWithSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1368,44 +1644,58 @@ func (x *SendFriendRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendFriendRequestResponse.ProtoReflect.Descriptor instead.
-func (*SendFriendRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PreviewWithSettingsResponse.ProtoReflect.Descriptor instead.
+func (*PreviewWithSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SendFriendRequestResponse) GetRequest() *FriendRequest {
+func (x *PreviewWithSettingsResponse) GetNumPoints() int32 {
 	if x != nil {
-		return x.Request
+		return x.NumPoints
+	}
+	return 0
+}
+
+func (x *PreviewWithSettingsResponse) GetCurrent() *ScorePreview {
+	if x != nil {
+		return x.Current
 	}
 	return nil
 }
 
-type RespondFriendRequestRequest struct {
+func (x *PreviewWithSettingsResponse) GetProposed() *ScorePreview {
+	if x != nil {
+		return x.Proposed
+	}
+	return nil
+}
+
+// Static catalog for UI tooltips, kept in sync with the scoring code.
+type MetricDefinitionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestId int64  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Action    string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // accept | decline
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"` // ru | en; anything else falls back to ru
 }
 
-func (x *RespondFriendRequestRequest) Reset() {
-	*x = RespondFriendRequestRequest{}
+func (x *MetricDefinitionsRequest) Reset() {
+	*x = MetricDefinitionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[23]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RespondFriendRequestRequest) String() string {
+func (x *MetricDefinitionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondFriendRequestRequest) ProtoMessage() {}
+func (*MetricDefinitionsRequest) ProtoMessage() {}
 
-func (x *RespondFriendRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[23]
+func (x *MetricDefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,50 +1706,47 @@ func (x *RespondFriendRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondFriendRequestRequest.ProtoReflect.Descriptor instead.
-func (*RespondFriendRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *RespondFriendRequestRequest) GetRequestId() int64 {
-	if x != nil {
-		return x.RequestId
-	}
-	return 0
+// Deprecated: Use MetricDefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*MetricDefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RespondFriendRequestRequest) GetAction() string {
+func (x *MetricDefinitionsRequest) GetLanguage() string {
 	if x != nil {
-		return x.Action
+		return x.Language
 	}
 	return ""
 }
 
-type RespondFriendRequestResponse struct {
+type MetricDefinition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Code        string  `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // stable: productivity weight key, burnout reason code, or energy_score | productivity_score | burnout_risk
+	Kind        string  `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // score | productivity_component | burnout_signal
+	Name        string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Weight      float64 `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"` // share of the productivity score, or risk points of a burnout signal; 0 for scores
 }
 
-func (x *RespondFriendRequestResponse) Reset() {
-	*x = RespondFriendRequestResponse{}
+func (x *MetricDefinition) Reset() {
+	*x = MetricDefinition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[24]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RespondFriendRequestResponse) String() string {
+func (x *MetricDefinition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondFriendRequestResponse) ProtoMessage() {}
+func (*MetricDefinition) ProtoMessage() {}
 
-func (x *RespondFriendRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[24]
+func (x *MetricDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,44 +1757,72 @@ func (x *RespondFriendRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondFriendRequestResponse.ProtoReflect.Descriptor instead.
-func (*RespondFriendRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use MetricDefinition.ProtoReflect.Descriptor instead.
+func (*MetricDefinition) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *RespondFriendRequestResponse) GetOk() bool {
+func (x *MetricDefinition) GetCode() string {
 	if x != nil {
-		return x.Ok
+		return x.Code
 	}
-	return false
+	return ""
 }
 
-type Constraints struct {
+func (x *MetricDefinition) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MetricDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetricDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MetricDefinition) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type MetricDefinitionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkStartHour int32 `protobuf:"varint,1,opt,name=work_start_hour,json=workStartHour,proto3" json:"work_start_hour,omitempty"`
-	WorkEndHour   int32 `protobuf:"varint,2,opt,name=work_end_hour,json=workEndHour,proto3" json:"work_end_hour,omitempty"`
+	Language    string              `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"` // language actually used
+	Definitions []*MetricDefinition `protobuf:"bytes,2,rep,name=definitions,proto3" json:"definitions,omitempty"`
 }
 
-func (x *Constraints) Reset() {
-	*x = Constraints{}
+func (x *MetricDefinitionsResponse) Reset() {
+	*x = MetricDefinitionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[25]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Constraints) String() string {
+func (x *MetricDefinitionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Constraints) ProtoMessage() {}
+func (*MetricDefinitionsResponse) ProtoMessage() {}
 
-func (x *Constraints) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[25]
+func (x *MetricDefinitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,55 +1833,53 @@ func (x *Constraints) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Constraints.ProtoReflect.Descriptor instead.
-func (*Constraints) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MetricDefinitionsResponse.ProtoReflect.Descriptor instead.
+func (*MetricDefinitionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Constraints) GetWorkStartHour() int32 {
+func (x *MetricDefinitionsResponse) GetLanguage() string {
 	if x != nil {
-		return x.WorkStartHour
+		return x.Language
 	}
-	return 0
+	return ""
 }
 
-func (x *Constraints) GetWorkEndHour() int32 {
+func (x *MetricDefinitionsResponse) GetDefinitions() []*MetricDefinition {
 	if x != nil {
-		return x.WorkEndHour
+		return x.Definitions
 	}
-	return 0
+	return nil
 }
 
-type AnalyzeResponse struct {
+// Most frequent words of the period's notes, stop words removed. No LLM involved.
+type NoteKeywordsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EnergyByWeekday   map[string]float64 `protobuf:"bytes,1,rep,name=energy_by_weekday,json=energyByWeekday,proto3" json:"energy_by_weekday,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	ProductivityModel *ProductivityModel `protobuf:"bytes,2,opt,name=productivity_model,json=productivityModel,proto3" json:"productivity_model,omitempty"`
-	BurnoutRisk       *BurnoutRisk       `protobuf:"bytes,3,opt,name=burnout_risk,json=burnoutRisk,proto3" json:"burnout_risk,omitempty"`
-	OptimalSchedule   *OptimalSchedule   `protobuf:"bytes,4,opt,name=optimal_schedule,json=optimalSchedule,proto3" json:"optimal_schedule,omitempty"`
-	LlmInsight        string             `protobuf:"bytes,5,opt,name=llm_insight,json=llmInsight,proto3" json:"llm_insight,omitempty"`
-	Debug             *structpb.Struct   `protobuf:"bytes,6,opt,name=debug,proto3" json:"debug,omitempty"`
+	UserTz string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"` // empty = saved timezone
+	Period Period `protobuf:"varint,2,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+	Limit  int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // 0 = 10, at most 50
 }
 
-func (x *AnalyzeResponse) Reset() {
-	*x = AnalyzeResponse{}
+func (x *NoteKeywordsRequest) Reset() {
+	*x = NoteKeywordsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[26]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnalyzeResponse) String() string {
+func (x *NoteKeywordsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeResponse) ProtoMessage() {}
+func (*NoteKeywordsRequest) ProtoMessage() {}
 
-func (x *AnalyzeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[26]
+func (x *NoteKeywordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,76 +1890,59 @@ func (x *AnalyzeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeResponse.ProtoReflect.Descriptor instead.
-func (*AnalyzeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *AnalyzeResponse) GetEnergyByWeekday() map[string]float64 {
-	if x != nil {
-		return x.EnergyByWeekday
-	}
-	return nil
-}
-
-func (x *AnalyzeResponse) GetProductivityModel() *ProductivityModel {
-	if x != nil {
-		return x.ProductivityModel
-	}
-	return nil
-}
-
-func (x *AnalyzeResponse) GetBurnoutRisk() *BurnoutRisk {
-	if x != nil {
-		return x.BurnoutRisk
-	}
-	return nil
+// Deprecated: Use NoteKeywordsRequest.ProtoReflect.Descriptor instead.
+func (*NoteKeywordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AnalyzeResponse) GetOptimalSchedule() *OptimalSchedule {
+func (x *NoteKeywordsRequest) GetUserTz() string {
 	if x != nil {
-		return x.OptimalSchedule
+		return x.UserTz
 	}
-	return nil
+	return ""
 }
 
-func (x *AnalyzeResponse) GetLlmInsight() string {
+func (x *NoteKeywordsRequest) GetPeriod() Period {
 	if x != nil {
-		return x.LlmInsight
+		return x.Period
 	}
-	return ""
+	return Period_PERIOD_UNSPECIFIED
 }
 
-func (x *AnalyzeResponse) GetDebug() *structpb.Struct {
+func (x *NoteKeywordsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Debug
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type LastAnalysesRequest struct {
+type NoteKeyword struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Word  string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`    // lower-cased, "ё" folded to "е"
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // occurrences
+	Notes int32  `protobuf:"varint,3,opt,name=notes,proto3" json:"notes,omitempty"` // notes containing the word
 }
 
-func (x *LastAnalysesRequest) Reset() {
-	*x = LastAnalysesRequest{}
+func (x *NoteKeyword) Reset() {
+	*x = NoteKeyword{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[27]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LastAnalysesRequest) String() string {
+func (x *NoteKeyword) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastAnalysesRequest) ProtoMessage() {}
+func (*NoteKeyword) ProtoMessage() {}
 
-func (x *LastAnalysesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[27]
+func (x *NoteKeyword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1657,36 +1953,58 @@ func (x *LastAnalysesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LastAnalysesRequest.ProtoReflect.Descriptor instead.
-func (*LastAnalysesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use NoteKeyword.ProtoReflect.Descriptor instead.
+func (*NoteKeyword) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{24}
 }
 
-type LastAnalysesResponse struct {
+func (x *NoteKeyword) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *NoteKeyword) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NoteKeyword) GetNotes() int32 {
+	if x != nil {
+		return x.Notes
+	}
+	return 0
+}
+
+type NoteKeywordsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*LastAnalysisEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NumNotes int32          `protobuf:"varint,1,opt,name=num_notes,json=numNotes,proto3" json:"num_notes,omitempty"` // non-empty notes in the period
+	Keywords []*NoteKeyword `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"`
 }
 
-func (x *LastAnalysesResponse) Reset() {
-	*x = LastAnalysesResponse{}
+func (x *NoteKeywordsResponse) Reset() {
+	*x = NoteKeywordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[28]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LastAnalysesResponse) String() string {
+func (x *NoteKeywordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastAnalysesResponse) ProtoMessage() {}
+func (*NoteKeywordsResponse) ProtoMessage() {}
 
-func (x *LastAnalysesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[28]
+func (x *NoteKeywordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,45 +2015,53 @@ func (x *LastAnalysesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LastAnalysesResponse.ProtoReflect.Descriptor instead.
-func (*LastAnalysesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use NoteKeywordsResponse.ProtoReflect.Descriptor instead.
+func (*NoteKeywordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *LastAnalysesResponse) GetEntries() []*LastAnalysisEntry {
+func (x *NoteKeywordsResponse) GetNumNotes() int32 {
 	if x != nil {
-		return x.Entries
+		return x.NumNotes
+	}
+	return 0
+}
+
+func (x *NoteKeywordsResponse) GetKeywords() []*NoteKeyword {
+	if x != nil {
+		return x.Keywords
 	}
 	return nil
 }
 
-type LastAnalysisEntry struct {
+// Average mood and energy per value of one TrackPoint.context key over the period.
+type ContextBreakdownRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Period    string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
-	Response  *AnalyzeResponse       `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserTz string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"` // empty = saved timezone
+	Period Period `protobuf:"varint,2,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"` // required
 }
 
-func (x *LastAnalysisEntry) Reset() {
-	*x = LastAnalysisEntry{}
+func (x *ContextBreakdownRequest) Reset() {
+	*x = ContextBreakdownRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[29]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LastAnalysisEntry) String() string {
+func (x *ContextBreakdownRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastAnalysisEntry) ProtoMessage() {}
+func (*ContextBreakdownRequest) ProtoMessage() {}
 
-func (x *LastAnalysisEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[29]
+func (x *ContextBreakdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1746,58 +2072,60 @@ func (x *LastAnalysisEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LastAnalysisEntry.ProtoReflect.Descriptor instead.
-func (*LastAnalysisEntry) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ContextBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*ContextBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *LastAnalysisEntry) GetPeriod() string {
+func (x *ContextBreakdownRequest) GetUserTz() string {
 	if x != nil {
-		return x.Period
+		return x.UserTz
 	}
 	return ""
 }
 
-func (x *LastAnalysisEntry) GetResponse() *AnalyzeResponse {
+func (x *ContextBreakdownRequest) GetPeriod() Period {
 	if x != nil {
-		return x.Response
+		return x.Period
 	}
-	return nil
+	return Period_PERIOD_UNSPECIFIED
 }
 
-func (x *LastAnalysisEntry) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ContextBreakdownRequest) GetKey() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type ProductivityModel struct {
+type ContextGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Weights map[string]float64 `protobuf:"bytes,1,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	Score   float64            `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Value     string  `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` // lower-cased
+	NumPoints int32   `protobuf:"varint,2,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"`
+	AvgMood   float64 `protobuf:"fixed64,3,opt,name=avg_mood,json=avgMood,proto3" json:"avg_mood,omitempty"`       // over points with mood filled in; 0 = none
+	AvgEnergy float64 `protobuf:"fixed64,4,opt,name=avg_energy,json=avgEnergy,proto3" json:"avg_energy,omitempty"` // energy score 0..100
 }
 
-func (x *ProductivityModel) Reset() {
-	*x = ProductivityModel{}
+func (x *ContextGroup) Reset() {
+	*x = ContextGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[30]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProductivityModel) String() string {
+func (x *ContextGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductivityModel) ProtoMessage() {}
+func (*ContextGroup) ProtoMessage() {}
 
-func (x *ProductivityModel) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[30]
+func (x *ContextGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,53 +2136,67 @@ func (x *ProductivityModel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductivityModel.ProtoReflect.Descriptor instead.
-func (*ProductivityModel) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ContextGroup.ProtoReflect.Descriptor instead.
+func (*ContextGroup) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ProductivityModel) GetWeights() map[string]float64 {
+func (x *ContextGroup) GetValue() string {
 	if x != nil {
-		return x.Weights
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-func (x *ProductivityModel) GetScore() float64 {
+func (x *ContextGroup) GetNumPoints() int32 {
 	if x != nil {
-		return x.Score
+		return x.NumPoints
 	}
 	return 0
 }
 
-type BurnoutRisk struct {
+func (x *ContextGroup) GetAvgMood() float64 {
+	if x != nil {
+		return x.AvgMood
+	}
+	return 0
+}
+
+func (x *ContextGroup) GetAvgEnergy() float64 {
+	if x != nil {
+		return x.AvgEnergy
+	}
+	return 0
+}
+
+// Fails with INVALID_ARGUMENT when the key has more than 12 distinct values.
+type ContextBreakdownResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Score                 float64  `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
-	Level                 string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
-	Reasons               []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
-	PredictionHorizonDays int32    `protobuf:"varint,4,opt,name=prediction_horizon_days,json=predictionHorizonDays,proto3" json:"prediction_horizon_days,omitempty"`
+	Key       string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	NumPoints int32           `protobuf:"varint,2,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"` // points carrying the key
+	Groups    []*ContextGroup `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`                         // most frequent value first
 }
 
-func (x *BurnoutRisk) Reset() {
-	*x = BurnoutRisk{}
+func (x *ContextBreakdownResponse) Reset() {
+	*x = ContextBreakdownResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[31]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BurnoutRisk) String() string {
+func (x *ContextBreakdownResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BurnoutRisk) ProtoMessage() {}
+func (*ContextBreakdownResponse) ProtoMessage() {}
 
-func (x *BurnoutRisk) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[31]
+func (x *ContextBreakdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1865,555 +2207,8900 @@ func (x *BurnoutRisk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BurnoutRisk.ProtoReflect.Descriptor instead.
-func (*BurnoutRisk) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ContextBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*ContextBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *BurnoutRisk) GetScore() float64 {
+func (x *ContextBreakdownResponse) GetKey() string {
 	if x != nil {
-		return x.Score
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ContextBreakdownResponse) GetNumPoints() int32 {
+	if x != nil {
+		return x.NumPoints
 	}
 	return 0
 }
 
-func (x *BurnoutRisk) GetLevel() string {
+func (x *ContextBreakdownResponse) GetGroups() []*ContextGroup {
 	if x != nil {
-		return x.Level
+		return x.Groups
+	}
+	return nil
+}
+
+// Pearson correlation of every pair of sleep_hours, mood, energy, stress, concentration and productive
+// over the period's latest 1000 points; a pair uses only points where both values are filled in.
+type CorrelationMatrixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserTz string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"` // empty = saved timezone
+	Period Period `protobuf:"varint,2,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+}
+
+func (x *CorrelationMatrixRequest) Reset() {
+	*x = CorrelationMatrixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorrelationMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrelationMatrixRequest) ProtoMessage() {}
+
+func (x *CorrelationMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelationMatrixRequest.ProtoReflect.Descriptor instead.
+func (*CorrelationMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CorrelationMatrixRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
 	}
 	return ""
 }
 
-func (x *BurnoutRisk) GetReasons() []string {
+func (x *CorrelationMatrixRequest) GetPeriod() Period {
 	if x != nil {
-		return x.Reasons
+		return x.Period
 	}
-	return nil
+	return Period_PERIOD_UNSPECIFIED
 }
 
-func (x *BurnoutRisk) GetPredictionHorizonDays() int32 {
+type CorrelationCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A           string  `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B           string  `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	R           float64 `protobuf:"fixed64,3,opt,name=r,proto3" json:"r,omitempty"`                    // -1..1; 0 when strength = insufficient_data
+	N           int32   `protobuf:"varint,4,opt,name=n,proto3" json:"n,omitempty"`                     // pairs used
+	Strength    string  `protobuf:"bytes,5,opt,name=strength,proto3" json:"strength,omitempty"`        // strong (|r| >= 0.5) | moderate (>= 0.3) | weak | insufficient_data (fewer than 7 pairs or a constant series)
+	Significant bool    `protobuf:"varint,6,opt,name=significant,proto3" json:"significant,omitempty"` // p < 0.05 by Fisher's z
+}
+
+func (x *CorrelationCell) Reset() {
+	*x = CorrelationCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorrelationCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrelationCell) ProtoMessage() {}
+
+func (x *CorrelationCell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelationCell.ProtoReflect.Descriptor instead.
+func (*CorrelationCell) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CorrelationCell) GetA() string {
 	if x != nil {
-		return x.PredictionHorizonDays
+		return x.A
+	}
+	return ""
+}
+
+func (x *CorrelationCell) GetB() string {
+	if x != nil {
+		return x.B
+	}
+	return ""
+}
+
+func (x *CorrelationCell) GetR() float64 {
+	if x != nil {
+		return x.R
 	}
 	return 0
 }
 
-type OptimalSchedule struct {
+func (x *CorrelationCell) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *CorrelationCell) GetStrength() string {
+	if x != nil {
+		return x.Strength
+	}
+	return ""
+}
+
+func (x *CorrelationCell) GetSignificant() bool {
+	if x != nil {
+		return x.Significant
+	}
+	return false
+}
+
+type CorrelationMatrixResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SuggestedSleepWindow string   `protobuf:"bytes,1,opt,name=suggested_sleep_window,json=suggestedSleepWindow,proto3" json:"suggested_sleep_window,omitempty"`
-	BestFocusHours       []string `protobuf:"bytes,2,rep,name=best_focus_hours,json=bestFocusHours,proto3" json:"best_focus_hours,omitempty"`
-	BestLightTasksHours  []string `protobuf:"bytes,3,rep,name=best_light_tasks_hours,json=bestLightTasksHours,proto3" json:"best_light_tasks_hours,omitempty"`
-	RecoveryTips         []string `protobuf:"bytes,4,rep,name=recovery_tips,json=recoveryTips,proto3" json:"recovery_tips,omitempty"`
+	Metrics   []string           `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"` // labels, in matrix order
+	NumPoints int32              `protobuf:"varint,2,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"`
+	Cells     []*CorrelationCell `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"` // upper triangle, row by row
 }
 
-func (x *OptimalSchedule) Reset() {
-	*x = OptimalSchedule{}
+func (x *CorrelationMatrixResponse) Reset() {
+	*x = CorrelationMatrixResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[32]
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OptimalSchedule) String() string {
+func (x *CorrelationMatrixResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OptimalSchedule) ProtoMessage() {}
+func (*CorrelationMatrixResponse) ProtoMessage() {}
 
-func (x *OptimalSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[32]
+func (x *CorrelationMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OptimalSchedule.ProtoReflect.Descriptor instead.
-func (*OptimalSchedule) Descriptor() ([]byte, []int) {
-	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *OptimalSchedule) GetSuggestedSleepWindow() string {
-	if x != nil {
-		return x.SuggestedSleepWindow
-	}
-	return ""
-}
-
-func (x *OptimalSchedule) GetBestFocusHours() []string {
-	if x != nil {
-		return x.BestFocusHours
-	}
-	return nil
-}
-
-func (x *OptimalSchedule) GetBestLightTasksHours() []string {
-	if x != nil {
-		return x.BestLightTasksHours
-	}
-	return nil
-}
-
-func (x *OptimalSchedule) GetRecoveryTips() []string {
-	if x != nil {
-		return x.RecoveryTips
-	}
-	return nil
-}
-
-var File_proto_nexusai_v1_analyzer_proto protoreflect.FileDescriptor
-
-var file_proto_nexusai_v1_analyzer_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x0a, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a, 0x0c,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x22, 0x2c,
-	0x0a, 0x11, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x22, 0x5a, 0x0a, 0x12,
-	0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0e, 0x41, 0x6e, 0x61,
-	0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x54, 0x7a, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x65, 0x6b, 0x5f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x65, 0x6b, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78,
-	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73,
-	0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xf6, 0x03, 0x0a,
-	0x0a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6c, 0x65, 0x65, 0x70,
-	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x6c,
-	0x65, 0x65, 0x70, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6c, 0x65, 0x65,
-	0x70, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x6c, 0x65, 0x65, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6c, 0x65,
-	0x65, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6c,
-	0x65, 0x65, 0x70, 0x45, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x6f, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
-	0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63,
-	0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
-	0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x66, 0x66, 0x65, 0x69, 0x6e, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x66, 0x66, 0x65, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x6c, 0x63, 0x6f, 0x68, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x61, 0x6c, 0x63, 0x6f, 0x68, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
-	0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6c, 0x6d, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6c, 0x6d, 0x54, 0x65, 0x78, 0x74, 0x12, 0x27, 0x0a, 0x0f,
-	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
-	0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x19,
-	0x0a, 0x08, 0x62, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x62, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
-	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x22, 0xc8, 0x01, 0x0a, 0x0d, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x27, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d,
-	0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x22, 0x35, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73,
-	0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x14, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x67, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x67, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x22, 0x4a, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e,
-	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x2a,
-	0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x44, 0x0a, 0x13, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
-	0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
-	0x22, 0x33, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x18, 0x53, 0x65,
-	0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x6f, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65,
-	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x1c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x59, 0x0a, 0x0b,
-	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x77,
-	0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48,
-	0x6f, 0x75, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
-	0x68, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
-	0x45, 0x6e, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x22, 0xd5, 0x03, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c,
-	0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x65,
-	0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x79, 0x57, 0x65, 0x65, 0x6b,
-	0x64, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79,
-	0x42, 0x79, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x4c, 0x0a, 0x12, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x6f,
-	0x75, 0x74, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x6f,
-	0x75, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x0b, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x52,
-	0x69, 0x73, 0x6b, 0x12, 0x46, 0x0a, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d,
-	0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69,
-	0x6d, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x6c, 0x6d, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a, 0x05,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x1a, 0x42, 0x0a, 0x14, 0x45,
-	0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x79, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x15, 0x0a, 0x13, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x14, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e,
-	0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x73,
-	0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x4c, 0x61, 0x73, 0x74,
-	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
-	0x44, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
-	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x77, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x3a, 0x0a, 0x0c, 0x57,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x72, 0x6e,
-	0x6f, 0x75, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a,
-	0x17, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15,
-	0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f,
-	0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x61,
-	0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x77, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
-	0x28, 0x0a, 0x10, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x5f, 0x68, 0x6f,
-	0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x46,
-	0x6f, 0x63, 0x75, 0x73, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65, 0x73,
-	0x74, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x5f, 0x68, 0x6f,
-	0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x73, 0x74, 0x4c,
-	0x69, 0x67, 0x68, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x23,
-	0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x70, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54,
-	0x69, 0x70, 0x73, 0x2a, 0x63, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a,
-	0x12, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f,
-	0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f,
-	0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
-	0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52, 0x49,
-	0x4f, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x04, 0x32, 0xf0, 0x08, 0x0a, 0x0f, 0x41, 0x6e, 0x61,
-	0x6c, 0x79, 0x7a, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x05,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x41, 0x6e,
-	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12,
-	0x1d, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x64,
-	0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x64, 0x61,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65,
-	0x73, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x78,
-	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e,
-	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x12,
-	0x26, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
-	0x25, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
-	0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x75,
-	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x69, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x6e,
-	0x65, 0x78, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x65, 0x78, 0x75, 0x73,
-	0x61, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_nexusai_v1_analyzer_proto_rawDescOnce sync.Once
-	file_proto_nexusai_v1_analyzer_proto_rawDescData = file_proto_nexusai_v1_analyzer_proto_rawDesc
-)
-
-func file_proto_nexusai_v1_analyzer_proto_rawDescGZIP() []byte {
-	file_proto_nexusai_v1_analyzer_proto_rawDescOnce.Do(func() {
-		file_proto_nexusai_v1_analyzer_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_nexusai_v1_analyzer_proto_rawDescData)
-	})
-	return file_proto_nexusai_v1_analyzer_proto_rawDescData
-}
-
-var file_proto_nexusai_v1_analyzer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_nexusai_v1_analyzer_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
-var file_proto_nexusai_v1_analyzer_proto_goTypes = []any{
-	(Period)(0),                          // 0: nexusai.v1.Period
-	(*TrackRequest)(nil),                 // 1: nexusai.v1.TrackRequest
-	(*TrackResponse)(nil),                // 2: nexusai.v1.TrackResponse
-	(*TodayTrackRequest)(nil),            // 3: nexusai.v1.TodayTrackRequest
-	(*TodayTrackResponse)(nil),           // 4: nexusai.v1.TodayTrackResponse
-	(*AnalyzeRequest)(nil),               // 5: nexusai.v1.AnalyzeRequest
-	(*TrackPoint)(nil),                   // 6: nexusai.v1.TrackPoint
-	(*UserProfile)(nil),                  // 7: nexusai.v1.UserProfile
-	(*FriendRequest)(nil),                // 8: nexusai.v1.FriendRequest
-	(*GetMyProfileRequest)(nil),          // 9: nexusai.v1.GetMyProfileRequest
-	(*GetMyProfileResponse)(nil),         // 10: nexusai.v1.GetMyProfileResponse
-	(*GetUserProfileRequest)(nil),        // 11: nexusai.v1.GetUserProfileRequest
-	(*GetUserProfileResponse)(nil),       // 12: nexusai.v1.GetUserProfileResponse
-	(*GetUserLastAnalysesRequest)(nil),   // 13: nexusai.v1.GetUserLastAnalysesRequest
-	(*UpdateProfileRequest)(nil),         // 14: nexusai.v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),        // 15: nexusai.v1.UpdateProfileResponse
-	(*SearchUsersRequest)(nil),           // 16: nexusai.v1.SearchUsersRequest
-	(*SearchUsersResponse)(nil),          // 17: nexusai.v1.SearchUsersResponse
-	(*ListFriendsRequest)(nil),           // 18: nexusai.v1.ListFriendsRequest
-	(*ListFriendsResponse)(nil),          // 19: nexusai.v1.ListFriendsResponse
-	(*ListFriendRequestsRequest)(nil),    // 20: nexusai.v1.ListFriendRequestsRequest
-	(*ListFriendRequestsResponse)(nil),   // 21: nexusai.v1.ListFriendRequestsResponse
-	(*SendFriendRequestRequest)(nil),     // 22: nexusai.v1.SendFriendRequestRequest
-	(*SendFriendRequestResponse)(nil),    // 23: nexusai.v1.SendFriendRequestResponse
-	(*RespondFriendRequestRequest)(nil),  // 24: nexusai.v1.RespondFriendRequestRequest
-	(*RespondFriendRequestResponse)(nil), // 25: nexusai.v1.RespondFriendRequestResponse
-	(*Constraints)(nil),                  // 26: nexusai.v1.Constraints
-	(*AnalyzeResponse)(nil),              // 27: nexusai.v1.AnalyzeResponse
-	(*LastAnalysesRequest)(nil),          // 28: nexusai.v1.LastAnalysesRequest
-	(*LastAnalysesResponse)(nil),         // 29: nexusai.v1.LastAnalysesResponse
-	(*LastAnalysisEntry)(nil),            // 30: nexusai.v1.LastAnalysisEntry
-	(*ProductivityModel)(nil),            // 31: nexusai.v1.ProductivityModel
-	(*BurnoutRisk)(nil),                  // 32: nexusai.v1.BurnoutRisk
-	(*OptimalSchedule)(nil),              // 33: nexusai.v1.OptimalSchedule
-	nil,                                  // 34: nexusai.v1.AnalyzeResponse.EnergyByWeekdayEntry
-	nil,                                  // 35: nexusai.v1.ProductivityModel.WeightsEntry
-	(*timestamppb.Timestamp)(nil),        // 36: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),              // 37: google.protobuf.Struct
-}
-var file_proto_nexusai_v1_analyzer_proto_depIdxs = []int32{
-	6,  // 0: nexusai.v1.TrackRequest.points:type_name -> nexusai.v1.TrackPoint
-	6,  // 1: nexusai.v1.TodayTrackResponse.point:type_name -> nexusai.v1.TrackPoint
-	26, // 2: nexusai.v1.AnalyzeRequest.constraints:type_name -> nexusai.v1.Constraints
-	0,  // 3: nexusai.v1.AnalyzeRequest.period:type_name -> nexusai.v1.Period
-	36, // 4: nexusai.v1.TrackPoint.ts:type_name -> google.protobuf.Timestamp
-	7,  // 5: nexusai.v1.FriendRequest.from:type_name -> nexusai.v1.UserProfile
-	7,  // 6: nexusai.v1.FriendRequest.to:type_name -> nexusai.v1.UserProfile
-	36, // 7: nexusai.v1.FriendRequest.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 8: nexusai.v1.GetMyProfileResponse.profile:type_name -> nexusai.v1.UserProfile
-	7,  // 9: nexusai.v1.GetUserProfileResponse.profile:type_name -> nexusai.v1.UserProfile
-	7,  // 10: nexusai.v1.UpdateProfileResponse.profile:type_name -> nexusai.v1.UserProfile
-	7,  // 11: nexusai.v1.SearchUsersResponse.users:type_name -> nexusai.v1.UserProfile
-	7,  // 12: nexusai.v1.ListFriendsResponse.friends:type_name -> nexusai.v1.UserProfile
-	8,  // 13: nexusai.v1.ListFriendRequestsResponse.requests:type_name -> nexusai.v1.FriendRequest
-	8,  // 14: nexusai.v1.SendFriendRequestResponse.request:type_name -> nexusai.v1.FriendRequest
-	34, // 15: nexusai.v1.AnalyzeResponse.energy_by_weekday:type_name -> nexusai.v1.AnalyzeResponse.EnergyByWeekdayEntry
-	31, // 16: nexusai.v1.AnalyzeResponse.productivity_model:type_name -> nexusai.v1.ProductivityModel
-	32, // 17: nexusai.v1.AnalyzeResponse.burnout_risk:type_name -> nexusai.v1.BurnoutRisk
-	33, // 18: nexusai.v1.AnalyzeResponse.optimal_schedule:type_name -> nexusai.v1.OptimalSchedule
-	37, // 19: nexusai.v1.AnalyzeResponse.debug:type_name -> google.protobuf.Struct
-	30, // 20: nexusai.v1.LastAnalysesResponse.entries:type_name -> nexusai.v1.LastAnalysisEntry
-	27, // 21: nexusai.v1.LastAnalysisEntry.response:type_name -> nexusai.v1.AnalyzeResponse
-	36, // 22: nexusai.v1.LastAnalysisEntry.updated_at:type_name -> google.protobuf.Timestamp
-	35, // 23: nexusai.v1.ProductivityModel.weights:type_name -> nexusai.v1.ProductivityModel.WeightsEntry
-	1,  // 24: nexusai.v1.AnalyzerService.Track:input_type -> nexusai.v1.TrackRequest
-	5,  // 25: nexusai.v1.AnalyzerService.Analyze:input_type -> nexusai.v1.AnalyzeRequest
-	3,  // 26: nexusai.v1.AnalyzerService.GetTodayTrack:input_type -> nexusai.v1.TodayTrackRequest
-	28, // 27: nexusai.v1.AnalyzerService.GetLastAnalyses:input_type -> nexusai.v1.LastAnalysesRequest
-	9,  // 28: nexusai.v1.AnalyzerService.GetMyProfile:input_type -> nexusai.v1.GetMyProfileRequest
-	14, // 29: nexusai.v1.AnalyzerService.UpdateMyProfile:input_type -> nexusai.v1.UpdateProfileRequest
-	11, // 30: nexusai.v1.AnalyzerService.GetUserProfile:input_type -> nexusai.v1.GetUserProfileRequest
-	13, // 31: nexusai.v1.AnalyzerService.GetUserLastAnalyses:input_type -> nexusai.v1.GetUserLastAnalysesRequest
-	16, // 32: nexusai.v1.AnalyzerService.SearchUsers:input_type -> nexusai.v1.SearchUsersRequest
-	18, // 33: nexusai.v1.AnalyzerService.ListFriends:input_type -> nexusai.v1.ListFriendsRequest
-	20, // 34: nexusai.v1.AnalyzerService.ListFriendRequests:input_type -> nexusai.v1.ListFriendRequestsRequest
-	22, // 35: nexusai.v1.AnalyzerService.SendFriendRequest:input_type -> nexusai.v1.SendFriendRequestRequest
-	24, // 36: nexusai.v1.AnalyzerService.RespondFriendRequest:input_type -> nexusai.v1.RespondFriendRequestRequest
-	2,  // 37: nexusai.v1.AnalyzerService.Track:output_type -> nexusai.v1.TrackResponse
-	27, // 38: nexusai.v1.AnalyzerService.Analyze:output_type -> nexusai.v1.AnalyzeResponse
-	4,  // 39: nexusai.v1.AnalyzerService.GetTodayTrack:output_type -> nexusai.v1.TodayTrackResponse
-	29, // 40: nexusai.v1.AnalyzerService.GetLastAnalyses:output_type -> nexusai.v1.LastAnalysesResponse
-	10, // 41: nexusai.v1.AnalyzerService.GetMyProfile:output_type -> nexusai.v1.GetMyProfileResponse
-	15, // 42: nexusai.v1.AnalyzerService.UpdateMyProfile:output_type -> nexusai.v1.UpdateProfileResponse
-	12, // 43: nexusai.v1.AnalyzerService.GetUserProfile:output_type -> nexusai.v1.GetUserProfileResponse
-	29, // 44: nexusai.v1.AnalyzerService.GetUserLastAnalyses:output_type -> nexusai.v1.LastAnalysesResponse
-	17, // 45: nexusai.v1.AnalyzerService.SearchUsers:output_type -> nexusai.v1.SearchUsersResponse
-	19, // 46: nexusai.v1.AnalyzerService.ListFriends:output_type -> nexusai.v1.ListFriendsResponse
-	21, // 47: nexusai.v1.AnalyzerService.ListFriendRequests:output_type -> nexusai.v1.ListFriendRequestsResponse
-	23, // 48: nexusai.v1.AnalyzerService.SendFriendRequest:output_type -> nexusai.v1.SendFriendRequestResponse
-	25, // 49: nexusai.v1.AnalyzerService.RespondFriendRequest:output_type -> nexusai.v1.RespondFriendRequestResponse
-	37, // [37:50] is the sub-list for method output_type
-	24, // [24:37] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
-}
-
-func init() { file_proto_nexusai_v1_analyzer_proto_init() }
-func file_proto_nexusai_v1_analyzer_proto_init() {
-	if File_proto_nexusai_v1_analyzer_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*TrackRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelationMatrixResponse.ProtoReflect.Descriptor instead.
+func (*CorrelationMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CorrelationMatrixResponse) GetMetrics() []string {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *CorrelationMatrixResponse) GetNumPoints() int32 {
+	if x != nil {
+		return x.NumPoints
+	}
+	return 0
+}
+
+func (x *CorrelationMatrixResponse) GetCells() []*CorrelationCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+// Recommended bedtime for tonight: the usual bedtime (last month) moved earlier to repay a quarter of the
+// last week's sleep debt, by 15-60 minutes a night and never more than the debt itself.
+type BedtimeTonightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserTz string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"` // empty = saved timezone
+}
+
+func (x *BedtimeTonightRequest) Reset() {
+	*x = BedtimeTonightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BedtimeTonightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BedtimeTonightRequest) ProtoMessage() {}
+
+func (x *BedtimeTonightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BedtimeTonightRequest.ProtoReflect.Descriptor instead.
+func (*BedtimeTonightRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BedtimeTonightRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+type BedtimeTonightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bedtime        string  `protobuf:"bytes,1,opt,name=bedtime,proto3" json:"bedtime,omitempty"`                                         // HH:MM; empty when status = insufficient_data
+	UsualBedtime   string  `protobuf:"bytes,2,opt,name=usual_bedtime,json=usualBedtime,proto3" json:"usual_bedtime,omitempty"`           // HH:MM, circular mean of sleep_start
+	SleepDebtHours float64 `protobuf:"fixed64,3,opt,name=sleep_debt_hours,json=sleepDebtHours,proto3" json:"sleep_debt_hours,omitempty"` // shortfall below 7.5 h over the last 7 days
+	ShiftMinutes   int32   `protobuf:"varint,4,opt,name=shift_minutes,json=shiftMinutes,proto3" json:"shift_minutes,omitempty"`          // how much earlier than usual; 0 unless status = shift_earlier
+	Status         string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                           // shift_earlier | maintain | insufficient_data (fewer than 3 nights with sleep_start)
+	Message        string  `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                                         // ready-to-show text
+}
+
+func (x *BedtimeTonightResponse) Reset() {
+	*x = BedtimeTonightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BedtimeTonightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BedtimeTonightResponse) ProtoMessage() {}
+
+func (x *BedtimeTonightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BedtimeTonightResponse.ProtoReflect.Descriptor instead.
+func (*BedtimeTonightResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BedtimeTonightResponse) GetBedtime() string {
+	if x != nil {
+		return x.Bedtime
+	}
+	return ""
+}
+
+func (x *BedtimeTonightResponse) GetUsualBedtime() string {
+	if x != nil {
+		return x.UsualBedtime
+	}
+	return ""
+}
+
+func (x *BedtimeTonightResponse) GetSleepDebtHours() float64 {
+	if x != nil {
+		return x.SleepDebtHours
+	}
+	return 0
+}
+
+func (x *BedtimeTonightResponse) GetShiftMinutes() int32 {
+	if x != nil {
+		return x.ShiftMinutes
+	}
+	return 0
+}
+
+func (x *BedtimeTonightResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BedtimeTonightResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetFriendAnalysisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetFriendAnalysisRequest) Reset() {
+	*x = GetFriendAnalysisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendAnalysisRequest) ProtoMessage() {}
+
+func (x *GetFriendAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetFriendAnalysisRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Never triggers a new analysis. Without the friend's consent (shared = false) only the profile is set.
+type GetFriendAnalysisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *UserProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Shared  bool                 `protobuf:"varint,2,opt,name=shared,proto3" json:"shared,omitempty"`
+	Entries []*LastAnalysisEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetFriendAnalysisResponse) Reset() {
+	*x = GetFriendAnalysisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendAnalysisResponse) ProtoMessage() {}
+
+func (x *GetFriendAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*GetFriendAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetFriendAnalysisResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetFriendAnalysisResponse) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *GetFriendAnalysisResponse) GetEntries() []*LastAnalysisEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emoji           string `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	BgIndex         int32  `protobuf:"varint,2,opt,name=bg_index,json=bgIndex,proto3" json:"bg_index,omitempty"`
+	DayStartHour    *int32 `protobuf:"varint,3,opt,name=day_start_hour,json=dayStartHour,proto3,oneof" json:"day_start_hour,omitempty"`        // 0..12, unset = keep current
+	Tone            *Tone  `protobuf:"varint,4,opt,name=tone,proto3,enum=nexusai.v1.Tone,oneof" json:"tone,omitempty"`                         // unset = keep current; TONE_UNSPECIFIED resets to the neutral style
+	ShareAnalyses   *bool  `protobuf:"varint,5,opt,name=share_analyses,json=shareAnalyses,proto3,oneof" json:"share_analyses,omitempty"`       // unset = keep current
+	AnalysesEnabled *bool  `protobuf:"varint,6,opt,name=analyses_enabled,json=analysesEnabled,proto3,oneof" json:"analyses_enabled,omitempty"` // unset = keep current
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateProfileRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBgIndex() int32 {
+	if x != nil {
+		return x.BgIndex
+	}
+	return 0
+}
+
+func (x *UpdateProfileRequest) GetDayStartHour() int32 {
+	if x != nil && x.DayStartHour != nil {
+		return *x.DayStartHour
+	}
+	return 0
+}
+
+func (x *UpdateProfileRequest) GetTone() Tone {
+	if x != nil && x.Tone != nil {
+		return *x.Tone
+	}
+	return Tone_TONE_UNSPECIFIED
+}
+
+func (x *UpdateProfileRequest) GetShareAnalyses() bool {
+	if x != nil && x.ShareAnalyses != nil {
+		return *x.ShareAnalyses
+	}
+	return false
+}
+
+func (x *UpdateProfileRequest) GetAnalysesEnabled() bool {
+	if x != nil && x.AnalysesEnabled != nil {
+		return *x.AnalysesEnabled
+	}
+	return false
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Metrics the user does not track; they are left out of the prompt and burnout signals.
+// Allowed: alcohol, caffeine, workout, stress, sleep_quality, concentration, activity.
+type UpdateHiddenMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics []string `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *UpdateHiddenMetricsRequest) Reset() {
+	*x = UpdateHiddenMetricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHiddenMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHiddenMetricsRequest) ProtoMessage() {}
+
+func (x *UpdateHiddenMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHiddenMetricsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHiddenMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateHiddenMetricsRequest) GetMetrics() []string {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type UpdateHiddenMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics []string `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *UpdateHiddenMetricsResponse) Reset() {
+	*x = UpdateHiddenMetricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHiddenMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHiddenMetricsResponse) ProtoMessage() {}
+
+func (x *UpdateHiddenMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHiddenMetricsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHiddenMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateHiddenMetricsResponse) GetMetrics() []string {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type GetGoalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetGoalsRequest) Reset() {
+	*x = GetGoalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalsRequest) ProtoMessage() {}
+
+func (x *GetGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalsRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{40}
+}
+
+// metric -> target: sleep_hours (0..24], sleep_quality, mood, energy, concentration, productive, activity
+// (0..10], met when the day's value is at least the target; stress (0..10], met when at most the target.
+type UpdateGoalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Goals map[string]float64 `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *UpdateGoalsRequest) Reset() {
+	*x = UpdateGoalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalsRequest) ProtoMessage() {}
+
+func (x *UpdateGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateGoalsRequest) GetGoals() map[string]float64 {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type GoalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Goals map[string]float64 `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *GoalsResponse) Reset() {
+	*x = GoalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalsResponse) ProtoMessage() {}
+
+func (x *GoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalsResponse.ProtoReflect.Descriptor instead.
+func (*GoalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GoalsResponse) GetGoals() map[string]float64 {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type GetBurnoutWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetBurnoutWebhookRequest) Reset() {
+	*x = GetBurnoutWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBurnoutWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBurnoutWebhookRequest) ProtoMessage() {}
+
+func (x *GetBurnoutWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBurnoutWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetBurnoutWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{43}
+}
+
+type SetBurnoutWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *SetBurnoutWebhookRequest) Reset() {
+	*x = SetBurnoutWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBurnoutWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBurnoutWebhookRequest) ProtoMessage() {}
+
+func (x *SetBurnoutWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBurnoutWebhookRequest.ProtoReflect.Descriptor instead.
+func (*SetBurnoutWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetBurnoutWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type BurnoutWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *BurnoutWebhookResponse) Reset() {
+	*x = BurnoutWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnoutWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnoutWebhookResponse) ProtoMessage() {}
+
+func (x *BurnoutWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnoutWebhookResponse.ProtoReflect.Descriptor instead.
+func (*BurnoutWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BurnoutWebhookResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SearchUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*UserProfile `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*UserProfile {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type FindUserByEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *FindUserByEmailRequest) Reset() {
+	*x = FindUserByEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserByEmailRequest) ProtoMessage() {}
+
+func (x *FindUserByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserByEmailRequest.ProtoReflect.Descriptor instead.
+func (*FindUserByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FindUserByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Only user_id, name and emoji are filled; email is never echoed back.
+type FindUserByEmailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *UserProfile `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *FindUserByEmailResponse) Reset() {
+	*x = FindUserByEmailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserByEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserByEmailResponse) ProtoMessage() {}
+
+func (x *FindUserByEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserByEmailResponse.ProtoReflect.Descriptor instead.
+func (*FindUserByEmailResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FindUserByEmailResponse) GetUser() *UserProfile {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ListFriendsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFriendsRequest) Reset() {
+	*x = ListFriendsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsRequest) ProtoMessage() {}
+
+func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{50}
+}
+
+type ListFriendsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friends []*UserProfile `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+}
+
+func (x *ListFriendsResponse) Reset() {
+	*x = ListFriendsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsResponse) ProtoMessage() {}
+
+func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsResponse.ProtoReflect.Descriptor instead.
+func (*ListFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListFriendsResponse) GetFriends() []*UserProfile {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+// FriendSummary carries only status flags about a friend, never their entries or notes.
+type FriendSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friend       *UserProfile `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+	LoggedToday  bool         `protobuf:"varint,2,opt,name=logged_today,json=loggedToday,proto3" json:"logged_today,omitempty"`   // has an entry since the start of the friend's local day
+	BurnoutLevel string       `protobuf:"bytes,3,opt,name=burnout_level,json=burnoutLevel,proto3" json:"burnout_level,omitempty"` // from the friend's latest weekly analysis; empty if none
+}
+
+func (x *FriendSummary) Reset() {
+	*x = FriendSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendSummary) ProtoMessage() {}
+
+func (x *FriendSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendSummary.ProtoReflect.Descriptor instead.
+func (*FriendSummary) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FriendSummary) GetFriend() *UserProfile {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+func (x *FriendSummary) GetLoggedToday() bool {
+	if x != nil {
+		return x.LoggedToday
+	}
+	return false
+}
+
+func (x *FriendSummary) GetBurnoutLevel() string {
+	if x != nil {
+		return x.BurnoutLevel
+	}
+	return ""
+}
+
+type ListFriendsEnrichedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFriendsEnrichedRequest) Reset() {
+	*x = ListFriendsEnrichedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendsEnrichedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsEnrichedRequest) ProtoMessage() {}
+
+func (x *ListFriendsEnrichedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsEnrichedRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendsEnrichedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{53}
+}
+
+type ListFriendsEnrichedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friends []*FriendSummary `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+}
+
+func (x *ListFriendsEnrichedResponse) Reset() {
+	*x = ListFriendsEnrichedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendsEnrichedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsEnrichedResponse) ProtoMessage() {}
+
+func (x *ListFriendsEnrichedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsEnrichedResponse.ProtoReflect.Descriptor instead.
+func (*ListFriendsEnrichedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListFriendsEnrichedResponse) GetFriends() []*FriendSummary {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+// Milestones of friends who share their analyses (share_analyses), from the last 30 days, newest first.
+// Events carry no raw metrics.
+type ActivityFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 0 = 20; at most 100
+}
+
+func (x *ActivityFeedRequest) Reset() {
+	*x = ActivityFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityFeedRequest) ProtoMessage() {}
+
+func (x *ActivityFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityFeedRequest.ProtoReflect.Descriptor instead.
+func (*ActivityFeedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ActivityFeedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FriendActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Friend    *UserProfile           `protobuf:"bytes,2,opt,name=friend,proto3" json:"friend,omitempty"`
+	Kind      string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`       // streak | burnout_improved
+	Value     string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`     // streak: days (7, 30, 100, 365); burnout_improved: new level (low | medium)
+	Message   string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"` // ready-to-show text
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *FriendActivity) Reset() {
+	*x = FriendActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendActivity) ProtoMessage() {}
+
+func (x *FriendActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendActivity.ProtoReflect.Descriptor instead.
+func (*FriendActivity) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FriendActivity) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FriendActivity) GetFriend() *UserProfile {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+func (x *FriendActivity) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FriendActivity) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FriendActivity) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FriendActivity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ActivityFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*FriendActivity `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ActivityFeedResponse) Reset() {
+	*x = ActivityFeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityFeedResponse) ProtoMessage() {}
+
+func (x *ActivityFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityFeedResponse.ProtoReflect.Descriptor instead.
+func (*ActivityFeedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ActivityFeedResponse) GetEvents() []*FriendActivity {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListFriendRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListFriendRequestsRequest) Reset() {
+	*x = ListFriendRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendRequestsRequest) ProtoMessage() {}
+
+func (x *ListFriendRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListFriendRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListFriendRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*FriendRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListFriendRequestsResponse) Reset() {
+	*x = ListFriendRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendRequestsResponse) ProtoMessage() {}
+
+func (x *ListFriendRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListFriendRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListFriendRequestsResponse) GetRequests() []*FriendRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type SendFriendRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ToUserId int32 `protobuf:"varint,1,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+}
+
+func (x *SendFriendRequestRequest) Reset() {
+	*x = SendFriendRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestRequest) ProtoMessage() {}
+
+func (x *SendFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SendFriendRequestRequest) GetToUserId() int32 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+// status is "accepted" when the other user already had a pending request to the caller: both become friends at once.
+type SendFriendRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *FriendRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *SendFriendRequestResponse) Reset() {
+	*x = SendFriendRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestResponse) ProtoMessage() {}
+
+func (x *SendFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SendFriendRequestResponse) GetRequest() *FriendRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RespondFriendRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId int64  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Action    string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // accept | decline
+}
+
+func (x *RespondFriendRequestRequest) Reset() {
+	*x = RespondFriendRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondFriendRequestRequest) ProtoMessage() {}
+
+func (x *RespondFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*RespondFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RespondFriendRequestRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *RespondFriendRequestRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type RespondFriendRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *RespondFriendRequestResponse) Reset() {
+	*x = RespondFriendRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondFriendRequestResponse) ProtoMessage() {}
+
+func (x *RespondFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*RespondFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RespondFriendRequestResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type CompareWithFriendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FriendUserId int32  `protobuf:"varint,1,opt,name=friend_user_id,json=friendUserId,proto3" json:"friend_user_id,omitempty"`
+	Period       Period `protobuf:"varint,2,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+}
+
+func (x *CompareWithFriendRequest) Reset() {
+	*x = CompareWithFriendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareWithFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareWithFriendRequest) ProtoMessage() {}
+
+func (x *CompareWithFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareWithFriendRequest.ProtoReflect.Descriptor instead.
+func (*CompareWithFriendRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CompareWithFriendRequest) GetFriendUserId() int32 {
+	if x != nil {
+		return x.FriendUserId
+	}
+	return 0
+}
+
+func (x *CompareWithFriendRequest) GetPeriod() Period {
+	if x != nil {
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+type MetricComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric string  `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"` // productivity_score | burnout_score | avg_energy
+	Mine   float64 `protobuf:"fixed64,2,opt,name=mine,proto3" json:"mine,omitempty"`
+	Friend float64 `protobuf:"fixed64,3,opt,name=friend,proto3" json:"friend,omitempty"`
+	Delta  float64 `protobuf:"fixed64,4,opt,name=delta,proto3" json:"delta,omitempty"` // mine - friend
+	Leader string  `protobuf:"bytes,5,opt,name=leader,proto3" json:"leader,omitempty"` // me | friend | tie
+}
+
+func (x *MetricComparison) Reset() {
+	*x = MetricComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricComparison) ProtoMessage() {}
+
+func (x *MetricComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricComparison.ProtoReflect.Descriptor instead.
+func (*MetricComparison) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MetricComparison) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *MetricComparison) GetMine() float64 {
+	if x != nil {
+		return x.Mine
+	}
+	return 0
+}
+
+func (x *MetricComparison) GetFriend() float64 {
+	if x != nil {
+		return x.Friend
+	}
+	return 0
+}
+
+func (x *MetricComparison) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *MetricComparison) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+type CompareWithFriendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friend          *UserProfile           `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+	Period          string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Metrics         []*MetricComparison    `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	Summary         string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	MyUpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=my_updated_at,json=myUpdatedAt,proto3" json:"my_updated_at,omitempty"`
+	FriendUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=friend_updated_at,json=friendUpdatedAt,proto3" json:"friend_updated_at,omitempty"`
+}
+
+func (x *CompareWithFriendResponse) Reset() {
+	*x = CompareWithFriendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareWithFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareWithFriendResponse) ProtoMessage() {}
+
+func (x *CompareWithFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareWithFriendResponse.ProtoReflect.Descriptor instead.
+func (*CompareWithFriendResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CompareWithFriendResponse) GetFriend() *UserProfile {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+func (x *CompareWithFriendResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *CompareWithFriendResponse) GetMetrics() []*MetricComparison {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *CompareWithFriendResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *CompareWithFriendResponse) GetMyUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MyUpdatedAt
+	}
+	return nil
+}
+
+func (x *CompareWithFriendResponse) GetFriendUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FriendUpdatedAt
+	}
+	return nil
+}
+
+// Each timestamp resolves to the newest saved analysis of the period at or before it; NotFound when
+// there is none.
+type InsightDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period Period                 `protobuf:"varint,1,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *InsightDiffRequest) Reset() {
+	*x = InsightDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsightDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightDiffRequest) ProtoMessage() {}
+
+func (x *InsightDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightDiffRequest.ProtoReflect.Descriptor instead.
+func (*InsightDiffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *InsightDiffRequest) GetPeriod() Period {
+	if x != nil {
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+func (x *InsightDiffRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *InsightDiffRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type MetricDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric string  `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"` // productivity_score | burnout_score | avg_energy | an averages key
+	From   float64 `protobuf:"fixed64,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     float64 `protobuf:"fixed64,3,opt,name=to,proto3" json:"to,omitempty"`
+	Delta  float64 `protobuf:"fixed64,4,opt,name=delta,proto3" json:"delta,omitempty"` // to - from
+	Change string  `protobuf:"bytes,5,opt,name=change,proto3" json:"change,omitempty"` // improved | regressed | unchanged; lower stress and burnout_score count as improved
+}
+
+func (x *MetricDiff) Reset() {
+	*x = MetricDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricDiff) ProtoMessage() {}
+
+func (x *MetricDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricDiff.ProtoReflect.Descriptor instead.
+func (*MetricDiff) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MetricDiff) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *MetricDiff) GetFrom() float64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *MetricDiff) GetTo() float64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *MetricDiff) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *MetricDiff) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+type InsightDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period  string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	FromAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_at,json=fromAt,proto3" json:"from_at,omitempty"` // when the compared analyses were saved
+	ToAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_at,json=toAt,proto3" json:"to_at,omitempty"`
+	Metrics []*MetricDiff          `protobuf:"bytes,4,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *InsightDiffResponse) Reset() {
+	*x = InsightDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsightDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightDiffResponse) ProtoMessage() {}
+
+func (x *InsightDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightDiffResponse.ProtoReflect.Descriptor instead.
+func (*InsightDiffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *InsightDiffResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *InsightDiffResponse) GetFromAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromAt
+	}
+	return nil
+}
+
+func (x *InsightDiffResponse) GetToAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToAt
+	}
+	return nil
+}
+
+func (x *InsightDiffResponse) GetMetrics() []*MetricDiff {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// Admin only: days whose background analysis kept failing after all automatic retries.
+type ListDeadAnalysesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 0 = 100, at most 500
+}
+
+func (x *ListDeadAnalysesRequest) Reset() {
+	*x = ListDeadAnalysesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadAnalysesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadAnalysesRequest) ProtoMessage() {}
+
+func (x *ListDeadAnalysesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadAnalysesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadAnalysesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListDeadAnalysesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeadAnalysesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*FailedAnalysis `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ListDeadAnalysesResponse) Reset() {
+	*x = ListDeadAnalysesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadAnalysesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadAnalysesResponse) ProtoMessage() {}
+
+func (x *ListDeadAnalysesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadAnalysesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadAnalysesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListDeadAnalysesResponse) GetItems() []*FailedAnalysis {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Admin only: health of the LLM providers, in the order they are called.
+type ProviderStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProviderStatusRequest) Reset() {
+	*x = ProviderStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderStatusRequest) ProtoMessage() {}
+
+func (x *ProviderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderStatusRequest.ProtoReflect.Descriptor instead.
+func (*ProviderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{72}
+}
+
+type ProviderStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // host of the provider's API URL
+	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	WindowSeconds int32                  `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"` // successes and errors count the calls in this recent window
+	Successes     int64                  `protobuf:"varint,4,opt,name=successes,proto3" json:"successes,omitempty"`
+	Errors        int64                  `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"`
+	Degraded      bool                   `protobuf:"varint,6,opt,name=degraded,proto3" json:"degraded,omitempty"`                   // at least half of the calls in the window failed
+	LastError     string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"` // truncated; empty if no call has failed since startup
+	LastErrorAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_error_at,json=lastErrorAt,proto3" json:"last_error_at,omitempty"`
+}
+
+func (x *ProviderStatus) Reset() {
+	*x = ProviderStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderStatus) ProtoMessage() {}
+
+func (x *ProviderStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderStatus.ProtoReflect.Descriptor instead.
+func (*ProviderStatus) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ProviderStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderStatus) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ProviderStatus) GetWindowSeconds() int32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetSuccesses() int64 {
+	if x != nil {
+		return x.Successes
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *ProviderStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ProviderStatus) GetLastErrorAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return nil
+}
+
+type ProviderStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Providers []*ProviderStatus `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *ProviderStatusResponse) Reset() {
+	*x = ProviderStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderStatusResponse) ProtoMessage() {}
+
+func (x *ProviderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderStatusResponse.ProtoReflect.Descriptor instead.
+func (*ProviderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ProviderStatusResponse) GetProviders() []*ProviderStatus {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type PromptAggregatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId             int32  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 0 = the caller; any other user requires x-admin-token
+	UserTz             string `protobuf:"bytes,2,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`  // empty = the user's saved timezone
+	Period             Period `protobuf:"varint,3,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+	BurnoutHorizonDays int32  `protobuf:"varint,4,opt,name=burnout_horizon_days,json=burnoutHorizonDays,proto3" json:"burnout_horizon_days,omitempty"` // 0 = default (14)
+}
+
+func (x *PromptAggregatesRequest) Reset() {
+	*x = PromptAggregatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptAggregatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptAggregatesRequest) ProtoMessage() {}
+
+func (x *PromptAggregatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptAggregatesRequest.ProtoReflect.Descriptor instead.
+func (*PromptAggregatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PromptAggregatesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PromptAggregatesRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+func (x *PromptAggregatesRequest) GetPeriod() Period {
+	if x != nil {
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+func (x *PromptAggregatesRequest) GetBurnoutHorizonDays() int32 {
+	if x != nil {
+		return x.BurnoutHorizonDays
+	}
+	return 0
+}
+
+// Mirrors the values of the insight prompt; user_notes is only returned to the user themselves.
+type PromptAggregatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserTz               string                 `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+	Period               string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	PeriodStart          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	EnergyByWeekday      map[string]float64     `protobuf:"bytes,5,rep,name=energy_by_weekday,json=energyByWeekday,proto3" json:"energy_by_weekday,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	ProductivityScore    float64                `protobuf:"fixed64,6,opt,name=productivity_score,json=productivityScore,proto3" json:"productivity_score,omitempty"`
+	BurnoutScore         float64                `protobuf:"fixed64,7,opt,name=burnout_score,json=burnoutScore,proto3" json:"burnout_score,omitempty"`
+	BurnoutLevel         string                 `protobuf:"bytes,8,opt,name=burnout_level,json=burnoutLevel,proto3" json:"burnout_level,omitempty"`
+	BurnoutReasons       []string               `protobuf:"bytes,9,rep,name=burnout_reasons,json=burnoutReasons,proto3" json:"burnout_reasons,omitempty"`
+	NumPoints            int32                  `protobuf:"varint,10,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"`
+	NumObservedWeekdays  int32                  `protobuf:"varint,11,opt,name=num_observed_weekdays,json=numObservedWeekdays,proto3" json:"num_observed_weekdays,omitempty"`
+	NumObservedDays      int32                  `protobuf:"varint,12,opt,name=num_observed_days,json=numObservedDays,proto3" json:"num_observed_days,omitempty"`
+	ObservedWeekdaysList string                 `protobuf:"bytes,13,opt,name=observed_weekdays_list,json=observedWeekdaysList,proto3" json:"observed_weekdays_list,omitempty"`
+	TrendsReliable       bool                   `protobuf:"varint,14,opt,name=trends_reliable,json=trendsReliable,proto3" json:"trends_reliable,omitempty"`
+	HiddenMetrics        []string               `protobuf:"bytes,15,rep,name=hidden_metrics,json=hiddenMetrics,proto3" json:"hidden_metrics,omitempty"`
+	Tone                 Tone                   `protobuf:"varint,16,opt,name=tone,proto3,enum=nexusai.v1.Tone" json:"tone,omitempty"`
+	UserNotes            string                 `protobuf:"bytes,17,opt,name=user_notes,json=userNotes,proto3" json:"user_notes,omitempty"`
+	AvgSleepHours        float64                `protobuf:"fixed64,18,opt,name=avg_sleep_hours,json=avgSleepHours,proto3" json:"avg_sleep_hours,omitempty"`
+	AvgSleepQuality      float64                `protobuf:"fixed64,19,opt,name=avg_sleep_quality,json=avgSleepQuality,proto3" json:"avg_sleep_quality,omitempty"`
+	AvgMood              float64                `protobuf:"fixed64,20,opt,name=avg_mood,json=avgMood,proto3" json:"avg_mood,omitempty"`
+	AvgActivity          float64                `protobuf:"fixed64,21,opt,name=avg_activity,json=avgActivity,proto3" json:"avg_activity,omitempty"`
+	AvgProductive        float64                `protobuf:"fixed64,22,opt,name=avg_productive,json=avgProductive,proto3" json:"avg_productive,omitempty"`
+	AvgStress            float64                `protobuf:"fixed64,23,opt,name=avg_stress,json=avgStress,proto3" json:"avg_stress,omitempty"`
+	AvgEnergy            float64                `protobuf:"fixed64,24,opt,name=avg_energy,json=avgEnergy,proto3" json:"avg_energy,omitempty"`
+	AvgConcentration     float64                `protobuf:"fixed64,25,opt,name=avg_concentration,json=avgConcentration,proto3" json:"avg_concentration,omitempty"`
+	AvgSleepStart        string                 `protobuf:"bytes,26,opt,name=avg_sleep_start,json=avgSleepStart,proto3" json:"avg_sleep_start,omitempty"`
+	AvgSleepEnd          string                 `protobuf:"bytes,27,opt,name=avg_sleep_end,json=avgSleepEnd,proto3" json:"avg_sleep_end,omitempty"`
+	MinEnergy            float64                `protobuf:"fixed64,28,opt,name=min_energy,json=minEnergy,proto3" json:"min_energy,omitempty"`
+	MaxEnergy            float64                `protobuf:"fixed64,29,opt,name=max_energy,json=maxEnergy,proto3" json:"max_energy,omitempty"`
+	MinStress            float64                `protobuf:"fixed64,30,opt,name=min_stress,json=minStress,proto3" json:"min_stress,omitempty"`
+	MaxStress            float64                `protobuf:"fixed64,31,opt,name=max_stress,json=maxStress,proto3" json:"max_stress,omitempty"`
+	MinSleepHours        float64                `protobuf:"fixed64,32,opt,name=min_sleep_hours,json=minSleepHours,proto3" json:"min_sleep_hours,omitempty"`
+	MaxSleepHours        float64                `protobuf:"fixed64,33,opt,name=max_sleep_hours,json=maxSleepHours,proto3" json:"max_sleep_hours,omitempty"`
+	SocialJetlagHours    float64                `protobuf:"fixed64,34,opt,name=social_jetlag_hours,json=socialJetlagHours,proto3" json:"social_jetlag_hours,omitempty"` // weekend sleep midpoint minus weekday midpoint, hours; 0 = none or too few nights
+	// Long histories only (recent_window_days > 0): notes come from the last recent_window_days of the period,
+	// the recent_* fields average that window, and older history is summarized by its note count and words.
+	RecentWindowDays    int32           `protobuf:"varint,35,opt,name=recent_window_days,json=recentWindowDays,proto3" json:"recent_window_days,omitempty"`
+	RecentAvgSleepHours float64         `protobuf:"fixed64,36,opt,name=recent_avg_sleep_hours,json=recentAvgSleepHours,proto3" json:"recent_avg_sleep_hours,omitempty"`
+	RecentAvgMood       float64         `protobuf:"fixed64,37,opt,name=recent_avg_mood,json=recentAvgMood,proto3" json:"recent_avg_mood,omitempty"`
+	RecentAvgStress     float64         `protobuf:"fixed64,38,opt,name=recent_avg_stress,json=recentAvgStress,proto3" json:"recent_avg_stress,omitempty"`
+	RecentAvgEnergy     float64         `protobuf:"fixed64,39,opt,name=recent_avg_energy,json=recentAvgEnergy,proto3" json:"recent_avg_energy,omitempty"`
+	OlderNotes          int32           `protobuf:"varint,40,opt,name=older_notes,json=olderNotes,proto3" json:"older_notes,omitempty"`
+	OlderKeywords       []string        `protobuf:"bytes,41,rep,name=older_keywords,json=olderKeywords,proto3" json:"older_keywords,omitempty"` // taken from the notes, so only returned to the user themselves
+	Goals               []*GoalProgress `protobuf:"bytes,42,rep,name=goals,proto3" json:"goals,omitempty"`                                      // the user's goals with their attainment over the last days; empty without goals
+}
+
+func (x *PromptAggregatesResponse) Reset() {
+	*x = PromptAggregatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptAggregatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptAggregatesResponse) ProtoMessage() {}
+
+func (x *PromptAggregatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptAggregatesResponse.ProtoReflect.Descriptor instead.
+func (*PromptAggregatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PromptAggregatesResponse) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+func (x *PromptAggregatesResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *PromptAggregatesResponse) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *PromptAggregatesResponse) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *PromptAggregatesResponse) GetEnergyByWeekday() map[string]float64 {
+	if x != nil {
+		return x.EnergyByWeekday
+	}
+	return nil
+}
+
+func (x *PromptAggregatesResponse) GetProductivityScore() float64 {
+	if x != nil {
+		return x.ProductivityScore
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetBurnoutScore() float64 {
+	if x != nil {
+		return x.BurnoutScore
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetBurnoutLevel() string {
+	if x != nil {
+		return x.BurnoutLevel
+	}
+	return ""
+}
+
+func (x *PromptAggregatesResponse) GetBurnoutReasons() []string {
+	if x != nil {
+		return x.BurnoutReasons
+	}
+	return nil
+}
+
+func (x *PromptAggregatesResponse) GetNumPoints() int32 {
+	if x != nil {
+		return x.NumPoints
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetNumObservedWeekdays() int32 {
+	if x != nil {
+		return x.NumObservedWeekdays
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetNumObservedDays() int32 {
+	if x != nil {
+		return x.NumObservedDays
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetObservedWeekdaysList() string {
+	if x != nil {
+		return x.ObservedWeekdaysList
+	}
+	return ""
+}
+
+func (x *PromptAggregatesResponse) GetTrendsReliable() bool {
+	if x != nil {
+		return x.TrendsReliable
+	}
+	return false
+}
+
+func (x *PromptAggregatesResponse) GetHiddenMetrics() []string {
+	if x != nil {
+		return x.HiddenMetrics
+	}
+	return nil
+}
+
+func (x *PromptAggregatesResponse) GetTone() Tone {
+	if x != nil {
+		return x.Tone
+	}
+	return Tone_TONE_UNSPECIFIED
+}
+
+func (x *PromptAggregatesResponse) GetUserNotes() string {
+	if x != nil {
+		return x.UserNotes
+	}
+	return ""
+}
+
+func (x *PromptAggregatesResponse) GetAvgSleepHours() float64 {
+	if x != nil {
+		return x.AvgSleepHours
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetAvgSleepQuality() float64 {
+	if x != nil {
+		return x.AvgSleepQuality
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetAvgMood() float64 {
+	if x != nil {
+		return x.AvgMood
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetAvgActivity() float64 {
+	if x != nil {
+		return x.AvgActivity
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetAvgProductive() float64 {
+	if x != nil {
+		return x.AvgProductive
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetAvgStress() float64 {
+	if x != nil {
+		return x.AvgStress
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetAvgEnergy() float64 {
+	if x != nil {
+		return x.AvgEnergy
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetAvgConcentration() float64 {
+	if x != nil {
+		return x.AvgConcentration
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetAvgSleepStart() string {
+	if x != nil {
+		return x.AvgSleepStart
+	}
+	return ""
+}
+
+func (x *PromptAggregatesResponse) GetAvgSleepEnd() string {
+	if x != nil {
+		return x.AvgSleepEnd
+	}
+	return ""
+}
+
+func (x *PromptAggregatesResponse) GetMinEnergy() float64 {
+	if x != nil {
+		return x.MinEnergy
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetMaxEnergy() float64 {
+	if x != nil {
+		return x.MaxEnergy
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetMinStress() float64 {
+	if x != nil {
+		return x.MinStress
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetMaxStress() float64 {
+	if x != nil {
+		return x.MaxStress
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetMinSleepHours() float64 {
+	if x != nil {
+		return x.MinSleepHours
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetMaxSleepHours() float64 {
+	if x != nil {
+		return x.MaxSleepHours
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetSocialJetlagHours() float64 {
+	if x != nil {
+		return x.SocialJetlagHours
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetRecentWindowDays() int32 {
+	if x != nil {
+		return x.RecentWindowDays
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetRecentAvgSleepHours() float64 {
+	if x != nil {
+		return x.RecentAvgSleepHours
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetRecentAvgMood() float64 {
+	if x != nil {
+		return x.RecentAvgMood
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetRecentAvgStress() float64 {
+	if x != nil {
+		return x.RecentAvgStress
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetRecentAvgEnergy() float64 {
+	if x != nil {
+		return x.RecentAvgEnergy
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetOlderNotes() int32 {
+	if x != nil {
+		return x.OlderNotes
+	}
+	return 0
+}
+
+func (x *PromptAggregatesResponse) GetOlderKeywords() []string {
+	if x != nil {
+		return x.OlderKeywords
+	}
+	return nil
+}
+
+func (x *PromptAggregatesResponse) GetGoals() []*GoalProgress {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+// A goal is met on a day when the day's average is at least target, or at most target for stress.
+type GoalProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric string  `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Target float64 `protobuf:"fixed64,2,opt,name=target,proto3" json:"target,omitempty"`
+	Met    int32   `protobuf:"varint,3,opt,name=met,proto3" json:"met,omitempty"`   // days the goal was met
+	Days   int32   `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"` // days with the metric logged
+}
+
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalProgress) ProtoMessage() {}
+
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GoalProgress) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *GoalProgress) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetMet() int32 {
+	if x != nil {
+		return x.Met
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type FailedAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DayStart  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=day_start,json=dayStart,proto3" json:"day_start,omitempty"`
+	Attempts  int32                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string                 `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *FailedAnalysis) Reset() {
+	*x = FailedAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailedAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedAnalysis) ProtoMessage() {}
+
+func (x *FailedAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedAnalysis.ProtoReflect.Descriptor instead.
+func (*FailedAnalysis) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *FailedAnalysis) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FailedAnalysis) GetDayStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DayStart
+	}
+	return nil
+}
+
+func (x *FailedAnalysis) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *FailedAnalysis) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *FailedAnalysis) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Unset to = now, unset from = 30 days before to; the range may not exceed one year.
+type AggregateStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *AggregateStatsRequest) Reset() {
+	*x = AggregateStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateStatsRequest) ProtoMessage() {}
+
+func (x *AggregateStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateStatsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AggregateStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AggregateStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// Anonymized population aggregates; buckets with too few distinct users are dropped.
+type AggregateStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	NumPoints      int64                  `protobuf:"varint,3,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"`
+	NumUsers       int64                  `protobuf:"varint,4,opt,name=num_users,json=numUsers,proto3" json:"num_users,omitempty"`
+	AvgSleepHours  float64                `protobuf:"fixed64,5,opt,name=avg_sleep_hours,json=avgSleepHours,proto3" json:"avg_sleep_hours,omitempty"`
+	AvgMood        float64                `protobuf:"fixed64,6,opt,name=avg_mood,json=avgMood,proto3" json:"avg_mood,omitempty"`
+	AvgStress      float64                `protobuf:"fixed64,7,opt,name=avg_stress,json=avgStress,proto3" json:"avg_stress,omitempty"`
+	AvgEnergy      float64                `protobuf:"fixed64,8,opt,name=avg_energy,json=avgEnergy,proto3" json:"avg_energy,omitempty"`
+	SleepHistogram []*HistogramBucket     `protobuf:"bytes,9,rep,name=sleep_histogram,json=sleepHistogram,proto3" json:"sleep_histogram,omitempty"`
+	ByWeekday      []*WeekdayAggregate    `protobuf:"bytes,10,rep,name=by_weekday,json=byWeekday,proto3" json:"by_weekday,omitempty"`
+}
+
+func (x *AggregateStatsResponse) Reset() {
+	*x = AggregateStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateStatsResponse) ProtoMessage() {}
+
+func (x *AggregateStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateStatsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AggregateStatsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AggregateStatsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AggregateStatsResponse) GetNumPoints() int64 {
+	if x != nil {
+		return x.NumPoints
+	}
+	return 0
+}
+
+func (x *AggregateStatsResponse) GetNumUsers() int64 {
+	if x != nil {
+		return x.NumUsers
+	}
+	return 0
+}
+
+func (x *AggregateStatsResponse) GetAvgSleepHours() float64 {
+	if x != nil {
+		return x.AvgSleepHours
+	}
+	return 0
+}
+
+func (x *AggregateStatsResponse) GetAvgMood() float64 {
+	if x != nil {
+		return x.AvgMood
+	}
+	return 0
+}
+
+func (x *AggregateStatsResponse) GetAvgStress() float64 {
+	if x != nil {
+		return x.AvgStress
+	}
+	return 0
+}
+
+func (x *AggregateStatsResponse) GetAvgEnergy() float64 {
+	if x != nil {
+		return x.AvgEnergy
+	}
+	return 0
+}
+
+func (x *AggregateStatsResponse) GetSleepHistogram() []*HistogramBucket {
+	if x != nil {
+		return x.SleepHistogram
+	}
+	return nil
+}
+
+func (x *AggregateStatsResponse) GetByWeekday() []*WeekdayAggregate {
+	if x != nil {
+		return x.ByWeekday
+	}
+	return nil
+}
+
+type HistogramBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lower float64 `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper float64 `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Count int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *HistogramBucket) Reset() {
+	*x = HistogramBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistogramBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistogramBucket) ProtoMessage() {}
+
+func (x *HistogramBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistogramBucket.ProtoReflect.Descriptor instead.
+func (*HistogramBucket) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *HistogramBucket) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *HistogramBucket) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *HistogramBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type WeekdayAggregate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weekday   string  `protobuf:"bytes,1,opt,name=weekday,proto3" json:"weekday,omitempty"` // UTC weekday
+	NumPoints int64   `protobuf:"varint,2,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"`
+	AvgMood   float64 `protobuf:"fixed64,3,opt,name=avg_mood,json=avgMood,proto3" json:"avg_mood,omitempty"`
+	AvgStress float64 `protobuf:"fixed64,4,opt,name=avg_stress,json=avgStress,proto3" json:"avg_stress,omitempty"`
+	AvgEnergy float64 `protobuf:"fixed64,5,opt,name=avg_energy,json=avgEnergy,proto3" json:"avg_energy,omitempty"`
+}
+
+func (x *WeekdayAggregate) Reset() {
+	*x = WeekdayAggregate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeekdayAggregate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekdayAggregate) ProtoMessage() {}
+
+func (x *WeekdayAggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekdayAggregate.ProtoReflect.Descriptor instead.
+func (*WeekdayAggregate) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *WeekdayAggregate) GetWeekday() string {
+	if x != nil {
+		return x.Weekday
+	}
+	return ""
+}
+
+func (x *WeekdayAggregate) GetNumPoints() int64 {
+	if x != nil {
+		return x.NumPoints
+	}
+	return 0
+}
+
+func (x *WeekdayAggregate) GetAvgMood() float64 {
+	if x != nil {
+		return x.AvgMood
+	}
+	return 0
+}
+
+func (x *WeekdayAggregate) GetAvgStress() float64 {
+	if x != nil {
+		return x.AvgStress
+	}
+	return 0
+}
+
+func (x *WeekdayAggregate) GetAvgEnergy() float64 {
+	if x != nil {
+		return x.AvgEnergy
+	}
+	return 0
+}
+
+type Constraints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkStartHour int32 `protobuf:"varint,1,opt,name=work_start_hour,json=workStartHour,proto3" json:"work_start_hour,omitempty"`
+	WorkEndHour   int32 `protobuf:"varint,2,opt,name=work_end_hour,json=workEndHour,proto3" json:"work_end_hour,omitempty"`
+}
+
+func (x *Constraints) Reset() {
+	*x = Constraints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Constraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constraints) ProtoMessage() {}
+
+func (x *Constraints) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Constraints.ProtoReflect.Descriptor instead.
+func (*Constraints) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Constraints) GetWorkStartHour() int32 {
+	if x != nil {
+		return x.WorkStartHour
+	}
+	return 0
+}
+
+func (x *Constraints) GetWorkEndHour() int32 {
+	if x != nil {
+		return x.WorkEndHour
+	}
+	return 0
+}
+
+type AnalyzeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnergyByWeekday     map[string]float64    `protobuf:"bytes,1,rep,name=energy_by_weekday,json=energyByWeekday,proto3" json:"energy_by_weekday,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	ProductivityModel   *ProductivityModel    `protobuf:"bytes,2,opt,name=productivity_model,json=productivityModel,proto3" json:"productivity_model,omitempty"`
+	BurnoutRisk         *BurnoutRisk          `protobuf:"bytes,3,opt,name=burnout_risk,json=burnoutRisk,proto3" json:"burnout_risk,omitempty"`
+	OptimalSchedule     *OptimalSchedule      `protobuf:"bytes,4,opt,name=optimal_schedule,json=optimalSchedule,proto3" json:"optimal_schedule,omitempty"`
+	LlmInsight          string                `protobuf:"bytes,5,opt,name=llm_insight,json=llmInsight,proto3" json:"llm_insight,omitempty"`
+	Debug               *structpb.Struct      `protobuf:"bytes,6,opt,name=debug,proto3" json:"debug,omitempty"`
+	DataSufficiency     *DataSufficiency      `protobuf:"bytes,7,opt,name=data_sufficiency,json=dataSufficiency,proto3" json:"data_sufficiency,omitempty"`
+	DataQualityWarnings []*DataQualityWarning `protobuf:"bytes,8,rep,name=data_quality_warnings,json=dataQualityWarnings,proto3" json:"data_quality_warnings,omitempty"`                                                                                      // non-fatal; the data itself is not changed
+	FilterTags          []string              `protobuf:"bytes,9,rep,name=filter_tags,json=filterTags,proto3" json:"filter_tags,omitempty"`                                                                                                                   // normalized tags the analysis was filtered by
+	Averages            map[string]float64    `protobuf:"bytes,10,rep,name=averages,proto3" json:"averages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`                                              // per-metric averages over the analyzed points
+	FocusStats          *FocusStats           `protobuf:"bytes,11,opt,name=focus_stats,json=focusStats,proto3" json:"focus_stats,omitempty"`                                                                                                                  // unset when no focus sessions were logged in the window
+	InsightSource       string                `protobuf:"bytes,12,opt,name=insight_source,json=insightSource,proto3" json:"insight_source,omitempty"`                                                                                                         // llm | summary (deterministic numeric summary, no AI) | onboarding (template for the first points); empty when no insight was requested
+	SocialJetlagHours   float64               `protobuf:"fixed64,13,opt,name=social_jetlag_hours,json=socialJetlagHours,proto3" json:"social_jetlag_hours,omitempty"`                                                                                         // weekend sleep midpoint minus weekday midpoint, hours; 0 = none or too few nights
+	PeriodMeta          *PeriodMeta           `protobuf:"bytes,14,opt,name=period_meta,json=periodMeta,proto3" json:"period_meta,omitempty"`                                                                                                                  // the window actually analyzed
+	ExcludedDays        int32                 `protobuf:"varint,15,opt,name=excluded_days,json=excludedDays,proto3" json:"excluded_days,omitempty"`                                                                                                           // days left out by exclude_tags and exclude_outliers
+	InsightUnchanged    bool                  `protobuf:"varint,16,opt,name=insight_unchanged,json=insightUnchanged,proto3" json:"insight_unchanged,omitempty"`                                                                                               // the new insight nearly matched the previous one for the period, which is returned instead
+	EnergyByWeekdayCi   map[string]*EnergyCI  `protobuf:"bytes,17,rep,name=energy_by_weekday_ci,json=energyByWeekdayCi,proto3" json:"energy_by_weekday_ci,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // energy_by_weekday with 95% confidence intervals, for error bars
+}
+
+func (x *AnalyzeResponse) Reset() {
+	*x = AnalyzeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeResponse) ProtoMessage() {}
+
+func (x *AnalyzeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AnalyzeResponse) GetEnergyByWeekday() map[string]float64 {
+	if x != nil {
+		return x.EnergyByWeekday
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetProductivityModel() *ProductivityModel {
+	if x != nil {
+		return x.ProductivityModel
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetBurnoutRisk() *BurnoutRisk {
+	if x != nil {
+		return x.BurnoutRisk
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetOptimalSchedule() *OptimalSchedule {
+	if x != nil {
+		return x.OptimalSchedule
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetLlmInsight() string {
+	if x != nil {
+		return x.LlmInsight
+	}
+	return ""
+}
+
+func (x *AnalyzeResponse) GetDebug() *structpb.Struct {
+	if x != nil {
+		return x.Debug
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetDataSufficiency() *DataSufficiency {
+	if x != nil {
+		return x.DataSufficiency
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetDataQualityWarnings() []*DataQualityWarning {
+	if x != nil {
+		return x.DataQualityWarnings
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetFilterTags() []string {
+	if x != nil {
+		return x.FilterTags
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetAverages() map[string]float64 {
+	if x != nil {
+		return x.Averages
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetFocusStats() *FocusStats {
+	if x != nil {
+		return x.FocusStats
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetInsightSource() string {
+	if x != nil {
+		return x.InsightSource
+	}
+	return ""
+}
+
+func (x *AnalyzeResponse) GetSocialJetlagHours() float64 {
+	if x != nil {
+		return x.SocialJetlagHours
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetPeriodMeta() *PeriodMeta {
+	if x != nil {
+		return x.PeriodMeta
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetExcludedDays() int32 {
+	if x != nil {
+		return x.ExcludedDays
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetInsightUnchanged() bool {
+	if x != nil {
+		return x.InsightUnchanged
+	}
+	return false
+}
+
+func (x *AnalyzeResponse) GetEnergyByWeekdayCi() map[string]*EnergyCI {
+	if x != nil {
+		return x.EnergyByWeekdayCi
+	}
+	return nil
+}
+
+// Mean energy of a weekday with its 95% confidence interval. With a single sample the variance is
+// undefined: std_err is unset and the interval spans the whole 0..100 scale.
+type EnergyCI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mean    float64  `protobuf:"fixed64,1,opt,name=mean,proto3" json:"mean,omitempty"`
+	StdErr  *float64 `protobuf:"fixed64,2,opt,name=std_err,json=stdErr,proto3,oneof" json:"std_err,omitempty"`
+	Low     float64  `protobuf:"fixed64,3,opt,name=low,proto3" json:"low,omitempty"`
+	High    float64  `protobuf:"fixed64,4,opt,name=high,proto3" json:"high,omitempty"`
+	Samples int32    `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *EnergyCI) Reset() {
+	*x = EnergyCI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnergyCI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnergyCI) ProtoMessage() {}
+
+func (x *EnergyCI) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnergyCI.ProtoReflect.Descriptor instead.
+func (*EnergyCI) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *EnergyCI) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *EnergyCI) GetStdErr() float64 {
+	if x != nil && x.StdErr != nil {
+		return *x.StdErr
+	}
+	return 0
+}
+
+func (x *EnergyCI) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *EnergyCI) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *EnergyCI) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+// Window of an analysis in the user's timezone; end is exclusive. start is unset for the all-time period.
+type PeriodMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Label string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"` // e.g. "3–9 марта"
+}
+
+func (x *PeriodMeta) Reset() {
+	*x = PeriodMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodMeta) ProtoMessage() {}
+
+func (x *PeriodMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodMeta.ProtoReflect.Descriptor instead.
+func (*PeriodMeta) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PeriodMeta) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *PeriodMeta) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *PeriodMeta) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type FocusStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumSessions       int32    `protobuf:"varint,1,opt,name=num_sessions,json=numSessions,proto3" json:"num_sessions,omitempty"`
+	TotalHours        float64  `protobuf:"fixed64,2,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
+	AvgSessionMinutes float64  `protobuf:"fixed64,3,opt,name=avg_session_minutes,json=avgSessionMinutes,proto3" json:"avg_session_minutes,omitempty"`
+	AvgQuality        float64  `protobuf:"fixed64,4,opt,name=avg_quality,json=avgQuality,proto3" json:"avg_quality,omitempty"`
+	BestHours         []string `protobuf:"bytes,5,rep,name=best_hours,json=bestHours,proto3" json:"best_hours,omitempty"` // "HH:00" start hours with the best average session quality
+}
+
+func (x *FocusStats) Reset() {
+	*x = FocusStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FocusStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FocusStats) ProtoMessage() {}
+
+func (x *FocusStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FocusStats.ProtoReflect.Descriptor instead.
+func (*FocusStats) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *FocusStats) GetNumSessions() int32 {
+	if x != nil {
+		return x.NumSessions
+	}
+	return 0
+}
+
+func (x *FocusStats) GetTotalHours() float64 {
+	if x != nil {
+		return x.TotalHours
+	}
+	return 0
+}
+
+func (x *FocusStats) GetAvgSessionMinutes() float64 {
+	if x != nil {
+		return x.AvgSessionMinutes
+	}
+	return 0
+}
+
+func (x *FocusStats) GetAvgQuality() float64 {
+	if x != nil {
+		return x.AvgQuality
+	}
+	return 0
+}
+
+func (x *FocusStats) GetBestHours() []string {
+	if x != nil {
+		return x.BestHours
+	}
+	return nil
+}
+
+type FocusSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Quality   float64                `protobuf:"fixed64,4,opt,name=quality,proto3" json:"quality,omitempty"` // 0..10
+}
+
+func (x *FocusSession) Reset() {
+	*x = FocusSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FocusSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FocusSession) ProtoMessage() {}
+
+func (x *FocusSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FocusSession.ProtoReflect.Descriptor instead.
+func (*FocusSession) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *FocusSession) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FocusSession) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *FocusSession) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *FocusSession) GetQuality() float64 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+// ended_at must be after started_at, at most 12 hours later and not in the future.
+type LogFocusSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Quality   float64                `protobuf:"fixed64,3,opt,name=quality,proto3" json:"quality,omitempty"` // 0..10
+}
+
+func (x *LogFocusSessionRequest) Reset() {
+	*x = LogFocusSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogFocusSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogFocusSessionRequest) ProtoMessage() {}
+
+func (x *LogFocusSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogFocusSessionRequest.ProtoReflect.Descriptor instead.
+func (*LogFocusSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *LogFocusSessionRequest) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *LogFocusSessionRequest) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *LogFocusSessionRequest) GetQuality() float64 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+type LogFocusSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *FocusSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *LogFocusSessionResponse) Reset() {
+	*x = LogFocusSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogFocusSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogFocusSessionResponse) ProtoMessage() {}
+
+func (x *LogFocusSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogFocusSessionResponse.ProtoReflect.Descriptor instead.
+func (*LogFocusSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *LogFocusSessionResponse) GetSession() *FocusSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type DeleteFocusSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteFocusSessionRequest) Reset() {
+	*x = DeleteFocusSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFocusSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFocusSessionRequest) ProtoMessage() {}
+
+func (x *DeleteFocusSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFocusSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFocusSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeleteFocusSessionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteFocusSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *DeleteFocusSessionResponse) Reset() {
+	*x = DeleteFocusSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFocusSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFocusSessionResponse) ProtoMessage() {}
+
+func (x *DeleteFocusSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFocusSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFocusSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeleteFocusSessionResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type DataQualityWarning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date    string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD in the user's time zone
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // energy_contradiction | sleep_hours_mismatch
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DataQualityWarning) Reset() {
+	*x = DataQualityWarning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataQualityWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataQualityWarning) ProtoMessage() {}
+
+func (x *DataQualityWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataQualityWarning.ProtoReflect.Descriptor instead.
+func (*DataQualityWarning) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DataQualityWarning) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DataQualityWarning) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DataQualityWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DataSufficiency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level           string              `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"` // none | insufficient | sufficient
+	NumPoints       int32               `protobuf:"varint,2,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"`
+	NumObservedDays int32               `protobuf:"varint,3,opt,name=num_observed_days,json=numObservedDays,proto3" json:"num_observed_days,omitempty"` // all logged days, complete or not
+	Coverage        []*AnalysisCoverage `protobuf:"bytes,4,rep,name=coverage,proto3" json:"coverage,omitempty"`
+}
+
+func (x *DataSufficiency) Reset() {
+	*x = DataSufficiency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSufficiency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSufficiency) ProtoMessage() {}
+
+func (x *DataSufficiency) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSufficiency.ProtoReflect.Descriptor instead.
+func (*DataSufficiency) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DataSufficiency) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *DataSufficiency) GetNumPoints() int32 {
+	if x != nil {
+		return x.NumPoints
+	}
+	return 0
+}
+
+func (x *DataSufficiency) GetNumObservedDays() int32 {
+	if x != nil {
+		return x.NumObservedDays
+	}
+	return 0
+}
+
+func (x *DataSufficiency) GetCoverage() []*AnalysisCoverage {
+	if x != nil {
+		return x.Coverage
+	}
+	return nil
+}
+
+// How many logged days are usable for one analysis type: a day counts when one of its entries has all
+// required_fields. missing_fields lists the required fields absent on the days that did not count.
+type AnalysisCoverage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Analysis       string   `protobuf:"bytes,1,opt,name=analysis,proto3" json:"analysis,omitempty"` // energy | burnout | trends
+	Days           int32    `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	MinDays        int32    `protobuf:"varint,3,opt,name=min_days,json=minDays,proto3" json:"min_days,omitempty"` // days needed for a confident result: at most the period length; trends need half the burnout horizon
+	RequiredFields []string `protobuf:"bytes,4,rep,name=required_fields,json=requiredFields,proto3" json:"required_fields,omitempty"`
+	MissingFields  []string `protobuf:"bytes,5,rep,name=missing_fields,json=missingFields,proto3" json:"missing_fields,omitempty"`
+}
+
+func (x *AnalysisCoverage) Reset() {
+	*x = AnalysisCoverage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalysisCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisCoverage) ProtoMessage() {}
+
+func (x *AnalysisCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisCoverage.ProtoReflect.Descriptor instead.
+func (*AnalysisCoverage) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AnalysisCoverage) GetAnalysis() string {
+	if x != nil {
+		return x.Analysis
+	}
+	return ""
+}
+
+func (x *AnalysisCoverage) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *AnalysisCoverage) GetMinDays() int32 {
+	if x != nil {
+		return x.MinDays
+	}
+	return 0
+}
+
+func (x *AnalysisCoverage) GetRequiredFields() []string {
+	if x != nil {
+		return x.RequiredFields
+	}
+	return nil
+}
+
+func (x *AnalysisCoverage) GetMissingFields() []string {
+	if x != nil {
+		return x.MissingFields
+	}
+	return nil
+}
+
+// Explicit from/to take precedence over period; the range is capped at one year.
+type TrendsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserTz          string                 `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+	Period          Period                 `protobuf:"varint,2,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+	From            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To              *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	SmoothingWindow int32                  `protobuf:"varint,5,opt,name=smoothing_window,json=smoothingWindow,proto3" json:"smoothing_window,omitempty"` // trailing moving average in days, 0..30; 0 = raw daily values
+}
+
+func (x *TrendsRequest) Reset() {
+	*x = TrendsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendsRequest) ProtoMessage() {}
+
+func (x *TrendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendsRequest.ProtoReflect.Descriptor instead.
+func (*TrendsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TrendsRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+func (x *TrendsRequest) GetPeriod() Period {
+	if x != nil {
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+func (x *TrendsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TrendsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TrendsRequest) GetSmoothingWindow() int32 {
+	if x != nil {
+		return x.SmoothingWindow
+	}
+	return 0
+}
+
+// from/to are local YYYY-MM-DD dates, inclusive, at most 93 days apart; both empty = current month up to today.
+type StreakCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserTz        string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+	From          string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	IncludeEnergy bool   `protobuf:"varint,4,opt,name=include_energy,json=includeEnergy,proto3" json:"include_energy,omitempty"` // also return the energy score of logged days
+}
+
+func (x *StreakCalendarRequest) Reset() {
+	*x = StreakCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreakCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreakCalendarRequest) ProtoMessage() {}
+
+func (x *StreakCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreakCalendarRequest.ProtoReflect.Descriptor instead.
+func (*StreakCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *StreakCalendarRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+func (x *StreakCalendarRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StreakCalendarRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StreakCalendarRequest) GetIncludeEnergy() bool {
+	if x != nil {
+		return x.IncludeEnergy
+	}
+	return false
+}
+
+type CalendarDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date        string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Logged      bool     `protobuf:"varint,2,opt,name=logged,proto3" json:"logged,omitempty"`
+	EnergyScore *float64 `protobuf:"fixed64,3,opt,name=energy_score,json=energyScore,proto3,oneof" json:"energy_score,omitempty"` // set only for logged days when include_energy is true
+}
+
+func (x *CalendarDay) Reset() {
+	*x = CalendarDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDay) ProtoMessage() {}
+
+func (x *CalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDay.ProtoReflect.Descriptor instead.
+func (*CalendarDay) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CalendarDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetLogged() bool {
+	if x != nil {
+		return x.Logged
+	}
+	return false
+}
+
+func (x *CalendarDay) GetEnergyScore() float64 {
+	if x != nil && x.EnergyScore != nil {
+		return *x.EnergyScore
+	}
+	return 0
+}
+
+type StreakCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string         `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string         `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Days []*CalendarDay `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"` // one per date in from..to
+}
+
+func (x *StreakCalendarResponse) Reset() {
+	*x = StreakCalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreakCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreakCalendarResponse) ProtoMessage() {}
+
+func (x *StreakCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreakCalendarResponse.ProtoReflect.Descriptor instead.
+func (*StreakCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *StreakCalendarResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StreakCalendarResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StreakCalendarResponse) GetDays() []*CalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type MetricSeries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric    string    `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`          // sleep_hours | mood | energy | stress | productive | energy_score
+	Values    []float64 `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"` // aligned with TrendsResponse.days
+	Slope     float64   `protobuf:"fixed64,3,opt,name=slope,proto3" json:"slope,omitempty"`          // least-squares change per day over the raw values
+	Direction string    `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`    // up | down | flat
+}
+
+func (x *MetricSeries) Reset() {
+	*x = MetricSeries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricSeries) ProtoMessage() {}
+
+func (x *MetricSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricSeries.ProtoReflect.Descriptor instead.
+func (*MetricSeries) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *MetricSeries) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *MetricSeries) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *MetricSeries) GetSlope() float64 {
+	if x != nil {
+		return x.Slope
+	}
+	return 0
+}
+
+func (x *MetricSeries) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type TrendsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To              *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Days            []string               `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"` // YYYY-MM-DD of each logical day with entries
+	Series          []*MetricSeries        `protobuf:"bytes,4,rep,name=series,proto3" json:"series,omitempty"`
+	SmoothingWindow int32                  `protobuf:"varint,5,opt,name=smoothing_window,json=smoothingWindow,proto3" json:"smoothing_window,omitempty"`
+}
+
+func (x *TrendsResponse) Reset() {
+	*x = TrendsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendsResponse) ProtoMessage() {}
+
+func (x *TrendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendsResponse.ProtoReflect.Descriptor instead.
+func (*TrendsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *TrendsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TrendsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TrendsResponse) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *TrendsResponse) GetSeries() []*MetricSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *TrendsResponse) GetSmoothingWindow() int32 {
+	if x != nil {
+		return x.SmoothingWindow
+	}
+	return 0
+}
+
+type SuggestedReminderTimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserTz string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+}
+
+func (x *SuggestedReminderTimeRequest) Reset() {
+	*x = SuggestedReminderTimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestedReminderTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedReminderTimeRequest) ProtoMessage() {}
+
+func (x *SuggestedReminderTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedReminderTimeRequest.ProtoReflect.Descriptor instead.
+func (*SuggestedReminderTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SuggestedReminderTimeRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+type SuggestedReminderTimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time       string  `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                       // HH:MM local; 21:00 when there is no habitual time
+	Habitual   bool    `protobuf:"varint,2,opt,name=habitual,proto3" json:"habitual,omitempty"`              // false: too few logs or logs spread across the day
+	Confidence float64 `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`         // 0..1, how tightly logs cluster around time
+	NumLogs    int32   `protobuf:"varint,4,opt,name=num_logs,json=numLogs,proto3" json:"num_logs,omitempty"` // logs in the last month used for the estimate
+}
+
+func (x *SuggestedReminderTimeResponse) Reset() {
+	*x = SuggestedReminderTimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestedReminderTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedReminderTimeResponse) ProtoMessage() {}
+
+func (x *SuggestedReminderTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedReminderTimeResponse.ProtoReflect.Descriptor instead.
+func (*SuggestedReminderTimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SuggestedReminderTimeResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *SuggestedReminderTimeResponse) GetHabitual() bool {
+	if x != nil {
+		return x.Habitual
+	}
+	return false
+}
+
+func (x *SuggestedReminderTimeResponse) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *SuggestedReminderTimeResponse) GetNumLogs() int32 {
+	if x != nil {
+		return x.NumLogs
+	}
+	return 0
+}
+
+type WeeklyReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserTz string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+}
+
+func (x *WeeklyReportRequest) Reset() {
+	*x = WeeklyReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeeklyReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyReportRequest) ProtoMessage() {}
+
+func (x *WeeklyReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyReportRequest.ProtoReflect.Descriptor instead.
+func (*WeeklyReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *WeeklyReportRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+// Last 7 days as a share card; built from stored data without an LLM call, so repeated calls agree.
+type WeeklyReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WeekStart    string             `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"` // YYYY-MM-DD, local
+	WeekEnd      string             `protobuf:"bytes,2,opt,name=week_end,json=weekEnd,proto3" json:"week_end,omitempty"`
+	NumDays      int32              `protobuf:"varint,3,opt,name=num_days,json=numDays,proto3" json:"num_days,omitempty"`                                                                             // days with entries in the week
+	Averages     map[string]float64 `protobuf:"bytes,4,rep,name=averages,proto3" json:"averages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"` // sleep_hours, mood, energy, stress, productive; empty without entries
+	BestWeekday  string             `protobuf:"bytes,5,opt,name=best_weekday,json=bestWeekday,proto3" json:"best_weekday,omitempty"`                                                                  // Mon..Sun by average energy; empty with fewer than two observed weekdays
+	WorstWeekday string             `protobuf:"bytes,6,opt,name=worst_weekday,json=worstWeekday,proto3" json:"worst_weekday,omitempty"`
+	BurnoutLevel string             `protobuf:"bytes,7,opt,name=burnout_level,json=burnoutLevel,proto3" json:"burnout_level,omitempty"` // from the stored weekly analysis; empty if there is none
+	StreakDays   int32              `protobuf:"varint,8,opt,name=streak_days,json=streakDays,proto3" json:"streak_days,omitempty"`      // consecutive days with entries up to today (or yesterday)
+	TopHabit     string             `protobuf:"bytes,9,opt,name=top_habit,json=topHabit,proto3" json:"top_habit,omitempty"`             // workout | caffeine | alcohol, most days in the week; hidden metrics are skipped
+	TopHabitDays int32              `protobuf:"varint,10,opt,name=top_habit_days,json=topHabitDays,proto3" json:"top_habit_days,omitempty"`
+	Summary      string             `protobuf:"bytes,11,opt,name=summary,proto3" json:"summary,omitempty"` // first two sentences of the stored weekly insight
+}
+
+func (x *WeeklyReportResponse) Reset() {
+	*x = WeeklyReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeeklyReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyReportResponse) ProtoMessage() {}
+
+func (x *WeeklyReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyReportResponse.ProtoReflect.Descriptor instead.
+func (*WeeklyReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *WeeklyReportResponse) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *WeeklyReportResponse) GetWeekEnd() string {
+	if x != nil {
+		return x.WeekEnd
+	}
+	return ""
+}
+
+func (x *WeeklyReportResponse) GetNumDays() int32 {
+	if x != nil {
+		return x.NumDays
+	}
+	return 0
+}
+
+func (x *WeeklyReportResponse) GetAverages() map[string]float64 {
+	if x != nil {
+		return x.Averages
+	}
+	return nil
+}
+
+func (x *WeeklyReportResponse) GetBestWeekday() string {
+	if x != nil {
+		return x.BestWeekday
+	}
+	return ""
+}
+
+func (x *WeeklyReportResponse) GetWorstWeekday() string {
+	if x != nil {
+		return x.WorstWeekday
+	}
+	return ""
+}
+
+func (x *WeeklyReportResponse) GetBurnoutLevel() string {
+	if x != nil {
+		return x.BurnoutLevel
+	}
+	return ""
+}
+
+func (x *WeeklyReportResponse) GetStreakDays() int32 {
+	if x != nil {
+		return x.StreakDays
+	}
+	return 0
+}
+
+func (x *WeeklyReportResponse) GetTopHabit() string {
+	if x != nil {
+		return x.TopHabit
+	}
+	return ""
+}
+
+func (x *WeeklyReportResponse) GetTopHabitDays() int32 {
+	if x != nil {
+		return x.TopHabitDays
+	}
+	return 0
+}
+
+func (x *WeeklyReportResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+type TomorrowScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserTz string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+}
+
+func (x *TomorrowScheduleRequest) Reset() {
+	*x = TomorrowScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TomorrowScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TomorrowScheduleRequest) ProtoMessage() {}
+
+func (x *TomorrowScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TomorrowScheduleRequest.ProtoReflect.Descriptor instead.
+func (*TomorrowScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *TomorrowScheduleRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+// Precomputed nightly and after a new day is logged; computed on the spot on a cache miss.
+type TomorrowScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date       string                         `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD of the next logical day, local
+	Schedule   *OptimalSchedule               `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Reminder   *SuggestedReminderTimeResponse `protobuf:"bytes,3,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	NumPoints  int32                          `protobuf:"varint,4,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"` // logs in the last month the schedule is based on
+	ComputedAt *timestamppb.Timestamp         `protobuf:"bytes,5,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+}
+
+func (x *TomorrowScheduleResponse) Reset() {
+	*x = TomorrowScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TomorrowScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TomorrowScheduleResponse) ProtoMessage() {}
+
+func (x *TomorrowScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TomorrowScheduleResponse.ProtoReflect.Descriptor instead.
+func (*TomorrowScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *TomorrowScheduleResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TomorrowScheduleResponse) GetSchedule() *OptimalSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *TomorrowScheduleResponse) GetReminder() *SuggestedReminderTimeResponse {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+func (x *TomorrowScheduleResponse) GetNumPoints() int32 {
+	if x != nil {
+		return x.NumPoints
+	}
+	return 0
+}
+
+func (x *TomorrowScheduleResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type BestTimeTomorrowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserTz string `protobuf:"bytes,1,opt,name=user_tz,json=userTz,proto3" json:"user_tz,omitempty"`
+}
+
+func (x *BestTimeTomorrowRequest) Reset() {
+	*x = BestTimeTomorrowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BestTimeTomorrowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BestTimeTomorrowRequest) ProtoMessage() {}
+
+func (x *BestTimeTomorrowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BestTimeTomorrowRequest.ProtoReflect.Descriptor instead.
+func (*BestTimeTomorrowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *BestTimeTomorrowRequest) GetUserTz() string {
+	if x != nil {
+		return x.UserTz
+	}
+	return ""
+}
+
+type BestTimeTomorrowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnoughData         bool    `protobuf:"varint,1,opt,name=enough_data,json=enoughData,proto3" json:"enough_data,omitempty"`
+	FocusHour          string  `protobuf:"bytes,2,opt,name=focus_hour,json=focusHour,proto3" json:"focus_hour,omitempty"`                     // HH:00, empty when enough_data is false
+	FocusConfidence    float64 `protobuf:"fixed64,3,opt,name=focus_confidence,json=focusConfidence,proto3" json:"focus_confidence,omitempty"` // 0..1, grows with the number of samples in that hour
+	RecoveryHour       string  `protobuf:"bytes,4,opt,name=recovery_hour,json=recoveryHour,proto3" json:"recovery_hour,omitempty"`
+	RecoveryConfidence float64 `protobuf:"fixed64,5,opt,name=recovery_confidence,json=recoveryConfidence,proto3" json:"recovery_confidence,omitempty"`
+	Message            string  `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BestTimeTomorrowResponse) Reset() {
+	*x = BestTimeTomorrowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BestTimeTomorrowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BestTimeTomorrowResponse) ProtoMessage() {}
+
+func (x *BestTimeTomorrowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BestTimeTomorrowResponse.ProtoReflect.Descriptor instead.
+func (*BestTimeTomorrowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *BestTimeTomorrowResponse) GetEnoughData() bool {
+	if x != nil {
+		return x.EnoughData
+	}
+	return false
+}
+
+func (x *BestTimeTomorrowResponse) GetFocusHour() string {
+	if x != nil {
+		return x.FocusHour
+	}
+	return ""
+}
+
+func (x *BestTimeTomorrowResponse) GetFocusConfidence() float64 {
+	if x != nil {
+		return x.FocusConfidence
+	}
+	return 0
+}
+
+func (x *BestTimeTomorrowResponse) GetRecoveryHour() string {
+	if x != nil {
+		return x.RecoveryHour
+	}
+	return ""
+}
+
+func (x *BestTimeTomorrowResponse) GetRecoveryConfidence() float64 {
+	if x != nil {
+		return x.RecoveryConfidence
+	}
+	return 0
+}
+
+func (x *BestTimeTomorrowResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LastAnalysesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LastAnalysesRequest) Reset() {
+	*x = LastAnalysesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastAnalysesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastAnalysesRequest) ProtoMessage() {}
+
+func (x *LastAnalysesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastAnalysesRequest.ProtoReflect.Descriptor instead.
+func (*LastAnalysesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{110}
+}
+
+type LastAnalysesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LastAnalysisEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *LastAnalysesResponse) Reset() {
+	*x = LastAnalysesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastAnalysesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastAnalysesResponse) ProtoMessage() {}
+
+func (x *LastAnalysesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastAnalysesResponse.ProtoReflect.Descriptor instead.
+func (*LastAnalysesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *LastAnalysesResponse) GetEntries() []*LastAnalysisEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LastAnalysisEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period             string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Response           *AnalyzeResponse       `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Stale              bool                   `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`                                                                                          // the user has logged data newer than updated_at; only set for the caller's own analyses
+	Trends             map[string]string      `protobuf:"bytes,5,rep,name=trends,proto3" json:"trends,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // metric -> up | down | flat versus the analysis this one replaced; empty for the first one
+	AnalysisInProgress bool                   `protobuf:"varint,6,opt,name=analysis_in_progress,json=analysisInProgress,proto3" json:"analysis_in_progress,omitempty"`                                    // a newer analysis is being computed after a recent Track; response is the previous one
+}
+
+func (x *LastAnalysisEntry) Reset() {
+	*x = LastAnalysisEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastAnalysisEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastAnalysisEntry) ProtoMessage() {}
+
+func (x *LastAnalysisEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastAnalysisEntry.ProtoReflect.Descriptor instead.
+func (*LastAnalysisEntry) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *LastAnalysisEntry) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *LastAnalysisEntry) GetResponse() *AnalyzeResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *LastAnalysisEntry) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *LastAnalysisEntry) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *LastAnalysisEntry) GetTrends() map[string]string {
+	if x != nil {
+		return x.Trends
+	}
+	return nil
+}
+
+func (x *LastAnalysisEntry) GetAnalysisInProgress() bool {
+	if x != nil {
+		return x.AnalysisInProgress
+	}
+	return false
+}
+
+type ProductivityModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weights       map[string]float64 `protobuf:"bytes,1,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Score         float64            `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Components    map[string]float64 `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`       // raw 0..100 value of each factor
+	Contributions map[string]float64 `protobuf:"bytes,4,rep,name=contributions,proto3" json:"contributions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"` // points each factor added to the score
+	Insufficient  bool               `protobuf:"varint,5,opt,name=insufficient,proto3" json:"insufficient,omitempty"`                                                                                            // fewer than 2 points: score is 0 and there are no components
+}
+
+func (x *ProductivityModel) Reset() {
+	*x = ProductivityModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductivityModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductivityModel) ProtoMessage() {}
+
+func (x *ProductivityModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductivityModel.ProtoReflect.Descriptor instead.
+func (*ProductivityModel) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ProductivityModel) GetWeights() map[string]float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *ProductivityModel) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ProductivityModel) GetComponents() map[string]float64 {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *ProductivityModel) GetContributions() map[string]float64 {
+	if x != nil {
+		return x.Contributions
+	}
+	return nil
+}
+
+func (x *ProductivityModel) GetInsufficient() bool {
+	if x != nil {
+		return x.Insufficient
+	}
+	return false
+}
+
+type BurnoutRisk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score                 float64          `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	Level                 string           `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Reasons               []string         `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	PredictionHorizonDays int32            `protobuf:"varint,4,opt,name=prediction_horizon_days,json=predictionHorizonDays,proto3" json:"prediction_horizon_days,omitempty"`
+	StructuredReasons     []*BurnoutReason `protobuf:"bytes,5,rep,name=structured_reasons,json=structuredReasons,proto3" json:"structured_reasons,omitempty"` // one per triggered signal, same order as reasons
+	Dismissed             bool             `protobuf:"varint,6,opt,name=dismissed,proto3" json:"dismissed,omitempty"`                                         // high risk the user already acknowledged; shown again once the score rises meaningfully
+	CoverageRatio         float64          `protobuf:"fixed64,7,opt,name=coverage_ratio,json=coverageRatio,proto3" json:"coverage_ratio,omitempty"`           // share of days logged in the forecast window, counted from the first entry (0..1)
+}
+
+func (x *BurnoutRisk) Reset() {
+	*x = BurnoutRisk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnoutRisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnoutRisk) ProtoMessage() {}
+
+func (x *BurnoutRisk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnoutRisk.ProtoReflect.Descriptor instead.
+func (*BurnoutRisk) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *BurnoutRisk) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *BurnoutRisk) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *BurnoutRisk) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *BurnoutRisk) GetPredictionHorizonDays() int32 {
+	if x != nil {
+		return x.PredictionHorizonDays
+	}
+	return 0
+}
+
+func (x *BurnoutRisk) GetStructuredReasons() []*BurnoutReason {
+	if x != nil {
+		return x.StructuredReasons
+	}
+	return nil
+}
+
+func (x *BurnoutRisk) GetDismissed() bool {
+	if x != nil {
+		return x.Dismissed
+	}
+	return false
+}
+
+func (x *BurnoutRisk) GetCoverageRatio() float64 {
+	if x != nil {
+		return x.CoverageRatio
+	}
+	return 0
+}
+
+// Acknowledges the high burnout warning of the latest analysis for period.
+type DismissBurnoutWarningRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period Period `protobuf:"varint,1,opt,name=period,proto3,enum=nexusai.v1.Period" json:"period,omitempty"`
+}
+
+func (x *DismissBurnoutWarningRequest) Reset() {
+	*x = DismissBurnoutWarningRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissBurnoutWarningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissBurnoutWarningRequest) ProtoMessage() {}
+
+func (x *DismissBurnoutWarningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissBurnoutWarningRequest.ProtoReflect.Descriptor instead.
+func (*DismissBurnoutWarningRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DismissBurnoutWarningRequest) GetPeriod() Period {
+	if x != nil {
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+type DismissBurnoutWarningResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BurnoutRisk *BurnoutRisk `protobuf:"bytes,1,opt,name=burnout_risk,json=burnoutRisk,proto3" json:"burnout_risk,omitempty"`
+}
+
+func (x *DismissBurnoutWarningResponse) Reset() {
+	*x = DismissBurnoutWarningResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissBurnoutWarningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissBurnoutWarningResponse) ProtoMessage() {}
+
+func (x *DismissBurnoutWarningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissBurnoutWarningResponse.ProtoReflect.Descriptor instead.
+func (*DismissBurnoutWarningResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *DismissBurnoutWarningResponse) GetBurnoutRisk() *BurnoutRisk {
+	if x != nil {
+		return x.BurnoutRisk
+	}
+	return nil
+}
+
+type BurnoutReason struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     string  `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`         // sleep_debt | mood_down | energy_volatile | low_productivity | high_stress | low_self_energy | poor_sleep_quality | alcohol_often | workout_rare | social_jetlag
+	Weight   float64 `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`   // points added to the risk score
+	Severity string  `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"` // low | medium | high
+}
+
+func (x *BurnoutReason) Reset() {
+	*x = BurnoutReason{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnoutReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnoutReason) ProtoMessage() {}
+
+func (x *BurnoutReason) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnoutReason.ProtoReflect.Descriptor instead.
+func (*BurnoutReason) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *BurnoutReason) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BurnoutReason) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BurnoutReason) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+type OptimalSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SuggestedSleepWindow string   `protobuf:"bytes,1,opt,name=suggested_sleep_window,json=suggestedSleepWindow,proto3" json:"suggested_sleep_window,omitempty"`
+	BestFocusHours       []string `protobuf:"bytes,2,rep,name=best_focus_hours,json=bestFocusHours,proto3" json:"best_focus_hours,omitempty"`
+	BestLightTasksHours  []string `protobuf:"bytes,3,rep,name=best_light_tasks_hours,json=bestLightTasksHours,proto3" json:"best_light_tasks_hours,omitempty"`
+	RecoveryTips         []string `protobuf:"bytes,4,rep,name=recovery_tips,json=recoveryTips,proto3" json:"recovery_tips,omitempty"`
+	Chronotype           string   `protobuf:"bytes,5,opt,name=chronotype,proto3" json:"chronotype,omitempty"` // morning | evening | neutral | unknown (too few hours logged in the 6–11 or 17–22 window)
+}
+
+func (x *OptimalSchedule) Reset() {
+	*x = OptimalSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimalSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimalSchedule) ProtoMessage() {}
+
+func (x *OptimalSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexusai_v1_analyzer_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimalSchedule.ProtoReflect.Descriptor instead.
+func (*OptimalSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_nexusai_v1_analyzer_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *OptimalSchedule) GetSuggestedSleepWindow() string {
+	if x != nil {
+		return x.SuggestedSleepWindow
+	}
+	return ""
+}
+
+func (x *OptimalSchedule) GetBestFocusHours() []string {
+	if x != nil {
+		return x.BestFocusHours
+	}
+	return nil
+}
+
+func (x *OptimalSchedule) GetBestLightTasksHours() []string {
+	if x != nil {
+		return x.BestLightTasksHours
+	}
+	return nil
+}
+
+func (x *OptimalSchedule) GetRecoveryTips() []string {
+	if x != nil {
+		return x.RecoveryTips
+	}
+	return nil
+}
+
+func (x *OptimalSchedule) GetChronotype() string {
+	if x != nil {
+		return x.Chronotype
+	}
+	return ""
+}
+
+var File_proto_nexusai_v1_analyzer_proto protoreflect.FileDescriptor
+
+var file_proto_nexusai_v1_analyzer_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0a, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a, 0x0c,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x22, 0x2c,
+	0x0a, 0x11, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x22, 0x5a, 0x0a, 0x12,
+	0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0xe4, 0x05, 0x0a, 0x16, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x24, 0x0a, 0x0b,
+	0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x73, 0x6c, 0x65, 0x65, 0x70,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x6c, 0x65, 0x65,
+	0x70, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x73,
+	0x6c, 0x65, 0x65, 0x70, 0x45, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x6f,
+	0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x6f, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x06, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x07, 0x52, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x48, 0x08, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x0d, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x01, 0x48, 0x09, 0x52, 0x0c, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x66, 0x66,
+	0x65, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0a, 0x52, 0x08, 0x63, 0x61,
+	0x66, 0x66, 0x65, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x6c, 0x63,
+	0x6f, 0x68, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0b, 0x52, 0x07, 0x61, 0x6c,
+	0x63, 0x6f, 0x68, 0x6f, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0c, 0x52, 0x07, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6e, 0x61, 0x70, 0x5f, 0x6d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x48, 0x0d, 0x52, 0x0a,
+	0x6e, 0x61, 0x70, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6d, 0x6f, 0x6f, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x6c, 0x65,
+	0x65, 0x70, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63,
+	0x61, 0x66, 0x66, 0x65, 0x69, 0x6e, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x6c, 0x63, 0x6f,
+	0x68, 0x6f, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x61, 0x70, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x4a,
+	0x04, 0x08, 0x0f, 0x10, 0x10, 0x52, 0x08, 0x6c, 0x6c, 0x6d, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0x61, 0x0a, 0x17, 0x50, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x16, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x46, 0x6f,
+	0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xed, 0x03, 0x0a, 0x0e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x65, 0x65, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x65, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12,
+	0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75,
+	0x74, 0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x48, 0x6f, 0x72,
+	0x69, 0x7a, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x24,
+	0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6e, 0x65, 0x52, 0x04,
+	0x74, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x70,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6f, 0x75, 0x74,
+	0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x7b, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x22,
+	0xbd, 0x07, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x02, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x6c,
+	0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x0a, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x45, 0x6e, 0x64, 0x12, 0x17,
+	0x0a, 0x04, 0x6d, 0x6f, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x04,
+	0x6d, 0x6f, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x08, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x65, 0x6e,
+	0x65, 0x72, 0x67, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x06, 0x65, 0x6e,
+	0x65, 0x72, 0x67, 0x79, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x06,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x07, 0x52, 0x0c, 0x73, 0x6c, 0x65,
+	0x65, 0x70, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x66, 0x66, 0x65, 0x69, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x63, 0x61, 0x66, 0x66, 0x65, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x63, 0x6f,
+	0x68, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x63, 0x6f, 0x68,
+	0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x6c, 0x6d, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x6c, 0x6d, 0x54, 0x65, 0x78, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x45, 0x6e, 0x64, 0x54, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x70, 0x5f, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x61, 0x70, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x6f, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22,
+	0xbc, 0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x67, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x67, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x6f,
+	0x75, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x61, 0x79, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x6f, 0x6e, 0x65, 0x52, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x5f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xc8,
+	0x01, 0x0a, 0x0d, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x27, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x30, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x35, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xbd, 0x03, 0x0a, 0x1a, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x69, 0x74,
+	0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x5d, 0x0a, 0x0d, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x72, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x45, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xb1, 0x01, 0x0a, 0x0c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x67, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x76,
+	0x67, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xa6, 0x01, 0x0a, 0x1b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x22, 0x36,
+	0x0a, 0x18, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x77, 0x0a, 0x19, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x70, 0x0a, 0x13, 0x4e, 0x6f,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4d, 0x0a, 0x0b,
+	0x4e, 0x6f, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x14, 0x4e,
+	0x6f, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x6f, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x7d, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x76, 0x67, 0x5f, 0x6d, 0x6f, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x61, 0x76, 0x67, 0x4d, 0x6f, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x65,
+	0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67,
+	0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x22, 0x7d, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x5f, 0x0a, 0x18, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x01, 0x62, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74,
+	0x22, 0x87, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75,
+	0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x30, 0x0a, 0x15, 0x42, 0x65,
+	0x64, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x22, 0xd8, 0x01, 0x0a,
+	0x16, 0x42, 0x65, 0x64, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x65, 0x64, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x65, 0x64, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x75, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x64, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x75, 0x61, 0x6c, 0x42,
+	0x65, 0x64, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f,
+	0x64, 0x65, 0x62, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x44, 0x65, 0x62, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x66, 0x74, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x9f, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xbd,
+	0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x62, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x29, 0x0a, 0x0e, 0x64, 0x61, 0x79, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x0c, 0x64, 0x61, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x6f, 0x75, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x6e, 0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a,
+	0x0a, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64,
+	0x61, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x74, 0x6f, 0x6e, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x4a,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x36, 0x0a, 0x1a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x22, 0x37, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f,
+	0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x85, 0x01, 0x0a, 0x0d, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x6f, 0x61,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x1a, 0x38,
+	0x0a, 0x0a, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42,
+	0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x6f,
+	0x75, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x22, 0x2a, 0x0a, 0x16, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x2a,
+	0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x44, 0x0a, 0x13, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x22, 0x2e, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x22, 0x46, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x73, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x52, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
+	0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x33, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x2b, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x0e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x06,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x33, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x18, 0x53,
+	0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x6f, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a,
+	0x1c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x6c, 0x0a,
+	0x18, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x10,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x22, 0xbe, 0x02, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x6d,
+	0x79, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x6d, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02,
+	0x74, 0x6f, 0x22, 0x76, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x69, 0x66, 0x66,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x13, 0x49,
+	0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x74, 0x12,
+	0x2f, 0x0a, 0x05, 0x74, 0x6f, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x6f, 0x41, 0x74,
+	0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x69, 0x66, 0x66, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x4c, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x17, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x3e, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x41, 0x74, 0x22,
+	0x52, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x74, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54,
+	0x7a, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a,
+	0x14, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e,
+	0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x62, 0x75, 0x72,
+	0x6e, 0x6f, 0x75, 0x74, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22,
+	0xa3, 0x0e, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3d, 0x0a,
+	0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x65, 0x0a, 0x11, 0x65, 0x6e, 0x65, 0x72, 0x67,
+	0x79, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42,
+	0x79, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x65,
+	0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x79, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x2d,
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x6f,
+	0x75, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x72, 0x6e, 0x6f,
+	0x75, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x32, 0x0a, 0x15, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f,
+	0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13,
+	0x6e, 0x75, 0x6d, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x57, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x6e, 0x75, 0x6d, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x34, 0x0a, 0x16, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x65, 0x6b,
+	0x64, 0x61, 0x79, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x5f,
+	0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x74, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x6f, 0x6e, 0x65, 0x52, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x76,
+	0x67, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x61,
+	0x76, 0x67, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x76, 0x67, 0x5f, 0x6d, 0x6f, 0x6f, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x61, 0x76, 0x67, 0x4d, 0x6f, 0x6f, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x76, 0x67,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x61, 0x76, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x76, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67, 0x53, 0x74, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67, 0x45, 0x6e, 0x65, 0x72, 0x67,
+	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x76,
+	0x67, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x0f, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x53, 0x6c, 0x65, 0x65,
+	0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x6c,
+	0x65, 0x65, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x76, 0x67, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x45, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69,
+	0x6e, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6d, 0x69, 0x6e, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
+	0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69,
+	0x6e, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x73, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x6c,
+	0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x6c, 0x65, 0x65,
+	0x70, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x5f, 0x6a, 0x65, 0x74, 0x6c, 0x61, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4a, 0x65, 0x74, 0x6c, 0x61,
+	0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x23, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x44, 0x61, 0x79, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x76, 0x67, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x24,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x76, 0x67, 0x53,
+	0x6c, 0x65, 0x65, 0x70, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x67, 0x5f, 0x6d, 0x6f, 0x6f, 0x64, 0x18, 0x25, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x76, 0x67, 0x4d, 0x6f, 0x6f,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x67, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x18, 0x26, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65,
+	0x63, 0x65, 0x6e, 0x74, 0x41, 0x76, 0x67, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x67, 0x5f, 0x65, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x18, 0x27, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x41, 0x76, 0x67, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x29, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x2e, 0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x67, 0x6f, 0x61, 0x6c,
+	0x73, 0x1a, 0x42, 0x0a, 0x14, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x79, 0x57, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64, 0x0a, 0x0c, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6d, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x0e,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x64, 0x61, 0x79, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x73, 0x0a, 0x15, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xb4, 0x03, 0x0a, 0x16,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x53, 0x6c, 0x65, 0x65,
+	0x70, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x76, 0x67, 0x5f, 0x6d, 0x6f,
+	0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x76, 0x67, 0x4d, 0x6f, 0x6f,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12,
+	0x44, 0x0a, 0x0f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0e, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x79, 0x5f, 0x77, 0x65, 0x65, 0x6b,
+	0x64, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x09, 0x62, 0x79, 0x57, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x22, 0x53, 0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x57, 0x65, 0x65, 0x6b,
+	0x64, 0x61, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77,
+	0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x76, 0x67, 0x5f, 0x6d, 0x6f, 0x6f,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x76, 0x67, 0x4d, 0x6f, 0x6f, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x22, 0x59,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x65, 0x6e,
+	0x64, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x45, 0x6e, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x22, 0xf2, 0x09, 0x0a, 0x0f, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x11, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x79, 0x57, 0x65,
+	0x65, 0x6b, 0x64, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x65, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x42, 0x79, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x4c, 0x0a, 0x12, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x0c, 0x62, 0x75, 0x72,
+	0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72,
+	0x6e, 0x6f, 0x75, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x0b, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75,
+	0x74, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x46, 0x0a, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6d, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d,
+	0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x46, 0x0a,
+	0x10, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x53, 0x75, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x52, 0x0a, 0x15, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x64, 0x61, 0x74, 0x61, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x0b, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0a,
+	0x66, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x73, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6a, 0x65, 0x74, 0x6c,
+	0x61, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4a, 0x65, 0x74, 0x6c, 0x61, 0x67, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x12, 0x37, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x55, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x63, 0x0a, 0x14,
+	0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61,
+	0x79, 0x5f, 0x63, 0x69, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x79,
+	0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x43, 0x69, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11,
+	0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x79, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x43,
+	0x69, 0x1a, 0x42, 0x0a, 0x14, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x79, 0x57, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x16, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x42, 0x79, 0x57, 0x65,
+	0x65, 0x6b, 0x64, 0x61, 0x79, 0x43, 0x69, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x65, 0x72, 0x67,
+	0x79, 0x43, 0x49, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x88,
+	0x01, 0x0a, 0x08, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x43, 0x49, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x65, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12,
+	0x1c, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x64, 0x45, 0x72, 0x72, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68,
+	0x69, 0x67, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x73, 0x74, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xc0,
+	0x01, 0x0a, 0x0a, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x61, 0x76, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x67, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x76, 0x67, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xa4,
+	0x01, 0x0a, 0x16, 0x4c, 0x6f, 0x67, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x71,
+	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x4d, 0x0a, 0x17, 0x4c, 0x6f, 0x67, 0x46, 0x6f, 0x63, 0x75,
+	0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6f, 0x63, 0x75, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f,
+	0x63, 0x75, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x63, 0x75, 0x73,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22,
+	0x56, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x75, 0x6d,
+	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x38, 0x0a, 0x08,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x08, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
+	0x69, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x65, 0x6e, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54,
+	0x7a, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6d, 0x6f,
+	0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x22, 0x7b, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e, 0x65, 0x72, 0x67,
+	0x79, 0x22, 0x72, 0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x44, 0x61, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0c,
+	0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x69, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x44, 0x61, 0x79, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x22, 0x72, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdd, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6d, 0x6f,
+	0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x22, 0x37, 0x0a, 0x1c, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x22, 0x8a, 0x01,
+	0x0a, 0x1d, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x62, 0x69, 0x74, 0x75, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x62, 0x69, 0x74, 0x75, 0x61, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x2e, 0x0a, 0x13, 0x57, 0x65,
+	0x65, 0x6b, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a, 0x22, 0xdf, 0x03, 0x0a, 0x14, 0x57,
+	0x65, 0x65, 0x6b, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x65, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x65, 0x65, 0x6b, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x45, 0x6e, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6e, 0x75, 0x6d, 0x44, 0x61, 0x79, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x77, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x73, 0x74,
+	0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x73, 0x74,
+	0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x77, 0x6f, 0x72, 0x73, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x61, 0x62, 0x69, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x48, 0x61, 0x62, 0x69, 0x74, 0x12,
+	0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x61, 0x62, 0x69, 0x74, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x48, 0x61, 0x62, 0x69,
+	0x74, 0x44, 0x61, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a,
+	0x3b, 0x0a, 0x0d, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x32, 0x0a, 0x17,
+	0x54, 0x6f, 0x6d, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x7a,
+	0x22, 0x8a, 0x02, 0x0a, 0x18, 0x54, 0x6f, 0x6d, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x72, 0x65,
+	0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x32, 0x0a,
+	0x17, 0x42, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x6d, 0x6f, 0x72, 0x72, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x74, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x54,
+	0x7a, 0x22, 0xf5, 0x01, 0x0a, 0x18, 0x42, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x6f,
+	0x6d, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x6e, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6e, 0x6f, 0x75, 0x67, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x29,
+	0x0a, 0x10, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x2f,
+	0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x61, 0x73,
+	0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x4f, 0x0a, 0x14, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0xe5, 0x02, 0x0a, 0x11, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x74, 0x72, 0x65,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf7, 0x03, 0x0a, 0x11, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x44, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x75, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x40, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x9a, 0x02, 0x0a, 0x0b, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x52,
+	0x69, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x70, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x11, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x22, 0x4a, 0x0a, 0x1c, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x42, 0x75, 0x72, 0x6e, 0x6f,
+	0x75, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2a, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x5b, 0x0a, 0x1d,
+	0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0c, 0x62, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x0b, 0x62, 0x75,
+	0x72, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x22, 0x57, 0x0a, 0x0d, 0x42, 0x75, 0x72,
+	0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x22, 0xeb, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x28, 0x0a, 0x10,
+	0x62, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x63, 0x75,
+	0x73, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x67, 0x68,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x69, 0x70, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x74, 0x79, 0x70, 0x65,
+	0x2a, 0x52, 0x0a, 0x04, 0x54, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x4f, 0x4e, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x54, 0x4c, 0x45, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x02,
+	0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4f, 0x4e,
+	0x4c, 0x59, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16,
+	0x0a, 0x12, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
+	0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
+	0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52,
+	0x49, 0x4f, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x04, 0x32, 0xf9, 0x20, 0x0a, 0x0f, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a,
+	0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c,
+	0x0a, 0x15, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74,
+	0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x42, 0x75, 0x72, 0x6e,
+	0x6f, 0x75, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f,
+	0x4c, 0x6f, 0x67, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
+	0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1d,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x64, 0x61,
+	0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x64, 0x61, 0x79,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x0f, 0x50, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x12, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x6f,
+	0x6d, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x6d, 0x6f,
+	0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x54, 0x6f, 0x6d, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x19,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x28, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6d,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6d, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x6d, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57,
+	0x65, 0x65, 0x6b, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66,
+	0x0a, 0x13, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x24, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42,
+	0x65, 0x64, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x64, 0x74, 0x69,
+	0x6d, 0x65, 0x54, 0x6f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65,
+	0x64, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x51, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x79, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x12, 0x26, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x12,
+	0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5d, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x12,
+	0x26, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x24,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x46,
+	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x22,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x64, 0x12, 0x26,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x45,
+	0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x65,
+	0x65, 0x64, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x53, 0x65,
+	0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1e, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x2f, 0x76, 0x31, 0x3b,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x61, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_nexusai_v1_analyzer_proto_rawDescOnce sync.Once
+	file_proto_nexusai_v1_analyzer_proto_rawDescData = file_proto_nexusai_v1_analyzer_proto_rawDesc
+)
+
+func file_proto_nexusai_v1_analyzer_proto_rawDescGZIP() []byte {
+	file_proto_nexusai_v1_analyzer_proto_rawDescOnce.Do(func() {
+		file_proto_nexusai_v1_analyzer_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_nexusai_v1_analyzer_proto_rawDescData)
+	})
+	return file_proto_nexusai_v1_analyzer_proto_rawDescData
+}
+
+var file_proto_nexusai_v1_analyzer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_nexusai_v1_analyzer_proto_msgTypes = make([]protoimpl.MessageInfo, 133)
+var file_proto_nexusai_v1_analyzer_proto_goTypes = []any{
+	(Tone)(0),                             // 0: nexusai.v1.Tone
+	(Period)(0),                           // 1: nexusai.v1.Period
+	(*TrackRequest)(nil),                  // 2: nexusai.v1.TrackRequest
+	(*TrackResponse)(nil),                 // 3: nexusai.v1.TrackResponse
+	(*TodayTrackRequest)(nil),             // 4: nexusai.v1.TodayTrackRequest
+	(*TodayTrackResponse)(nil),            // 5: nexusai.v1.TodayTrackResponse
+	(*PatchTodayTrackRequest)(nil),        // 6: nexusai.v1.PatchTodayTrackRequest
+	(*PatchTodayTrackResponse)(nil),       // 7: nexusai.v1.PatchTodayTrackResponse
+	(*AnalysisForDateRequest)(nil),        // 8: nexusai.v1.AnalysisForDateRequest
+	(*AnalyzeRequest)(nil),                // 9: nexusai.v1.AnalyzeRequest
+	(*RegenerateInsightRequest)(nil),      // 10: nexusai.v1.RegenerateInsightRequest
+	(*TrackPoint)(nil),                    // 11: nexusai.v1.TrackPoint
+	(*UserProfile)(nil),                   // 12: nexusai.v1.UserProfile
+	(*FriendRequest)(nil),                 // 13: nexusai.v1.FriendRequest
+	(*GetMyProfileRequest)(nil),           // 14: nexusai.v1.GetMyProfileRequest
+	(*GetMyProfileResponse)(nil),          // 15: nexusai.v1.GetMyProfileResponse
+	(*GetUserProfileRequest)(nil),         // 16: nexusai.v1.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),        // 17: nexusai.v1.GetUserProfileResponse
+	(*GetUserLastAnalysesRequest)(nil),    // 18: nexusai.v1.GetUserLastAnalysesRequest
+	(*PreviewWithSettingsRequest)(nil),    // 19: nexusai.v1.PreviewWithSettingsRequest
+	(*ScorePreview)(nil),                  // 20: nexusai.v1.ScorePreview
+	(*PreviewWithSettingsResponse)(nil),   // 21: nexusai.v1.PreviewWithSettingsResponse
+	(*MetricDefinitionsRequest)(nil),      // 22: nexusai.v1.MetricDefinitionsRequest
+	(*MetricDefinition)(nil),              // 23: nexusai.v1.MetricDefinition
+	(*MetricDefinitionsResponse)(nil),     // 24: nexusai.v1.MetricDefinitionsResponse
+	(*NoteKeywordsRequest)(nil),           // 25: nexusai.v1.NoteKeywordsRequest
+	(*NoteKeyword)(nil),                   // 26: nexusai.v1.NoteKeyword
+	(*NoteKeywordsResponse)(nil),          // 27: nexusai.v1.NoteKeywordsResponse
+	(*ContextBreakdownRequest)(nil),       // 28: nexusai.v1.ContextBreakdownRequest
+	(*ContextGroup)(nil),                  // 29: nexusai.v1.ContextGroup
+	(*ContextBreakdownResponse)(nil),      // 30: nexusai.v1.ContextBreakdownResponse
+	(*CorrelationMatrixRequest)(nil),      // 31: nexusai.v1.CorrelationMatrixRequest
+	(*CorrelationCell)(nil),               // 32: nexusai.v1.CorrelationCell
+	(*CorrelationMatrixResponse)(nil),     // 33: nexusai.v1.CorrelationMatrixResponse
+	(*BedtimeTonightRequest)(nil),         // 34: nexusai.v1.BedtimeTonightRequest
+	(*BedtimeTonightResponse)(nil),        // 35: nexusai.v1.BedtimeTonightResponse
+	(*GetFriendAnalysisRequest)(nil),      // 36: nexusai.v1.GetFriendAnalysisRequest
+	(*GetFriendAnalysisResponse)(nil),     // 37: nexusai.v1.GetFriendAnalysisResponse
+	(*UpdateProfileRequest)(nil),          // 38: nexusai.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),         // 39: nexusai.v1.UpdateProfileResponse
+	(*UpdateHiddenMetricsRequest)(nil),    // 40: nexusai.v1.UpdateHiddenMetricsRequest
+	(*UpdateHiddenMetricsResponse)(nil),   // 41: nexusai.v1.UpdateHiddenMetricsResponse
+	(*GetGoalsRequest)(nil),               // 42: nexusai.v1.GetGoalsRequest
+	(*UpdateGoalsRequest)(nil),            // 43: nexusai.v1.UpdateGoalsRequest
+	(*GoalsResponse)(nil),                 // 44: nexusai.v1.GoalsResponse
+	(*GetBurnoutWebhookRequest)(nil),      // 45: nexusai.v1.GetBurnoutWebhookRequest
+	(*SetBurnoutWebhookRequest)(nil),      // 46: nexusai.v1.SetBurnoutWebhookRequest
+	(*BurnoutWebhookResponse)(nil),        // 47: nexusai.v1.BurnoutWebhookResponse
+	(*SearchUsersRequest)(nil),            // 48: nexusai.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),           // 49: nexusai.v1.SearchUsersResponse
+	(*FindUserByEmailRequest)(nil),        // 50: nexusai.v1.FindUserByEmailRequest
+	(*FindUserByEmailResponse)(nil),       // 51: nexusai.v1.FindUserByEmailResponse
+	(*ListFriendsRequest)(nil),            // 52: nexusai.v1.ListFriendsRequest
+	(*ListFriendsResponse)(nil),           // 53: nexusai.v1.ListFriendsResponse
+	(*FriendSummary)(nil),                 // 54: nexusai.v1.FriendSummary
+	(*ListFriendsEnrichedRequest)(nil),    // 55: nexusai.v1.ListFriendsEnrichedRequest
+	(*ListFriendsEnrichedResponse)(nil),   // 56: nexusai.v1.ListFriendsEnrichedResponse
+	(*ActivityFeedRequest)(nil),           // 57: nexusai.v1.ActivityFeedRequest
+	(*FriendActivity)(nil),                // 58: nexusai.v1.FriendActivity
+	(*ActivityFeedResponse)(nil),          // 59: nexusai.v1.ActivityFeedResponse
+	(*ListFriendRequestsRequest)(nil),     // 60: nexusai.v1.ListFriendRequestsRequest
+	(*ListFriendRequestsResponse)(nil),    // 61: nexusai.v1.ListFriendRequestsResponse
+	(*SendFriendRequestRequest)(nil),      // 62: nexusai.v1.SendFriendRequestRequest
+	(*SendFriendRequestResponse)(nil),     // 63: nexusai.v1.SendFriendRequestResponse
+	(*RespondFriendRequestRequest)(nil),   // 64: nexusai.v1.RespondFriendRequestRequest
+	(*RespondFriendRequestResponse)(nil),  // 65: nexusai.v1.RespondFriendRequestResponse
+	(*CompareWithFriendRequest)(nil),      // 66: nexusai.v1.CompareWithFriendRequest
+	(*MetricComparison)(nil),              // 67: nexusai.v1.MetricComparison
+	(*CompareWithFriendResponse)(nil),     // 68: nexusai.v1.CompareWithFriendResponse
+	(*InsightDiffRequest)(nil),            // 69: nexusai.v1.InsightDiffRequest
+	(*MetricDiff)(nil),                    // 70: nexusai.v1.MetricDiff
+	(*InsightDiffResponse)(nil),           // 71: nexusai.v1.InsightDiffResponse
+	(*ListDeadAnalysesRequest)(nil),       // 72: nexusai.v1.ListDeadAnalysesRequest
+	(*ListDeadAnalysesResponse)(nil),      // 73: nexusai.v1.ListDeadAnalysesResponse
+	(*ProviderStatusRequest)(nil),         // 74: nexusai.v1.ProviderStatusRequest
+	(*ProviderStatus)(nil),                // 75: nexusai.v1.ProviderStatus
+	(*ProviderStatusResponse)(nil),        // 76: nexusai.v1.ProviderStatusResponse
+	(*PromptAggregatesRequest)(nil),       // 77: nexusai.v1.PromptAggregatesRequest
+	(*PromptAggregatesResponse)(nil),      // 78: nexusai.v1.PromptAggregatesResponse
+	(*GoalProgress)(nil),                  // 79: nexusai.v1.GoalProgress
+	(*FailedAnalysis)(nil),                // 80: nexusai.v1.FailedAnalysis
+	(*AggregateStatsRequest)(nil),         // 81: nexusai.v1.AggregateStatsRequest
+	(*AggregateStatsResponse)(nil),        // 82: nexusai.v1.AggregateStatsResponse
+	(*HistogramBucket)(nil),               // 83: nexusai.v1.HistogramBucket
+	(*WeekdayAggregate)(nil),              // 84: nexusai.v1.WeekdayAggregate
+	(*Constraints)(nil),                   // 85: nexusai.v1.Constraints
+	(*AnalyzeResponse)(nil),               // 86: nexusai.v1.AnalyzeResponse
+	(*EnergyCI)(nil),                      // 87: nexusai.v1.EnergyCI
+	(*PeriodMeta)(nil),                    // 88: nexusai.v1.PeriodMeta
+	(*FocusStats)(nil),                    // 89: nexusai.v1.FocusStats
+	(*FocusSession)(nil),                  // 90: nexusai.v1.FocusSession
+	(*LogFocusSessionRequest)(nil),        // 91: nexusai.v1.LogFocusSessionRequest
+	(*LogFocusSessionResponse)(nil),       // 92: nexusai.v1.LogFocusSessionResponse
+	(*DeleteFocusSessionRequest)(nil),     // 93: nexusai.v1.DeleteFocusSessionRequest
+	(*DeleteFocusSessionResponse)(nil),    // 94: nexusai.v1.DeleteFocusSessionResponse
+	(*DataQualityWarning)(nil),            // 95: nexusai.v1.DataQualityWarning
+	(*DataSufficiency)(nil),               // 96: nexusai.v1.DataSufficiency
+	(*AnalysisCoverage)(nil),              // 97: nexusai.v1.AnalysisCoverage
+	(*TrendsRequest)(nil),                 // 98: nexusai.v1.TrendsRequest
+	(*StreakCalendarRequest)(nil),         // 99: nexusai.v1.StreakCalendarRequest
+	(*CalendarDay)(nil),                   // 100: nexusai.v1.CalendarDay
+	(*StreakCalendarResponse)(nil),        // 101: nexusai.v1.StreakCalendarResponse
+	(*MetricSeries)(nil),                  // 102: nexusai.v1.MetricSeries
+	(*TrendsResponse)(nil),                // 103: nexusai.v1.TrendsResponse
+	(*SuggestedReminderTimeRequest)(nil),  // 104: nexusai.v1.SuggestedReminderTimeRequest
+	(*SuggestedReminderTimeResponse)(nil), // 105: nexusai.v1.SuggestedReminderTimeResponse
+	(*WeeklyReportRequest)(nil),           // 106: nexusai.v1.WeeklyReportRequest
+	(*WeeklyReportResponse)(nil),          // 107: nexusai.v1.WeeklyReportResponse
+	(*TomorrowScheduleRequest)(nil),       // 108: nexusai.v1.TomorrowScheduleRequest
+	(*TomorrowScheduleResponse)(nil),      // 109: nexusai.v1.TomorrowScheduleResponse
+	(*BestTimeTomorrowRequest)(nil),       // 110: nexusai.v1.BestTimeTomorrowRequest
+	(*BestTimeTomorrowResponse)(nil),      // 111: nexusai.v1.BestTimeTomorrowResponse
+	(*LastAnalysesRequest)(nil),           // 112: nexusai.v1.LastAnalysesRequest
+	(*LastAnalysesResponse)(nil),          // 113: nexusai.v1.LastAnalysesResponse
+	(*LastAnalysisEntry)(nil),             // 114: nexusai.v1.LastAnalysisEntry
+	(*ProductivityModel)(nil),             // 115: nexusai.v1.ProductivityModel
+	(*BurnoutRisk)(nil),                   // 116: nexusai.v1.BurnoutRisk
+	(*DismissBurnoutWarningRequest)(nil),  // 117: nexusai.v1.DismissBurnoutWarningRequest
+	(*DismissBurnoutWarningResponse)(nil), // 118: nexusai.v1.DismissBurnoutWarningResponse
+	(*BurnoutReason)(nil),                 // 119: nexusai.v1.BurnoutReason
+	(*OptimalSchedule)(nil),               // 120: nexusai.v1.OptimalSchedule
+	nil,                                   // 121: nexusai.v1.TrackPoint.ContextEntry
+	nil,                                   // 122: nexusai.v1.PreviewWithSettingsRequest.EnergyParamsEntry
+	nil,                                   // 123: nexusai.v1.PreviewWithSettingsRequest.ProductivityWeightsEntry
+	nil,                                   // 124: nexusai.v1.UpdateGoalsRequest.GoalsEntry
+	nil,                                   // 125: nexusai.v1.GoalsResponse.GoalsEntry
+	nil,                                   // 126: nexusai.v1.PromptAggregatesResponse.EnergyByWeekdayEntry
+	nil,                                   // 127: nexusai.v1.AnalyzeResponse.EnergyByWeekdayEntry
+	nil,                                   // 128: nexusai.v1.AnalyzeResponse.AveragesEntry
+	nil,                                   // 129: nexusai.v1.AnalyzeResponse.EnergyByWeekdayCiEntry
+	nil,                                   // 130: nexusai.v1.WeeklyReportResponse.AveragesEntry
+	nil,                                   // 131: nexusai.v1.LastAnalysisEntry.TrendsEntry
+	nil,                                   // 132: nexusai.v1.ProductivityModel.WeightsEntry
+	nil,                                   // 133: nexusai.v1.ProductivityModel.ComponentsEntry
+	nil,                                   // 134: nexusai.v1.ProductivityModel.ContributionsEntry
+	(*timestamppb.Timestamp)(nil),         // 135: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),               // 136: google.protobuf.Struct
+}
+var file_proto_nexusai_v1_analyzer_proto_depIdxs = []int32{
+	11,  // 0: nexusai.v1.TrackRequest.points:type_name -> nexusai.v1.TrackPoint
+	11,  // 1: nexusai.v1.TodayTrackResponse.point:type_name -> nexusai.v1.TrackPoint
+	11,  // 2: nexusai.v1.PatchTodayTrackResponse.point:type_name -> nexusai.v1.TrackPoint
+	85,  // 3: nexusai.v1.AnalyzeRequest.constraints:type_name -> nexusai.v1.Constraints
+	1,   // 4: nexusai.v1.AnalyzeRequest.period:type_name -> nexusai.v1.Period
+	0,   // 5: nexusai.v1.AnalyzeRequest.tone:type_name -> nexusai.v1.Tone
+	1,   // 6: nexusai.v1.RegenerateInsightRequest.period:type_name -> nexusai.v1.Period
+	135, // 7: nexusai.v1.TrackPoint.ts:type_name -> google.protobuf.Timestamp
+	135, // 8: nexusai.v1.TrackPoint.sleep_start_ts:type_name -> google.protobuf.Timestamp
+	135, // 9: nexusai.v1.TrackPoint.sleep_end_ts:type_name -> google.protobuf.Timestamp
+	121, // 10: nexusai.v1.TrackPoint.context:type_name -> nexusai.v1.TrackPoint.ContextEntry
+	0,   // 11: nexusai.v1.UserProfile.tone:type_name -> nexusai.v1.Tone
+	12,  // 12: nexusai.v1.FriendRequest.from:type_name -> nexusai.v1.UserProfile
+	12,  // 13: nexusai.v1.FriendRequest.to:type_name -> nexusai.v1.UserProfile
+	135, // 14: nexusai.v1.FriendRequest.created_at:type_name -> google.protobuf.Timestamp
+	12,  // 15: nexusai.v1.GetMyProfileResponse.profile:type_name -> nexusai.v1.UserProfile
+	12,  // 16: nexusai.v1.GetUserProfileResponse.profile:type_name -> nexusai.v1.UserProfile
+	1,   // 17: nexusai.v1.PreviewWithSettingsRequest.period:type_name -> nexusai.v1.Period
+	122, // 18: nexusai.v1.PreviewWithSettingsRequest.energy_params:type_name -> nexusai.v1.PreviewWithSettingsRequest.EnergyParamsEntry
+	123, // 19: nexusai.v1.PreviewWithSettingsRequest.productivity_weights:type_name -> nexusai.v1.PreviewWithSettingsRequest.ProductivityWeightsEntry
+	20,  // 20: nexusai.v1.PreviewWithSettingsResponse.current:type_name -> nexusai.v1.ScorePreview
+	20,  // 21: nexusai.v1.PreviewWithSettingsResponse.proposed:type_name -> nexusai.v1.ScorePreview
+	23,  // 22: nexusai.v1.MetricDefinitionsResponse.definitions:type_name -> nexusai.v1.MetricDefinition
+	1,   // 23: nexusai.v1.NoteKeywordsRequest.period:type_name -> nexusai.v1.Period
+	26,  // 24: nexusai.v1.NoteKeywordsResponse.keywords:type_name -> nexusai.v1.NoteKeyword
+	1,   // 25: nexusai.v1.ContextBreakdownRequest.period:type_name -> nexusai.v1.Period
+	29,  // 26: nexusai.v1.ContextBreakdownResponse.groups:type_name -> nexusai.v1.ContextGroup
+	1,   // 27: nexusai.v1.CorrelationMatrixRequest.period:type_name -> nexusai.v1.Period
+	32,  // 28: nexusai.v1.CorrelationMatrixResponse.cells:type_name -> nexusai.v1.CorrelationCell
+	12,  // 29: nexusai.v1.GetFriendAnalysisResponse.profile:type_name -> nexusai.v1.UserProfile
+	114, // 30: nexusai.v1.GetFriendAnalysisResponse.entries:type_name -> nexusai.v1.LastAnalysisEntry
+	0,   // 31: nexusai.v1.UpdateProfileRequest.tone:type_name -> nexusai.v1.Tone
+	12,  // 32: nexusai.v1.UpdateProfileResponse.profile:type_name -> nexusai.v1.UserProfile
+	124, // 33: nexusai.v1.UpdateGoalsRequest.goals:type_name -> nexusai.v1.UpdateGoalsRequest.GoalsEntry
+	125, // 34: nexusai.v1.GoalsResponse.goals:type_name -> nexusai.v1.GoalsResponse.GoalsEntry
+	12,  // 35: nexusai.v1.SearchUsersResponse.users:type_name -> nexusai.v1.UserProfile
+	12,  // 36: nexusai.v1.FindUserByEmailResponse.user:type_name -> nexusai.v1.UserProfile
+	12,  // 37: nexusai.v1.ListFriendsResponse.friends:type_name -> nexusai.v1.UserProfile
+	12,  // 38: nexusai.v1.FriendSummary.friend:type_name -> nexusai.v1.UserProfile
+	54,  // 39: nexusai.v1.ListFriendsEnrichedResponse.friends:type_name -> nexusai.v1.FriendSummary
+	12,  // 40: nexusai.v1.FriendActivity.friend:type_name -> nexusai.v1.UserProfile
+	135, // 41: nexusai.v1.FriendActivity.created_at:type_name -> google.protobuf.Timestamp
+	58,  // 42: nexusai.v1.ActivityFeedResponse.events:type_name -> nexusai.v1.FriendActivity
+	13,  // 43: nexusai.v1.ListFriendRequestsResponse.requests:type_name -> nexusai.v1.FriendRequest
+	13,  // 44: nexusai.v1.SendFriendRequestResponse.request:type_name -> nexusai.v1.FriendRequest
+	1,   // 45: nexusai.v1.CompareWithFriendRequest.period:type_name -> nexusai.v1.Period
+	12,  // 46: nexusai.v1.CompareWithFriendResponse.friend:type_name -> nexusai.v1.UserProfile
+	67,  // 47: nexusai.v1.CompareWithFriendResponse.metrics:type_name -> nexusai.v1.MetricComparison
+	135, // 48: nexusai.v1.CompareWithFriendResponse.my_updated_at:type_name -> google.protobuf.Timestamp
+	135, // 49: nexusai.v1.CompareWithFriendResponse.friend_updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 50: nexusai.v1.InsightDiffRequest.period:type_name -> nexusai.v1.Period
+	135, // 51: nexusai.v1.InsightDiffRequest.from:type_name -> google.protobuf.Timestamp
+	135, // 52: nexusai.v1.InsightDiffRequest.to:type_name -> google.protobuf.Timestamp
+	135, // 53: nexusai.v1.InsightDiffResponse.from_at:type_name -> google.protobuf.Timestamp
+	135, // 54: nexusai.v1.InsightDiffResponse.to_at:type_name -> google.protobuf.Timestamp
+	70,  // 55: nexusai.v1.InsightDiffResponse.metrics:type_name -> nexusai.v1.MetricDiff
+	80,  // 56: nexusai.v1.ListDeadAnalysesResponse.items:type_name -> nexusai.v1.FailedAnalysis
+	135, // 57: nexusai.v1.ProviderStatus.last_error_at:type_name -> google.protobuf.Timestamp
+	75,  // 58: nexusai.v1.ProviderStatusResponse.providers:type_name -> nexusai.v1.ProviderStatus
+	1,   // 59: nexusai.v1.PromptAggregatesRequest.period:type_name -> nexusai.v1.Period
+	135, // 60: nexusai.v1.PromptAggregatesResponse.period_start:type_name -> google.protobuf.Timestamp
+	135, // 61: nexusai.v1.PromptAggregatesResponse.period_end:type_name -> google.protobuf.Timestamp
+	126, // 62: nexusai.v1.PromptAggregatesResponse.energy_by_weekday:type_name -> nexusai.v1.PromptAggregatesResponse.EnergyByWeekdayEntry
+	0,   // 63: nexusai.v1.PromptAggregatesResponse.tone:type_name -> nexusai.v1.Tone
+	79,  // 64: nexusai.v1.PromptAggregatesResponse.goals:type_name -> nexusai.v1.GoalProgress
+	135, // 65: nexusai.v1.FailedAnalysis.day_start:type_name -> google.protobuf.Timestamp
+	135, // 66: nexusai.v1.FailedAnalysis.updated_at:type_name -> google.protobuf.Timestamp
+	135, // 67: nexusai.v1.AggregateStatsRequest.from:type_name -> google.protobuf.Timestamp
+	135, // 68: nexusai.v1.AggregateStatsRequest.to:type_name -> google.protobuf.Timestamp
+	135, // 69: nexusai.v1.AggregateStatsResponse.from:type_name -> google.protobuf.Timestamp
+	135, // 70: nexusai.v1.AggregateStatsResponse.to:type_name -> google.protobuf.Timestamp
+	83,  // 71: nexusai.v1.AggregateStatsResponse.sleep_histogram:type_name -> nexusai.v1.HistogramBucket
+	84,  // 72: nexusai.v1.AggregateStatsResponse.by_weekday:type_name -> nexusai.v1.WeekdayAggregate
+	127, // 73: nexusai.v1.AnalyzeResponse.energy_by_weekday:type_name -> nexusai.v1.AnalyzeResponse.EnergyByWeekdayEntry
+	115, // 74: nexusai.v1.AnalyzeResponse.productivity_model:type_name -> nexusai.v1.ProductivityModel
+	116, // 75: nexusai.v1.AnalyzeResponse.burnout_risk:type_name -> nexusai.v1.BurnoutRisk
+	120, // 76: nexusai.v1.AnalyzeResponse.optimal_schedule:type_name -> nexusai.v1.OptimalSchedule
+	136, // 77: nexusai.v1.AnalyzeResponse.debug:type_name -> google.protobuf.Struct
+	96,  // 78: nexusai.v1.AnalyzeResponse.data_sufficiency:type_name -> nexusai.v1.DataSufficiency
+	95,  // 79: nexusai.v1.AnalyzeResponse.data_quality_warnings:type_name -> nexusai.v1.DataQualityWarning
+	128, // 80: nexusai.v1.AnalyzeResponse.averages:type_name -> nexusai.v1.AnalyzeResponse.AveragesEntry
+	89,  // 81: nexusai.v1.AnalyzeResponse.focus_stats:type_name -> nexusai.v1.FocusStats
+	88,  // 82: nexusai.v1.AnalyzeResponse.period_meta:type_name -> nexusai.v1.PeriodMeta
+	129, // 83: nexusai.v1.AnalyzeResponse.energy_by_weekday_ci:type_name -> nexusai.v1.AnalyzeResponse.EnergyByWeekdayCiEntry
+	135, // 84: nexusai.v1.PeriodMeta.start:type_name -> google.protobuf.Timestamp
+	135, // 85: nexusai.v1.PeriodMeta.end:type_name -> google.protobuf.Timestamp
+	135, // 86: nexusai.v1.FocusSession.started_at:type_name -> google.protobuf.Timestamp
+	135, // 87: nexusai.v1.FocusSession.ended_at:type_name -> google.protobuf.Timestamp
+	135, // 88: nexusai.v1.LogFocusSessionRequest.started_at:type_name -> google.protobuf.Timestamp
+	135, // 89: nexusai.v1.LogFocusSessionRequest.ended_at:type_name -> google.protobuf.Timestamp
+	90,  // 90: nexusai.v1.LogFocusSessionResponse.session:type_name -> nexusai.v1.FocusSession
+	97,  // 91: nexusai.v1.DataSufficiency.coverage:type_name -> nexusai.v1.AnalysisCoverage
+	1,   // 92: nexusai.v1.TrendsRequest.period:type_name -> nexusai.v1.Period
+	135, // 93: nexusai.v1.TrendsRequest.from:type_name -> google.protobuf.Timestamp
+	135, // 94: nexusai.v1.TrendsRequest.to:type_name -> google.protobuf.Timestamp
+	100, // 95: nexusai.v1.StreakCalendarResponse.days:type_name -> nexusai.v1.CalendarDay
+	135, // 96: nexusai.v1.TrendsResponse.from:type_name -> google.protobuf.Timestamp
+	135, // 97: nexusai.v1.TrendsResponse.to:type_name -> google.protobuf.Timestamp
+	102, // 98: nexusai.v1.TrendsResponse.series:type_name -> nexusai.v1.MetricSeries
+	130, // 99: nexusai.v1.WeeklyReportResponse.averages:type_name -> nexusai.v1.WeeklyReportResponse.AveragesEntry
+	120, // 100: nexusai.v1.TomorrowScheduleResponse.schedule:type_name -> nexusai.v1.OptimalSchedule
+	105, // 101: nexusai.v1.TomorrowScheduleResponse.reminder:type_name -> nexusai.v1.SuggestedReminderTimeResponse
+	135, // 102: nexusai.v1.TomorrowScheduleResponse.computed_at:type_name -> google.protobuf.Timestamp
+	114, // 103: nexusai.v1.LastAnalysesResponse.entries:type_name -> nexusai.v1.LastAnalysisEntry
+	86,  // 104: nexusai.v1.LastAnalysisEntry.response:type_name -> nexusai.v1.AnalyzeResponse
+	135, // 105: nexusai.v1.LastAnalysisEntry.updated_at:type_name -> google.protobuf.Timestamp
+	131, // 106: nexusai.v1.LastAnalysisEntry.trends:type_name -> nexusai.v1.LastAnalysisEntry.TrendsEntry
+	132, // 107: nexusai.v1.ProductivityModel.weights:type_name -> nexusai.v1.ProductivityModel.WeightsEntry
+	133, // 108: nexusai.v1.ProductivityModel.components:type_name -> nexusai.v1.ProductivityModel.ComponentsEntry
+	134, // 109: nexusai.v1.ProductivityModel.contributions:type_name -> nexusai.v1.ProductivityModel.ContributionsEntry
+	119, // 110: nexusai.v1.BurnoutRisk.structured_reasons:type_name -> nexusai.v1.BurnoutReason
+	1,   // 111: nexusai.v1.DismissBurnoutWarningRequest.period:type_name -> nexusai.v1.Period
+	116, // 112: nexusai.v1.DismissBurnoutWarningResponse.burnout_risk:type_name -> nexusai.v1.BurnoutRisk
+	87,  // 113: nexusai.v1.AnalyzeResponse.EnergyByWeekdayCiEntry.value:type_name -> nexusai.v1.EnergyCI
+	2,   // 114: nexusai.v1.AnalyzerService.Track:input_type -> nexusai.v1.TrackRequest
+	9,   // 115: nexusai.v1.AnalyzerService.Analyze:input_type -> nexusai.v1.AnalyzeRequest
+	10,  // 116: nexusai.v1.AnalyzerService.RegenerateInsight:input_type -> nexusai.v1.RegenerateInsightRequest
+	8,   // 117: nexusai.v1.AnalyzerService.GetAnalysisForDate:input_type -> nexusai.v1.AnalysisForDateRequest
+	117, // 118: nexusai.v1.AnalyzerService.DismissBurnoutWarning:input_type -> nexusai.v1.DismissBurnoutWarningRequest
+	91,  // 119: nexusai.v1.AnalyzerService.LogFocusSession:input_type -> nexusai.v1.LogFocusSessionRequest
+	93,  // 120: nexusai.v1.AnalyzerService.DeleteFocusSession:input_type -> nexusai.v1.DeleteFocusSessionRequest
+	4,   // 121: nexusai.v1.AnalyzerService.GetTodayTrack:input_type -> nexusai.v1.TodayTrackRequest
+	6,   // 122: nexusai.v1.AnalyzerService.PatchTodayTrack:input_type -> nexusai.v1.PatchTodayTrackRequest
+	112, // 123: nexusai.v1.AnalyzerService.GetLastAnalyses:input_type -> nexusai.v1.LastAnalysesRequest
+	110, // 124: nexusai.v1.AnalyzerService.GetBestTimeTomorrow:input_type -> nexusai.v1.BestTimeTomorrowRequest
+	98,  // 125: nexusai.v1.AnalyzerService.GetTrends:input_type -> nexusai.v1.TrendsRequest
+	104, // 126: nexusai.v1.AnalyzerService.GetSuggestedReminderTime:input_type -> nexusai.v1.SuggestedReminderTimeRequest
+	108, // 127: nexusai.v1.AnalyzerService.GetTomorrowSchedule:input_type -> nexusai.v1.TomorrowScheduleRequest
+	106, // 128: nexusai.v1.AnalyzerService.GetWeeklyReport:input_type -> nexusai.v1.WeeklyReportRequest
+	19,  // 129: nexusai.v1.AnalyzerService.PreviewWithSettings:input_type -> nexusai.v1.PreviewWithSettingsRequest
+	22,  // 130: nexusai.v1.AnalyzerService.GetMetricDefinitions:input_type -> nexusai.v1.MetricDefinitionsRequest
+	25,  // 131: nexusai.v1.AnalyzerService.GetNoteKeywords:input_type -> nexusai.v1.NoteKeywordsRequest
+	28,  // 132: nexusai.v1.AnalyzerService.GetContextBreakdown:input_type -> nexusai.v1.ContextBreakdownRequest
+	31,  // 133: nexusai.v1.AnalyzerService.GetCorrelationMatrix:input_type -> nexusai.v1.CorrelationMatrixRequest
+	34,  // 134: nexusai.v1.AnalyzerService.GetBedtimeTonight:input_type -> nexusai.v1.BedtimeTonightRequest
+	99,  // 135: nexusai.v1.AnalyzerService.GetStreakCalendar:input_type -> nexusai.v1.StreakCalendarRequest
+	14,  // 136: nexusai.v1.AnalyzerService.GetMyProfile:input_type -> nexusai.v1.GetMyProfileRequest
+	38,  // 137: nexusai.v1.AnalyzerService.UpdateMyProfile:input_type -> nexusai.v1.UpdateProfileRequest
+	40,  // 138: nexusai.v1.AnalyzerService.UpdateHiddenMetrics:input_type -> nexusai.v1.UpdateHiddenMetricsRequest
+	42,  // 139: nexusai.v1.AnalyzerService.GetGoals:input_type -> nexusai.v1.GetGoalsRequest
+	43,  // 140: nexusai.v1.AnalyzerService.UpdateGoals:input_type -> nexusai.v1.UpdateGoalsRequest
+	45,  // 141: nexusai.v1.AnalyzerService.GetBurnoutWebhook:input_type -> nexusai.v1.GetBurnoutWebhookRequest
+	46,  // 142: nexusai.v1.AnalyzerService.SetBurnoutWebhook:input_type -> nexusai.v1.SetBurnoutWebhookRequest
+	16,  // 143: nexusai.v1.AnalyzerService.GetUserProfile:input_type -> nexusai.v1.GetUserProfileRequest
+	18,  // 144: nexusai.v1.AnalyzerService.GetUserLastAnalyses:input_type -> nexusai.v1.GetUserLastAnalysesRequest
+	36,  // 145: nexusai.v1.AnalyzerService.GetFriendAnalysis:input_type -> nexusai.v1.GetFriendAnalysisRequest
+	48,  // 146: nexusai.v1.AnalyzerService.SearchUsers:input_type -> nexusai.v1.SearchUsersRequest
+	50,  // 147: nexusai.v1.AnalyzerService.FindUserByEmail:input_type -> nexusai.v1.FindUserByEmailRequest
+	52,  // 148: nexusai.v1.AnalyzerService.ListFriends:input_type -> nexusai.v1.ListFriendsRequest
+	55,  // 149: nexusai.v1.AnalyzerService.ListFriendsEnriched:input_type -> nexusai.v1.ListFriendsEnrichedRequest
+	57,  // 150: nexusai.v1.AnalyzerService.GetActivityFeed:input_type -> nexusai.v1.ActivityFeedRequest
+	60,  // 151: nexusai.v1.AnalyzerService.ListFriendRequests:input_type -> nexusai.v1.ListFriendRequestsRequest
+	62,  // 152: nexusai.v1.AnalyzerService.SendFriendRequest:input_type -> nexusai.v1.SendFriendRequestRequest
+	64,  // 153: nexusai.v1.AnalyzerService.RespondFriendRequest:input_type -> nexusai.v1.RespondFriendRequestRequest
+	66,  // 154: nexusai.v1.AnalyzerService.CompareWithFriend:input_type -> nexusai.v1.CompareWithFriendRequest
+	69,  // 155: nexusai.v1.AnalyzerService.GetInsightDiff:input_type -> nexusai.v1.InsightDiffRequest
+	81,  // 156: nexusai.v1.AnalyzerService.GetAggregateStats:input_type -> nexusai.v1.AggregateStatsRequest
+	72,  // 157: nexusai.v1.AnalyzerService.ListDeadAnalyses:input_type -> nexusai.v1.ListDeadAnalysesRequest
+	74,  // 158: nexusai.v1.AnalyzerService.GetProviderStatus:input_type -> nexusai.v1.ProviderStatusRequest
+	77,  // 159: nexusai.v1.AnalyzerService.GetPromptAggregates:input_type -> nexusai.v1.PromptAggregatesRequest
+	3,   // 160: nexusai.v1.AnalyzerService.Track:output_type -> nexusai.v1.TrackResponse
+	86,  // 161: nexusai.v1.AnalyzerService.Analyze:output_type -> nexusai.v1.AnalyzeResponse
+	86,  // 162: nexusai.v1.AnalyzerService.RegenerateInsight:output_type -> nexusai.v1.AnalyzeResponse
+	86,  // 163: nexusai.v1.AnalyzerService.GetAnalysisForDate:output_type -> nexusai.v1.AnalyzeResponse
+	118, // 164: nexusai.v1.AnalyzerService.DismissBurnoutWarning:output_type -> nexusai.v1.DismissBurnoutWarningResponse
+	92,  // 165: nexusai.v1.AnalyzerService.LogFocusSession:output_type -> nexusai.v1.LogFocusSessionResponse
+	94,  // 166: nexusai.v1.AnalyzerService.DeleteFocusSession:output_type -> nexusai.v1.DeleteFocusSessionResponse
+	5,   // 167: nexusai.v1.AnalyzerService.GetTodayTrack:output_type -> nexusai.v1.TodayTrackResponse
+	7,   // 168: nexusai.v1.AnalyzerService.PatchTodayTrack:output_type -> nexusai.v1.PatchTodayTrackResponse
+	113, // 169: nexusai.v1.AnalyzerService.GetLastAnalyses:output_type -> nexusai.v1.LastAnalysesResponse
+	111, // 170: nexusai.v1.AnalyzerService.GetBestTimeTomorrow:output_type -> nexusai.v1.BestTimeTomorrowResponse
+	103, // 171: nexusai.v1.AnalyzerService.GetTrends:output_type -> nexusai.v1.TrendsResponse
+	105, // 172: nexusai.v1.AnalyzerService.GetSuggestedReminderTime:output_type -> nexusai.v1.SuggestedReminderTimeResponse
+	109, // 173: nexusai.v1.AnalyzerService.GetTomorrowSchedule:output_type -> nexusai.v1.TomorrowScheduleResponse
+	107, // 174: nexusai.v1.AnalyzerService.GetWeeklyReport:output_type -> nexusai.v1.WeeklyReportResponse
+	21,  // 175: nexusai.v1.AnalyzerService.PreviewWithSettings:output_type -> nexusai.v1.PreviewWithSettingsResponse
+	24,  // 176: nexusai.v1.AnalyzerService.GetMetricDefinitions:output_type -> nexusai.v1.MetricDefinitionsResponse
+	27,  // 177: nexusai.v1.AnalyzerService.GetNoteKeywords:output_type -> nexusai.v1.NoteKeywordsResponse
+	30,  // 178: nexusai.v1.AnalyzerService.GetContextBreakdown:output_type -> nexusai.v1.ContextBreakdownResponse
+	33,  // 179: nexusai.v1.AnalyzerService.GetCorrelationMatrix:output_type -> nexusai.v1.CorrelationMatrixResponse
+	35,  // 180: nexusai.v1.AnalyzerService.GetBedtimeTonight:output_type -> nexusai.v1.BedtimeTonightResponse
+	101, // 181: nexusai.v1.AnalyzerService.GetStreakCalendar:output_type -> nexusai.v1.StreakCalendarResponse
+	15,  // 182: nexusai.v1.AnalyzerService.GetMyProfile:output_type -> nexusai.v1.GetMyProfileResponse
+	39,  // 183: nexusai.v1.AnalyzerService.UpdateMyProfile:output_type -> nexusai.v1.UpdateProfileResponse
+	41,  // 184: nexusai.v1.AnalyzerService.UpdateHiddenMetrics:output_type -> nexusai.v1.UpdateHiddenMetricsResponse
+	44,  // 185: nexusai.v1.AnalyzerService.GetGoals:output_type -> nexusai.v1.GoalsResponse
+	44,  // 186: nexusai.v1.AnalyzerService.UpdateGoals:output_type -> nexusai.v1.GoalsResponse
+	47,  // 187: nexusai.v1.AnalyzerService.GetBurnoutWebhook:output_type -> nexusai.v1.BurnoutWebhookResponse
+	47,  // 188: nexusai.v1.AnalyzerService.SetBurnoutWebhook:output_type -> nexusai.v1.BurnoutWebhookResponse
+	17,  // 189: nexusai.v1.AnalyzerService.GetUserProfile:output_type -> nexusai.v1.GetUserProfileResponse
+	113, // 190: nexusai.v1.AnalyzerService.GetUserLastAnalyses:output_type -> nexusai.v1.LastAnalysesResponse
+	37,  // 191: nexusai.v1.AnalyzerService.GetFriendAnalysis:output_type -> nexusai.v1.GetFriendAnalysisResponse
+	49,  // 192: nexusai.v1.AnalyzerService.SearchUsers:output_type -> nexusai.v1.SearchUsersResponse
+	51,  // 193: nexusai.v1.AnalyzerService.FindUserByEmail:output_type -> nexusai.v1.FindUserByEmailResponse
+	53,  // 194: nexusai.v1.AnalyzerService.ListFriends:output_type -> nexusai.v1.ListFriendsResponse
+	56,  // 195: nexusai.v1.AnalyzerService.ListFriendsEnriched:output_type -> nexusai.v1.ListFriendsEnrichedResponse
+	59,  // 196: nexusai.v1.AnalyzerService.GetActivityFeed:output_type -> nexusai.v1.ActivityFeedResponse
+	61,  // 197: nexusai.v1.AnalyzerService.ListFriendRequests:output_type -> nexusai.v1.ListFriendRequestsResponse
+	63,  // 198: nexusai.v1.AnalyzerService.SendFriendRequest:output_type -> nexusai.v1.SendFriendRequestResponse
+	65,  // 199: nexusai.v1.AnalyzerService.RespondFriendRequest:output_type -> nexusai.v1.RespondFriendRequestResponse
+	68,  // 200: nexusai.v1.AnalyzerService.CompareWithFriend:output_type -> nexusai.v1.CompareWithFriendResponse
+	71,  // 201: nexusai.v1.AnalyzerService.GetInsightDiff:output_type -> nexusai.v1.InsightDiffResponse
+	82,  // 202: nexusai.v1.AnalyzerService.GetAggregateStats:output_type -> nexusai.v1.AggregateStatsResponse
+	73,  // 203: nexusai.v1.AnalyzerService.ListDeadAnalyses:output_type -> nexusai.v1.ListDeadAnalysesResponse
+	76,  // 204: nexusai.v1.AnalyzerService.GetProviderStatus:output_type -> nexusai.v1.ProviderStatusResponse
+	78,  // 205: nexusai.v1.AnalyzerService.GetPromptAggregates:output_type -> nexusai.v1.PromptAggregatesResponse
+	160, // [160:206] is the sub-list for method output_type
+	114, // [114:160] is the sub-list for method input_type
+	114, // [114:114] is the sub-list for extension type_name
+	114, // [114:114] is the sub-list for extension extendee
+	0,   // [0:114] is the sub-list for field type_name
+}
+
+func init() { file_proto_nexusai_v1_analyzer_proto_init() }
+func file_proto_nexusai_v1_analyzer_proto_init() {
+	if File_proto_nexusai_v1_analyzer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*TrackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TodayTrackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*TodayTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*PatchTodayTrackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*PatchTodayTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalysisForDateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*RegenerateInsightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*TrackPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*UserProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*FriendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMyProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMyProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserLastAnalysesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*PreviewWithSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ScorePreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*PreviewWithSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricDefinitionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricDefinitionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*NoteKeywordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*NoteKeyword); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*NoteKeywordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ContextBreakdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ContextGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ContextBreakdownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*CorrelationMatrixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*CorrelationCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*CorrelationMatrixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*BedtimeTonightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*BedtimeTonightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFriendAnalysisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFriendAnalysisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateHiddenMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateHiddenMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGoalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateGoalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*GoalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBurnoutWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBurnoutWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*BurnoutWebhookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserByEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserByEmailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFriendsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFriendsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*FriendSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFriendsEnrichedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFriendsEnrichedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ActivityFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*FriendActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ActivityFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFriendRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFriendRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*SendFriendRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*SendFriendRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*RespondFriendRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*RespondFriendRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareWithFriendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareWithFriendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*InsightDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*InsightDiffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeadAnalysesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeadAnalysesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*ProviderStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*ProviderStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*ProviderStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*PromptAggregatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*PromptAggregatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*GoalProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*FailedAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2424,8 +11111,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*TrackResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*HistogramBucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2436,8 +11123,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*TodayTrackRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*WeekdayAggregate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2448,8 +11135,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*TodayTrackResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*Constraints); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2460,8 +11147,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*AnalyzeRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2472,8 +11159,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*TrackPoint); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*EnergyCI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2484,8 +11171,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*UserProfile); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*PeriodMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2496,8 +11183,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*FriendRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*FocusStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2508,8 +11195,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetMyProfileRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*FocusSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2520,8 +11207,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*GetMyProfileResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*LogFocusSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2532,8 +11219,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUserProfileRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*LogFocusSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2544,8 +11231,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUserProfileResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFocusSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2556,8 +11243,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUserLastAnalysesRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFocusSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2568,8 +11255,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateProfileRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*DataQualityWarning); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2580,8 +11267,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateProfileResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*DataSufficiency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2592,8 +11279,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUsersRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalysisCoverage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2604,8 +11291,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUsersResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*TrendsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2616,8 +11303,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*ListFriendsRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*StreakCalendarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2628,8 +11315,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*ListFriendsResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*CalendarDay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2640,8 +11327,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*ListFriendRequestsRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*StreakCalendarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2652,8 +11339,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*ListFriendRequestsResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2664,8 +11351,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*SendFriendRequestRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*TrendsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2676,8 +11363,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*SendFriendRequestResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*SuggestedReminderTimeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2688,8 +11375,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*RespondFriendRequestRequest); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*SuggestedReminderTimeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2700,8 +11387,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*RespondFriendRequestResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*WeeklyReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2712,8 +11399,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*Constraints); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*WeeklyReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2724,8 +11411,8 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*AnalyzeResponse); i {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*TomorrowScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2736,7 +11423,43 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[27].Exporter = func(v any, i int) any {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*TomorrowScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*BestTimeTomorrowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*BestTimeTomorrowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[110].Exporter = func(v any, i int) any {
 			switch v := v.(*LastAnalysesRequest); i {
 			case 0:
 				return &v.state
@@ -2748,7 +11471,7 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[28].Exporter = func(v any, i int) any {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[111].Exporter = func(v any, i int) any {
 			switch v := v.(*LastAnalysesResponse); i {
 			case 0:
 				return &v.state
@@ -2760,7 +11483,7 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[29].Exporter = func(v any, i int) any {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[112].Exporter = func(v any, i int) any {
 			switch v := v.(*LastAnalysisEntry); i {
 			case 0:
 				return &v.state
@@ -2772,7 +11495,7 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[30].Exporter = func(v any, i int) any {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[113].Exporter = func(v any, i int) any {
 			switch v := v.(*ProductivityModel); i {
 			case 0:
 				return &v.state
@@ -2784,7 +11507,7 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[31].Exporter = func(v any, i int) any {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[114].Exporter = func(v any, i int) any {
 			switch v := v.(*BurnoutRisk); i {
 			case 0:
 				return &v.state
@@ -2796,7 +11519,43 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 				return nil
 			}
 		}
-		file_proto_nexusai_v1_analyzer_proto_msgTypes[32].Exporter = func(v any, i int) any {
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[115].Exporter = func(v any, i int) any {
+			switch v := v.(*DismissBurnoutWarningRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[116].Exporter = func(v any, i int) any {
+			switch v := v.(*DismissBurnoutWarningResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[117].Exporter = func(v any, i int) any {
+			switch v := v.(*BurnoutReason); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_nexusai_v1_analyzer_proto_msgTypes[118].Exporter = func(v any, i int) any {
 			switch v := v.(*OptimalSchedule); i {
 			case 0:
 				return &v.state
@@ -2809,13 +11568,19 @@ func file_proto_nexusai_v1_analyzer_proto_init() {
 			}
 		}
 	}
+	file_proto_nexusai_v1_analyzer_proto_msgTypes[4].OneofWrappers = []any{}
+	file_proto_nexusai_v1_analyzer_proto_msgTypes[7].OneofWrappers = []any{}
+	file_proto_nexusai_v1_analyzer_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_nexusai_v1_analyzer_proto_msgTypes[36].OneofWrappers = []any{}
+	file_proto_nexusai_v1_analyzer_proto_msgTypes[85].OneofWrappers = []any{}
+	file_proto_nexusai_v1_analyzer_proto_msgTypes[98].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_nexusai_v1_analyzer_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   35,
+			NumEnums:      2,
+			NumMessages:   133,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,19 +19,52 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AnalyzerService_Track_FullMethodName                = "/nexusai.v1.AnalyzerService/Track"
-	AnalyzerService_Analyze_FullMethodName              = "/nexusai.v1.AnalyzerService/Analyze"
-	AnalyzerService_GetTodayTrack_FullMethodName        = "/nexusai.v1.AnalyzerService/GetTodayTrack"
-	AnalyzerService_GetLastAnalyses_FullMethodName      = "/nexusai.v1.AnalyzerService/GetLastAnalyses"
-	AnalyzerService_GetMyProfile_FullMethodName         = "/nexusai.v1.AnalyzerService/GetMyProfile"
-	AnalyzerService_UpdateMyProfile_FullMethodName      = "/nexusai.v1.AnalyzerService/UpdateMyProfile"
-	AnalyzerService_GetUserProfile_FullMethodName       = "/nexusai.v1.AnalyzerService/GetUserProfile"
-	AnalyzerService_GetUserLastAnalyses_FullMethodName  = "/nexusai.v1.AnalyzerService/GetUserLastAnalyses"
-	AnalyzerService_SearchUsers_FullMethodName          = "/nexusai.v1.AnalyzerService/SearchUsers"
-	AnalyzerService_ListFriends_FullMethodName          = "/nexusai.v1.AnalyzerService/ListFriends"
-	AnalyzerService_ListFriendRequests_FullMethodName   = "/nexusai.v1.AnalyzerService/ListFriendRequests"
-	AnalyzerService_SendFriendRequest_FullMethodName    = "/nexusai.v1.AnalyzerService/SendFriendRequest"
-	AnalyzerService_RespondFriendRequest_FullMethodName = "/nexusai.v1.AnalyzerService/RespondFriendRequest"
+	AnalyzerService_Track_FullMethodName                    = "/nexusai.v1.AnalyzerService/Track"
+	AnalyzerService_Analyze_FullMethodName                  = "/nexusai.v1.AnalyzerService/Analyze"
+	AnalyzerService_RegenerateInsight_FullMethodName        = "/nexusai.v1.AnalyzerService/RegenerateInsight"
+	AnalyzerService_GetAnalysisForDate_FullMethodName       = "/nexusai.v1.AnalyzerService/GetAnalysisForDate"
+	AnalyzerService_DismissBurnoutWarning_FullMethodName    = "/nexusai.v1.AnalyzerService/DismissBurnoutWarning"
+	AnalyzerService_LogFocusSession_FullMethodName          = "/nexusai.v1.AnalyzerService/LogFocusSession"
+	AnalyzerService_DeleteFocusSession_FullMethodName       = "/nexusai.v1.AnalyzerService/DeleteFocusSession"
+	AnalyzerService_GetTodayTrack_FullMethodName            = "/nexusai.v1.AnalyzerService/GetTodayTrack"
+	AnalyzerService_PatchTodayTrack_FullMethodName          = "/nexusai.v1.AnalyzerService/PatchTodayTrack"
+	AnalyzerService_GetLastAnalyses_FullMethodName          = "/nexusai.v1.AnalyzerService/GetLastAnalyses"
+	AnalyzerService_GetBestTimeTomorrow_FullMethodName      = "/nexusai.v1.AnalyzerService/GetBestTimeTomorrow"
+	AnalyzerService_GetTrends_FullMethodName                = "/nexusai.v1.AnalyzerService/GetTrends"
+	AnalyzerService_GetSuggestedReminderTime_FullMethodName = "/nexusai.v1.AnalyzerService/GetSuggestedReminderTime"
+	AnalyzerService_GetTomorrowSchedule_FullMethodName      = "/nexusai.v1.AnalyzerService/GetTomorrowSchedule"
+	AnalyzerService_GetWeeklyReport_FullMethodName          = "/nexusai.v1.AnalyzerService/GetWeeklyReport"
+	AnalyzerService_PreviewWithSettings_FullMethodName      = "/nexusai.v1.AnalyzerService/PreviewWithSettings"
+	AnalyzerService_GetMetricDefinitions_FullMethodName     = "/nexusai.v1.AnalyzerService/GetMetricDefinitions"
+	AnalyzerService_GetNoteKeywords_FullMethodName          = "/nexusai.v1.AnalyzerService/GetNoteKeywords"
+	AnalyzerService_GetContextBreakdown_FullMethodName      = "/nexusai.v1.AnalyzerService/GetContextBreakdown"
+	AnalyzerService_GetCorrelationMatrix_FullMethodName     = "/nexusai.v1.AnalyzerService/GetCorrelationMatrix"
+	AnalyzerService_GetBedtimeTonight_FullMethodName        = "/nexusai.v1.AnalyzerService/GetBedtimeTonight"
+	AnalyzerService_GetStreakCalendar_FullMethodName        = "/nexusai.v1.AnalyzerService/GetStreakCalendar"
+	AnalyzerService_GetMyProfile_FullMethodName             = "/nexusai.v1.AnalyzerService/GetMyProfile"
+	AnalyzerService_UpdateMyProfile_FullMethodName          = "/nexusai.v1.AnalyzerService/UpdateMyProfile"
+	AnalyzerService_UpdateHiddenMetrics_FullMethodName      = "/nexusai.v1.AnalyzerService/UpdateHiddenMetrics"
+	AnalyzerService_GetGoals_FullMethodName                 = "/nexusai.v1.AnalyzerService/GetGoals"
+	AnalyzerService_UpdateGoals_FullMethodName              = "/nexusai.v1.AnalyzerService/UpdateGoals"
+	AnalyzerService_GetBurnoutWebhook_FullMethodName        = "/nexusai.v1.AnalyzerService/GetBurnoutWebhook"
+	AnalyzerService_SetBurnoutWebhook_FullMethodName        = "/nexusai.v1.AnalyzerService/SetBurnoutWebhook"
+	AnalyzerService_GetUserProfile_FullMethodName           = "/nexusai.v1.AnalyzerService/GetUserProfile"
+	AnalyzerService_GetUserLastAnalyses_FullMethodName      = "/nexusai.v1.AnalyzerService/GetUserLastAnalyses"
+	AnalyzerService_GetFriendAnalysis_FullMethodName        = "/nexusai.v1.AnalyzerService/GetFriendAnalysis"
+	AnalyzerService_SearchUsers_FullMethodName              = "/nexusai.v1.AnalyzerService/SearchUsers"
+	AnalyzerService_FindUserByEmail_FullMethodName          = "/nexusai.v1.AnalyzerService/FindUserByEmail"
+	AnalyzerService_ListFriends_FullMethodName              = "/nexusai.v1.AnalyzerService/ListFriends"
+	AnalyzerService_ListFriendsEnriched_FullMethodName      = "/nexusai.v1.AnalyzerService/ListFriendsEnriched"
+	AnalyzerService_GetActivityFeed_FullMethodName          = "/nexusai.v1.AnalyzerService/GetActivityFeed"
+	AnalyzerService_ListFriendRequests_FullMethodName       = "/nexusai.v1.AnalyzerService/ListFriendRequests"
+	AnalyzerService_SendFriendRequest_FullMethodName        = "/nexusai.v1.AnalyzerService/SendFriendRequest"
+	AnalyzerService_RespondFriendRequest_FullMethodName     = "/nexusai.v1.AnalyzerService/RespondFriendRequest"
+	AnalyzerService_CompareWithFriend_FullMethodName        = "/nexusai.v1.AnalyzerService/CompareWithFriend"
+	AnalyzerService_GetInsightDiff_FullMethodName           = "/nexusai.v1.AnalyzerService/GetInsightDiff"
+	AnalyzerService_GetAggregateStats_FullMethodName        = "/nexusai.v1.AnalyzerService/GetAggregateStats"
+	AnalyzerService_ListDeadAnalyses_FullMethodName         = "/nexusai.v1.AnalyzerService/ListDeadAnalyses"
+	AnalyzerService_GetProviderStatus_FullMethodName        = "/nexusai.v1.AnalyzerService/GetProviderStatus"
+	AnalyzerService_GetPromptAggregates_FullMethodName      = "/nexusai.v1.AnalyzerService/GetPromptAggregates"
 )
 
 // AnalyzerServiceClient is the client API for AnalyzerService service.
@@ -40,17 +73,57 @@ const (
 type AnalyzerServiceClient interface {
 	Track(ctx context.Context, in *TrackRequest, opts ...grpc.CallOption) (*TrackResponse, error)
 	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	RegenerateInsight(ctx context.Context, in *RegenerateInsightRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	GetAnalysisForDate(ctx context.Context, in *AnalysisForDateRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	DismissBurnoutWarning(ctx context.Context, in *DismissBurnoutWarningRequest, opts ...grpc.CallOption) (*DismissBurnoutWarningResponse, error)
+	LogFocusSession(ctx context.Context, in *LogFocusSessionRequest, opts ...grpc.CallOption) (*LogFocusSessionResponse, error)
+	DeleteFocusSession(ctx context.Context, in *DeleteFocusSessionRequest, opts ...grpc.CallOption) (*DeleteFocusSessionResponse, error)
 	GetTodayTrack(ctx context.Context, in *TodayTrackRequest, opts ...grpc.CallOption) (*TodayTrackResponse, error)
+	PatchTodayTrack(ctx context.Context, in *PatchTodayTrackRequest, opts ...grpc.CallOption) (*PatchTodayTrackResponse, error)
 	GetLastAnalyses(ctx context.Context, in *LastAnalysesRequest, opts ...grpc.CallOption) (*LastAnalysesResponse, error)
+	GetBestTimeTomorrow(ctx context.Context, in *BestTimeTomorrowRequest, opts ...grpc.CallOption) (*BestTimeTomorrowResponse, error)
+	GetTrends(ctx context.Context, in *TrendsRequest, opts ...grpc.CallOption) (*TrendsResponse, error)
+	GetSuggestedReminderTime(ctx context.Context, in *SuggestedReminderTimeRequest, opts ...grpc.CallOption) (*SuggestedReminderTimeResponse, error)
+	GetTomorrowSchedule(ctx context.Context, in *TomorrowScheduleRequest, opts ...grpc.CallOption) (*TomorrowScheduleResponse, error)
+	GetWeeklyReport(ctx context.Context, in *WeeklyReportRequest, opts ...grpc.CallOption) (*WeeklyReportResponse, error)
+	PreviewWithSettings(ctx context.Context, in *PreviewWithSettingsRequest, opts ...grpc.CallOption) (*PreviewWithSettingsResponse, error)
+	GetMetricDefinitions(ctx context.Context, in *MetricDefinitionsRequest, opts ...grpc.CallOption) (*MetricDefinitionsResponse, error)
+	GetNoteKeywords(ctx context.Context, in *NoteKeywordsRequest, opts ...grpc.CallOption) (*NoteKeywordsResponse, error)
+	GetContextBreakdown(ctx context.Context, in *ContextBreakdownRequest, opts ...grpc.CallOption) (*ContextBreakdownResponse, error)
+	GetCorrelationMatrix(ctx context.Context, in *CorrelationMatrixRequest, opts ...grpc.CallOption) (*CorrelationMatrixResponse, error)
+	GetBedtimeTonight(ctx context.Context, in *BedtimeTonightRequest, opts ...grpc.CallOption) (*BedtimeTonightResponse, error)
+	GetStreakCalendar(ctx context.Context, in *StreakCalendarRequest, opts ...grpc.CallOption) (*StreakCalendarResponse, error)
 	GetMyProfile(ctx context.Context, in *GetMyProfileRequest, opts ...grpc.CallOption) (*GetMyProfileResponse, error)
 	UpdateMyProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	UpdateHiddenMetrics(ctx context.Context, in *UpdateHiddenMetricsRequest, opts ...grpc.CallOption) (*UpdateHiddenMetricsResponse, error)
+	GetGoals(ctx context.Context, in *GetGoalsRequest, opts ...grpc.CallOption) (*GoalsResponse, error)
+	// Replaces all goals; an empty map clears them. Goals and their weekly attainment feed the insight.
+	UpdateGoals(ctx context.Context, in *UpdateGoalsRequest, opts ...grpc.CallOption) (*GoalsResponse, error)
+	GetBurnoutWebhook(ctx context.Context, in *GetBurnoutWebhookRequest, opts ...grpc.CallOption) (*BurnoutWebhookResponse, error)
+	// Sets the https endpoint that gets a signed POST once the weekly burnout risk turns high; an empty
+	// url turns alerts off.
+	SetBurnoutWebhook(ctx context.Context, in *SetBurnoutWebhookRequest, opts ...grpc.CallOption) (*BurnoutWebhookResponse, error)
 	GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error)
+	// A friend's analyses are returned only if they enabled share_analyses; otherwise entries are empty.
 	GetUserLastAnalyses(ctx context.Context, in *GetUserLastAnalysesRequest, opts ...grpc.CallOption) (*LastAnalysesResponse, error)
+	GetFriendAnalysis(ctx context.Context, in *GetFriendAnalysisRequest, opts ...grpc.CallOption) (*GetFriendAnalysisResponse, error)
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	FindUserByEmail(ctx context.Context, in *FindUserByEmailRequest, opts ...grpc.CallOption) (*FindUserByEmailResponse, error)
 	ListFriends(ctx context.Context, in *ListFriendsRequest, opts ...grpc.CallOption) (*ListFriendsResponse, error)
+	ListFriendsEnriched(ctx context.Context, in *ListFriendsEnrichedRequest, opts ...grpc.CallOption) (*ListFriendsEnrichedResponse, error)
+	GetActivityFeed(ctx context.Context, in *ActivityFeedRequest, opts ...grpc.CallOption) (*ActivityFeedResponse, error)
 	ListFriendRequests(ctx context.Context, in *ListFriendRequestsRequest, opts ...grpc.CallOption) (*ListFriendRequestsResponse, error)
 	SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error)
 	RespondFriendRequest(ctx context.Context, in *RespondFriendRequestRequest, opts ...grpc.CallOption) (*RespondFriendRequestResponse, error)
+	CompareWithFriend(ctx context.Context, in *CompareWithFriendRequest, opts ...grpc.CallOption) (*CompareWithFriendResponse, error)
+	// Compares two of the caller's saved analyses of a period; read-only, nothing is recomputed.
+	GetInsightDiff(ctx context.Context, in *InsightDiffRequest, opts ...grpc.CallOption) (*InsightDiffResponse, error)
+	// Admin only: requires x-admin-token metadata.
+	GetAggregateStats(ctx context.Context, in *AggregateStatsRequest, opts ...grpc.CallOption) (*AggregateStatsResponse, error)
+	ListDeadAnalyses(ctx context.Context, in *ListDeadAnalysesRequest, opts ...grpc.CallOption) (*ListDeadAnalysesResponse, error)
+	GetProviderStatus(ctx context.Context, in *ProviderStatusRequest, opts ...grpc.CallOption) (*ProviderStatusResponse, error)
+	// Admin or self: the aggregates an insight prompt would receive, recomputed without calling the LLM.
+	GetPromptAggregates(ctx context.Context, in *PromptAggregatesRequest, opts ...grpc.CallOption) (*PromptAggregatesResponse, error)
 }
 
 type analyzerServiceClient struct {
@@ -79,6 +152,51 @@ func (c *analyzerServiceClient) Analyze(ctx context.Context, in *AnalyzeRequest,
 	return out, nil
 }
 
+func (c *analyzerServiceClient) RegenerateInsight(ctx context.Context, in *RegenerateInsightRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_RegenerateInsight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetAnalysisForDate(ctx context.Context, in *AnalysisForDateRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetAnalysisForDate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) DismissBurnoutWarning(ctx context.Context, in *DismissBurnoutWarningRequest, opts ...grpc.CallOption) (*DismissBurnoutWarningResponse, error) {
+	out := new(DismissBurnoutWarningResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_DismissBurnoutWarning_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) LogFocusSession(ctx context.Context, in *LogFocusSessionRequest, opts ...grpc.CallOption) (*LogFocusSessionResponse, error) {
+	out := new(LogFocusSessionResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_LogFocusSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) DeleteFocusSession(ctx context.Context, in *DeleteFocusSessionRequest, opts ...grpc.CallOption) (*DeleteFocusSessionResponse, error) {
+	out := new(DeleteFocusSessionResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_DeleteFocusSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerServiceClient) GetTodayTrack(ctx context.Context, in *TodayTrackRequest, opts ...grpc.CallOption) (*TodayTrackResponse, error) {
 	out := new(TodayTrackResponse)
 	err := c.cc.Invoke(ctx, AnalyzerService_GetTodayTrack_FullMethodName, in, out, opts...)
@@ -88,6 +206,15 @@ func (c *analyzerServiceClient) GetTodayTrack(ctx context.Context, in *TodayTrac
 	return out, nil
 }
 
+func (c *analyzerServiceClient) PatchTodayTrack(ctx context.Context, in *PatchTodayTrackRequest, opts ...grpc.CallOption) (*PatchTodayTrackResponse, error) {
+	out := new(PatchTodayTrackResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_PatchTodayTrack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerServiceClient) GetLastAnalyses(ctx context.Context, in *LastAnalysesRequest, opts ...grpc.CallOption) (*LastAnalysesResponse, error) {
 	out := new(LastAnalysesResponse)
 	err := c.cc.Invoke(ctx, AnalyzerService_GetLastAnalyses_FullMethodName, in, out, opts...)
@@ -97,6 +224,114 @@ func (c *analyzerServiceClient) GetLastAnalyses(ctx context.Context, in *LastAna
 	return out, nil
 }
 
+func (c *analyzerServiceClient) GetBestTimeTomorrow(ctx context.Context, in *BestTimeTomorrowRequest, opts ...grpc.CallOption) (*BestTimeTomorrowResponse, error) {
+	out := new(BestTimeTomorrowResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetBestTimeTomorrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetTrends(ctx context.Context, in *TrendsRequest, opts ...grpc.CallOption) (*TrendsResponse, error) {
+	out := new(TrendsResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetTrends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetSuggestedReminderTime(ctx context.Context, in *SuggestedReminderTimeRequest, opts ...grpc.CallOption) (*SuggestedReminderTimeResponse, error) {
+	out := new(SuggestedReminderTimeResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetSuggestedReminderTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetTomorrowSchedule(ctx context.Context, in *TomorrowScheduleRequest, opts ...grpc.CallOption) (*TomorrowScheduleResponse, error) {
+	out := new(TomorrowScheduleResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetTomorrowSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetWeeklyReport(ctx context.Context, in *WeeklyReportRequest, opts ...grpc.CallOption) (*WeeklyReportResponse, error) {
+	out := new(WeeklyReportResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetWeeklyReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) PreviewWithSettings(ctx context.Context, in *PreviewWithSettingsRequest, opts ...grpc.CallOption) (*PreviewWithSettingsResponse, error) {
+	out := new(PreviewWithSettingsResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_PreviewWithSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetMetricDefinitions(ctx context.Context, in *MetricDefinitionsRequest, opts ...grpc.CallOption) (*MetricDefinitionsResponse, error) {
+	out := new(MetricDefinitionsResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetMetricDefinitions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetNoteKeywords(ctx context.Context, in *NoteKeywordsRequest, opts ...grpc.CallOption) (*NoteKeywordsResponse, error) {
+	out := new(NoteKeywordsResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetNoteKeywords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetContextBreakdown(ctx context.Context, in *ContextBreakdownRequest, opts ...grpc.CallOption) (*ContextBreakdownResponse, error) {
+	out := new(ContextBreakdownResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetContextBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetCorrelationMatrix(ctx context.Context, in *CorrelationMatrixRequest, opts ...grpc.CallOption) (*CorrelationMatrixResponse, error) {
+	out := new(CorrelationMatrixResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetCorrelationMatrix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetBedtimeTonight(ctx context.Context, in *BedtimeTonightRequest, opts ...grpc.CallOption) (*BedtimeTonightResponse, error) {
+	out := new(BedtimeTonightResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetBedtimeTonight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetStreakCalendar(ctx context.Context, in *StreakCalendarRequest, opts ...grpc.CallOption) (*StreakCalendarResponse, error) {
+	out := new(StreakCalendarResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetStreakCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerServiceClient) GetMyProfile(ctx context.Context, in *GetMyProfileRequest, opts ...grpc.CallOption) (*GetMyProfileResponse, error) {
 	out := new(GetMyProfileResponse)
 	err := c.cc.Invoke(ctx, AnalyzerService_GetMyProfile_FullMethodName, in, out, opts...)
@@ -115,6 +350,51 @@ func (c *analyzerServiceClient) UpdateMyProfile(ctx context.Context, in *UpdateP
 	return out, nil
 }
 
+func (c *analyzerServiceClient) UpdateHiddenMetrics(ctx context.Context, in *UpdateHiddenMetricsRequest, opts ...grpc.CallOption) (*UpdateHiddenMetricsResponse, error) {
+	out := new(UpdateHiddenMetricsResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_UpdateHiddenMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetGoals(ctx context.Context, in *GetGoalsRequest, opts ...grpc.CallOption) (*GoalsResponse, error) {
+	out := new(GoalsResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetGoals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) UpdateGoals(ctx context.Context, in *UpdateGoalsRequest, opts ...grpc.CallOption) (*GoalsResponse, error) {
+	out := new(GoalsResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_UpdateGoals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetBurnoutWebhook(ctx context.Context, in *GetBurnoutWebhookRequest, opts ...grpc.CallOption) (*BurnoutWebhookResponse, error) {
+	out := new(BurnoutWebhookResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetBurnoutWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) SetBurnoutWebhook(ctx context.Context, in *SetBurnoutWebhookRequest, opts ...grpc.CallOption) (*BurnoutWebhookResponse, error) {
+	out := new(BurnoutWebhookResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_SetBurnoutWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerServiceClient) GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error) {
 	out := new(GetUserProfileResponse)
 	err := c.cc.Invoke(ctx, AnalyzerService_GetUserProfile_FullMethodName, in, out, opts...)
@@ -133,6 +413,15 @@ func (c *analyzerServiceClient) GetUserLastAnalyses(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *analyzerServiceClient) GetFriendAnalysis(ctx context.Context, in *GetFriendAnalysisRequest, opts ...grpc.CallOption) (*GetFriendAnalysisResponse, error) {
+	out := new(GetFriendAnalysisResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetFriendAnalysis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
 	out := new(SearchUsersResponse)
 	err := c.cc.Invoke(ctx, AnalyzerService_SearchUsers_FullMethodName, in, out, opts...)
@@ -142,6 +431,15 @@ func (c *analyzerServiceClient) SearchUsers(ctx context.Context, in *SearchUsers
 	return out, nil
 }
 
+func (c *analyzerServiceClient) FindUserByEmail(ctx context.Context, in *FindUserByEmailRequest, opts ...grpc.CallOption) (*FindUserByEmailResponse, error) {
+	out := new(FindUserByEmailResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_FindUserByEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerServiceClient) ListFriends(ctx context.Context, in *ListFriendsRequest, opts ...grpc.CallOption) (*ListFriendsResponse, error) {
 	out := new(ListFriendsResponse)
 	err := c.cc.Invoke(ctx, AnalyzerService_ListFriends_FullMethodName, in, out, opts...)
@@ -151,6 +449,24 @@ func (c *analyzerServiceClient) ListFriends(ctx context.Context, in *ListFriends
 	return out, nil
 }
 
+func (c *analyzerServiceClient) ListFriendsEnriched(ctx context.Context, in *ListFriendsEnrichedRequest, opts ...grpc.CallOption) (*ListFriendsEnrichedResponse, error) {
+	out := new(ListFriendsEnrichedResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_ListFriendsEnriched_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetActivityFeed(ctx context.Context, in *ActivityFeedRequest, opts ...grpc.CallOption) (*ActivityFeedResponse, error) {
+	out := new(ActivityFeedResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetActivityFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerServiceClient) ListFriendRequests(ctx context.Context, in *ListFriendRequestsRequest, opts ...grpc.CallOption) (*ListFriendRequestsResponse, error) {
 	out := new(ListFriendRequestsResponse)
 	err := c.cc.Invoke(ctx, AnalyzerService_ListFriendRequests_FullMethodName, in, out, opts...)
@@ -178,23 +494,117 @@ func (c *analyzerServiceClient) RespondFriendRequest(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *analyzerServiceClient) CompareWithFriend(ctx context.Context, in *CompareWithFriendRequest, opts ...grpc.CallOption) (*CompareWithFriendResponse, error) {
+	out := new(CompareWithFriendResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_CompareWithFriend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetInsightDiff(ctx context.Context, in *InsightDiffRequest, opts ...grpc.CallOption) (*InsightDiffResponse, error) {
+	out := new(InsightDiffResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetInsightDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetAggregateStats(ctx context.Context, in *AggregateStatsRequest, opts ...grpc.CallOption) (*AggregateStatsResponse, error) {
+	out := new(AggregateStatsResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetAggregateStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) ListDeadAnalyses(ctx context.Context, in *ListDeadAnalysesRequest, opts ...grpc.CallOption) (*ListDeadAnalysesResponse, error) {
+	out := new(ListDeadAnalysesResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_ListDeadAnalyses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetProviderStatus(ctx context.Context, in *ProviderStatusRequest, opts ...grpc.CallOption) (*ProviderStatusResponse, error) {
+	out := new(ProviderStatusResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetProviderStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetPromptAggregates(ctx context.Context, in *PromptAggregatesRequest, opts ...grpc.CallOption) (*PromptAggregatesResponse, error) {
+	out := new(PromptAggregatesResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetPromptAggregates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnalyzerServiceServer is the server API for AnalyzerService service.
 // All implementations must embed UnimplementedAnalyzerServiceServer
 // for forward compatibility
 type AnalyzerServiceServer interface {
 	Track(context.Context, *TrackRequest) (*TrackResponse, error)
 	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	RegenerateInsight(context.Context, *RegenerateInsightRequest) (*AnalyzeResponse, error)
+	GetAnalysisForDate(context.Context, *AnalysisForDateRequest) (*AnalyzeResponse, error)
+	DismissBurnoutWarning(context.Context, *DismissBurnoutWarningRequest) (*DismissBurnoutWarningResponse, error)
+	LogFocusSession(context.Context, *LogFocusSessionRequest) (*LogFocusSessionResponse, error)
+	DeleteFocusSession(context.Context, *DeleteFocusSessionRequest) (*DeleteFocusSessionResponse, error)
 	GetTodayTrack(context.Context, *TodayTrackRequest) (*TodayTrackResponse, error)
+	PatchTodayTrack(context.Context, *PatchTodayTrackRequest) (*PatchTodayTrackResponse, error)
 	GetLastAnalyses(context.Context, *LastAnalysesRequest) (*LastAnalysesResponse, error)
+	GetBestTimeTomorrow(context.Context, *BestTimeTomorrowRequest) (*BestTimeTomorrowResponse, error)
+	GetTrends(context.Context, *TrendsRequest) (*TrendsResponse, error)
+	GetSuggestedReminderTime(context.Context, *SuggestedReminderTimeRequest) (*SuggestedReminderTimeResponse, error)
+	GetTomorrowSchedule(context.Context, *TomorrowScheduleRequest) (*TomorrowScheduleResponse, error)
+	GetWeeklyReport(context.Context, *WeeklyReportRequest) (*WeeklyReportResponse, error)
+	PreviewWithSettings(context.Context, *PreviewWithSettingsRequest) (*PreviewWithSettingsResponse, error)
+	GetMetricDefinitions(context.Context, *MetricDefinitionsRequest) (*MetricDefinitionsResponse, error)
+	GetNoteKeywords(context.Context, *NoteKeywordsRequest) (*NoteKeywordsResponse, error)
+	GetContextBreakdown(context.Context, *ContextBreakdownRequest) (*ContextBreakdownResponse, error)
+	GetCorrelationMatrix(context.Context, *CorrelationMatrixRequest) (*CorrelationMatrixResponse, error)
+	GetBedtimeTonight(context.Context, *BedtimeTonightRequest) (*BedtimeTonightResponse, error)
+	GetStreakCalendar(context.Context, *StreakCalendarRequest) (*StreakCalendarResponse, error)
 	GetMyProfile(context.Context, *GetMyProfileRequest) (*GetMyProfileResponse, error)
 	UpdateMyProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	UpdateHiddenMetrics(context.Context, *UpdateHiddenMetricsRequest) (*UpdateHiddenMetricsResponse, error)
+	GetGoals(context.Context, *GetGoalsRequest) (*GoalsResponse, error)
+	// Replaces all goals; an empty map clears them. Goals and their weekly attainment feed the insight.
+	UpdateGoals(context.Context, *UpdateGoalsRequest) (*GoalsResponse, error)
+	GetBurnoutWebhook(context.Context, *GetBurnoutWebhookRequest) (*BurnoutWebhookResponse, error)
+	// Sets the https endpoint that gets a signed POST once the weekly burnout risk turns high; an empty
+	// url turns alerts off.
+	SetBurnoutWebhook(context.Context, *SetBurnoutWebhookRequest) (*BurnoutWebhookResponse, error)
 	GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error)
+	// A friend's analyses are returned only if they enabled share_analyses; otherwise entries are empty.
 	GetUserLastAnalyses(context.Context, *GetUserLastAnalysesRequest) (*LastAnalysesResponse, error)
+	GetFriendAnalysis(context.Context, *GetFriendAnalysisRequest) (*GetFriendAnalysisResponse, error)
 	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	FindUserByEmail(context.Context, *FindUserByEmailRequest) (*FindUserByEmailResponse, error)
 	ListFriends(context.Context, *ListFriendsRequest) (*ListFriendsResponse, error)
+	ListFriendsEnriched(context.Context, *ListFriendsEnrichedRequest) (*ListFriendsEnrichedResponse, error)
+	GetActivityFeed(context.Context, *ActivityFeedRequest) (*ActivityFeedResponse, error)
 	ListFriendRequests(context.Context, *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error)
 	SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error)
 	RespondFriendRequest(context.Context, *RespondFriendRequestRequest) (*RespondFriendRequestResponse, error)
+	CompareWithFriend(context.Context, *CompareWithFriendRequest) (*CompareWithFriendResponse, error)
+	// Compares two of the caller's saved analyses of a period; read-only, nothing is recomputed.
+	GetInsightDiff(context.Context, *InsightDiffRequest) (*InsightDiffResponse, error)
+	// Admin only: requires x-admin-token metadata.
+	GetAggregateStats(context.Context, *AggregateStatsRequest) (*AggregateStatsResponse, error)
+	ListDeadAnalyses(context.Context, *ListDeadAnalysesRequest) (*ListDeadAnalysesResponse, error)
+	GetProviderStatus(context.Context, *ProviderStatusRequest) (*ProviderStatusResponse, error)
+	// Admin or self: the aggregates an insight prompt would receive, recomputed without calling the LLM.
+	GetPromptAggregates(context.Context, *PromptAggregatesRequest) (*PromptAggregatesResponse, error)
 	mustEmbedUnimplementedAnalyzerServiceServer()
 }
 
@@ -208,30 +618,111 @@ func (UnimplementedAnalyzerServiceServer) Track(context.Context, *TrackRequest)
 func (UnimplementedAnalyzerServiceServer) Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) RegenerateInsight(context.Context, *RegenerateInsightRequest) (*AnalyzeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateInsight not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetAnalysisForDate(context.Context, *AnalysisForDateRequest) (*AnalyzeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalysisForDate not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) DismissBurnoutWarning(context.Context, *DismissBurnoutWarningRequest) (*DismissBurnoutWarningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissBurnoutWarning not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) LogFocusSession(context.Context, *LogFocusSessionRequest) (*LogFocusSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogFocusSession not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) DeleteFocusSession(context.Context, *DeleteFocusSessionRequest) (*DeleteFocusSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFocusSession not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) GetTodayTrack(context.Context, *TodayTrackRequest) (*TodayTrackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTodayTrack not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) PatchTodayTrack(context.Context, *PatchTodayTrackRequest) (*PatchTodayTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchTodayTrack not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) GetLastAnalyses(context.Context, *LastAnalysesRequest) (*LastAnalysesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLastAnalyses not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) GetBestTimeTomorrow(context.Context, *BestTimeTomorrowRequest) (*BestTimeTomorrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBestTimeTomorrow not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetTrends(context.Context, *TrendsRequest) (*TrendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrends not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetSuggestedReminderTime(context.Context, *SuggestedReminderTimeRequest) (*SuggestedReminderTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSuggestedReminderTime not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetTomorrowSchedule(context.Context, *TomorrowScheduleRequest) (*TomorrowScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTomorrowSchedule not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetWeeklyReport(context.Context, *WeeklyReportRequest) (*WeeklyReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeeklyReport not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) PreviewWithSettings(context.Context, *PreviewWithSettingsRequest) (*PreviewWithSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewWithSettings not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetMetricDefinitions(context.Context, *MetricDefinitionsRequest) (*MetricDefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetricDefinitions not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetNoteKeywords(context.Context, *NoteKeywordsRequest) (*NoteKeywordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteKeywords not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetContextBreakdown(context.Context, *ContextBreakdownRequest) (*ContextBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContextBreakdown not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetCorrelationMatrix(context.Context, *CorrelationMatrixRequest) (*CorrelationMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCorrelationMatrix not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetBedtimeTonight(context.Context, *BedtimeTonightRequest) (*BedtimeTonightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBedtimeTonight not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetStreakCalendar(context.Context, *StreakCalendarRequest) (*StreakCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStreakCalendar not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) GetMyProfile(context.Context, *GetMyProfileRequest) (*GetMyProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMyProfile not implemented")
 }
 func (UnimplementedAnalyzerServiceServer) UpdateMyProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMyProfile not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) UpdateHiddenMetrics(context.Context, *UpdateHiddenMetricsRequest) (*UpdateHiddenMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHiddenMetrics not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetGoals(context.Context, *GetGoalsRequest) (*GoalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoals not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) UpdateGoals(context.Context, *UpdateGoalsRequest) (*GoalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoals not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetBurnoutWebhook(context.Context, *GetBurnoutWebhookRequest) (*BurnoutWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBurnoutWebhook not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) SetBurnoutWebhook(context.Context, *SetBurnoutWebhookRequest) (*BurnoutWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBurnoutWebhook not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
 }
 func (UnimplementedAnalyzerServiceServer) GetUserLastAnalyses(context.Context, *GetUserLastAnalysesRequest) (*LastAnalysesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserLastAnalyses not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) GetFriendAnalysis(context.Context, *GetFriendAnalysisRequest) (*GetFriendAnalysisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendAnalysis not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) FindUserByEmail(context.Context, *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUserByEmail not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) ListFriends(context.Context, *ListFriendsRequest) (*ListFriendsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) ListFriendsEnriched(context.Context, *ListFriendsEnrichedRequest) (*ListFriendsEnrichedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriendsEnriched not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetActivityFeed(context.Context, *ActivityFeedRequest) (*ActivityFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityFeed not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) ListFriendRequests(context.Context, *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFriendRequests not implemented")
 }
@@ -241,6 +732,24 @@ func (UnimplementedAnalyzerServiceServer) SendFriendRequest(context.Context, *Se
 func (UnimplementedAnalyzerServiceServer) RespondFriendRequest(context.Context, *RespondFriendRequestRequest) (*RespondFriendRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RespondFriendRequest not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) CompareWithFriend(context.Context, *CompareWithFriendRequest) (*CompareWithFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareWithFriend not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetInsightDiff(context.Context, *InsightDiffRequest) (*InsightDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInsightDiff not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetAggregateStats(context.Context, *AggregateStatsRequest) (*AggregateStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAggregateStats not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) ListDeadAnalyses(context.Context, *ListDeadAnalysesRequest) (*ListDeadAnalysesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadAnalyses not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetProviderStatus(context.Context, *ProviderStatusRequest) (*ProviderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderStatus not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetPromptAggregates(context.Context, *PromptAggregatesRequest) (*PromptAggregatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromptAggregates not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) mustEmbedUnimplementedAnalyzerServiceServer() {}
 
 // UnsafeAnalyzerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -290,85 +799,499 @@ func _AnalyzerService_Analyze_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AnalyzerService_GetTodayTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TodayTrackRequest)
+func _AnalyzerService_RegenerateInsight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateInsightRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnalyzerServiceServer).GetTodayTrack(ctx, in)
+		return srv.(AnalyzerServiceServer).RegenerateInsight(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AnalyzerService_GetTodayTrack_FullMethodName,
+		FullMethod: AnalyzerService_RegenerateInsight_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnalyzerServiceServer).GetTodayTrack(ctx, req.(*TodayTrackRequest))
+		return srv.(AnalyzerServiceServer).RegenerateInsight(ctx, req.(*RegenerateInsightRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AnalyzerService_GetLastAnalyses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastAnalysesRequest)
+func _AnalyzerService_GetAnalysisForDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalysisForDateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnalyzerServiceServer).GetLastAnalyses(ctx, in)
+		return srv.(AnalyzerServiceServer).GetAnalysisForDate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AnalyzerService_GetLastAnalyses_FullMethodName,
+		FullMethod: AnalyzerService_GetAnalysisForDate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnalyzerServiceServer).GetLastAnalyses(ctx, req.(*LastAnalysesRequest))
+		return srv.(AnalyzerServiceServer).GetAnalysisForDate(ctx, req.(*AnalysisForDateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AnalyzerService_GetMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMyProfileRequest)
+func _AnalyzerService_DismissBurnoutWarning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissBurnoutWarningRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnalyzerServiceServer).GetMyProfile(ctx, in)
+		return srv.(AnalyzerServiceServer).DismissBurnoutWarning(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AnalyzerService_GetMyProfile_FullMethodName,
+		FullMethod: AnalyzerService_DismissBurnoutWarning_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnalyzerServiceServer).GetMyProfile(ctx, req.(*GetMyProfileRequest))
+		return srv.(AnalyzerServiceServer).DismissBurnoutWarning(ctx, req.(*DismissBurnoutWarningRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AnalyzerService_UpdateMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProfileRequest)
+func _AnalyzerService_LogFocusSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogFocusSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnalyzerServiceServer).UpdateMyProfile(ctx, in)
+		return srv.(AnalyzerServiceServer).LogFocusSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AnalyzerService_UpdateMyProfile_FullMethodName,
+		FullMethod: AnalyzerService_LogFocusSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnalyzerServiceServer).UpdateMyProfile(ctx, req.(*UpdateProfileRequest))
+		return srv.(AnalyzerServiceServer).LogFocusSession(ctx, req.(*LogFocusSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AnalyzerService_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserProfileRequest)
+func _AnalyzerService_DeleteFocusSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFocusSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnalyzerServiceServer).GetUserProfile(ctx, in)
+		return srv.(AnalyzerServiceServer).DeleteFocusSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_DeleteFocusSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).DeleteFocusSession(ctx, req.(*DeleteFocusSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetTodayTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TodayTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetTodayTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetTodayTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetTodayTrack(ctx, req.(*TodayTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_PatchTodayTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchTodayTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).PatchTodayTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_PatchTodayTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).PatchTodayTrack(ctx, req.(*PatchTodayTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetLastAnalyses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastAnalysesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetLastAnalyses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetLastAnalyses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetLastAnalyses(ctx, req.(*LastAnalysesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetBestTimeTomorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BestTimeTomorrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetBestTimeTomorrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetBestTimeTomorrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetBestTimeTomorrow(ctx, req.(*BestTimeTomorrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetTrends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetTrends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetTrends(ctx, req.(*TrendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetSuggestedReminderTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestedReminderTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetSuggestedReminderTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetSuggestedReminderTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetSuggestedReminderTime(ctx, req.(*SuggestedReminderTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetTomorrowSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TomorrowScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetTomorrowSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetTomorrowSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetTomorrowSchedule(ctx, req.(*TomorrowScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetWeeklyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WeeklyReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetWeeklyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetWeeklyReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetWeeklyReport(ctx, req.(*WeeklyReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_PreviewWithSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewWithSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).PreviewWithSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_PreviewWithSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).PreviewWithSettings(ctx, req.(*PreviewWithSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetMetricDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetMetricDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetMetricDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetMetricDefinitions(ctx, req.(*MetricDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetNoteKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteKeywordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetNoteKeywords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetNoteKeywords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetNoteKeywords(ctx, req.(*NoteKeywordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetContextBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContextBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetContextBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetContextBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetContextBreakdown(ctx, req.(*ContextBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetCorrelationMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrelationMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetCorrelationMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetCorrelationMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetCorrelationMatrix(ctx, req.(*CorrelationMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetBedtimeTonight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BedtimeTonightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetBedtimeTonight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetBedtimeTonight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetBedtimeTonight(ctx, req.(*BedtimeTonightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetStreakCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreakCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetStreakCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetStreakCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetStreakCalendar(ctx, req.(*StreakCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetMyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetMyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetMyProfile(ctx, req.(*GetMyProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_UpdateMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).UpdateMyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_UpdateMyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).UpdateMyProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_UpdateHiddenMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHiddenMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).UpdateHiddenMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_UpdateHiddenMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).UpdateHiddenMetrics(ctx, req.(*UpdateHiddenMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetGoals(ctx, req.(*GetGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_UpdateGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).UpdateGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_UpdateGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).UpdateGoals(ctx, req.(*UpdateGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetBurnoutWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBurnoutWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetBurnoutWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetBurnoutWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetBurnoutWebhook(ctx, req.(*GetBurnoutWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_SetBurnoutWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBurnoutWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).SetBurnoutWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_SetBurnoutWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).SetBurnoutWebhook(ctx, req.(*SetBurnoutWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetUserProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -398,6 +1321,24 @@ func _AnalyzerService_GetUserLastAnalyses_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyzerService_GetFriendAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetFriendAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetFriendAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetFriendAnalysis(ctx, req.(*GetFriendAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AnalyzerService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchUsersRequest)
 	if err := dec(in); err != nil {
@@ -416,6 +1357,24 @@ func _AnalyzerService_SearchUsers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyzerService_FindUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).FindUserByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_FindUserByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).FindUserByEmail(ctx, req.(*FindUserByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AnalyzerService_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFriendsRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +1393,42 @@ func _AnalyzerService_ListFriends_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyzerService_ListFriendsEnriched_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendsEnrichedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).ListFriendsEnriched(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_ListFriendsEnriched_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).ListFriendsEnriched(ctx, req.(*ListFriendsEnrichedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetActivityFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivityFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetActivityFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetActivityFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetActivityFeed(ctx, req.(*ActivityFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AnalyzerService_ListFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFriendRequestsRequest)
 	if err := dec(in); err != nil {
@@ -488,6 +1483,114 @@ func _AnalyzerService_RespondFriendRequest_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyzerService_CompareWithFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareWithFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).CompareWithFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_CompareWithFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).CompareWithFriend(ctx, req.(*CompareWithFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetInsightDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsightDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetInsightDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetInsightDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetInsightDiff(ctx, req.(*InsightDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetAggregateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetAggregateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetAggregateStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetAggregateStats(ctx, req.(*AggregateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_ListDeadAnalyses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadAnalysesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).ListDeadAnalyses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_ListDeadAnalyses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).ListDeadAnalyses(ctx, req.(*ListDeadAnalysesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetProviderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetProviderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetProviderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetProviderStatus(ctx, req.(*ProviderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetPromptAggregates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromptAggregatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetPromptAggregates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetPromptAggregates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetPromptAggregates(ctx, req.(*PromptAggregatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AnalyzerService_ServiceDesc is the grpc.ServiceDesc for AnalyzerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -503,14 +1606,86 @@ var AnalyzerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Analyze",
 			Handler:    _AnalyzerService_Analyze_Handler,
 		},
+		{
+			MethodName: "RegenerateInsight",
+			Handler:    _AnalyzerService_RegenerateInsight_Handler,
+		},
+		{
+			MethodName: "GetAnalysisForDate",
+			Handler:    _AnalyzerService_GetAnalysisForDate_Handler,
+		},
+		{
+			MethodName: "DismissBurnoutWarning",
+			Handler:    _AnalyzerService_DismissBurnoutWarning_Handler,
+		},
+		{
+			MethodName: "LogFocusSession",
+			Handler:    _AnalyzerService_LogFocusSession_Handler,
+		},
+		{
+			MethodName: "DeleteFocusSession",
+			Handler:    _AnalyzerService_DeleteFocusSession_Handler,
+		},
 		{
 			MethodName: "GetTodayTrack",
 			Handler:    _AnalyzerService_GetTodayTrack_Handler,
 		},
+		{
+			MethodName: "PatchTodayTrack",
+			Handler:    _AnalyzerService_PatchTodayTrack_Handler,
+		},
 		{
 			MethodName: "GetLastAnalyses",
 			Handler:    _AnalyzerService_GetLastAnalyses_Handler,
 		},
+		{
+			MethodName: "GetBestTimeTomorrow",
+			Handler:    _AnalyzerService_GetBestTimeTomorrow_Handler,
+		},
+		{
+			MethodName: "GetTrends",
+			Handler:    _AnalyzerService_GetTrends_Handler,
+		},
+		{
+			MethodName: "GetSuggestedReminderTime",
+			Handler:    _AnalyzerService_GetSuggestedReminderTime_Handler,
+		},
+		{
+			MethodName: "GetTomorrowSchedule",
+			Handler:    _AnalyzerService_GetTomorrowSchedule_Handler,
+		},
+		{
+			MethodName: "GetWeeklyReport",
+			Handler:    _AnalyzerService_GetWeeklyReport_Handler,
+		},
+		{
+			MethodName: "PreviewWithSettings",
+			Handler:    _AnalyzerService_PreviewWithSettings_Handler,
+		},
+		{
+			MethodName: "GetMetricDefinitions",
+			Handler:    _AnalyzerService_GetMetricDefinitions_Handler,
+		},
+		{
+			MethodName: "GetNoteKeywords",
+			Handler:    _AnalyzerService_GetNoteKeywords_Handler,
+		},
+		{
+			MethodName: "GetContextBreakdown",
+			Handler:    _AnalyzerService_GetContextBreakdown_Handler,
+		},
+		{
+			MethodName: "GetCorrelationMatrix",
+			Handler:    _AnalyzerService_GetCorrelationMatrix_Handler,
+		},
+		{
+			MethodName: "GetBedtimeTonight",
+			Handler:    _AnalyzerService_GetBedtimeTonight_Handler,
+		},
+		{
+			MethodName: "GetStreakCalendar",
+			Handler:    _AnalyzerService_GetStreakCalendar_Handler,
+		},
 		{
 			MethodName: "GetMyProfile",
 			Handler:    _AnalyzerService_GetMyProfile_Handler,
@@ -519,6 +1694,26 @@ var AnalyzerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMyProfile",
 			Handler:    _AnalyzerService_UpdateMyProfile_Handler,
 		},
+		{
+			MethodName: "UpdateHiddenMetrics",
+			Handler:    _AnalyzerService_UpdateHiddenMetrics_Handler,
+		},
+		{
+			MethodName: "GetGoals",
+			Handler:    _AnalyzerService_GetGoals_Handler,
+		},
+		{
+			MethodName: "UpdateGoals",
+			Handler:    _AnalyzerService_UpdateGoals_Handler,
+		},
+		{
+			MethodName: "GetBurnoutWebhook",
+			Handler:    _AnalyzerService_GetBurnoutWebhook_Handler,
+		},
+		{
+			MethodName: "SetBurnoutWebhook",
+			Handler:    _AnalyzerService_SetBurnoutWebhook_Handler,
+		},
 		{
 			MethodName: "GetUserProfile",
 			Handler:    _AnalyzerService_GetUserProfile_Handler,
@@ -527,14 +1722,30 @@ var AnalyzerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserLastAnalyses",
 			Handler:    _AnalyzerService_GetUserLastAnalyses_Handler,
 		},
+		{
+			MethodName: "GetFriendAnalysis",
+			Handler:    _AnalyzerService_GetFriendAnalysis_Handler,
+		},
 		{
 			MethodName: "SearchUsers",
 			Handler:    _AnalyzerService_SearchUsers_Handler,
 		},
+		{
+			MethodName: "FindUserByEmail",
+			Handler:    _AnalyzerService_FindUserByEmail_Handler,
+		},
 		{
 			MethodName: "ListFriends",
 			Handler:    _AnalyzerService_ListFriends_Handler,
 		},
+		{
+			MethodName: "ListFriendsEnriched",
+			Handler:    _AnalyzerService_ListFriendsEnriched_Handler,
+		},
+		{
+			MethodName: "GetActivityFeed",
+			Handler:    _AnalyzerService_GetActivityFeed_Handler,
+		},
 		{
 			MethodName: "ListFriendRequests",
 			Handler:    _AnalyzerService_ListFriendRequests_Handler,
@@ -547,6 +1758,30 @@ var AnalyzerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RespondFriendRequest",
 			Handler:    _AnalyzerService_RespondFriendRequest_Handler,
 		},
+		{
+			MethodName: "CompareWithFriend",
+			Handler:    _AnalyzerService_CompareWithFriend_Handler,
+		},
+		{
+			MethodName: "GetInsightDiff",
+			Handler:    _AnalyzerService_GetInsightDiff_Handler,
+		},
+		{
+			MethodName: "GetAggregateStats",
+			Handler:    _AnalyzerService_GetAggregateStats_Handler,
+		},
+		{
+			MethodName: "ListDeadAnalyses",
+			Handler:    _AnalyzerService_ListDeadAnalyses_Handler,
+		},
+		{
+			MethodName: "GetProviderStatus",
+			Handler:    _AnalyzerService_GetProviderStatus_Handler,
+		},
+		{
+			MethodName: "GetPromptAggregates",
+			Handler:    _AnalyzerService_GetPromptAggregates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/nexusai/v1/analyzer.proto",